@@ -3,8 +3,11 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"google.golang.org/api/option"
 
 	"pubsub-gui/internal/app"
+	"pubsub-gui/internal/audit"
 	"pubsub-gui/internal/auth"
 	"pubsub-gui/internal/config"
 	"pubsub-gui/internal/emulator"
@@ -22,6 +26,7 @@ import (
 	"pubsub-gui/internal/models"
 	"pubsub-gui/internal/pubsub/admin"
 	"pubsub-gui/internal/pubsub/publisher"
+	"pubsub-gui/internal/pubsub/schema"
 	"pubsub-gui/internal/pubsub/subscriber"
 	versionpkg "pubsub-gui/internal/version"
 )
@@ -36,10 +41,20 @@ type App struct {
 	topicMonitors  map[string]string // topicID -> temp subscriptionID
 	monitorsMu     sync.RWMutex
 
-	// Resource store for synchronized state
-	resourceMu    sync.RWMutex
-	topics        []admin.TopicInfo
-	subscriptions []admin.SubscriptionInfo
+	// Topics and subscriptions from the last sync, shared by the resource and monitoring handlers
+	resourceStore *app.ResourceStore
+
+	// Subscription→topic links from the last sync (connection-scoped)
+	subscriptionLinks *app.SubscriptionLinkCache
+
+	// Recent publishes (in memory)
+	publishHistory *app.PublishHistory
+
+	// Pending scheduled publishes (in memory, cancelled on disconnect)
+	publishScheduler *publisher.Scheduler
+
+	// Running publish loops (stopped on disconnect)
+	publishLoops *publisher.LoopRunner
 
 	// Handlers
 	connection                 *app.ConnectionHandler
@@ -49,11 +64,21 @@ type App struct {
 	monitoring                 *app.MonitoringHandler
 	configH                    *app.ConfigHandler
 	snapshots                  *app.SnapshotHandler
+	schemas                    *app.SchemaHandler
 	logs                       *app.LogsHandler
 
 	// Emulator manager for managed Docker emulator
 	emulatorManager *emulator.Manager
 
+	// Append-only audit trail of resource-changing operations
+	auditLog *audit.Logger
+
+	// In-flight publish/resource operations that Disconnect waits for
+	// A counter under a mutex rather than a WaitGroup: operations may start while Disconnect is waiting
+	inFlightMu    sync.Mutex
+	inFlightCount int
+	inFlightIdle  chan struct{} // Closed when the count drops to zero; replaced when an operation starts
+
 	// Track active profile for emulator lifecycle
 	activeProfileMu sync.RWMutex
 	activeProfile   *models.ConnectionProfile
@@ -67,14 +92,26 @@ type App struct {
 	upgradeCheckTicker *time.Ticker
 	upgradeCheckTimer  *time.Timer
 	upgradeCheckDone   chan struct{}
+
+	// Update downloaded by DownloadUpdate; the only file InstallUpdate accepts
+	updateMu         sync.Mutex
+	downloadedUpdate *versionpkg.DownloadedUpdate
 }
 
 // NewApp creates a new App application struct
 func NewApp() *App {
-	return &App{
-		activeMonitors: make(map[string]*subscriber.MessageStreamer),
-		topicMonitors:  make(map[string]string),
+	a := &App{
+		activeMonitors:    make(map[string]*subscriber.MessageStreamer),
+		topicMonitors:     make(map[string]string),
+		resourceStore:     app.NewResourceStore(),
+		subscriptionLinks: app.NewSubscriptionLinkCache(),
+		publishHistory:    app.NewPublishHistory(),
 	}
+	a.publishScheduler = publisher.NewScheduler(a.fireScheduledPublish)
+	a.publishLoops = publisher.NewLoopRunner(func(status publisher.PublishLoopStatus) {
+		runtime.EventsEmit(a.ctx, "publish:loop-progress", status)
+	})
+	return a
 }
 
 // startup is called when the app starts
@@ -107,13 +144,11 @@ func (a *App) startup(ctx context.Context) {
 	a.resources = app.NewResourceHandler(
 		a.ctx,
 		a.clientManager,
-		&a.resourceMu,
-		&a.topics,
-		&a.subscriptions,
+		a.resourceStore,
 	)
 
 	// Set emulator check function for better error handling
-	a.resources.SetEmulatorCheckFunc(func() bool {
+	isEmulatorEnabled := func() bool {
 		a.activeProfileMu.RLock()
 		profile := a.activeProfile
 		a.activeProfileMu.RUnlock()
@@ -121,7 +156,10 @@ func (a *App) startup(ctx context.Context) {
 			return profile.IsEmulatorEnabled()
 		}
 		return false
-	})
+	}
+	a.resources.SetEmulatorCheckFunc(isEmulatorEnabled)
+	a.resources.SetAPITimeoutFunc(func() time.Duration { return a.config.GetAPITimeout() })
+	a.resources.SetSubscriptionLinkCache(a.subscriptionLinks)
 
 	a.connection = app.NewConnectionHandler(
 		a.ctx,
@@ -144,9 +182,12 @@ func (a *App) startup(ctx context.Context) {
 		a.activeMonitors,
 		a.topicMonitors,
 		&a.monitorsMu,
-		&a.resourceMu,
-		&a.subscriptions,
+		a.resourceStore,
 	)
+	a.monitoring.SetEmulatorCheckFunc(isEmulatorEnabled)
+	a.monitoring.SetSubscriptionLinkCache(a.subscriptionLinks)
+	a.monitoring.SetPublishHistory(a.publishHistory)
+	go a.monitoring.RunBacklogHealthCheck()
 	a.configH = app.NewConfigHandler(
 		a.ctx,
 		a.config,
@@ -158,14 +199,70 @@ func (a *App) startup(ctx context.Context) {
 		a.ctx,
 		a.clientManager,
 	)
+	a.schemas = app.NewSchemaHandler(
+		a.ctx,
+		a.clientManager,
+	)
 	a.logs = app.NewLogsHandler()
+	logger.PruneLogs(a.config.GetLogRetentionDays())
 
 	// Initialize emulator manager
 	a.emulatorManager = emulator.NewManager(a.ctx)
+	a.emulatorManager.SetPortReassignedHandler(func(profileID string, configuredPort, assignedPort int) {
+		runtime.EventsEmit(a.ctx, "emulator:port-reassigned", map[string]interface{}{
+			"profileId":      profileID,
+			"configuredPort": configuredPort,
+			"port":           assignedPort,
+		})
+	})
+	a.emulatorManager.SetHealthHandler(func(profileID string, healthy bool, reason string) {
+		if healthy {
+			runtime.EventsEmit(a.ctx, "emulator:recovered", map[string]interface{}{
+				"profileId": profileID,
+			})
+			return
+		}
+		runtime.EventsEmit(a.ctx, "emulator:unhealthy", map[string]interface{}{
+			"profileId": profileID,
+			"reason":    reason,
+		})
+	})
+	a.emulatorManager.StartHealthChecks(a.config.GetEmulatorHealthCheckInterval())
+
+	// Probe the connection in the background and rebuild the client after sleep or network loss
+	a.clientManager.StartKeepalive(auth.KeepaliveOptions{
+		Reconnect: a.reconnectActiveProfile,
+		OnLost: func(err error) {
+			runtime.EventsEmit(a.ctx, "connection:lost", map[string]interface{}{
+				"error": err.Error(),
+			})
+		},
+		OnRestored: func(rebuilt bool) {
+			restarted := 0
+			if rebuilt {
+				restarted = a.monitoring.RestartMonitors()
+			}
+			runtime.EventsEmit(a.ctx, "connection:restored", map[string]interface{}{
+				"rebuilt":           rebuilt,
+				"restartedMonitors": restarted,
+			})
+		},
+	})
+
+	// Initialize audit trail (stored next to config, separate from debug logs)
+	auditLog, err := audit.NewLogger(filepath.Join(filepath.Dir(a.configManager.GetConfigPath()), "audit"))
+	if err != nil {
+		logger.Error("Error initializing audit log", "error", err)
+	} else {
+		a.auditLog = auditLog
+	}
 
 	// Log startup
 	logger.Info("Application started", "version", a.version)
 
+	// Flag misconfigured profiles before the user tries to connect with them
+	a.connection.ValidateAllProfiles()
+
 	// Auto-connect to active profile if set (persists across app restarts)
 	if a.config.ActiveProfileID != "" {
 		// Find the active profile
@@ -379,17 +476,14 @@ func (a *App) performUpgradeCheck() {
 		return
 	}
 
-	// Check if update is available and not dismissed
+	// Check if update is available, not dismissed and not snoozed
 	if updateInfo != nil && updateInfo.IsUpdateAvailable {
-		// Check if this version was already dismissed (with mutex protection)
+		// Check the user's upgrade preferences (with mutex protection)
 		a.upgradeCheckMu.Lock()
-		dismissedVersion := ""
-		if a.config != nil {
-			dismissedVersion = a.config.DismissedUpgradeVersion
-		}
+		prompt := a.config == nil || a.config.ShouldPromptUpgrade(updateInfo.LatestVersion, time.Now())
 		a.upgradeCheckMu.Unlock()
 
-		if dismissedVersion == updateInfo.LatestVersion {
+		if !prompt {
 			return
 		}
 
@@ -398,9 +492,9 @@ func (a *App) performUpgradeCheck() {
 	}
 }
 
-// DismissUpgradeNotification dismisses the upgrade notification for a specific version
-// Updates config with dismissed version and saves config
-func (a *App) DismissUpgradeNotification(version string) error {
+// DismissUpgrade stops upgrade prompts for a specific version; newer versions are still prompted
+// Updates config with dismissed version and saves config. An empty version clears the dismissal.
+func (a *App) DismissUpgrade(version string) error {
 	if a.config == nil {
 		return fmt.Errorf("config not initialized")
 	}
@@ -419,6 +513,126 @@ func (a *App) DismissUpgradeNotification(version string) error {
 	return nil
 }
 
+// SnoozeUpgrade stops all upgrade prompts for the given number of hours; 0 ends the snooze
+// Background checks keep running while snoozed.
+func (a *App) SnoozeUpgrade(hours int) error {
+	if a.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+	if err := models.ValidateUpgradeSnoozeHours(hours); err != nil {
+		return err
+	}
+
+	a.upgradeCheckMu.Lock()
+	if hours == 0 {
+		a.config.UpgradeSnoozedUntil = time.Time{}
+	} else {
+		a.config.UpgradeSnoozedUntil = time.Now().Add(time.Duration(hours) * time.Hour)
+	}
+	a.upgradeCheckMu.Unlock()
+
+	if a.configManager != nil {
+		if err := a.configManager.SaveConfig(a.config); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+	return nil
+}
+
+// UpgradePreferences is the upgrade check state shown in settings
+type UpgradePreferences struct {
+	AutoCheckUpgrades       bool      `json:"autoCheckUpgrades"`
+	UpgradeCheckInterval    int       `json:"upgradeCheckInterval"` // hours
+	LastUpgradeCheck        time.Time `json:"lastUpgradeCheck"`
+	DismissedUpgradeVersion string    `json:"dismissedUpgradeVersion"`
+	SnoozedUntil            time.Time `json:"snoozedUntil"` // Zero when not snoozed
+	Snoozed                 bool      `json:"snoozed"`
+}
+
+// GetUpgradePreferences returns the upgrade check settings, dismissed version and snooze state
+func (a *App) GetUpgradePreferences() (UpgradePreferences, error) {
+	if a.config == nil {
+		return UpgradePreferences{}, fmt.Errorf("config not initialized")
+	}
+
+	a.upgradeCheckMu.Lock()
+	defer a.upgradeCheckMu.Unlock()
+
+	prefs := UpgradePreferences{
+		AutoCheckUpgrades:       a.config.AutoCheckUpgrades,
+		UpgradeCheckInterval:    a.config.UpgradeCheckInterval,
+		LastUpgradeCheck:        a.config.LastUpgradeCheck,
+		DismissedUpgradeVersion: a.config.DismissedUpgradeVersion,
+	}
+	if time.Now().Before(a.config.UpgradeSnoozedUntil) {
+		prefs.SnoozedUntil = a.config.UpgradeSnoozedUntil
+		prefs.Snoozed = true
+	}
+	return prefs, nil
+}
+
+// GetLatestReleaseInfo returns the latest release with its markdown release notes for the upgrade dialog
+func (a *App) GetLatestReleaseInfo() (versionpkg.ReleaseInfo, error) {
+	versionpkg.SetVersion(a.GetVersion())
+	return versionpkg.GetLatestReleaseInfo()
+}
+
+// DownloadUpdate downloads the latest release for this OS and architecture and returns the downloaded file's path
+// Emits update:download-progress while downloading. The file is checked against the release checksums when published.
+// Only releases newer than the running version are downloaded; a previous download that was not installed is deleted.
+func (a *App) DownloadUpdate() (string, error) {
+	currentVersion := a.GetVersion()
+	versionpkg.SetVersion(currentVersion)
+	release, err := versionpkg.FetchLatestRelease()
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch latest release: %w", err)
+	}
+	if !versionpkg.IsNewer(currentVersion, release.TagName) {
+		return "", fmt.Errorf("release %s is not newer than the running version %s", release.TagName, currentVersion)
+	}
+
+	update, err := versionpkg.DownloadUpdate(a.ctx, release, func(progress versionpkg.DownloadProgress) {
+		runtime.EventsEmit(a.ctx, "update:download-progress", progress)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to download update: %w", err)
+	}
+
+	a.updateMu.Lock()
+	if a.downloadedUpdate != nil {
+		a.downloadedUpdate.Remove()
+	}
+	a.downloadedUpdate = &update
+	a.updateMu.Unlock()
+
+	logger.Info("Downloaded update", "version", update.Version, "path", update.Path)
+	return update.Path, nil
+}
+
+// InstallUpdate replaces the installed app with the file returned by the last DownloadUpdate call
+// Any other path is rejected, and the file's checksum is checked again before installing. The download is
+// deleted afterwards, whether or not the install succeeded. The update is used after the app is restarted.
+func (a *App) InstallUpdate(path string) error {
+	a.updateMu.Lock()
+	defer a.updateMu.Unlock()
+
+	update := a.downloadedUpdate
+	if update == nil {
+		return fmt.Errorf("no update has been downloaded")
+	}
+	if path != update.Path {
+		return fmt.Errorf("%s is not the downloaded update", path)
+	}
+	a.downloadedUpdate = nil
+
+	if err := versionpkg.InstallUpdate(*update); err != nil {
+		logger.Error("Failed to install update", "path", path, "error", err)
+		return fmt.Errorf("failed to install update: %w", err)
+	}
+	logger.Info("Installed update", "version", update.Version, "path", path)
+	return nil
+}
+
 // OpenReleasesPage opens the GitHub releases page in the default browser
 // Uses runtime.BrowserOpenURL(ctx, url) to open the URL
 func (a *App) OpenReleasesPage(url string) error {
@@ -441,11 +655,62 @@ func (a *App) ConnectWithServiceAccount(projectID, keyPath string, emulatorHost
 
 // ConnectWithOAuth connects to Pub/Sub using OAuth2 credentials
 func (a *App) ConnectWithOAuth(projectID, oauthClientPath string, emulatorHost string) error {
-	return a.connection.ConnectWithOAuth(projectID, oauthClientPath, emulatorHost)
+	return a.connection.ConnectWithOAuth(projectID, oauthClientPath, "", emulatorHost)
+}
+
+// ListOAuthAccounts returns the stored OAuth tokens with their Google account, profile and expiry
+func (a *App) ListOAuthAccounts() ([]auth.OAuthAccount, error) {
+	return a.connection.ListOAuthAccounts()
+}
+
+// RevokeOAuthAccount revokes a Google account's OAuth grant and deletes its stored tokens
+// A connection using the account is closed first.
+func (a *App) RevokeOAuthAccount(email string) error {
+	if status := a.connection.GetConnectionStatus(); status.IsConnected && status.AuthMethod == "OAuth" && strings.EqualFold(a.connection.GetIdentity(), email) {
+		if err := a.Disconnect(); err != nil {
+			return fmt.Errorf("failed to disconnect before revoking the account: %w", err)
+		}
+	}
+
+	err := a.connection.RevokeOAuthAccount(email)
+	a.recordAudit("revoke", "oauth-account", email, err)
+	return err
 }
 
 // Disconnect closes the current Pub/Sub connection
 func (a *App) Disconnect() error {
+	a.releaseProjectResources()
+	a.stopUpgradeCheck()
+
+	// Clear tracked emulator host
+	if a.connection != nil {
+		a.connection.ClearEmulatorHost()
+	}
+
+	// Stop managed emulator if autoStop is enabled
+	a.stopManagedEmulatorIfNeeded()
+
+	// Clear active profile
+	a.activeProfileMu.Lock()
+	a.activeProfile = nil
+	a.activeProfileMu.Unlock()
+
+	return a.clientManager.Close()
+}
+
+// releaseProjectResources stops everything bound to the connected project before its client goes away:
+// in-flight operations are given time to finish, scheduled publishes and publish loops are cancelled,
+// monitors are stopped, temporary subscriptions are deleted and the resource cache is cleared
+func (a *App) releaseProjectResources() {
+	// Let in-flight operations (publish, template create, ...) finish before tearing down the client
+	a.waitForInFlightOperations(disconnectGracePeriod)
+
+	if n := a.publishScheduler.CancelAll(); n > 0 {
+		logger.Info("Cancelled scheduled publishes", "count", n)
+	}
+	if n := a.publishLoops.StopAll(); n > 0 {
+		logger.Info("Stopped publish loops", "count", n)
+	}
 	a.stopAllMonitors()
 	time.Sleep(100 * time.Millisecond) // Give monitors a brief moment to start stopping
 
@@ -456,22 +721,153 @@ func (a *App) Disconnect() error {
 
 	a.cleanupTemporarySubscriptions(client, projectID)
 	a.clearResourceStore()
-	a.stopUpgradeCheck()
+}
 
-	// Clear tracked emulator host
-	if a.connection != nil {
-		a.connection.ClearEmulatorHost()
+// SwitchProject points the current ADC or OAuth connection at another project without creating or changing a profile
+// Credentials and emulator settings are kept. Monitors, publish loops and scheduled publishes of the previous
+// project are stopped first; if the switch fails, the previous project stays connected and its resources are
+// reloaded. Service account connections are rejected because a key belongs to one project.
+// Emits "connection:project-changed".
+func (a *App) SwitchProject(projectID string) error {
+	projectID = strings.TrimSpace(projectID)
+	if err := a.connection.CheckProjectSwitch(projectID); err != nil {
+		return err
+	}
+	previousProjectID := a.clientManager.GetProjectID()
+	if projectID == previousProjectID {
+		return nil
 	}
 
-	// Stop managed emulator if autoStop is enabled
-	a.stopManagedEmulatorIfNeeded()
+	a.releaseProjectResources()
+	if err := a.connection.SwitchProject(projectID); err != nil {
+		logger.Error("Failed to switch project", "from", previousProjectID, "to", projectID, "error", err)
+		// The new client is validated before it replaces the old one, so the previous project is still
+		// connected: reload its resources, which were cleared above
+		a.syncResources()
+		return err
+	}
 
-	// Clear active profile
+	// Keepalive rebuilds use the active profile: point the in-memory copy (not the saved profile) at the new project
 	a.activeProfileMu.Lock()
-	a.activeProfile = nil
+	if a.activeProfile != nil {
+		a.activeProfile.ProjectID = projectID
+	}
 	a.activeProfileMu.Unlock()
 
-	return a.clientManager.Close()
+	status := a.connection.GetConnectionStatus()
+	logger.Info("Switched project", "from", previousProjectID, "to", projectID, "authMethod", status.AuthMethod)
+	runtime.EventsEmit(a.ctx, "connection:project-changed", map[string]interface{}{
+		"previousProjectId": previousProjectID,
+		"projectId":         projectID,
+		"authMethod":        status.AuthMethod,
+	})
+	return nil
+}
+
+// SetTestMode forces every connection to the given emulator host, ignoring profiles' real-GCP settings
+// An active connection is dropped first and, if it came from a profile, re-established against the emulator,
+// so nothing keeps talking to real GCP. Test mode stays on until ClearTestMode is called.
+func (a *App) SetTestMode(emulatorHost string) error {
+	emulatorHost = strings.TrimSpace(emulatorHost)
+	if emulatorHost == "" {
+		return fmt.Errorf("emulator host is required; use ClearTestMode to leave test mode")
+	}
+
+	a.activeProfileMu.RLock()
+	var profile *models.ConnectionProfile
+	if a.activeProfile != nil {
+		profileCopy := *a.activeProfile
+		profile = &profileCopy
+	}
+	a.activeProfileMu.RUnlock()
+
+	wasConnected := a.clientManager.IsConnected()
+	if wasConnected {
+		if err := a.Disconnect(); err != nil {
+			return fmt.Errorf("failed to disconnect before entering test mode: %w", err)
+		}
+	}
+
+	a.connection.SetTestMode(emulatorHost)
+	logger.Info("Test mode enabled", "emulatorHost", emulatorHost)
+	runtime.EventsEmit(a.ctx, "connection:test-mode", map[string]interface{}{
+		"enabled":      true,
+		"emulatorHost": emulatorHost,
+	})
+
+	if wasConnected && profile != nil {
+		if err := a.connectWithProfile(profile); err != nil {
+			return fmt.Errorf("test mode enabled but reconnecting to the emulator failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ClearTestMode leaves test mode and disconnects, since the current connection points at the test emulator
+// Reconnecting (possibly to real GCP) is left to the user
+func (a *App) ClearTestMode() error {
+	if a.connection.TestModeHost() == "" {
+		return nil
+	}
+
+	if a.clientManager.IsConnected() {
+		if err := a.Disconnect(); err != nil {
+			return fmt.Errorf("failed to disconnect while leaving test mode: %w", err)
+		}
+	}
+
+	a.connection.ClearTestMode()
+	logger.Info("Test mode disabled")
+	runtime.EventsEmit(a.ctx, "connection:test-mode", map[string]interface{}{
+		"enabled": false,
+	})
+
+	return nil
+}
+
+// disconnectGracePeriod is how long Disconnect waits for in-flight operations before forcing
+const disconnectGracePeriod = 10 * time.Second
+
+// trackOperation marks an operation as in flight until the returned function is called
+// Usage: defer a.trackOperation()()
+func (a *App) trackOperation() func() {
+	a.inFlightMu.Lock()
+	if a.inFlightCount == 0 {
+		a.inFlightIdle = make(chan struct{})
+	}
+	a.inFlightCount++
+	a.inFlightMu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			a.inFlightMu.Lock()
+			defer a.inFlightMu.Unlock()
+			a.inFlightCount--
+			if a.inFlightCount == 0 {
+				close(a.inFlightIdle)
+			}
+		})
+	}
+}
+
+// waitForInFlightOperations waits for tracked operations to complete, up to timeout
+// After the timeout the caller proceeds anyway and a warning is logged
+func (a *App) waitForInFlightOperations(timeout time.Duration) {
+	a.inFlightMu.Lock()
+	if a.inFlightCount == 0 {
+		a.inFlightMu.Unlock()
+		return
+	}
+	idle := a.inFlightIdle
+	a.inFlightMu.Unlock()
+
+	select {
+	case <-idle:
+	case <-time.After(timeout):
+		logger.Warn("Forcing disconnect with operations still in flight", "timeout", timeout.String())
+	}
 }
 
 // stopManagedEmulatorIfNeeded stops the managed emulator if autoStop is enabled
@@ -572,10 +968,16 @@ func (a *App) cleanupTemporarySubscriptions(client *pubsub.Client, projectID str
 
 // clearResourceStore clears the resource store (initialize to empty slices instead of nil)
 func (a *App) clearResourceStore() {
-	a.resourceMu.Lock()
-	a.topics = []admin.TopicInfo{}
-	a.subscriptions = []admin.SubscriptionInfo{}
-	a.resourceMu.Unlock()
+	// A sync still in flight must not repopulate the cleared store
+	if a.resources != nil {
+		a.resources.CancelSync()
+	}
+	a.resourceStore.Clear()
+
+	if a.subscriptionLinks != nil {
+		a.subscriptionLinks.Clear()
+	}
+	admin.ClearTopicExistenceCache()
 }
 
 // stopUpgradeCheck stops upgrade check ticker and timer if running
@@ -626,9 +1028,22 @@ func (a *App) SaveProfile(profile models.ConnectionProfile) error {
 	return a.connection.SaveProfile(profile)
 }
 
+// ValidateAllProfiles validates every stored profile and returns the invalid ones with reasons
+// Emits "profiles:validation" with the same list
+func (a *App) ValidateAllProfiles() []app.ProfileValidationIssue {
+	return a.connection.ValidateAllProfiles()
+}
+
+// ImportGcloudConfigurations proposes ADC profiles from the user's gcloud configurations without saving them
+// Projects that already have a profile are skipped; the returned profiles can be passed to SaveProfile
+func (a *App) ImportGcloudConfigurations() ([]models.ConnectionProfile, error) {
+	return a.connection.ImportGcloudConfigurations()
+}
+
 // DeleteProfile removes a connection profile from the configuration
+// Any managed emulator container belonging to the profile is stopped and removed first
 func (a *App) DeleteProfile(profileID string) error {
-	return a.connection.DeleteProfile(profileID, a.Disconnect)
+	return a.connection.DeleteProfile(profileID, a.Disconnect, a.emulatorManager.Remove)
 }
 
 // SwitchProfile switches to a different connection profile
@@ -636,10 +1051,66 @@ func (a *App) SwitchProfile(profileID string) error {
 	return a.connection.SwitchProfile(profileID, a.Disconnect)
 }
 
+// findProfile returns a copy of the saved profile with the given ID, or ErrProfileNotFound
+func (a *App) findProfile(profileID string) (models.ConnectionProfile, error) {
+	for _, profile := range a.config.Profiles {
+		if profile.ID == profileID {
+			return profile, nil
+		}
+	}
+	return models.ConnectionProfile{}, models.ErrProfileNotFound
+}
+
+// TestProfile checks that a saved profile can connect, without switching to it
+// Managed emulator profiles also check the container runtime; a stopped emulator is not started.
+func (a *App) TestProfile(profileID string) (app.ProfileTestResult, error) {
+	profile, err := a.findProfile(profileID)
+	if err != nil {
+		return app.ProfileTestResult{}, err
+	}
+
+	emulatorHost := profile.GetEffectiveEmulatorHost()
+	if a.connection.TestModeHost() == "" && profile.GetEffectiveEmulatorMode() == models.EmulatorModeManaged {
+		preference := ""
+		if profile.ManagedEmulator != nil {
+			preference = profile.ManagedEmulator.Runtime
+		}
+		rt, err := a.emulatorManager.CheckRuntime(preference)
+		if err != nil {
+			return app.ProfileTestResult{
+				ProfileID:  profileID,
+				AuthMethod: profile.AuthMethod,
+				Error:      fmt.Sprintf("container runtime required for managed emulator: %v", err),
+			}, nil
+		}
+
+		host, running := a.emulatorManager.ConnectHost(profileID)
+		if !running {
+			return app.ProfileTestResult{
+				ProfileID:        profileID,
+				Success:          true,
+				AuthMethod:       profile.AuthMethod,
+				EmulatorHost:     emulatorHost,
+				ContainerRuntime: rt.Name(),
+				Message:          fmt.Sprintf("%s is available; the emulator is not running, so it was not contacted", rt.Name()),
+			}, nil
+		}
+		result := a.connection.TestProfile(profile, host)
+		result.ContainerRuntime = rt.Name()
+		return result, nil
+	}
+
+	return a.connection.TestProfile(profile, emulatorHost), nil
+}
+
 // connectWithProfile is a helper method to connect using a profile's settings
 func (a *App) connectWithProfile(profile *models.ConnectionProfile) error {
 	// Handle managed emulator mode
 	emulatorMode := profile.GetEffectiveEmulatorMode()
+	// In test mode every connection goes to the test emulator, so the profile's managed emulator is not needed
+	if a.connection.TestModeHost() != "" {
+		emulatorMode = models.EmulatorModeExternal
+	}
 	if emulatorMode == models.EmulatorModeManaged {
 		// Get or create managed emulator config
 		config := profile.ManagedEmulator
@@ -648,9 +1119,9 @@ func (a *App) connectWithProfile(profile *models.ConnectionProfile) error {
 			config = &defaultConfig
 		}
 
-		// Check Docker availability
-		if err := a.emulatorManager.CheckDocker(); err != nil {
-			return fmt.Errorf("docker required for managed emulator: %w", err)
+		// Check container runtime availability
+		if _, err := a.emulatorManager.CheckRuntime(config.Runtime); err != nil {
+			return fmt.Errorf("container runtime required for managed emulator: %w", err)
 		}
 
 		// Start emulator if autoStart is enabled (default: true)
@@ -679,7 +1150,13 @@ func (a *App) connectWithProfile(profile *models.ConnectionProfile) error {
 	}
 
 	// Get effective emulator host (works for both external and managed modes)
+	// A managed emulator may have been assigned a different port than configured, so ask the manager first
 	emulatorHost := profile.GetEffectiveEmulatorHost()
+	if emulatorMode == models.EmulatorModeManaged {
+		if host, ok := a.emulatorManager.ConnectHost(profile.ID); ok {
+			emulatorHost = host
+		}
+	}
 
 	// Store active profile for disconnect cleanup
 	a.activeProfileMu.Lock()
@@ -690,26 +1167,46 @@ func (a *App) connectWithProfile(profile *models.ConnectionProfile) error {
 	// Set emulator mode for status display
 	a.connection.SetEmulatorMode(string(emulatorMode))
 
-	var err error
-	switch profile.AuthMethod {
-	case "ADC":
-		err = a.connection.ConnectWithADC(profile.ProjectID, emulatorHost)
-	case "ServiceAccount":
-		err = a.connection.ConnectWithServiceAccount(profile.ProjectID, profile.ServiceAccountPath, emulatorHost)
-	case "OAuth":
-		err = a.connection.ConnectWithOAuth(profile.ProjectID, profile.OAuthClientPath, emulatorHost)
-	default:
-		err = fmt.Errorf("unsupported auth method: %s", profile.AuthMethod)
-	}
+	err := a.connection.ConnectProfile(profile, emulatorHost)
 
 	// If connection failed and we started a managed emulator, stop it
 	if err != nil && emulatorMode == models.EmulatorModeManaged {
 		a.emulatorManager.Stop(profile.ID)
 	}
 
+	// Prepare the profile's data directory so persistence features never write into another profile's
+	if err == nil {
+		if _, dirErr := a.GetProfileDataDir(profile.ID); dirErr != nil {
+			logger.Warn("Failed to create profile data directory", "profileId", profile.ID, "error", dirErr)
+		}
+		a.attachPublishHistory(profile.ID)
+	}
+
 	return err
 }
 
+// reconnectActiveProfile rebuilds the Pub/Sub client from the active profile after the connection was lost
+func (a *App) reconnectActiveProfile() error {
+	a.activeProfileMu.RLock()
+	var profile models.ConnectionProfile
+	hasProfile := a.activeProfile != nil
+	if hasProfile {
+		profile = *a.activeProfile
+	}
+	a.activeProfileMu.RUnlock()
+
+	if !hasProfile {
+		return fmt.Errorf("no active profile to reconnect with")
+	}
+	return a.connection.Reconnect(profile)
+}
+
+// GetProfileDataDir returns the directory where persisted data of a profile is stored, creating it if needed
+// All persistence features (saved buffers, recordings, sessions) write below this directory
+func (a *App) GetProfileDataDir(profileID string) (string, error) {
+	return config.EnsureProfileDataDir(filepath.Dir(a.configManager.GetConfigPath()), profileID)
+}
+
 // SyncResources manually triggers a resource sync (exposed for frontend refresh button)
 func (a *App) SyncResources() error {
 	return a.resources.SyncResources()
@@ -720,6 +1217,16 @@ func (a *App) syncResources() {
 	go a.resources.SyncResources()
 }
 
+// ExportCatalog writes a JSON catalog of all topics and subscriptions (full configs and relationships) to outPath
+func (a *App) ExportCatalog(outPath string) error {
+	return a.resources.ExportCatalog(outPath, a.GetVersion())
+}
+
+// GetResourceCounts returns topic and subscription counts (by delivery type) from the cached store
+func (a *App) GetResourceCounts() app.ResourceCounts {
+	return a.resources.GetResourceCounts()
+}
+
 // ListTopics returns all topics in the connected project (from cached store)
 func (a *App) ListTopics() ([]admin.TopicInfo, error) {
 	return a.resources.ListTopics()
@@ -730,6 +1237,23 @@ func (a *App) ListSubscriptions() ([]admin.SubscriptionInfo, error) {
 	return a.resources.ListSubscriptions()
 }
 
+// ListTopicsPage lists one page of topics from the API for projects too large to browse from the cache
+// Pass the returned nextPageToken to get the following page; prefix filters by short ID within each page.
+func (a *App) ListTopicsPage(pageSize int, pageToken, prefix string) (admin.TopicsPage, error) {
+	return a.resources.ListTopicsPage(pageSize, pageToken, prefix)
+}
+
+// ListSubscriptionsPage lists one page of subscriptions from the API, like ListTopicsPage
+func (a *App) ListSubscriptionsPage(pageSize int, pageToken, prefix string) (admin.SubscriptionsPage, error) {
+	return a.resources.ListSubscriptionsPage(pageSize, pageToken, prefix)
+}
+
+// ResolveResourceName returns the canonical short and fully-qualified names of a topic, subscription,
+// snapshot or schema so the frontend can display and copy the full resource path
+func (a *App) ResolveResourceName(resourceType, name string) (app.ResourceName, error) {
+	return a.resources.ResolveResourceName(resourceType, name)
+}
+
 // GetTopicMetadata retrieves metadata for a specific topic
 func (a *App) GetTopicMetadata(topicID string) (admin.TopicInfo, error) {
 	return a.resources.GetTopicMetadata(topicID)
@@ -744,14 +1268,61 @@ func (a *App) GetSubscriptionMetadata(subID string) (admin.SubscriptionInfo, err
 // have been removed. The frontend now filters relationships locally from the synchronized resource store
 // for instant updates without API roundtrips.
 
-// CreateTopic creates a new topic with optional message retention duration
-func (a *App) CreateTopic(topicID string, messageRetentionDuration string) error {
-	return a.resources.CreateTopic(topicID, messageRetentionDuration, a.syncResources)
+// CreateTopic creates a new topic with optional message retention duration, labels and schema (nil for none)
+func (a *App) CreateTopic(topicID string, messageRetentionDuration string, labels map[string]string, schemaSettings *admin.SchemaSettings) error {
+	defer a.trackOperation()()
+
+	err := a.resources.CreateTopic(topicID, messageRetentionDuration, labels, schemaSettings, a.syncResources)
+	a.recordAudit("create", "topic", topicID, err)
+	return err
+}
+
+// UpdateTopic updates a topic's labels and/or message retention duration
+func (a *App) UpdateTopic(topicID string, params admin.TopicUpdateParams) error {
+	defer a.trackOperation()()
+
+	err := a.resources.UpdateTopic(topicID, params, a.syncResources)
+	a.recordAudit("update", "topic", topicID, err)
+	return err
 }
 
 // DeleteTopic deletes a topic
 func (a *App) DeleteTopic(topicID string) error {
-	return a.resources.DeleteTopic(topicID, a.syncResources)
+	defer a.trackOperation()()
+
+	err := a.resources.DeleteTopic(topicID, a.syncResources)
+	a.recordAudit("delete", "topic", topicID, err)
+	return err
+}
+
+// GetTopicIAMPolicy returns a topic's IAM policy (not available on emulators)
+func (a *App) GetTopicIAMPolicy(topicID string) (admin.IAMPolicy, error) {
+	return a.resources.GetTopicIAMPolicy(topicID)
+}
+
+// SetTopicIAMPolicy replaces a topic's IAM policy and returns the stored policy
+// The policy must carry the etag it was read with; a concurrent change fails with "policy changed, please refresh".
+func (a *App) SetTopicIAMPolicy(topicID string, policy admin.IAMPolicy) (admin.IAMPolicy, error) {
+	defer a.trackOperation()()
+
+	updated, err := a.resources.SetTopicIAMPolicy(topicID, policy)
+	a.recordAudit("set-iam-policy", "topic", topicID, err)
+	return updated, err
+}
+
+// GetSubscriptionIAMPolicy returns a subscription's IAM policy (not available on emulators)
+func (a *App) GetSubscriptionIAMPolicy(subID string) (admin.IAMPolicy, error) {
+	return a.resources.GetSubscriptionIAMPolicy(subID)
+}
+
+// SetSubscriptionIAMPolicy replaces a subscription's IAM policy and returns the stored policy
+// The policy must carry the etag it was read with; a concurrent change fails with "policy changed, please refresh".
+func (a *App) SetSubscriptionIAMPolicy(subID string, policy admin.IAMPolicy) (admin.IAMPolicy, error) {
+	defer a.trackOperation()()
+
+	updated, err := a.resources.SetSubscriptionIAMPolicy(subID, policy)
+	a.recordAudit("set-iam-policy", "subscription", subID, err)
+	return updated, err
 }
 
 // SubscriptionUpdateParams represents parameters for updating a subscription
@@ -759,30 +1330,216 @@ type SubscriptionUpdateParams = app.SubscriptionUpdateParams
 
 // CreateSubscription creates a new subscription for a topic
 func (a *App) CreateSubscription(topicID string, subID string, ttlSeconds int64) error {
-	return a.resources.CreateSubscription(topicID, subID, ttlSeconds, a.syncResources)
-}
+	defer a.trackOperation()()
 
-// DeleteSubscription deletes a subscription
-func (a *App) DeleteSubscription(subID string) error {
-	return a.resources.DeleteSubscription(subID, a.syncResources)
+	err := a.resources.CreateSubscription(topicID, subID, ttlSeconds, a.syncResources)
+	a.recordAudit("create", "subscription", subID, err)
+	return err
 }
 
-// UpdateSubscription updates a subscription's configuration
-func (a *App) UpdateSubscription(subID string, params SubscriptionUpdateParams) error {
-	return a.resources.UpdateSubscription(subID, params, a.syncResources)
+// TestSubscriptionFilter parses a subscription filter locally and reports whether a sample message would match it
+// Filters only see attributes: samplePayload never affects the result, and filters referring to the payload are
+// rejected. Syntax errors include the 1-based position of the problem.
+func (a *App) TestSubscriptionFilter(filter, samplePayload string, sampleAttributes map[string]string) (bool, error) {
+	parsed, err := subscriber.ParseFilter(filter)
+	if err != nil {
+		return false, err
+	}
+	return parsed.Matches(sampleAttributes), nil
 }
 
-// SeekToTimestamp seeks a subscription to a specific timestamp.
-// Messages published after the timestamp will be redelivered.
-// The timestamp should be in RFC3339 format (e.g., "2024-01-15T10:30:00Z").
-func (a *App) SeekToTimestamp(subscriptionID, timestamp string) error {
-	return a.resources.SeekToTimestamp(subscriptionID, timestamp, a.syncResources)
-}
+// CloneSubscription creates newSubID on the topic of sourceSubID with the source's configuration
+// (ack deadline, retention, filter, retry and dead letter policies, ordering, exactly-once, delivery type, labels)
+// and overrides applied on top. Fails if newSubID already exists.
+func (a *App) CloneSubscription(sourceSubID, newSubID string, overrides admin.SubscriptionUpdateParams) error {
+	defer a.trackOperation()()
 
-// SeekToSnapshot seeks a subscription to a snapshot.
-// Messages in the snapshot will be redelivered.
-func (a *App) SeekToSnapshot(subscriptionID, snapshotID string) error {
-	return a.resources.SeekToSnapshot(subscriptionID, snapshotID, a.syncResources)
+	err := a.resources.CloneSubscription(sourceSubID, newSubID, overrides, a.syncResources)
+	a.recordAudit("create", "subscription", newSubID, err)
+	return err
+}
+
+// EnsureTopicAndSubscription idempotently creates a topic and a subscription on it
+// Returns which of the two were created; an existing subscription that differs from subConfig is reported in warnings
+func (a *App) EnsureTopicAndSubscription(topicID, subID string, subConfig admin.SubscriptionConfig) (*admin.EnsureResult, error) {
+	defer a.trackOperation()()
+
+	result, err := a.resources.EnsureTopicAndSubscription(topicID, subID, subConfig, a.syncResources)
+	if err != nil {
+		a.recordAudit("create", "subscription", subID, err)
+		return nil, err
+	}
+	if result.TopicCreated {
+		a.recordAudit("create", "topic", topicID, nil)
+	}
+	if result.SubscriptionCreated {
+		a.recordAudit("create", "subscription", subID, nil)
+	}
+	return result, nil
+}
+
+// PreviewDelete lists what deleting a topic or subscription would affect (attached and monitored
+// subscriptions, dead letter relationships), derived from the resource cache
+func (a *App) PreviewDelete(resourceType, id string) (*app.DeletePreview, error) {
+	monitored := make(map[string]bool)
+	for _, monitor := range a.monitoring.GetActiveMonitors() {
+		monitored[monitor.SubscriptionID] = true
+	}
+	return a.resources.PreviewDelete(resourceType, id, monitored)
+}
+
+// GetDeadLetterChain returns the dead letter topic of a subscription and the subscriptions reading it,
+// resolved from the resource cache; the chain is empty (Configured false) when no dead letter policy is set
+func (a *App) GetDeadLetterChain(subID string) (app.DeadLetterChain, error) {
+	return a.resources.GetDeadLetterChain(subID)
+}
+
+// DeleteSubscription deletes a subscription
+func (a *App) DeleteSubscription(subID string) error {
+	defer a.trackOperation()()
+
+	err := a.resources.DeleteSubscription(subID, a.syncResources)
+	a.recordAudit("delete", "subscription", subID, err)
+	return err
+}
+
+// BulkDeleteResources deletes subscriptions and then topics, continuing past per-resource failures
+// With dryRun nothing is deleted: the result lists what would be, with dependency warnings (e.g. a topic that
+// remains the dead letter topic of a kept subscription). Monitors of deleted subscriptions are stopped first.
+// Resources are synced once at the end; emits "resources:bulk-deleted".
+func (a *App) BulkDeleteResources(topicIDs, subscriptionIDs []string, dryRun bool) (app.BulkDeleteResult, error) {
+	defer a.trackOperation()()
+
+	monitored := make(map[string]bool)
+	for _, monitor := range a.monitoring.GetActiveMonitors() {
+		monitored[monitor.SubscriptionID] = true
+	}
+
+	stopMonitor := func(subID string) {
+		if a.monitoring.IsMonitoring(subID) {
+			if err := a.monitoring.StopMonitor(subID); err != nil {
+				logger.Warn("Failed to stop monitor before deleting", "subscriptionId", subID, "error", err)
+			}
+		}
+	}
+	audit := func(item app.BulkDeleteItem) {
+		var err error
+		if item.Error != "" {
+			err = errors.New(item.Error)
+		}
+		a.recordAudit("delete", item.ResourceType, item.ID, err)
+	}
+
+	return a.resources.BulkDelete(topicIDs, subscriptionIDs, dryRun, monitored, stopMonitor, audit, a.syncResources)
+}
+
+// DetachSubscription detaches a subscription from its topic: delivery stops but the subscription is kept
+// A running monitor on the subscription is stopped first.
+func (a *App) DetachSubscription(subID string) error {
+	defer a.trackOperation()()
+
+	if a.monitoring.IsMonitoring(subID) {
+		if err := a.monitoring.StopMonitor(subID); err != nil {
+			logger.Warn("Failed to stop monitor before detaching", "subscriptionId", subID, "error", err)
+		}
+	}
+
+	err := a.resources.DetachSubscription(subID, a.syncResources)
+	a.recordAudit("detach", "subscription", subID, err)
+	return err
+}
+
+// UpdateSubscription updates a subscription's configuration
+func (a *App) UpdateSubscription(subID string, params SubscriptionUpdateParams) error {
+	defer a.trackOperation()()
+
+	err := a.resources.UpdateSubscription(subID, params, a.syncResources)
+	a.recordAudit("update", "subscription", subID, err)
+	return err
+}
+
+// SeekSubscription seeks a subscription to a specific timestamp.
+// Messages published after the timestamp will be redelivered.
+// The timestamp must be in RFC3339 format (e.g., "2024-01-15T10:30:00Z") and not older than the retention window.
+func (a *App) SeekSubscription(subID string, rfc3339Time string) error {
+	defer a.trackOperation()()
+
+	err := a.resources.SeekSubscription(subID, rfc3339Time, a.syncResources)
+	a.recordAudit("seek", "subscription", subID, err)
+	if err == nil {
+		a.clearBufferAfterSeek(subID)
+	}
+	return err
+}
+
+// SeekToTimestamp is the former name of SeekSubscription, kept for existing callers
+func (a *App) SeekToTimestamp(subscriptionID, timestamp string) error {
+	return a.SeekSubscription(subscriptionID, timestamp)
+}
+
+// PurgeSubscription discards a subscription's backlog by seeking it to now
+// Works for pull and push subscriptions; an active monitor's buffer is cleared too.
+// Fails with a clear error on emulators that do not implement seek.
+func (a *App) PurgeSubscription(subID string) error {
+	defer a.trackOperation()()
+
+	err := a.resources.PurgeSubscription(subID, a.syncResources)
+	a.recordAudit("purge", "subscription", subID, err)
+	if err == nil {
+		a.clearBufferAfterSeek(subID)
+	}
+	return err
+}
+
+// clearBufferAfterSeek drops the buffered messages of an active monitor on a seeked subscription
+// so the monitor only shows the redelivered messages
+func (a *App) clearBufferAfterSeek(subID string) {
+	if !a.monitoring.IsMonitoring(subID) {
+		return
+	}
+	if _, err := a.monitoring.ClearMessageBuffer(subID); err != nil {
+		logger.Warn("Failed to clear monitor buffer after seek", "subscriptionID", subID, "error", err)
+	}
+}
+
+// ReplayLast redelivers the last duration (e.g., "10m") of messages on a subscription
+// Enables retain_acked_messages after user confirmation if needed, then seeks to now-duration
+func (a *App) ReplayLast(subID string, duration string) (*app.ReplayResult, error) {
+	defer a.trackOperation()()
+
+	result, err := a.resources.ReplayLast(subID, duration, a.syncResources)
+	if result != nil && result.EnabledRetainAcked {
+		a.recordAudit("update", "subscription", subID, nil)
+	}
+	a.recordAudit("seek", "subscription", subID, err)
+	if err == nil {
+		a.clearBufferAfterSeek(subID)
+	}
+	return result, err
+}
+
+// SeekToSnapshot seeks a subscription to a snapshot.
+// Messages in the snapshot will be redelivered.
+func (a *App) SeekToSnapshot(subscriptionID, snapshotID string) error {
+	defer a.trackOperation()()
+
+	err := a.resources.SeekToSnapshot(subscriptionID, snapshotID, a.syncResources)
+	a.recordAudit("seek", "subscription", subscriptionID, err)
+	if err == nil {
+		a.clearBufferAfterSeek(subscriptionID)
+	}
+	return err
+}
+
+// ListSchemas returns all schemas in the project (without definitions)
+func (a *App) ListSchemas() ([]schema.SchemaInfo, error) {
+	return a.schemas.ListSchemas()
+}
+
+// ValidateMessageAgainstSchema checks a JSON payload against a schema before publishing
+// Validation failures carry the API's message verbatim
+func (a *App) ValidateMessageAgainstSchema(schemaName, payload string) error {
+	return a.schemas.ValidateMessageAgainstSchema(schemaName, payload)
 }
 
 // ListSnapshots returns all snapshots in the project
@@ -803,9 +1560,12 @@ func (a *App) GetSnapshot(snapshotID string) (admin.SnapshotInfo, error) {
 
 // CreateSnapshot creates a new snapshot from a subscription
 func (a *App) CreateSnapshot(subscriptionID, snapshotID string) error {
+	defer a.trackOperation()()
+
 	// opts is for future snapshot creation options/metadata (e.g., labels, expiration)
 	var opts map[string]string = nil
 	err := a.snapshots.CreateSnapshot(subscriptionID, snapshotID, opts)
+	a.recordAudit("create", "snapshot", snapshotID, err)
 	if err != nil {
 		return err
 	}
@@ -815,13 +1575,17 @@ func (a *App) CreateSnapshot(subscriptionID, snapshotID string) error {
 		"subscriptionID": subscriptionID,
 		"snapshotID":     snapshotID,
 	})
+	a.syncResources()
 
 	return nil
 }
 
 // DeleteSnapshot deletes a snapshot
 func (a *App) DeleteSnapshot(snapshotID string) error {
+	defer a.trackOperation()()
+
 	err := a.snapshots.DeleteSnapshot(snapshotID)
+	a.recordAudit("delete", "snapshot", snapshotID, err)
 	if err != nil {
 		return err
 	}
@@ -830,6 +1594,7 @@ func (a *App) DeleteSnapshot(snapshotID string) error {
 	runtime.EventsEmit(a.ctx, "snapshot:deleted", map[string]interface{}{
 		"snapshotID": snapshotID,
 	})
+	a.syncResources()
 
 	return nil
 }
@@ -856,14 +1621,51 @@ func (a *App) DeleteTemplate(templateID string) error {
 	return a.templates.DeleteTemplate(templateID)
 }
 
+// ListAttributeTemplates returns the saved attribute templates
+func (a *App) ListAttributeTemplates() []models.AttributeTemplate {
+	return a.templates.ListAttributeTemplates()
+}
+
+// SaveAttributeTemplate creates an attribute template (empty ID) or replaces the one with the same ID
+func (a *App) SaveAttributeTemplate(template models.AttributeTemplate) (models.AttributeTemplate, error) {
+	return a.templates.SaveAttributeTemplate(template)
+}
+
+// DeleteAttributeTemplate removes an attribute template
+func (a *App) DeleteAttributeTemplate(templateID string) error {
+	return a.templates.DeleteAttributeTemplate(templateID)
+}
+
+// ExportTemplatesReport writes a Markdown report of all message templates grouped by linked topic
+func (a *App) ExportTemplatesReport(outPath string) error {
+	topics, _ := a.resources.ListTopics()
+	return a.templates.ExportTemplatesReport(outPath, topics)
+}
+
 // PublishResult represents the result of a publish operation
 type PublishResult struct {
-	MessageID string `json:"messageId"`
-	Timestamp string `json:"timestamp"`
+	MessageID  string                `json:"messageId"`
+	Timestamp  string                `json:"timestamp"`
+	Attributes []publisher.Attribute `json:"attributes,omitempty"` // Ordered by key for reproducible display
 }
 
 // PublishMessage publishes a message to a Pub/Sub topic
-func (a *App) PublishMessage(topicID, payload string, attributes map[string]string) (PublishResult, error) {
+// With validateJSON, a payload that is not valid JSON is rejected before anything is sent.
+// The attributes of attributeTemplateIDs are merged in first; keys given in attributes win on conflict.
+func (a *App) PublishMessage(topicID, payload string, attributes map[string]string, validateJSON bool, attributeTemplateIDs []string) (PublishResult, error) {
+	defer a.trackOperation()()
+
+	if validateJSON {
+		if err := publisher.ValidateJSONPayload(payload); err != nil {
+			return PublishResult{}, err
+		}
+	}
+
+	attributes, err := a.templates.MergeAttributeTemplates(attributeTemplateIDs, attributes)
+	if err != nil {
+		return PublishResult{}, err
+	}
+
 	// Check connection status
 	client := a.clientManager.GetClient()
 	if client == nil {
@@ -872,20 +1674,447 @@ func (a *App) PublishMessage(topicID, payload string, attributes map[string]stri
 
 	// Publish message
 	pubResult, err := publisher.PublishMessageWithResult(a.ctx, client, topicID, payload, attributes)
+	a.publishHistory.RecordPublish("publish", topicID, payload, attributes, pubResult.MessageID, err)
 	if err != nil {
 		return PublishResult{}, fmt.Errorf("failed to publish message: %w", err)
 	}
 
 	// Convert publisher.PublishResult to app.PublishResult
 	return PublishResult{
-		MessageID: pubResult.MessageID,
-		Timestamp: pubResult.Timestamp,
+		MessageID:  pubResult.MessageID,
+		Timestamp:  pubResult.Timestamp,
+		Attributes: pubResult.Attributes,
+	}, nil
+}
+
+// FormatPayload pretty-prints ("pretty") or minifies ("minify") a JSON payload
+// Invalid JSON returns an error with the line and column of the problem.
+func (a *App) FormatPayload(payload, mode string) (string, error) {
+	return publisher.FormatPayload(payload, mode)
+}
+
+// ValidateMessage checks a payload and attributes against Pub/Sub size and attribute limits without publishing
+// Cheap enough to call as the user types.
+func (a *App) ValidateMessage(payload string, attributes map[string]string) error {
+	return publisher.ValidateMessage(payload, attributes)
+}
+
+// SchedulePublish queues a message for publishing to topicID at publishAt (RFC3339, in the future)
+// Returns the schedule ID. Scheduled publishes live in memory: they are cancelled on Disconnect and lost on exit.
+func (a *App) SchedulePublish(topicID, payload string, attributes map[string]string, publishAt string) (string, error) {
+	if !a.clientManager.IsConnected() {
+		return "", models.ErrNotConnected
+	}
+	at, err := time.Parse(time.RFC3339, publishAt)
+	if err != nil {
+		return "", fmt.Errorf("invalid publish time %q: expected RFC3339 (e.g. 2025-01-15T10:30:00Z)", publishAt)
+	}
+
+	scheduled, err := a.publishScheduler.Schedule(topicID, payload, attributes, at)
+	if err != nil {
+		return "", err
+	}
+	logger.Info("Publish scheduled", "scheduleId", scheduled.ID, "topicID", topicID, "publishAt", scheduled.PublishAt)
+	return scheduled.ID, nil
+}
+
+// ListScheduledPublishes returns the pending scheduled publishes, soonest first
+func (a *App) ListScheduledPublishes() []publisher.ScheduledPublish {
+	return a.publishScheduler.List()
+}
+
+// CancelScheduledPublish cancels a pending scheduled publish
+func (a *App) CancelScheduledPublish(id string) error {
+	return a.publishScheduler.Cancel(id)
+}
+
+// StartPublishLoop publishes a message to topicID every intervalMs milliseconds, count times (0 = until stopped)
+// Returns the loop ID. Placeholders are resolved for every message. The interval must be at least 50ms.
+// Emits "publish:loop-progress" about once a second and when the loop ends; loops stop on Disconnect.
+// Loop messages are not added to publish history.
+func (a *App) StartPublishLoop(topicID, payload string, attributes map[string]string, intervalMs, count int) (string, error) {
+	client := a.clientManager.GetClient()
+	if client == nil {
+		return "", models.ErrNotConnected
+	}
+
+	id, err := a.publishLoops.Start(a.ctx, client, topicID, payload, attributes, time.Duration(intervalMs)*time.Millisecond, count)
+	if err != nil {
+		return "", err
+	}
+	logger.Info("Publish loop started", "loopId", id, "topicID", topicID, "intervalMs", intervalMs, "count", count)
+	return id, nil
+}
+
+// StopPublishLoop stops a running publish loop
+func (a *App) StopPublishLoop(id string) error {
+	return a.publishLoops.Stop(id)
+}
+
+// ListPublishLoops returns the running publish loops
+func (a *App) ListPublishLoops() []publisher.PublishLoopStatus {
+	return a.publishLoops.List()
+}
+
+// fireScheduledPublish publishes a due scheduled message and emits "publish:scheduled-fired"
+func (a *App) fireScheduledPublish(scheduled publisher.ScheduledPublish) {
+	defer a.trackOperation()()
+
+	event := map[string]interface{}{
+		"scheduleId": scheduled.ID,
+		"topicId":    scheduled.TopicID,
+	}
+
+	client := a.clientManager.GetClient()
+	if client == nil {
+		event["error"] = models.ErrNotConnected.Error()
+		runtime.EventsEmit(a.ctx, "publish:scheduled-fired", event)
+		return
+	}
+
+	pubResult, err := publisher.PublishMessageWithResult(a.ctx, client, scheduled.TopicID, scheduled.Payload, scheduled.Attributes)
+	a.publishHistory.RecordPublish("scheduled", scheduled.TopicID, scheduled.Payload, scheduled.Attributes, pubResult.MessageID, err)
+	if err != nil {
+		logger.Error("Scheduled publish failed", "scheduleId", scheduled.ID, "topicID", scheduled.TopicID, "error", err)
+		event["error"] = err.Error()
+	} else {
+		event["messageId"] = pubResult.MessageID
+	}
+	runtime.EventsEmit(a.ctx, "publish:scheduled-fired", event)
+}
+
+// RenderTemplate resolves the placeholders of a saved message template without publishing it
+// Values are generated afresh on every call; unknown placeholders are kept and listed in Warnings.
+func (a *App) RenderTemplate(templateID string) (publisher.RenderedMessage, error) {
+	template, err := a.templates.GetTemplate(templateID)
+	if err != nil {
+		return publisher.RenderedMessage{}, err
+	}
+	return publisher.RenderMessage(template.Payload, template.Attributes)
+}
+
+// PublishFromTemplate publishes a message built from a saved template
+// {{faker:...}} and {{uuid}}-style placeholders in the payload and attribute values are resolved on every call,
+// so repeated publishes produce varied data. topicID defaults to the template's linked topic.
+func (a *App) PublishFromTemplate(templateID, topicID string) (PublishResult, error) {
+	defer a.trackOperation()()
+
+	client := a.clientManager.GetClient()
+	if client == nil {
+		return PublishResult{}, models.ErrNotConnected
+	}
+
+	template, err := a.templates.GetTemplate(templateID)
+	if err != nil {
+		return PublishResult{}, err
+	}
+	if topicID == "" {
+		topicID = template.TopicID
+	}
+	if topicID == "" {
+		return PublishResult{}, fmt.Errorf("template %q is not linked to a topic; a topic ID is required", template.Name)
+	}
+
+	rendered, err := publisher.RenderMessage(template.Payload, template.Attributes)
+	if err != nil {
+		return PublishResult{}, fmt.Errorf("failed to resolve template variables: %w", err)
+	}
+	for _, warning := range rendered.Warnings {
+		logger.Warn("Template placeholder not resolved", "templateId", templateID, "warning", warning)
+	}
+	payload, attributes := rendered.Payload, rendered.Attributes
+
+	pubResult, err := publisher.PublishMessageWithResult(a.ctx, client, topicID, payload, attributes)
+	a.publishHistory.RecordPublish("template", topicID, payload, attributes, pubResult.MessageID, err)
+	if err != nil {
+		return PublishResult{}, fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	return PublishResult{
+		MessageID:  pubResult.MessageID,
+		Timestamp:  pubResult.Timestamp,
+		Attributes: pubResult.Attributes,
+	}, nil
+}
+
+// RepublishWithEdits publishes an edited copy of a message captured by a monitor
+// targetTopicID defaults to the subscription's topic; nil newAttributes keeps the original attributes.
+// If the original payload was JSON the edited payload must be valid JSON. The publish is recorded in history.
+func (a *App) RepublishWithEdits(subscriptionID, messageID, newPayload string, newAttributes map[string]string, targetTopicID string) (PublishResult, error) {
+	defer a.trackOperation()()
+
+	pubResult, err := a.monitoring.RepublishWithEdits(subscriptionID, messageID, newPayload, newAttributes, targetTopicID)
+	if err != nil {
+		return PublishResult{}, err
+	}
+
+	return PublishResult{
+		MessageID:  pubResult.MessageID,
+		Timestamp:  pubResult.Timestamp,
+		Attributes: pubResult.Attributes,
+	}, nil
+}
+
+// GetPublishHistory returns up to limit recent publishes, newest first (limit <= 0 returns all)
+func (a *App) GetPublishHistory(limit int) []app.PublishHistoryEntry {
+	return a.publishHistory.List(limit)
+}
+
+// ClearPublishHistory removes every publish history entry, including the persisted ones of the active profile
+func (a *App) ClearPublishHistory() {
+	a.publishHistory.Clear()
+}
+
+// SetPersistPublishHistory sets whether each profile's publish history is kept on disk across restarts
+// Enabling it while connected saves the current history for the active profile.
+func (a *App) SetPersistPublishHistory(enabled bool) error {
+	if err := a.configH.SetPersistPublishHistory(enabled); err != nil {
+		return err
+	}
+
+	a.activeProfileMu.RLock()
+	profileID := ""
+	if a.activeProfile != nil {
+		profileID = a.activeProfile.ID
+	}
+	a.activeProfileMu.RUnlock()
+
+	if !enabled || profileID == "" {
+		a.publishHistory.Detach()
+		return nil
+	}
+	dir, err := a.GetProfileDataDir(profileID)
+	if err != nil {
+		return err
+	}
+	a.publishHistory.PersistTo(filepath.Join(dir, app.PublishHistoryFileName))
+	return nil
+}
+
+// attachPublishHistory loads the persisted publish history of a newly connected profile
+// Without persistence the in-memory history is kept when reconnecting the same profile and cleared otherwise.
+func (a *App) attachPublishHistory(profileID string) {
+	if a.config == nil || !a.config.PersistPublishHistory {
+		a.publishHistory.KeepInMemory(profileID)
+		return
+	}
+	dir, err := a.GetProfileDataDir(profileID)
+	if err == nil {
+		err = a.publishHistory.Attach(profileID, filepath.Join(dir, app.PublishHistoryFileName))
+	}
+	if err != nil {
+		logger.Warn("Failed to load publish history", "profileId", profileID, "error", err)
+	}
+}
+
+// PublishToMultiple publishes the same message to several topics in parallel and returns per-topic results
+// Resources do not change, so no resource sync is triggered
+func (a *App) PublishToMultiple(topicIDs []string, payload string, attributes map[string]string) (publisher.MultiPublishResult, error) {
+	defer a.trackOperation()()
+
+	client := a.clientManager.GetClient()
+	if client == nil {
+		return publisher.MultiPublishResult{}, models.ErrNotConnected
+	}
+
+	result, err := publisher.PublishToMultiple(a.ctx, client, a.clientManager.GetProjectID(), topicIDs, payload, attributes)
+	if err != nil {
+		return publisher.MultiPublishResult{}, err
+	}
+
+	for _, r := range result.Results {
+		var pubErr error
+		messageID := ""
+		if r.Result != nil {
+			messageID = r.Result.MessageID
+		} else {
+			pubErr = errors.New(r.Error)
+		}
+		a.publishHistory.RecordPublish("publish", r.TopicID, payload, attributes, messageID, pubErr)
+	}
+
+	return result, nil
+}
+
+// PublishToTopics publishes the same message to several topics in parallel and returns the results by topic ID
+// Successful topics are always in the map; if any topic failed, the error lists each failed topic and its reason.
+func (a *App) PublishToTopics(topicIDs []string, payload string, attributes map[string]string) (map[string]PublishResult, error) {
+	result, err := a.PublishToMultiple(topicIDs, payload, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]PublishResult, result.Succeeded)
+	for _, r := range result.Results {
+		if r.Result != nil {
+			results[r.TopicID] = PublishResult{
+				MessageID:  r.Result.MessageID,
+				Timestamp:  r.Result.Timestamp,
+				Attributes: r.Result.Attributes,
+			}
+		}
+	}
+	return results, result.Err()
+}
+
+// PublishMessagesBatch publishes many messages to one topic with at most `concurrency` in flight
+// Emits "publish:batch-progress" every publisher.BatchProgressInterval messages and on completion.
+// Failed messages are reported in the result and do not abort the batch. Messages are added to publish history
+// (only the newest fit).
+func (a *App) PublishMessagesBatch(topicID string, messages []publisher.BatchMessageInput, concurrency int) (publisher.BatchPublishResult, error) {
+	defer a.trackOperation()()
+
+	client := a.clientManager.GetClient()
+	if client == nil {
+		return publisher.BatchPublishResult{}, models.ErrNotConnected
+	}
+
+	result, err := publisher.PublishMessagesBatch(a.ctx, client, topicID, messages, concurrency, func(done, total int) {
+		runtime.EventsEmit(a.ctx, "publish:batch-progress", map[string]interface{}{
+			"topicId": topicID,
+			"done":    done,
+			"total":   total,
+		})
+	})
+	if err != nil {
+		return publisher.BatchPublishResult{}, err
+	}
+
+	a.publishHistory.RecordBatch(batchHistoryEntries(topicID, messages, result))
+
+	logger.Info("Batch publish finished", "topicID", topicID, "total", result.Total, "failed", result.Failed, "durationMs", result.DurationMs)
+	return result, nil
+}
+
+// batchHistoryEntries converts the messages of a batch publish and their outcomes into history entries
+func batchHistoryEntries(topicID string, messages []publisher.BatchMessageInput, result publisher.BatchPublishResult) []app.PublishHistoryEntry {
+	failures := make(map[int]string, len(result.Failures))
+	for _, failure := range result.Failures {
+		failures[failure.Index] = failure.Error
+	}
+
+	entries := make([]app.PublishHistoryEntry, len(messages))
+	for i, msg := range messages {
+		entries[i] = app.PublishHistoryEntry{
+			TopicID:    topicID,
+			Payload:    msg.Payload,
+			Attributes: msg.Attributes,
+			Source:     "batch",
+		}
+		if i < len(result.MessageIDs) {
+			entries[i].MessageID = result.MessageIDs[i]
+		}
+		if failure, failed := failures[i]; failed {
+			entries[i].Error = failure
+		} else {
+			entries[i].Success = entries[i].MessageID != ""
+		}
+	}
+	return entries
+}
+
+// PublishFromFile publishes every message of a JSONL or CSV file to a topic
+// The file is streamed, so large files are not loaded into memory. format is "jsonl", "csv", or "" to use the
+// file extension. Malformed lines and failed publishes are listed with their line numbers without aborting the run.
+// Emits "publish:batch-progress" like PublishMessagesBatch; file publishes are not added to publish history.
+func (a *App) PublishFromFile(topicID, filePath, format string) (publisher.BatchPublishResult, error) {
+	defer a.trackOperation()()
+
+	client := a.clientManager.GetClient()
+	if client == nil {
+		return publisher.BatchPublishResult{}, models.ErrNotConnected
+	}
+
+	result, err := publisher.PublishFromFile(a.ctx, client, topicID, filePath, format, 0, func(done, total int) {
+		runtime.EventsEmit(a.ctx, "publish:batch-progress", map[string]interface{}{
+			"topicId": topicID,
+			"done":    done,
+			"total":   total,
+		})
+	})
+	if err != nil {
+		return publisher.BatchPublishResult{}, err
+	}
+
+	logger.Info("File publish finished", "topicID", topicID, "file", filePath, "total", result.Total, "failed", result.Failed, "durationMs", result.DurationMs)
+	return result, nil
+}
+
+// ResendFromHistory republishes a past message exactly as recorded, to the same topic
+func (a *App) ResendFromHistory(historyID string) (PublishResult, error) {
+	return a.ResendFromHistoryToTopic(historyID, "")
+}
+
+// ResendFromHistoryToTopic republishes a past message to another topic (empty topicID uses the original topic)
+// The recorded ordering key is kept. Entries whose payload was truncated in history cannot be resent.
+func (a *App) ResendFromHistoryToTopic(historyID, topicID string) (PublishResult, error) {
+	defer a.trackOperation()()
+
+	entry, err := a.publishHistory.Get(historyID)
+	if err != nil {
+		return PublishResult{}, err
+	}
+	if entry.Truncated {
+		return PublishResult{}, fmt.Errorf("payload of history entry %s was truncated and cannot be resent", historyID)
+	}
+	if topicID == "" {
+		topicID = entry.TopicID
+	}
+
+	client := a.clientManager.GetClient()
+	if client == nil {
+		return PublishResult{}, models.ErrNotConnected
+	}
+
+	pubResult, err := publisher.PublishOrderedMessageWithResult(a.ctx, client, topicID, entry.Payload, entry.Attributes, entry.OrderingKey)
+	a.publishHistory.RecordOrderedPublish("resend", topicID, entry.Payload, entry.Attributes, entry.OrderingKey, pubResult.MessageID, err)
+	if err != nil {
+		return PublishResult{}, fmt.Errorf("failed to resend message: %w", err)
+	}
+
+	return PublishResult{
+		MessageID:  pubResult.MessageID,
+		Timestamp:  pubResult.Timestamp,
+		Attributes: pubResult.Attributes,
 	}, nil
 }
 
+// ForwardMessage republishes a buffered message from a monitored subscription to another topic
+// preserveAttributes keeps the original attributes and ordering key
+func (a *App) ForwardMessage(subscriptionID, messageID, targetTopicID string, preserveAttributes bool) (PublishResult, error) {
+	defer a.trackOperation()()
+
+	pubResult, err := a.monitoring.ForwardMessage(subscriptionID, messageID, targetTopicID, preserveAttributes)
+	if err != nil {
+		return PublishResult{}, err
+	}
+
+	return PublishResult{
+		MessageID:  pubResult.MessageID,
+		Timestamp:  pubResult.Timestamp,
+		Attributes: pubResult.Attributes,
+	}, nil
+}
+
+// ReplayBufferedMessages republishes every message buffered for a monitored subscription to a topic
+// Dead letter attributes (CloudPubSubDeadLetterSource*) are dropped unless preserveAttributes is true.
+// Emits "publish:batch-progress" like PublishMessagesBatch; replays are not added to publish history.
+func (a *App) ReplayBufferedMessages(subID, targetTopicID string, preserveAttributes bool) (publisher.BatchPublishResult, error) {
+	defer a.trackOperation()()
+
+	return a.monitoring.ReplayBufferedMessages(subID, targetTopicID, preserveAttributes, func(done, total int) {
+		runtime.EventsEmit(a.ctx, "publish:batch-progress", map[string]interface{}{
+			"topicId": targetTopicID,
+			"done":    done,
+			"total":   total,
+		})
+	})
+}
+
 // StartMonitor starts streaming pull for a subscription
-func (a *App) StartMonitor(subscriptionID string) error {
-	return a.monitoring.StartMonitor(subscriptionID)
+// Zero fields of options fall back to the configured monitor options
+func (a *App) StartMonitor(subscriptionID string, options models.MonitorOptions) error {
+	return a.monitoring.StartMonitor(subscriptionID, options)
 }
 
 // StopMonitor stops streaming pull for a subscription
@@ -893,10 +2122,20 @@ func (a *App) StopMonitor(subscriptionID string) error {
 	return a.monitoring.StopMonitor(subscriptionID)
 }
 
+// PauseMonitor stops receiving messages for a monitored subscription, keeping the buffer and subscription
+func (a *App) PauseMonitor(subID string) error {
+	return a.monitoring.PauseMonitor(subID)
+}
+
+// ResumeMonitor restarts receiving messages for a paused monitor
+func (a *App) ResumeMonitor(subID string) error {
+	return a.monitoring.ResumeMonitor(subID)
+}
+
 // StartTopicMonitor creates a temporary subscription and starts monitoring a topic
 // If subscriptionID is provided and not empty, it uses that existing subscription instead of creating a new one
-func (a *App) StartTopicMonitor(topicID string, subscriptionID string) error {
-	return a.monitoring.StartTopicMonitor(topicID, subscriptionID)
+func (a *App) StartTopicMonitor(topicID string, subscriptionID string, options models.MonitorOptions) error {
+	return a.monitoring.StartTopicMonitor(topicID, subscriptionID, options)
 }
 
 // StopTopicMonitor stops monitoring a topic and deletes the temporary subscription
@@ -909,11 +2148,142 @@ func (a *App) GetBufferedMessages(subscriptionID string) ([]subscriber.PubSubMes
 	return a.monitoring.GetBufferedMessages(subscriptionID)
 }
 
-// ClearMessageBuffer clears the message buffer for a subscription
-func (a *App) ClearMessageBuffer(subscriptionID string) error {
+// GetBufferedMessagesDeduped returns the buffered messages with redeliveries of the same message ID collapsed
+// Each message keeps its first occurrence and gets a deliveryCount; GetBufferedMessages still returns every delivery.
+func (a *App) GetBufferedMessagesDeduped(subID string) ([]subscriber.PubSubMessage, error) {
+	return a.monitoring.GetBufferedMessagesDeduped(subID)
+}
+
+// GetBufferedMessagesAs returns the buffered messages with payloads rendered as "auto", "text", "base64" or "hex"
+// "auto" shows valid UTF-8 as text and anything else as base64. Each message reports its encoding and content type.
+func (a *App) GetBufferedMessagesAs(subID, mode string) ([]subscriber.PubSubMessage, error) {
+	return a.monitoring.GetBufferedMessagesAs(subID, mode)
+}
+
+// SearchBufferedMessages returns buffered messages whose payload (and optionally attributes) contain query,
+// case-insensitively; "attr:key=value" matches an attribute exactly
+func (a *App) SearchBufferedMessages(subID, query string, searchAttributes bool) ([]subscriber.PubSubMessage, error) {
+	return a.monitoring.SearchBufferedMessages(subID, query, searchAttributes)
+}
+
+// ExportBufferedMessages returns the buffered messages serialized as "ndjson" or "csv" for saving to a file
+// base64Data encodes payloads as base64 instead of writing them as received
+func (a *App) ExportBufferedMessages(subID, format string, base64Data bool) (string, error) {
+	return a.monitoring.ExportBufferedMessages(subID, format, base64Data)
+}
+
+// GetAckStats returns how many messages of a monitored subscription were acked, nacked, expired or redelivered
+// Counts reset when the subscription's buffer is cleared
+func (a *App) GetAckStats(subscriptionID string) (subscriber.AckStats, error) {
+	return a.monitoring.GetAckStats(subscriptionID)
+}
+
+// GetPayloadSizeHistogram returns bucketed payload sizes and the largest size in a monitor's buffer
+func (a *App) GetPayloadSizeHistogram(subscriptionID string) (subscriber.SizeHistogram, error) {
+	return a.monitoring.GetPayloadSizeHistogram(subscriptionID)
+}
+
+// ClearMessageBuffer clears the message buffer for a subscription and returns the number of messages cleared
+func (a *App) ClearMessageBuffer(subscriptionID string) (int, error) {
 	return a.monitoring.ClearMessageBuffer(subscriptionID)
 }
 
+// ClearAllBuffers clears the message buffers of all active monitors and returns the total cleared
+func (a *App) ClearAllBuffers() int {
+	return a.monitoring.ClearAllBuffers()
+}
+
+// EstimateDrainTime estimates how long a subscription's backlog will take to clear
+func (a *App) EstimateDrainTime(subscriptionID string) (*app.DrainEstimate, error) {
+	return a.monitoring.EstimateDrainTime(subscriptionID)
+}
+
+// GetSubscriptionBacklog returns the approximate undelivered count and oldest unacked age of a subscription
+// Emits "subscription:backlog"; poll it on an interval for a live view. On the emulator the result is flagged
+// with metricsAvailable false instead of failing.
+func (a *App) GetSubscriptionBacklog(subID string) (app.BacklogInfo, error) {
+	return a.monitoring.GetSubscriptionBacklog(subID)
+}
+
+// GetSubscriptionOps returns backlog, throughput, ack stats and drain estimate for a subscription in one call
+func (a *App) GetSubscriptionOps(subscriptionID string) (*app.SubscriptionOps, error) {
+	return a.monitoring.GetSubscriptionOps(subscriptionID)
+}
+
+// SimulateRedelivery nacks the next message on a subscription `times` times to exercise dead letter routing
+// Reports the resulting delivery attempt and whether the message landed in the dead letter topic
+func (a *App) SimulateRedelivery(subscriptionID string, times int) (*app.RedeliveryResult, error) {
+	return a.monitoring.SimulateRedelivery(subscriptionID, times)
+}
+
+// GetActiveMonitors returns a report of all running monitors, including the effective TTL of auto-created subscriptions
+func (a *App) GetActiveMonitors() []app.ActiveMonitorInfo {
+	return a.monitoring.GetActiveMonitors()
+}
+
+// AckMessage acknowledges a single buffered message that is still unacked
+func (a *App) AckMessage(subID, messageID string) error {
+	return a.monitoring.AckMessage(subID, messageID)
+}
+
+// NackMessage nacks a single buffered message that is still unacked so it is redelivered
+func (a *App) NackMessage(subID, messageID string) error {
+	return a.monitoring.NackMessage(subID, messageID)
+}
+
+// SetMessageLease sets how long unacked messages of a monitored subscription stay leased before redelivery
+// holdSeconds=0 disables deadline extension (messages are released after the subscription's ack deadline)
+func (a *App) SetMessageLease(subscriptionID string, holdSeconds int) error {
+	return a.monitoring.SetMessageLease(subscriptionID, holdSeconds)
+}
+
+// GetMessageSummaries returns compact summaries of buffered messages, colored by the monitor highlight rules
+func (a *App) GetMessageSummaries(subscriptionID string) ([]app.MessageSummary, error) {
+	return a.monitoring.GetMessageSummaries(subscriptionID)
+}
+
+// SetMonitorHighlightRules sets the attribute-based coloring rules for monitored messages
+func (a *App) SetMonitorHighlightRules(rules []models.HighlightRule) error {
+	return a.configH.SetMonitorHighlightRules(rules)
+}
+
+// SetMonitorSubscriptionTTL sets the TTL (in hours) for auto-created monitor subscriptions
+func (a *App) SetMonitorSubscriptionTTL(hours int) error {
+	return a.configH.SetMonitorSubscriptionTTL(hours)
+}
+
+// SetMonitorOptions sets the default flow control of monitors started afterwards
+func (a *App) SetMonitorOptions(options models.MonitorOptions) error {
+	return a.configH.SetMonitorOptions(options)
+}
+
+// SetAPICallPolicy sets the per-call timeout in seconds (0 keeps the client library default) and the retry
+// policy of admin API calls on transient errors. Syncs use the timeout right away; reconnect to apply the rest.
+func (a *App) SetAPICallPolicy(timeoutSeconds int, retry models.APIRetryPolicy) error {
+	return a.configH.SetAPICallPolicy(timeoutSeconds, retry)
+}
+
+// SetEmulatorHealthCheckSeconds sets how often (in seconds) managed emulator containers are checked
+// and restarts the health checker with the new interval
+func (a *App) SetEmulatorHealthCheckSeconds(seconds int) error {
+	if err := a.configH.SetEmulatorHealthCheckSeconds(seconds); err != nil {
+		return err
+	}
+	a.emulatorManager.StartHealthChecks(a.config.GetEmulatorHealthCheckInterval())
+	return nil
+}
+
+// SetLogRetentionDays sets how many days daily log files are kept; older files are pruned on startup (0 disables pruning)
+func (a *App) SetLogRetentionDays(days int) error {
+	return a.configH.SetLogRetentionDays(days)
+}
+
+// SetBacklogAgeWarnSeconds sets the oldest unacked message age (seconds) that triggers
+// "subscription:backlog-warning" for monitored subscriptions (0 disables)
+func (a *App) SetBacklogAgeWarnSeconds(seconds int) error {
+	return a.configH.SetBacklogAgeWarnSeconds(seconds)
+}
+
 // SetAutoAck updates auto-acknowledge setting
 func (a *App) SetAutoAck(enabled bool) error {
 	return a.configH.SetAutoAck(enabled)
@@ -1014,6 +2384,32 @@ func (a *App) SaveConfigFileContent(content string) error {
 	return err
 }
 
+// ExportConfig returns the config as JSON with credential paths redacted, for moving it to another machine
+func (a *App) ExportConfig() (string, error) {
+	return a.configH.ExportConfig()
+}
+
+// ImportConfig applies a config exported with ExportConfig
+// merge adds profiles and templates to the existing ones; otherwise they and the settings are replaced.
+// The active connection's profile is always kept.
+func (a *App) ImportConfig(content string, merge bool) error {
+	a.activeProfileMu.RLock()
+	activeProfileID := ""
+	if a.activeProfile != nil {
+		activeProfileID = a.activeProfile.ID
+	}
+	a.activeProfileMu.RUnlock()
+
+	if _, err := a.configH.ImportConfig(content, merge, activeProfileID); err != nil {
+		return err
+	}
+	if err := a.topicSubscriptionTemplates.ReloadCustomTemplates(); err != nil {
+		return fmt.Errorf("config imported but templates could not be loaded: %w", err)
+	}
+	a.connection.ValidateAllProfiles()
+	return nil
+}
+
 // GetTopicSubscriptionTemplates returns all topic/subscription templates (built-in and custom)
 func (a *App) GetTopicSubscriptionTemplates() ([]*models.TopicSubscriptionTemplate, error) {
 	return a.topicSubscriptionTemplates.GetTemplates()
@@ -1026,7 +2422,10 @@ func (a *App) GetTopicSubscriptionTemplatesByCategory(category string) ([]*model
 
 // CreateFromTemplate creates resources from a topic/subscription template
 func (a *App) CreateFromTemplate(request models.TemplateCreateRequest) (models.TemplateCreateResult, error) {
+	defer a.trackOperation()()
+
 	result, err := a.topicSubscriptionTemplates.CreateFromTemplate(&request)
+	a.recordTemplateAudit(request, result, err)
 	if err != nil {
 		return models.TemplateCreateResult{
 			Success: false,
@@ -1063,52 +2462,222 @@ func (a *App) CreateFromTemplate(request models.TemplateCreateRequest) (models.T
 	return *result, nil
 }
 
-// SaveCustomTopicSubscriptionTemplate saves a custom topic/subscription template
-func (a *App) SaveCustomTopicSubscriptionTemplate(template models.TopicSubscriptionTemplate) error {
-	return a.topicSubscriptionTemplates.SaveCustomTemplate(&template)
+// CheckSubscriptionDrift reports settings on a live subscription that differ from a template's subscription at subIndex
+// Compares ack deadline, retry policy, ordering, exactly-once, filter and dead letter policy
+func (a *App) CheckSubscriptionDrift(subID, templateID string, subIndex int) ([]models.DriftField, error) {
+	return a.topicSubscriptionTemplates.CheckSubscriptionDrift(subID, templateID, subIndex)
+}
+
+// CreateFromTemplateMultiEnv creates the same template topology once per environment (e.g., dev, staging, prod)
+// Failures in one environment do not stop or roll back the others; check each result's Success field
+func (a *App) CreateFromTemplateMultiEnv(templateID, baseName string, environments []string) ([]models.TemplateEnvironmentResult, error) {
+	defer a.trackOperation()()
+
+	results, err := a.topicSubscriptionTemplates.CreateFromTemplateMultiEnv(templateID, baseName, environments)
+	if err != nil {
+		return nil, err
+	}
+
+	anySuccess := false
+	for i := range results {
+		request := models.TemplateCreateRequest{
+			TemplateID:  templateID,
+			BaseName:    baseName,
+			Environment: results[i].Environment,
+		}
+		a.recordTemplateAudit(request, &results[i].Result, nil)
+
+		if results[i].Result.Success {
+			anySuccess = true
+			runtime.EventsEmit(a.ctx, "template:created", map[string]interface{}{
+				"templateId":      templateID,
+				"topicId":         results[i].Result.TopicID,
+				"subscriptionIds": results[i].Result.SubscriptionIDs,
+			})
+		}
+	}
+
+	if anySuccess {
+		// Same delay as CreateFromTemplate to let the emulator process creations
+		go func() {
+			time.Sleep(2 * time.Second)
+			a.resources.SyncResources()
+		}()
+	}
+
+	return results, nil
+}
+
+// SaveTopicSubscriptionTemplate creates or updates a user topic/subscription template
+// An empty ID creates a new template; built-in templates cannot be edited and names must be unique.
+func (a *App) SaveTopicSubscriptionTemplate(template models.TopicSubscriptionTemplate) (models.TopicSubscriptionTemplate, error) {
+	if err := a.topicSubscriptionTemplates.SaveCustomTemplate(&template); err != nil {
+		return models.TopicSubscriptionTemplate{}, err
+	}
+	return template, nil
 }
 
-// DeleteCustomTopicSubscriptionTemplate deletes a custom topic/subscription template
-func (a *App) DeleteCustomTopicSubscriptionTemplate(templateID string) error {
+// DeleteTopicSubscriptionTemplate deletes a user topic/subscription template
+func (a *App) DeleteTopicSubscriptionTemplate(templateID string) error {
 	return a.topicSubscriptionTemplates.DeleteCustomTemplate(templateID)
 }
 
+// GetAuditLog returns audited resource-changing operations for a specific date (YYYY-MM-DD)
+func (a *App) GetAuditLog(date string) ([]audit.Entry, error) {
+	if a.auditLog == nil {
+		return nil, fmt.Errorf("audit log not initialized")
+	}
+	return a.auditLog.Read(date)
+}
+
+// recordAudit appends a resource-changing operation and its outcome to the audit trail
+// Failures to write are logged but never affect the operation itself
+func (a *App) recordAudit(operation, resourceType, resource string, opErr error) {
+	if a.auditLog == nil {
+		return
+	}
+
+	entry := audit.Entry{
+		Operation:    operation,
+		ResourceType: resourceType,
+		Resource:     resource,
+		Outcome:      audit.OutcomeSuccess,
+	}
+	if opErr != nil {
+		entry.Outcome = audit.OutcomeFailure
+		entry.Error = opErr.Error()
+	}
+
+	if a.clientManager != nil {
+		entry.ProjectID = a.clientManager.GetProjectID()
+	}
+	if a.connection != nil {
+		entry.Identity = a.connection.GetIdentity()
+	}
+
+	a.activeProfileMu.RLock()
+	if a.activeProfile != nil {
+		entry.ProfileID = a.activeProfile.ID
+		entry.ProfileName = a.activeProfile.Name
+	}
+	a.activeProfileMu.RUnlock()
+
+	if err := a.auditLog.Record(entry); err != nil {
+		logger.Warn("Failed to write audit entry", "operation", operation, "resource", resource, "error", err)
+	}
+}
+
+// recordTemplateAudit records each resource created from a template, or the failure if nothing was created
+func (a *App) recordTemplateAudit(request models.TemplateCreateRequest, result *models.TemplateCreateResult, opErr error) {
+	if opErr == nil && (result == nil || !result.Success) {
+		msg := "template creation failed"
+		if result != nil && result.Error != "" {
+			msg = result.Error
+		}
+		opErr = fmt.Errorf("%s", msg)
+	}
+	if opErr != nil {
+		resource := request.TemplateID + ":" + request.BaseName
+		if request.Environment != "" {
+			resource += "-" + request.Environment
+		}
+		a.recordAudit("create", "template", resource, opErr)
+		return
+	}
+
+	if result.DeadLetterTopicID != "" {
+		a.recordAudit("create", "topic", result.DeadLetterTopicID, nil)
+	}
+	if result.DeadLetterSubID != "" {
+		a.recordAudit("create", "subscription", result.DeadLetterSubID, nil)
+	}
+	a.recordAudit("create", "topic", result.TopicID, nil)
+	for _, subID := range result.SubscriptionIDs {
+		a.recordAudit("create", "subscription", subID, nil)
+	}
+}
+
 // GetLogs returns logs for a specific date
 func (a *App) GetLogs(date string, limit int, offset int) ([]app.LogEntry, error) {
 	return a.logs.GetLogs(date, limit, offset)
 }
 
 // GetLogsFiltered returns filtered logs across a date range
-func (a *App) GetLogsFiltered(startDate, endDate, levelFilter, searchTerm string, limit, offset int) (app.FilteredLogsResult, error) {
-	return a.logs.GetLogsFiltered(startDate, endDate, levelFilter, searchTerm, limit, offset)
+// fieldFilters requires exact matches on structured fields (e.g. {"profileId": "test-profile"}); pass an empty map to skip
+func (a *App) GetLogsFiltered(startDate, endDate, levelFilter, searchTerm string, fieldFilters map[string]string, limit, offset int) (app.FilteredLogsResult, error) {
+	return a.logs.GetLogsFiltered(startDate, endDate, levelFilter, searchTerm, fieldFilters, limit, offset)
 }
 
-// EmulatorStatus represents the status of a managed emulator instance
-type EmulatorStatus struct {
-	ProfileID     string `json:"profileId"`
-	ContainerName string `json:"containerName"`
-	Host          string `json:"host"`
-	Port          int    `json:"port"`
-	Status        string `json:"status"` // "stopped", "starting", "running", "stopping", "error"
-	Error         string `json:"error,omitempty"`
+// StartLogTail emits "log:entry" for every new log entry matching levelFilter (e.g. "INFO,ERROR"; "" for all)
+// Calling it again replaces the filter.
+func (a *App) StartLogTail(levelFilter string) {
+	a.logs.StartLogTail(levelFilter, func(entry app.LogEntry) {
+		runtime.EventsEmit(a.ctx, "log:entry", entry)
+	})
 }
 
-// GetEmulatorStatus returns the status of the managed emulator for a profile
+// StopLogTail stops emitting "log:entry" events
+func (a *App) StopLogTail() {
+	a.logs.StopLogTail()
+}
+
+// ClearLogs deletes the daily log files dated before beforeDate (YYYY-MM-DD) and returns how many were removed
+func (a *App) ClearLogs(beforeDate string) (int, error) {
+	return a.logs.ClearLogs(beforeDate)
+}
+
+// ClearAllLogs deletes every daily log file and empties the current one; returns how many files were removed
+func (a *App) ClearAllLogs() (int, error) {
+	return a.logs.ClearAllLogs()
+}
+
+// EmulatorStatus represents the status of a managed emulator instance
+type EmulatorStatus struct {
+	ProfileID         string `json:"profileId"`
+	ContainerName     string `json:"containerName"`
+	Host              string `json:"host"`
+	Port              int    `json:"port"`
+	ConfiguredPort    int    `json:"configuredPort,omitempty"` // Port from the profile config when a different port was assigned
+	Status            string `json:"status"`                   // "stopped", "starting", "running", "stopping", "error"
+	Error             string `json:"error,omitempty"`
+	ImageID           string `json:"imageId,omitempty"`      // Image ID (sha256 digest) of the running container
+	ImageWarning      string `json:"imageWarning,omitempty"` // Set when the local image tag resolves to a newer image
+	Persistent        bool   `json:"persistent,omitempty"`   // Container is kept when stopped
+	Runtime           string `json:"runtime,omitempty"`      // Container runtime running the emulator ("docker" or "podman")
+	DataDirConfigured bool   `json:"dataDirConfigured"`      // Emulator state is persisted to a host directory
+	DataDir           string `json:"dataDir,omitempty"`      // Host directory mounted at /data
+}
+
+// GetEmulatorStatus returns the live status of the managed emulator for a profile
+// A running emulator's container is checked first, so a container lost to a Docker restart reports "error".
 func (a *App) GetEmulatorStatus(profileID string) EmulatorStatus {
+	a.emulatorManager.CheckProfileHealth(profileID)
 	info := a.emulatorManager.GetStatus(profileID)
 	return EmulatorStatus{
-		ProfileID:     info.ProfileID,
-		ContainerName: info.ContainerName,
-		Host:          info.Host,
-		Port:          info.Port,
-		Status:        string(info.Status),
-		Error:         info.Error,
+		ProfileID:         info.ProfileID,
+		ContainerName:     info.ContainerName,
+		Host:              info.Host,
+		Port:              info.Port,
+		ConfiguredPort:    info.ConfiguredPort,
+		Status:            string(info.Status),
+		Error:             info.Error,
+		ImageID:           info.ImageID,
+		ImageWarning:      info.ImageWarning,
+		Persistent:        info.Persistent,
+		Runtime:           info.Runtime,
+		DataDirConfigured: info.DataDirConfigured,
+		DataDir:           info.DataDir,
 	}
 }
 
-// CheckDockerAvailable checks if Docker is installed and running
-func (a *App) CheckDockerAvailable() error {
-	return a.emulatorManager.CheckDocker()
+// CheckContainerRuntime checks that the container runtime for a preference ("auto", "docker" or "podman")
+// is installed and running, and returns the name of the runtime that would be used
+func (a *App) CheckContainerRuntime(preference string) (string, error) {
+	rt, err := a.emulatorManager.CheckRuntime(preference)
+	if err != nil {
+		return "", err
+	}
+	return rt.Name(), nil
 }
 
 // StartManagedEmulator manually starts the managed emulator for a profile
@@ -1138,9 +2707,9 @@ func (a *App) StartManagedEmulator(profileID string) error {
 		config = &defaultConfig
 	}
 
-	// Check Docker availability
-	if err := a.emulatorManager.CheckDocker(); err != nil {
-		return fmt.Errorf("docker required: %w", err)
+	// Check container runtime availability
+	if _, err := a.emulatorManager.CheckRuntime(config.Runtime); err != nil {
+		return fmt.Errorf("container runtime required: %w", err)
 	}
 
 	// Start emulator
@@ -1151,3 +2720,161 @@ func (a *App) StartManagedEmulator(profileID string) error {
 func (a *App) StopManagedEmulator(profileID string) error {
 	return a.emulatorManager.Stop(profileID)
 }
+
+// checkEmulatorNotShared fails when other profiles use the profile's emulator container or data directory
+// A shared container keeps running when one profile stops it, so flushing or resetting it would do nothing
+// or clear state the other profiles still use.
+func (a *App) checkEmulatorNotShared(profile models.ConnectionProfile) error {
+	dataDir := ""
+	if profile.ManagedEmulator != nil {
+		dataDir = profile.ManagedEmulator.DataDir
+	}
+	others := a.emulatorManager.SharingProfiles(profile.ID, dataDir)
+	if len(others) == 0 {
+		return nil
+	}
+	names := make([]string, len(others))
+	for i, id := range others {
+		names[i] = id
+		if other, err := a.findProfile(id); err == nil {
+			names[i] = other.Name
+		}
+	}
+	return fmt.Errorf("%w (%s)", models.ErrEmulatorShared, strings.Join(names, ", "))
+}
+
+// FlushEmulatorData makes a running managed emulator write its state to the profile's data directory
+// The emulator only persists on shutdown, so it is restarted gracefully; when the profile is the active
+// connection it is disconnected first and reconnected afterwards.
+func (a *App) FlushEmulatorData(profileID string) error {
+	profile, err := a.findProfile(profileID)
+	if err != nil {
+		return err
+	}
+	if profile.GetEffectiveEmulatorMode() != models.EmulatorModeManaged {
+		return fmt.Errorf("profile is not configured for managed emulator mode")
+	}
+	if profile.ManagedEmulator == nil || profile.ManagedEmulator.DataDir == "" {
+		return fmt.Errorf("no data directory configured for this profile: emulator state is not persisted")
+	}
+	if !a.emulatorManager.IsRunning(profileID) {
+		return fmt.Errorf("emulator is not running")
+	}
+	if err := a.checkEmulatorNotShared(profile); err != nil {
+		return err
+	}
+
+	a.activeProfileMu.RLock()
+	wasActive := a.activeProfile != nil && a.activeProfile.ID == profileID
+	a.activeProfileMu.RUnlock()
+
+	if !wasActive {
+		if err := a.emulatorManager.Restart(profileID, profile.ManagedEmulator); err != nil {
+			return fmt.Errorf("failed to restart emulator: %w", err)
+		}
+		logger.Info("Emulator data flushed", "profileId", profileID, "dataDir", profile.ManagedEmulator.DataDir)
+		return nil
+	}
+
+	if err := a.Disconnect(); err != nil {
+		logger.Warn("Error disconnecting before emulator flush", "profileId", profileID, "error", err)
+	}
+	if err := a.emulatorManager.Stop(profileID); err != nil {
+		return fmt.Errorf("failed to stop emulator: %w", err)
+	}
+
+	// Reconnecting starts the emulator again, even if the profile disables autoStart
+	config := *profile.ManagedEmulator
+	config.AutoStart = true
+	profile.ManagedEmulator = &config
+	if err := a.connectWithProfile(&profile); err != nil {
+		return err
+	}
+	a.syncResources()
+	logger.Info("Emulator data flushed", "profileId", profileID, "dataDir", config.DataDir)
+	return nil
+}
+
+// ResetEmulator gives a managed emulator profile a fresh local Pub/Sub in one step:
+// stops and removes the container, clears the data directory (if set), restarts the emulator,
+// reconnects and reseeds from the profile's SeedConfig. Emits "emulator:reset-progress" for each phase.
+func (a *App) ResetEmulator(profileID string) error {
+	profile, err := a.findProfile(profileID)
+	if err != nil {
+		return err
+	}
+	if profile.GetEffectiveEmulatorMode() != models.EmulatorModeManaged {
+		return fmt.Errorf("profile is not configured for managed emulator mode")
+	}
+	if err := a.checkEmulatorNotShared(profile); err != nil {
+		return err
+	}
+
+	config := models.DefaultManagedEmulatorConfig()
+	if profile.ManagedEmulator != nil {
+		config = *profile.ManagedEmulator
+	}
+
+	progress := func(phase string, err error) {
+		payload := map[string]interface{}{
+			"profileId": profileID,
+			"phase":     phase, // "stopping" | "clearing-data" | "reconnecting" | "seeding" | "done"
+		}
+		if err != nil {
+			payload["error"] = err.Error()
+		}
+		runtime.EventsEmit(a.ctx, "emulator:reset-progress", payload)
+	}
+
+	// Stop: disconnect whatever is connected, since the reset reconnects with this profile, then remove
+	// the container so it can't be reused
+	progress("stopping", nil)
+	if a.clientManager.IsConnected() {
+		if err := a.Disconnect(); err != nil {
+			logger.Warn("Error disconnecting before emulator reset", "profileId", profileID, "error", err)
+		}
+	}
+	if err := a.emulatorManager.Remove(profileID, &config); err != nil {
+		err = fmt.Errorf("failed to stop emulator: %w", err)
+		progress("stopping", err)
+		return err
+	}
+
+	if config.DataDir != "" {
+		progress("clearing-data", nil)
+		if err := emulator.ClearDataDir(config.DataDir); err != nil {
+			progress("clearing-data", err)
+			return err
+		}
+	}
+
+	// Restart and reconnect; the emulator is started even if the profile disables autoStart
+	progress("reconnecting", nil)
+	config.AutoStart = true
+	profile.ManagedEmulator = &config
+	if err := a.connectWithProfile(&profile); err != nil {
+		progress("reconnecting", err)
+		return err
+	}
+	a.config.ActiveProfileID = profileID
+	if err := a.configManager.SaveConfig(a.config); err != nil {
+		logger.Warn("Failed to save active profile after emulator reset", "profileId", profileID, "error", err)
+	}
+
+	seeded := 0
+	if profile.SeedConfig != nil && len(profile.SeedConfig.Topics) > 0 {
+		progress("seeding", nil)
+		created, err := admin.SeedResourcesAdmin(a.ctx, a.clientManager.GetClient(), profile.ProjectID, profile.SeedConfig)
+		seeded = created
+		if err != nil {
+			progress("seeding", err)
+			a.syncResources()
+			return err
+		}
+	}
+
+	a.syncResources()
+	logger.Info("Emulator reset", "profileId", profileID, "seededResources", seeded)
+	progress("done", nil)
+	return nil
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"pubsub-gui/internal/config"
 	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/publisher"
 )
 
 func TestGetCurrentVersion(t *testing.T) {
@@ -120,6 +123,39 @@ func TestCheckForUpdates_ErrorHandling(t *testing.T) {
 	}
 }
 
+func TestApp_UpgradePreferences(t *testing.T) {
+	app := NewApp()
+	app.config = models.NewDefaultConfig()
+
+	if err := app.DismissUpgrade("v1.3.0"); err != nil {
+		t.Fatalf("DismissUpgrade() error = %v", err)
+	}
+	if err := app.SnoozeUpgrade(24); err != nil {
+		t.Fatalf("SnoozeUpgrade() error = %v", err)
+	}
+	if err := app.SnoozeUpgrade(models.MaxUpgradeSnoozeHours + 1); err == nil {
+		t.Error("SnoozeUpgrade() over the maximum error = nil, want error")
+	}
+
+	prefs, err := app.GetUpgradePreferences()
+	if err != nil {
+		t.Fatalf("GetUpgradePreferences() error = %v", err)
+	}
+	if prefs.DismissedUpgradeVersion != "v1.3.0" || !prefs.Snoozed {
+		t.Errorf("GetUpgradePreferences() = %+v, want v1.3.0 dismissed and snoozed", prefs)
+	}
+	if until := time.Until(prefs.SnoozedUntil); until < 23*time.Hour || until > 24*time.Hour {
+		t.Errorf("SnoozedUntil is %v from now, want about 24h", until)
+	}
+
+	if err := app.SnoozeUpgrade(0); err != nil {
+		t.Fatalf("SnoozeUpgrade(0) error = %v", err)
+	}
+	if prefs, _ := app.GetUpgradePreferences(); prefs.Snoozed || !prefs.SnoozedUntil.IsZero() {
+		t.Errorf("GetUpgradePreferences() after ending the snooze = %+v, want not snoozed", prefs)
+	}
+}
+
 func TestApp_UpgradeCheckFields(t *testing.T) {
 	app := NewApp()
 
@@ -137,3 +173,111 @@ func TestApp_UpgradeCheckFields(t *testing.T) {
 		t.Error("lastUpgradeCheck should be set after lock/unlock")
 	}
 }
+
+func TestApp_WaitForInFlightOperations(t *testing.T) {
+	app := NewApp()
+
+	done := app.trackOperation()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		done()
+	}()
+
+	start := time.Now()
+	app.waitForInFlightOperations(5 * time.Second)
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("waitForInFlightOperations() returned after %v, want after the operation completed", elapsed)
+	}
+}
+
+func TestApp_TrackOperationDuringWait(t *testing.T) {
+	app := NewApp()
+
+	// Operations start and finish while a wait is in progress, including from an idle count
+	first := app.trackOperation()
+	waited := make(chan struct{})
+	go func() {
+		defer close(waited)
+		app.waitForInFlightOperations(5 * time.Second)
+	}()
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			done := app.trackOperation()
+			done()
+			done() // Calling the returned function again has no effect
+		}()
+	}
+	wg.Wait()
+	first()
+
+	select {
+	case <-waited:
+	case <-time.After(2 * time.Second):
+		t.Fatal("waitForInFlightOperations() did not return after the operations completed")
+	}
+	app.inFlightMu.Lock()
+	defer app.inFlightMu.Unlock()
+	if app.inFlightCount != 0 {
+		t.Errorf("in-flight count = %d, want 0", app.inFlightCount)
+	}
+}
+
+func TestApp_FindProfile(t *testing.T) {
+	app := NewApp()
+	app.config = models.NewDefaultConfig()
+	app.config.Profiles = []models.ConnectionProfile{
+		{ID: "1", Name: "Dev", ProjectID: "dev"},
+		{ID: "2", Name: "Prod", ProjectID: "prod"},
+	}
+
+	profile, err := app.findProfile("2")
+	if err != nil || profile.Name != "Prod" {
+		t.Errorf("findProfile(2) = %+v, %v, want the Prod profile", profile, err)
+	}
+	profile.Name = "Changed"
+	if app.config.Profiles[1].Name != "Prod" {
+		t.Error("findProfile() returned the stored profile instead of a copy")
+	}
+	if _, err := app.findProfile("3"); !errors.Is(err, models.ErrProfileNotFound) {
+		t.Errorf("findProfile(3) error = %v, want ErrProfileNotFound", err)
+	}
+}
+
+func TestBatchHistoryEntries(t *testing.T) {
+	messages := []publisher.BatchMessageInput{
+		{Payload: "ok", Attributes: map[string]string{"k": "v"}},
+		{Payload: "failed"},
+		{Payload: "never sent"},
+	}
+	result := publisher.BatchPublishResult{
+		Total:      3,
+		Succeeded:  1,
+		Failed:     1,
+		MessageIDs: []string{"m1", "", ""},
+		Failures:   []publisher.BatchFailure{{Index: 1, Error: "denied"}},
+	}
+
+	entries := batchHistoryEntries("orders", messages, result)
+	if len(entries) != 3 {
+		t.Fatalf("batchHistoryEntries() = %d entries, want 3", len(entries))
+	}
+	for _, entry := range entries {
+		if entry.TopicID != "orders" || entry.Source != "batch" {
+			t.Errorf("entry topic/source = %s/%s, want orders/batch", entry.TopicID, entry.Source)
+		}
+	}
+	if e := entries[0]; !e.Success || e.MessageID != "m1" || e.Payload != "ok" || e.Attributes["k"] != "v" || e.Error != "" {
+		t.Errorf("published entry = %+v, want a success with message ID m1", e)
+	}
+	if e := entries[1]; e.Success || e.Error != "denied" || e.Payload != "failed" {
+		t.Errorf("failed entry = %+v, want the failure error", e)
+	}
+	// A message without an ID or failure (e.g. the batch was cancelled) is not a success
+	if e := entries[2]; e.Success || e.Error != "" {
+		t.Errorf("unsent entry = %+v, want no success and no error", e)
+	}
+}
@@ -416,7 +416,7 @@ function App() {
 
   const handleCreateTopic = async (topicID: string, messageRetentionDuration: string) => {
     try {
-      await CreateTopic(topicID, messageRetentionDuration);
+      await CreateTopic(topicID, messageRetentionDuration, {}, null as any);
       await loadResources();
     } catch (e: any) {
       throw e;
@@ -284,7 +284,7 @@ export function LogsSettingsTab({ activeTab }: LogsSettingsTabProps) {
       const end = endDate || (startDate ? '' : selectedDate);
 
       const result = hasFilters
-        ? await GetLogsFiltered(start, end, levelFilter, searchTerm, limit, offset)
+        ? await GetLogsFiltered(start, end, levelFilter, searchTerm, {}, limit, offset)
         : await GetLogs(selectedDate, limit, offset);
 
       if (!result) {
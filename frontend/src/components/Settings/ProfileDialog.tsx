@@ -382,6 +382,20 @@ export default function ProfileDialog({ profile, onSave, onClose, error: externa
                     />
                   </FormField>
 
+                  <FormField
+                    label="Image Digest"
+                    helperText="Optional: Pin the image to a digest (sha256:...) so every run uses the same emulator version"
+                  >
+                    <Input
+                      id="emulator-image-digest"
+                      type="text"
+                      value={managedConfig.imageDigest ?? ''}
+                      onChange={(e) => setManagedConfig({ ...managedConfig, imageDigest: e.target.value })}
+                      placeholder="sha256:..."
+                      disabled={saving}
+                    />
+                  </FormField>
+
                   <FormField
                     label="Data Directory"
                     helperText="Optional: Persist emulator data to this directory"
@@ -396,6 +410,18 @@ export default function ProfileDialog({ profile, onSave, onClose, error: externa
                     />
                   </FormField>
 
+                  <div className="flex items-center gap-2">
+                    <Checkbox
+                      id="emulator-persistent"
+                      checked={managedConfig.persistent ?? false}
+                      onCheckedChange={(checked) => setManagedConfig({ ...managedConfig, persistent: checked === true })}
+                      disabled={saving}
+                    />
+                    <Label htmlFor="emulator-persistent" className="text-sm">
+                      Keep container when stopped (state survives app restarts)
+                    </Label>
+                  </div>
+
                   <FormField
                     label="Bind Address"
                     helperText="127.0.0.1 (localhost only) or 0.0.0.0 (LAN access)"
@@ -1,5 +1,5 @@
 import { useState, useEffect, useRef, useCallback } from 'react';
-import { UpdateTheme, UpdateFontSize, GetConfigFileContent, SaveConfigFileContent, GetProfiles, SaveProfile, DeleteProfile, GetConnectionStatus, GetTopicSubscriptionTemplates, SaveCustomTopicSubscriptionTemplate, DeleteCustomTopicSubscriptionTemplate } from '../../wailsjs/go/main/App';
+import { UpdateTheme, UpdateFontSize, GetConfigFileContent, SaveConfigFileContent, GetProfiles, SaveProfile, DeleteProfile, GetConnectionStatus, GetTopicSubscriptionTemplates, SaveTopicSubscriptionTemplate, DeleteTopicSubscriptionTemplate } from '../../wailsjs/go/main/App';
 import { useTheme } from '../hooks/useTheme';
 import type { Theme, FontSize } from '../types/theme';
 import type { ConnectionProfile } from '../types';
@@ -386,7 +386,7 @@ export default function SettingsDialog({ open, onClose }: SettingsDialogProps) {
     if (!templateToDelete) return;
     setTemplateError('');
     try {
-      await DeleteCustomTopicSubscriptionTemplate(templateToDelete.id);
+      await DeleteTopicSubscriptionTemplate(templateToDelete.id);
       await loadTemplates();
       setDeleteTemplateConfirmOpen(false);
       setTemplateToDelete(null);
@@ -398,7 +398,7 @@ export default function SettingsDialog({ open, onClose }: SettingsDialogProps) {
   const handleSaveTemplate = async (template: models.TopicSubscriptionTemplate) => {
     setTemplateError('');
     try {
-      await SaveCustomTopicSubscriptionTemplate(template);
+      await SaveTopicSubscriptionTemplate(template);
       await loadTemplates();
       setTemplateDialogOpen(false);
       setEditingTemplate(null);
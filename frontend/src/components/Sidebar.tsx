@@ -128,8 +128,24 @@ export default function Sidebar({
           onCreateNew={onCreateConnection}
           refreshTrigger={profileRefreshTrigger}
         />
+        {/* Test Mode Indicator (emulator toggle is locked while test mode is on) */}
+        {status.testMode && (
+          <div
+            className="mt-3 px-3 py-2 text-sm font-medium text-center rounded-md"
+            style={{
+              backgroundColor: 'var(--color-warning-bg)',
+              borderColor: 'var(--color-warning-border)',
+              color: 'var(--color-warning)',
+              borderWidth: '1px',
+              borderStyle: 'solid',
+            }}
+            title={`All connections are routed to the emulator at ${status.emulatorHost ?? ''}`}
+          >
+            TEST MODE · Emulator only
+          </div>
+        )}
         {/* Emulator Toggle Button */}
-        {status.isConnected && onToggleEmulator && (
+        {status.isConnected && onToggleEmulator && !status.testMode && (
           <div className="mt-3 flex items-center gap-2">
             <button
               onClick={onToggleEmulator}
@@ -65,11 +65,17 @@ export default function SnapshotManager({ subscription }: SnapshotManagerProps)
       loadSnapshots();
     });
 
+    // Resource syncs carry every snapshot in the project; keep the ones on this subscription's topic
+    const unsubscribeUpdated = EventsOn('snapshots:updated', (data: { snapshots: SnapshotInfo[] }) => {
+      setSnapshots((data?.snapshots || []).filter((snapshot) => snapshot.topic === subscription.topic));
+    });
+
     return () => {
       unsubscribeCreated();
       unsubscribeDeleted();
+      unsubscribeUpdated();
     };
-  }, [loadSnapshots]);
+  }, [loadSnapshots, subscription.topic]);
 
   const handleCreateSnapshot = async () => {
     if (!newSnapshotName.trim()) {
@@ -46,7 +46,7 @@ export default function SubscriptionDialog({
   const [ackDeadline, setAckDeadline] = useState('10');
   const [retentionDuration, setRetentionDuration] = useState('');
   const [filter, setFilter] = useState('');
-  const [subscriptionType, setSubscriptionType] = useState<'pull' | 'push'>('pull');
+  const [subscriptionType, setSubscriptionType] = useState<Subscription['subscriptionType']>('pull');
   const [pushEndpoint, setPushEndpoint] = useState('');
   const [deadLetterTopic, setDeadLetterTopic] = useState('');
   const [maxDeliveryAttempts, setMaxDeliveryAttempts] = useState('');
@@ -82,6 +82,9 @@ export default function SubscriptionDialog({
     setError('');
   }, [mode, subscription, open]);
 
+  // BigQuery and Cloud Storage subscriptions cannot be turned into pull or push subscriptions
+  const deliveryTypeLocked = subscriptionType === 'bigquery' || subscriptionType === 'cloudstorage';
+
   const handleSave = async () => {
     setError('');
 
@@ -112,9 +115,14 @@ export default function SubscriptionDialog({
     try {
       const params: SubscriptionUpdateParams = {
         ackDeadline: ackDeadlineNum,
-        subscriptionType,
       };
 
+      // When editing, only send the delivery type if the user changed it, so the push config is left alone otherwise
+      const typeChanged = mode === 'create' || subscriptionType !== subscription?.subscriptionType;
+      if (typeChanged && (subscriptionType === 'pull' || subscriptionType === 'push')) {
+        params.subscriptionType = subscriptionType;
+      }
+
       if (retentionDuration.trim()) {
         params.retentionDuration = retentionDuration.trim();
       }
@@ -123,7 +131,11 @@ export default function SubscriptionDialog({
         params.filter = filter.trim();
       }
 
-      if (subscriptionType === 'push' && pushEndpoint.trim()) {
+      if (
+        subscriptionType === 'push' &&
+        pushEndpoint.trim() &&
+        (typeChanged || pushEndpoint.trim() !== subscription?.pushEndpoint)
+      ) {
         params.pushEndpoint = pushEndpoint.trim();
       }
 
@@ -244,7 +256,15 @@ export default function SubscriptionDialog({
           </FormField>
 
           {/* Subscription Type */}
-          <FormField label="Subscription Type" required>
+          <FormField
+            label="Subscription Type"
+            required
+            helperText={
+              deliveryTypeLocked
+                ? `This is a ${subscriptionType === 'bigquery' ? 'BigQuery' : 'Cloud Storage'} subscription; its delivery type cannot be changed`
+                : undefined
+            }
+          >
             <div className="flex gap-2">
               <button
                 type="button"
@@ -252,7 +272,7 @@ export default function SubscriptionDialog({
                   setSubscriptionType('pull');
                   setPushEndpoint('');
                 }}
-                disabled={isSaving}
+                disabled={isSaving || deliveryTypeLocked}
                 className={`flex-1 px-4 py-3 rounded-md border-2 transition-all font-medium ${
                   subscriptionType === 'pull'
                     ? 'border-blue-500'
@@ -283,7 +303,7 @@ export default function SubscriptionDialog({
               <button
                 type="button"
                 onClick={() => setSubscriptionType('push')}
-                disabled={isSaving}
+                disabled={isSaving || deliveryTypeLocked}
                 className={`flex-1 px-4 py-3 rounded-md border-2 transition-all font-medium ${
                   subscriptionType === 'push'
                     ? 'border-blue-500'
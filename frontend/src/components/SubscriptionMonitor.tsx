@@ -9,6 +9,7 @@ import {
   ClearMessageBuffer,
   SetAutoAck,
   GetAutoAck,
+  SetMessageLease,
 } from '../../wailsjs/go/main/App';
 import { useMessageSearch } from '../hooks/useMessageSearch';
 import MessageRow from './MessageRow';
@@ -30,6 +31,7 @@ export default function SubscriptionMonitor({ subscription }: SubscriptionMonito
   const [error, setError] = useState<string | null>(null);
   const [searchQuery, setSearchQuery] = useState('');
   const [autoAck, setAutoAck] = useState(true);
+  const [leaseHoldSeconds, setLeaseHoldSeconds] = useState(3600);
   const [debouncedSearchQuery, setDebouncedSearchQuery] = useState('');
   const [selectedMessage, setSelectedMessage] = useState<PubSubMessage | null>(null);
   const [isDetailDialogOpen, setIsDetailDialogOpen] = useState(false);
@@ -99,6 +101,13 @@ export default function SubscriptionMonitor({ subscription }: SubscriptionMonito
       }
     });
 
+    // Buffer cleared event (also fired by ClearAllBuffers)
+    const unsubscribeCleared = EventsOn('monitor:buffer-cleared', (data: { subscriptionID: string; count: number }) => {
+      if (data.subscriptionID === subscription.name) {
+        setMessages([]);
+      }
+    });
+
     // Monitor error event
     const unsubscribeError = EventsOn('monitor:error', (data: { subscriptionID: string; error: string }) => {
       if (data.subscriptionID === subscription.name) {
@@ -111,6 +120,7 @@ export default function SubscriptionMonitor({ subscription }: SubscriptionMonito
       unsubscribeMessage();
       unsubscribeStarted();
       unsubscribeStopped();
+      unsubscribeCleared();
       unsubscribeError();
     };
   }, [subscription.name]);
@@ -133,7 +143,7 @@ export default function SubscriptionMonitor({ subscription }: SubscriptionMonito
     setIsLoading(true);
     setError(null);
     try {
-      await StartMonitor(subscription.name);
+      await StartMonitor(subscription.name, {});
       // Event listener will update isMonitoring state
     } catch (err) {
       setIsLoading(false);
@@ -170,6 +180,14 @@ export default function SubscriptionMonitor({ subscription }: SubscriptionMonito
     }
   };
 
+  const handleCommitLeaseHold = async () => {
+    try {
+      await SetMessageLease(subscription.name, leaseHoldSeconds);
+    } catch (err) {
+      setError(err instanceof Error ? err.message : 'Failed to update message lease');
+    }
+  };
+
   const handleOpenMessageDetail = (message: PubSubMessage) => {
     setSelectedMessage(message);
     setIsDetailDialogOpen(true);
@@ -284,6 +302,36 @@ export default function SubscriptionMonitor({ subscription }: SubscriptionMonito
               </span>
             </label>
 
+            {!autoAck && (
+              <label
+                className="flex items-center gap-2"
+                title="How long unacked messages are held before being released for redelivery (0 = release at ack deadline)"
+              >
+                <span
+                  className="text-xs"
+                  style={{ color: 'var(--color-text-primary)' }}
+                >
+                  Hold
+                </span>
+                <input
+                  type="range"
+                  min={0}
+                  max={3600}
+                  step={30}
+                  value={leaseHoldSeconds}
+                  onChange={(e) => setLeaseHoldSeconds(Number(e.target.value))}
+                  onPointerUp={handleCommitLeaseHold}
+                  onKeyUp={handleCommitLeaseHold}
+                />
+                <span
+                  className="text-xs w-12"
+                  style={{ color: 'var(--color-text-secondary)' }}
+                >
+                  {leaseHoldSeconds === 0 ? 'off' : `${leaseHoldSeconds}s`}
+                </span>
+              </label>
+            )}
+
             <Button
               variant="outline"
               size="sm"
@@ -167,10 +167,17 @@ export default function TopicDetails({ topic, allSubscriptions, allTopics, onDel
       }
     });
 
+    const unsubscribeCleared = EventsOn('monitor:buffer-cleared', (data: { subscriptionID: string; count: number }) => {
+      if (data.subscriptionID === tempSubId) {
+        setMonitoringMessages([]);
+      }
+    });
+
     return () => {
       unsubscribeMessage();
       unsubscribeStarted();
       unsubscribeStopped();
+      unsubscribeCleared();
     };
   }, [tempSubId]);
 
@@ -291,7 +298,7 @@ export default function TopicDetails({ topic, allSubscriptions, allTopics, onDel
 
     setIsPublishing(true);
     try {
-      const result = await PublishMessage(topic.name, payload, attrsObj);
+      const result = await PublishMessage(topic.name, payload, attrsObj, false, []);
       setPublishResult(result as PublishResult);
       setError('');
     } catch (e: any) {
@@ -365,7 +372,7 @@ export default function TopicDetails({ topic, allSubscriptions, allTopics, onDel
     try {
       // Pass selected subscription ID (or empty string for auto-create)
       const subscriptionID = selectedSubscriptionForMonitoring || '';
-      await StartTopicMonitor(topic.name, subscriptionID);
+      await StartTopicMonitor(topic.name, subscriptionID, {});
     } catch (err) {
       const errorMessage = err instanceof Error ? err.message : String(err);
       // Extract a user-friendly error message
@@ -3,7 +3,7 @@ import { EventsOn } from '../wailsjs/runtime/runtime';
 import {
   GetCurrentVersion,
   CheckForUpdates,
-  DismissUpgradeNotification,
+  DismissUpgrade,
   OpenReleasesPage,
 } from '../wailsjs/go/main/App';
 import { version } from '../wailsjs/go/models';
@@ -43,7 +43,7 @@ export default function UpgradeNotification() {
     setIsDismissing(true);
     setError('');
     try {
-      await DismissUpgradeNotification(updateInfo.latestVersion);
+      await DismissUpgrade(updateInfo.latestVersion);
       setIsVisible(false);
       setUpdateInfo(null);
       setShowReleaseNotes(false);
@@ -7,10 +7,25 @@ export type EmulatorMode = 'off' | 'external' | 'managed';
 export interface ManagedEmulatorConfig {
   port: number;                    // Host port to expose (default: 8085)
   image?: string;                  // Docker image (default: google/cloud-sdk:emulators)
+  imageDigest?: string;            // Optional digest (sha256:...) pinning the image for reproducible runs
   dataDir?: string;                // Optional data directory for persistence
   autoStart: boolean;              // Start emulator automatically on connect (default: true)
   autoStop: boolean;               // Stop emulator on disconnect (default: true)
   bindAddress?: string;            // Bind address (default: 127.0.0.1, use 0.0.0.0 for LAN access)
+  persistent?: boolean;            // Keep the container when stopped and restart it on next start
+  runtime?: 'auto' | 'docker' | 'podman'; // Container runtime (default: auto, Docker if installed, else Podman)
+}
+
+// Result of TestProfile (checks a profile without switching to it)
+export interface ProfileTestResult {
+  profileId: string;
+  success: boolean;
+  authMethod: string;
+  emulatorHost?: string;
+  latencyMs: number;
+  containerRuntime?: string; // Managed emulator profiles: runtime that would run the emulator
+  message?: string;          // Informational note when reachability could not be checked
+  error?: string;
 }
 
 export interface ConnectionProfile {
@@ -26,6 +41,11 @@ export interface ConnectionProfile {
   managedEmulator?: ManagedEmulatorConfig;  // Settings for managed Docker emulator
   isDefault: boolean;
   createdAt: string;
+  seedConfig?: EmulatorSeedConfig; // Resources created after an emulator reset
+}
+
+export interface EmulatorSeedConfig {
+  topics: { id: string; subscriptions?: string[] }[];
 }
 
 export interface ConnectionStatus {
@@ -35,12 +55,34 @@ export interface ConnectionStatus {
   emulatorHost?: string;
   emulatorMode?: string; // 'off' | 'external' | 'managed'
   managedEmulatorRunning?: boolean;
+  testMode?: boolean;    // All connections are forced to the test-mode emulator host
 }
 
 export interface Topic {
   name: string;
   displayName: string;
   messageRetention?: string;
+  labels?: Record<string, string>;
+  schemaSettings?: SchemaSettings;
+}
+
+export interface SchemaSettings {
+  schema: string; // Schema ID or full name
+  encoding: string; // "JSON" | "BINARY"
+}
+
+export interface SchemaInfo {
+  name: string;
+  displayName: string;
+  type: string; // "AVRO" | "PROTOCOL_BUFFER"
+  definition?: string;
+  revisionId?: string;
+  revisionCreateTime?: string;
+}
+
+export interface TopicUpdateParams {
+  labels?: Record<string, string>; // Replaces all labels; {} removes them
+  messageRetentionDuration?: string; // "" clears the retention
 }
 
 export interface Subscription {
@@ -51,8 +93,32 @@ export interface Subscription {
   retentionDuration: string;
   filter?: string;
   deadLetterPolicy?: DeadLetterPolicy;
-  subscriptionType: 'pull' | 'push';
+  subscriptionType: 'pull' | 'push' | 'bigquery' | 'cloudstorage';
   pushEndpoint?: string;
+  bigQueryTable?: string;    // BigQuery subscriptions: destination table (project.dataset.table)
+  cloudStorageBucket?: string; // Cloud Storage subscriptions: destination bucket
+  retainAckedMessages?: boolean;
+  enableOrdering?: boolean;
+  enableExactlyOnce?: boolean;
+  retryPolicy?: RetryPolicy;
+  readOnlyFields?: string[]; // Fields that cannot be changed after creation (e.g. "topic", "enableOrdering")
+  detached?: boolean;        // Detached from its topic (no longer receives messages)
+}
+
+// Sidebar badge counts (from GetResourceCounts and resources:updated)
+export interface ResourceCounts {
+  topics: number;
+  pullSubscriptions: number;
+  pushSubscriptions: number;
+  bigQuerySubscriptions: number;
+  cloudStorageSubscriptions: number;
+  detachedSubscriptions: number;  // Also counted under their delivery type
+}
+
+// Canonical names of a resource (from ResolveResourceName)
+export interface ResourceName {
+  short: string;  // e.g. "orders"
+  full: string;   // e.g. "projects/my-project/topics/orders"
 }
 
 export interface DeadLetterPolicy {
@@ -67,6 +133,7 @@ export interface SubscriptionUpdateParams {
   deadLetterPolicy?: DeadLetterPolicy;
   pushEndpoint?: string;
   subscriptionType?: 'pull' | 'push';
+  retainAckedMessages?: boolean;
 }
 
 export type ResourceType = 'topic' | 'subscription';
@@ -91,6 +158,51 @@ export interface PublishResult {
   timestamp: string;
 }
 
+export interface TopicPublishResult {
+  topicId: string;
+  result?: PublishResult;
+  error?: string;
+}
+
+export interface MultiPublishResult {
+  results: TopicPublishResult[];
+  succeeded: number;
+  failed: number;
+}
+
+export interface BatchMessageInput {
+  payload: string;
+  attributes?: Record<string, string>;
+}
+
+export interface BatchFailure {
+  index: number;
+  line?: number; // 1-based file line (PublishFromFile only)
+  error: string;
+}
+
+export interface BatchPublishResult {
+  total: number;
+  succeeded: number;
+  failed: number;
+  messageIds: string[]; // Index-aligned with the input; empty for failed messages
+  failures: BatchFailure[];
+  durationMs: number;
+}
+
+export interface PublishHistoryEntry {
+  id: string;
+  topicId: string;
+  payload: string;
+  attributes?: Record<string, string>;
+  messageId?: string;
+  timestamp: string;
+  success: boolean;
+  error?: string;
+  source: 'publish' | 'template' | 'republish' | 'resend';
+  truncated?: boolean;
+}
+
 export interface PubSubMessage {
   id: string;
   publishTime: string;           // ISO 8601
@@ -179,6 +291,12 @@ export interface TemplateCreateResult {
   error?: string;
 }
 
+export interface DriftField {
+  field: string;
+  expected: string;
+  actual: string;
+}
+
 // Snapshot Types
 export interface SnapshotInfo {
   name: string;
@@ -196,7 +314,26 @@ export interface EmulatorStatus {
   profileId: string;
   containerName: string;
   host: string;
-  port: number;
+  port: number;           // Host port actually assigned to the container
+  configuredPort?: number; // Port from the profile config when another managed emulator held it
   status: EmulatorStatusType;
   error?: string;
+  imageId?: string;       // Image ID (sha256 digest) of the running container
+  imageWarning?: string;  // Set when the local image tag resolves to a newer image
+  persistent?: boolean;   // Container is kept when stopped
+  runtime?: string;       // Container runtime running the emulator ("docker" or "podman")
+  dataDirConfigured: boolean; // Emulator state is persisted to a host directory
+  dataDir?: string;       // Host directory mounted at /data
+}
+
+export interface EmulatorPortReassigned {
+  profileId: string;
+  configuredPort: number;
+  port: number;
+}
+
+export interface BacklogWarning {
+  subscriptionId: string;
+  ageSeconds: number;
+  thresholdSeconds: number;
 }
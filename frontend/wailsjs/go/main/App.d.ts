@@ -1,19 +1,43 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
+import {app} from '../models';
 import {version} from '../models';
 import {models} from '../models';
-import {subscriber} from '../models';
-import {app} from '../models';
-import {main} from '../models';
 import {admin} from '../models';
+import {main} from '../models';
+import {subscriber} from '../models';
+import {audit} from '../models';
+import {auth} from '../models';
+import {publisher} from '../models';
+import {schema} from '../models';
+
+export function AckMessage(arg1:string,arg2:string):Promise<void>;
 
-export function CheckDockerAvailable():Promise<void>;
+export function BulkDeleteResources(arg1:Array<string>,arg2:Array<string>,arg3:boolean):Promise<app.BulkDeleteResult>;
+
+export function CancelScheduledPublish(arg1:string):Promise<void>;
+
+export function CheckContainerRuntime(arg1:string):Promise<string>;
 
 export function CheckEmulatorStatus(arg1:string):Promise<Record<string, any>>;
 
 export function CheckForUpdates():Promise<version.UpdateInfo>;
 
-export function ClearMessageBuffer(arg1:string):Promise<void>;
+export function CheckSubscriptionDrift(arg1:string,arg2:string,arg3:number):Promise<Array<models.DriftField>>;
+
+export function ClearAllBuffers():Promise<number>;
+
+export function ClearAllLogs():Promise<number>;
+
+export function ClearLogs(arg1:string):Promise<number>;
+
+export function ClearMessageBuffer(arg1:string):Promise<number>;
+
+export function ClearPublishHistory():Promise<void>;
+
+export function ClearTestMode():Promise<void>;
+
+export function CloneSubscription(arg1:string,arg2:string,arg3:admin.SubscriptionUpdateParams):Promise<void>;
 
 export function ConnectWithADC(arg1:string,arg2:string):Promise<void>;
 
@@ -23,13 +47,15 @@ export function ConnectWithServiceAccount(arg1:string,arg2:string,arg3:string):P
 
 export function CreateFromTemplate(arg1:models.TemplateCreateRequest):Promise<models.TemplateCreateResult>;
 
+export function CreateFromTemplateMultiEnv(arg1:string,arg2:string,arg3:Array<string>):Promise<Array<models.TemplateEnvironmentResult>>;
+
 export function CreateSnapshot(arg1:string,arg2:string):Promise<void>;
 
 export function CreateSubscription(arg1:string,arg2:string,arg3:number):Promise<void>;
 
-export function CreateTopic(arg1:string,arg2:string):Promise<void>;
+export function CreateTopic(arg1:string,arg2:string,arg3:Record<string, string>,arg4:admin.SchemaSettings):Promise<void>;
 
-export function DeleteCustomTopicSubscriptionTemplate(arg1:string):Promise<void>;
+export function DeleteAttributeTemplate(arg1:string):Promise<void>;
 
 export function DeleteProfile(arg1:string):Promise<void>;
 
@@ -41,88 +67,254 @@ export function DeleteTemplate(arg1:string):Promise<void>;
 
 export function DeleteTopic(arg1:string):Promise<void>;
 
+export function DeleteTopicSubscriptionTemplate(arg1:string):Promise<void>;
+
+export function DetachSubscription(arg1:string):Promise<void>;
+
 export function Disconnect():Promise<void>;
 
-export function DismissUpgradeNotification(arg1:string):Promise<void>;
+export function DismissUpgrade(arg1:string):Promise<void>;
+
+export function DownloadUpdate():Promise<string>;
+
+export function EnsureTopicAndSubscription(arg1:string,arg2:string,arg3:admin.SubscriptionConfig):Promise<admin.EnsureResult>;
+
+export function EstimateDrainTime(arg1:string):Promise<app.DrainEstimate>;
+
+export function ExportBufferedMessages(arg1:string,arg2:string,arg3:boolean):Promise<string>;
+
+export function ExportCatalog(arg1:string):Promise<void>;
+
+export function ExportConfig():Promise<string>;
+
+export function ExportTemplatesReport(arg1:string):Promise<void>;
+
+export function FlushEmulatorData(arg1:string):Promise<void>;
+
+export function FormatPayload(arg1:string,arg2:string):Promise<string>;
+
+export function ForwardMessage(arg1:string,arg2:string,arg3:string,arg4:boolean):Promise<main.PublishResult>;
+
+export function GetAckStats(arg1:string):Promise<subscriber.AckStats>;
+
+export function GetActiveMonitors():Promise<Array<app.ActiveMonitorInfo>>;
+
+export function GetAuditLog(arg1:string):Promise<Array<audit.Entry>>;
 
 export function GetAutoAck():Promise<boolean>;
 
 export function GetBufferedMessages(arg1:string):Promise<Array<subscriber.PubSubMessage>>;
 
+export function GetBufferedMessagesAs(arg1:string,arg2:string):Promise<Array<subscriber.PubSubMessage>>;
+
+export function GetBufferedMessagesDeduped(arg1:string):Promise<Array<subscriber.PubSubMessage>>;
+
 export function GetConfigFileContent():Promise<string>;
 
 export function GetConnectionStatus():Promise<app.ConnectionStatus>;
 
 export function GetCurrentVersion():Promise<string>;
 
+export function GetDeadLetterChain(arg1:string):Promise<app.DeadLetterChain>;
+
 export function GetEmulatorStatus(arg1:string):Promise<main.EmulatorStatus>;
 
+export function GetLatestReleaseInfo():Promise<version.ReleaseInfo>;
+
 export function GetLogs(arg1:string,arg2:number,arg3:number):Promise<Array<app.LogEntry>>;
 
-export function GetLogsFiltered(arg1:string,arg2:string,arg3:string,arg4:string,arg5:number,arg6:number):Promise<app.FilteredLogsResult>;
+export function GetLogsFiltered(arg1:string,arg2:string,arg3:string,arg4:string,arg5:Record<string, string>,arg6:number,arg7:number):Promise<app.FilteredLogsResult>;
+
+export function GetMessageSummaries(arg1:string):Promise<Array<app.MessageSummary>>;
+
+export function GetPayloadSizeHistogram(arg1:string):Promise<subscriber.SizeHistogram>;
+
+export function GetProfileDataDir(arg1:string):Promise<string>;
 
 export function GetProfiles():Promise<Array<models.ConnectionProfile>>;
 
+export function GetPublishHistory(arg1:number):Promise<Array<app.PublishHistoryEntry>>;
+
+export function GetResourceCounts():Promise<app.ResourceCounts>;
+
 export function GetSnapshot(arg1:string):Promise<admin.SnapshotInfo>;
 
+export function GetSubscriptionBacklog(arg1:string):Promise<app.BacklogInfo>;
+
+export function GetSubscriptionIAMPolicy(arg1:string):Promise<admin.IAMPolicy>;
+
 export function GetSubscriptionMetadata(arg1:string):Promise<admin.SubscriptionInfo>;
 
+export function GetSubscriptionOps(arg1:string):Promise<app.SubscriptionOps>;
+
 export function GetTemplates(arg1:string):Promise<Array<models.MessageTemplate>>;
 
+export function GetTopicIAMPolicy(arg1:string):Promise<admin.IAMPolicy>;
+
 export function GetTopicMetadata(arg1:string):Promise<admin.TopicInfo>;
 
 export function GetTopicSubscriptionTemplates():Promise<Array<models.TopicSubscriptionTemplate>>;
 
 export function GetTopicSubscriptionTemplatesByCategory(arg1:string):Promise<Array<models.TopicSubscriptionTemplate>>;
 
+export function GetUpgradePreferences():Promise<main.UpgradePreferences>;
+
 export function GetVersion():Promise<string>;
 
+export function ImportConfig(arg1:string,arg2:boolean):Promise<void>;
+
+export function ImportGcloudConfigurations():Promise<Array<models.ConnectionProfile>>;
+
+export function InstallUpdate(arg1:string):Promise<void>;
+
+export function ListAttributeTemplates():Promise<Array<models.AttributeTemplate>>;
+
+export function ListOAuthAccounts():Promise<Array<auth.OAuthAccount>>;
+
+export function ListPublishLoops():Promise<Array<publisher.PublishLoopStatus>>;
+
+export function ListScheduledPublishes():Promise<Array<publisher.ScheduledPublish>>;
+
+export function ListSchemas():Promise<Array<schema.SchemaInfo>>;
+
 export function ListSnapshots():Promise<Array<admin.SnapshotInfo>>;
 
 export function ListSnapshotsForSubscription(arg1:string):Promise<Array<admin.SnapshotInfo>>;
 
 export function ListSubscriptions():Promise<Array<admin.SubscriptionInfo>>;
 
+export function ListSubscriptionsPage(arg1:number,arg2:string,arg3:string):Promise<admin.SubscriptionsPage>;
+
 export function ListTopics():Promise<Array<admin.TopicInfo>>;
 
+export function ListTopicsPage(arg1:number,arg2:string,arg3:string):Promise<admin.TopicsPage>;
+
+export function NackMessage(arg1:string,arg2:string):Promise<void>;
+
 export function OpenReleasesPage(arg1:string):Promise<void>;
 
-export function PublishMessage(arg1:string,arg2:string,arg3:Record<string, string>):Promise<main.PublishResult>;
+export function PauseMonitor(arg1:string):Promise<void>;
 
-export function SaveConfigFileContent(arg1:string):Promise<void>;
+export function PreviewDelete(arg1:string,arg2:string):Promise<app.DeletePreview>;
+
+export function PublishFromFile(arg1:string,arg2:string,arg3:string):Promise<publisher.BatchPublishResult>;
+
+export function PublishFromTemplate(arg1:string,arg2:string):Promise<main.PublishResult>;
+
+export function PublishMessage(arg1:string,arg2:string,arg3:Record<string, string>,arg4:boolean,arg5:Array<string>):Promise<main.PublishResult>;
+
+export function PublishMessagesBatch(arg1:string,arg2:Array<publisher.BatchMessageInput>,arg3:number):Promise<publisher.BatchPublishResult>;
+
+export function PublishToMultiple(arg1:Array<string>,arg2:string,arg3:Record<string, string>):Promise<publisher.MultiPublishResult>;
+
+export function PublishToTopics(arg1:Array<string>,arg2:string,arg3:Record<string, string>):Promise<Record<string, main.PublishResult>>;
+
+export function PurgeSubscription(arg1:string):Promise<void>;
+
+export function RenderTemplate(arg1:string):Promise<publisher.RenderedMessage>;
+
+export function ReplayBufferedMessages(arg1:string,arg2:string,arg3:boolean):Promise<publisher.BatchPublishResult>;
 
-export function SaveCustomTopicSubscriptionTemplate(arg1:models.TopicSubscriptionTemplate):Promise<void>;
+export function ReplayLast(arg1:string,arg2:string):Promise<app.ReplayResult>;
+
+export function RepublishWithEdits(arg1:string,arg2:string,arg3:string,arg4:Record<string, string>,arg5:string):Promise<main.PublishResult>;
+
+export function ResendFromHistory(arg1:string):Promise<main.PublishResult>;
+
+export function ResendFromHistoryToTopic(arg1:string,arg2:string):Promise<main.PublishResult>;
+
+export function ResetEmulator(arg1:string):Promise<void>;
+
+export function ResolveResourceName(arg1:string,arg2:string):Promise<app.ResourceName>;
+
+export function ResumeMonitor(arg1:string):Promise<void>;
+
+export function RevokeOAuthAccount(arg1:string):Promise<void>;
+
+export function SaveAttributeTemplate(arg1:models.AttributeTemplate):Promise<models.AttributeTemplate>;
+
+export function SaveConfigFileContent(arg1:string):Promise<void>;
 
 export function SaveProfile(arg1:models.ConnectionProfile):Promise<void>;
 
 export function SaveTemplate(arg1:models.MessageTemplate):Promise<void>;
 
+export function SaveTopicSubscriptionTemplate(arg1:models.TopicSubscriptionTemplate):Promise<models.TopicSubscriptionTemplate>;
+
+export function SchedulePublish(arg1:string,arg2:string,arg3:Record<string, string>,arg4:string):Promise<string>;
+
+export function SearchBufferedMessages(arg1:string,arg2:string,arg3:boolean):Promise<Array<subscriber.PubSubMessage>>;
+
+export function SeekSubscription(arg1:string,arg2:string):Promise<void>;
+
 export function SeekToSnapshot(arg1:string,arg2:string):Promise<void>;
 
 export function SeekToTimestamp(arg1:string,arg2:string):Promise<void>;
 
+export function SetAPICallPolicy(arg1:number,arg2:models.APIRetryPolicy):Promise<void>;
+
 export function SetAutoAck(arg1:boolean):Promise<void>;
 
+export function SetBacklogAgeWarnSeconds(arg1:number):Promise<void>;
+
+export function SetEmulatorHealthCheckSeconds(arg1:number):Promise<void>;
+
+export function SetLogRetentionDays(arg1:number):Promise<void>;
+
+export function SetMessageLease(arg1:string,arg2:number):Promise<void>;
+
+export function SetMonitorHighlightRules(arg1:Array<models.HighlightRule>):Promise<void>;
+
+export function SetMonitorOptions(arg1:models.MonitorOptions):Promise<void>;
+
+export function SetMonitorSubscriptionTTL(arg1:number):Promise<void>;
+
+export function SetPersistPublishHistory(arg1:boolean):Promise<void>;
+
+export function SetSubscriptionIAMPolicy(arg1:string,arg2:admin.IAMPolicy):Promise<admin.IAMPolicy>;
+
+export function SetTestMode(arg1:string):Promise<void>;
+
+export function SetTopicIAMPolicy(arg1:string,arg2:admin.IAMPolicy):Promise<admin.IAMPolicy>;
+
 export function SetVersion(arg1:string):Promise<void>;
 
+export function SimulateRedelivery(arg1:string,arg2:number):Promise<app.RedeliveryResult>;
+
+export function SnoozeUpgrade(arg1:number):Promise<void>;
+
+export function StartLogTail(arg1:string):Promise<void>;
+
 export function StartManagedEmulator(arg1:string):Promise<void>;
 
-export function StartMonitor(arg1:string):Promise<void>;
+export function StartMonitor(arg1:string,arg2:models.MonitorOptions):Promise<void>;
 
 export function StartPeriodicUpgradeCheck():Promise<void>;
 
-export function StartTopicMonitor(arg1:string,arg2:string):Promise<void>;
+export function StartPublishLoop(arg1:string,arg2:string,arg3:Record<string, string>,arg4:number,arg5:number):Promise<string>;
+
+export function StartTopicMonitor(arg1:string,arg2:string,arg3:models.MonitorOptions):Promise<void>;
+
+export function StopLogTail():Promise<void>;
 
 export function StopManagedEmulator(arg1:string):Promise<void>;
 
 export function StopMonitor(arg1:string):Promise<void>;
 
+export function StopPublishLoop(arg1:string):Promise<void>;
+
 export function StopTopicMonitor(arg1:string):Promise<void>;
 
 export function SwitchProfile(arg1:string):Promise<void>;
 
+export function SwitchProject(arg1:string):Promise<void>;
+
 export function SyncResources():Promise<void>;
 
+export function TestProfile(arg1:string):Promise<app.ProfileTestResult>;
+
+export function TestSubscriptionFilter(arg1:string,arg2:string,arg3:Record<string, string>):Promise<boolean>;
+
 export function UpdateFontSize(arg1:string):Promise<void>;
 
 export function UpdateSubscription(arg1:string,arg2:app.SubscriptionUpdateParams):Promise<void>;
@@ -130,3 +322,11 @@ export function UpdateSubscription(arg1:string,arg2:app.SubscriptionUpdateParams
 export function UpdateTemplate(arg1:string,arg2:models.MessageTemplate):Promise<void>;
 
 export function UpdateTheme(arg1:string):Promise<void>;
+
+export function UpdateTopic(arg1:string,arg2:admin.TopicUpdateParams):Promise<void>;
+
+export function ValidateAllProfiles():Promise<Array<app.ProfileValidationIssue>>;
+
+export function ValidateMessage(arg1:string,arg2:Record<string, string>):Promise<void>;
+
+export function ValidateMessageAgainstSchema(arg1:string,arg2:string):Promise<void>;
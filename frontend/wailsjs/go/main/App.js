@@ -2,8 +2,20 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
-export function CheckDockerAvailable() {
-  return window['go']['main']['App']['CheckDockerAvailable']();
+export function AckMessage(arg1, arg2) {
+  return window['go']['main']['App']['AckMessage'](arg1, arg2);
+}
+
+export function BulkDeleteResources(arg1, arg2, arg3) {
+  return window['go']['main']['App']['BulkDeleteResources'](arg1, arg2, arg3);
+}
+
+export function CancelScheduledPublish(arg1) {
+  return window['go']['main']['App']['CancelScheduledPublish'](arg1);
+}
+
+export function CheckContainerRuntime(arg1) {
+  return window['go']['main']['App']['CheckContainerRuntime'](arg1);
 }
 
 export function CheckEmulatorStatus(arg1) {
@@ -14,10 +26,38 @@ export function CheckForUpdates() {
   return window['go']['main']['App']['CheckForUpdates']();
 }
 
+export function CheckSubscriptionDrift(arg1, arg2, arg3) {
+  return window['go']['main']['App']['CheckSubscriptionDrift'](arg1, arg2, arg3);
+}
+
+export function ClearAllBuffers() {
+  return window['go']['main']['App']['ClearAllBuffers']();
+}
+
+export function ClearAllLogs() {
+  return window['go']['main']['App']['ClearAllLogs']();
+}
+
+export function ClearLogs(arg1) {
+  return window['go']['main']['App']['ClearLogs'](arg1);
+}
+
 export function ClearMessageBuffer(arg1) {
   return window['go']['main']['App']['ClearMessageBuffer'](arg1);
 }
 
+export function ClearPublishHistory() {
+  return window['go']['main']['App']['ClearPublishHistory']();
+}
+
+export function ClearTestMode() {
+  return window['go']['main']['App']['ClearTestMode']();
+}
+
+export function CloneSubscription(arg1, arg2, arg3) {
+  return window['go']['main']['App']['CloneSubscription'](arg1, arg2, arg3);
+}
+
 export function ConnectWithADC(arg1, arg2) {
   return window['go']['main']['App']['ConnectWithADC'](arg1, arg2);
 }
@@ -34,6 +74,10 @@ export function CreateFromTemplate(arg1) {
   return window['go']['main']['App']['CreateFromTemplate'](arg1);
 }
 
+export function CreateFromTemplateMultiEnv(arg1, arg2, arg3) {
+  return window['go']['main']['App']['CreateFromTemplateMultiEnv'](arg1, arg2, arg3);
+}
+
 export function CreateSnapshot(arg1, arg2) {
   return window['go']['main']['App']['CreateSnapshot'](arg1, arg2);
 }
@@ -42,12 +86,12 @@ export function CreateSubscription(arg1, arg2, arg3) {
   return window['go']['main']['App']['CreateSubscription'](arg1, arg2, arg3);
 }
 
-export function CreateTopic(arg1, arg2) {
-  return window['go']['main']['App']['CreateTopic'](arg1, arg2);
+export function CreateTopic(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['CreateTopic'](arg1, arg2, arg3, arg4);
 }
 
-export function DeleteCustomTopicSubscriptionTemplate(arg1) {
-  return window['go']['main']['App']['DeleteCustomTopicSubscriptionTemplate'](arg1);
+export function DeleteAttributeTemplate(arg1) {
+  return window['go']['main']['App']['DeleteAttributeTemplate'](arg1);
 }
 
 export function DeleteProfile(arg1) {
@@ -70,12 +114,72 @@ export function DeleteTopic(arg1) {
   return window['go']['main']['App']['DeleteTopic'](arg1);
 }
 
+export function DeleteTopicSubscriptionTemplate(arg1) {
+  return window['go']['main']['App']['DeleteTopicSubscriptionTemplate'](arg1);
+}
+
+export function DetachSubscription(arg1) {
+  return window['go']['main']['App']['DetachSubscription'](arg1);
+}
+
 export function Disconnect() {
   return window['go']['main']['App']['Disconnect']();
 }
 
-export function DismissUpgradeNotification(arg1) {
-  return window['go']['main']['App']['DismissUpgradeNotification'](arg1);
+export function DismissUpgrade(arg1) {
+  return window['go']['main']['App']['DismissUpgrade'](arg1);
+}
+
+export function DownloadUpdate() {
+  return window['go']['main']['App']['DownloadUpdate']();
+}
+
+export function EnsureTopicAndSubscription(arg1, arg2, arg3) {
+  return window['go']['main']['App']['EnsureTopicAndSubscription'](arg1, arg2, arg3);
+}
+
+export function EstimateDrainTime(arg1) {
+  return window['go']['main']['App']['EstimateDrainTime'](arg1);
+}
+
+export function ExportBufferedMessages(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ExportBufferedMessages'](arg1, arg2, arg3);
+}
+
+export function ExportCatalog(arg1) {
+  return window['go']['main']['App']['ExportCatalog'](arg1);
+}
+
+export function ExportConfig() {
+  return window['go']['main']['App']['ExportConfig']();
+}
+
+export function ExportTemplatesReport(arg1) {
+  return window['go']['main']['App']['ExportTemplatesReport'](arg1);
+}
+
+export function FlushEmulatorData(arg1) {
+  return window['go']['main']['App']['FlushEmulatorData'](arg1);
+}
+
+export function FormatPayload(arg1, arg2) {
+  return window['go']['main']['App']['FormatPayload'](arg1, arg2);
+}
+
+export function ForwardMessage(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['ForwardMessage'](arg1, arg2, arg3, arg4);
+}
+
+export function GetAckStats(arg1) {
+  return window['go']['main']['App']['GetAckStats'](arg1);
+}
+
+export function GetActiveMonitors() {
+  return window['go']['main']['App']['GetActiveMonitors']();
+}
+
+export function GetAuditLog(arg1) {
+  return window['go']['main']['App']['GetAuditLog'](arg1);
 }
 
 export function GetAutoAck() {
@@ -86,6 +190,14 @@ export function GetBufferedMessages(arg1) {
   return window['go']['main']['App']['GetBufferedMessages'](arg1);
 }
 
+export function GetBufferedMessagesAs(arg1, arg2) {
+  return window['go']['main']['App']['GetBufferedMessagesAs'](arg1, arg2);
+}
+
+export function GetBufferedMessagesDeduped(arg1) {
+  return window['go']['main']['App']['GetBufferedMessagesDeduped'](arg1);
+}
+
 export function GetConfigFileContent() {
   return window['go']['main']['App']['GetConfigFileContent']();
 }
@@ -98,34 +210,78 @@ export function GetCurrentVersion() {
   return window['go']['main']['App']['GetCurrentVersion']();
 }
 
+export function GetDeadLetterChain(arg1) {
+  return window['go']['main']['App']['GetDeadLetterChain'](arg1);
+}
+
 export function GetEmulatorStatus(arg1) {
   return window['go']['main']['App']['GetEmulatorStatus'](arg1);
 }
 
+export function GetLatestReleaseInfo() {
+  return window['go']['main']['App']['GetLatestReleaseInfo']();
+}
+
 export function GetLogs(arg1, arg2, arg3) {
   return window['go']['main']['App']['GetLogs'](arg1, arg2, arg3);
 }
 
-export function GetLogsFiltered(arg1, arg2, arg3, arg4, arg5, arg6) {
-  return window['go']['main']['App']['GetLogsFiltered'](arg1, arg2, arg3, arg4, arg5, arg6);
+export function GetLogsFiltered(arg1, arg2, arg3, arg4, arg5, arg6, arg7) {
+  return window['go']['main']['App']['GetLogsFiltered'](arg1, arg2, arg3, arg4, arg5, arg6, arg7);
+}
+
+export function GetMessageSummaries(arg1) {
+  return window['go']['main']['App']['GetMessageSummaries'](arg1);
+}
+
+export function GetPayloadSizeHistogram(arg1) {
+  return window['go']['main']['App']['GetPayloadSizeHistogram'](arg1);
+}
+
+export function GetProfileDataDir(arg1) {
+  return window['go']['main']['App']['GetProfileDataDir'](arg1);
 }
 
 export function GetProfiles() {
   return window['go']['main']['App']['GetProfiles']();
 }
 
+export function GetPublishHistory(arg1) {
+  return window['go']['main']['App']['GetPublishHistory'](arg1);
+}
+
+export function GetResourceCounts() {
+  return window['go']['main']['App']['GetResourceCounts']();
+}
+
 export function GetSnapshot(arg1) {
   return window['go']['main']['App']['GetSnapshot'](arg1);
 }
 
+export function GetSubscriptionBacklog(arg1) {
+  return window['go']['main']['App']['GetSubscriptionBacklog'](arg1);
+}
+
+export function GetSubscriptionIAMPolicy(arg1) {
+  return window['go']['main']['App']['GetSubscriptionIAMPolicy'](arg1);
+}
+
 export function GetSubscriptionMetadata(arg1) {
   return window['go']['main']['App']['GetSubscriptionMetadata'](arg1);
 }
 
+export function GetSubscriptionOps(arg1) {
+  return window['go']['main']['App']['GetSubscriptionOps'](arg1);
+}
+
 export function GetTemplates(arg1) {
   return window['go']['main']['App']['GetTemplates'](arg1);
 }
 
+export function GetTopicIAMPolicy(arg1) {
+  return window['go']['main']['App']['GetTopicIAMPolicy'](arg1);
+}
+
 export function GetTopicMetadata(arg1) {
   return window['go']['main']['App']['GetTopicMetadata'](arg1);
 }
@@ -138,10 +294,46 @@ export function GetTopicSubscriptionTemplatesByCategory(arg1) {
   return window['go']['main']['App']['GetTopicSubscriptionTemplatesByCategory'](arg1);
 }
 
+export function GetUpgradePreferences() {
+  return window['go']['main']['App']['GetUpgradePreferences']();
+}
+
 export function GetVersion() {
   return window['go']['main']['App']['GetVersion']();
 }
 
+export function ImportConfig(arg1, arg2) {
+  return window['go']['main']['App']['ImportConfig'](arg1, arg2);
+}
+
+export function ImportGcloudConfigurations() {
+  return window['go']['main']['App']['ImportGcloudConfigurations']();
+}
+
+export function InstallUpdate(arg1) {
+  return window['go']['main']['App']['InstallUpdate'](arg1);
+}
+
+export function ListAttributeTemplates() {
+  return window['go']['main']['App']['ListAttributeTemplates']();
+}
+
+export function ListOAuthAccounts() {
+  return window['go']['main']['App']['ListOAuthAccounts']();
+}
+
+export function ListPublishLoops() {
+  return window['go']['main']['App']['ListPublishLoops']();
+}
+
+export function ListScheduledPublishes() {
+  return window['go']['main']['App']['ListScheduledPublishes']();
+}
+
+export function ListSchemas() {
+  return window['go']['main']['App']['ListSchemas']();
+}
+
 export function ListSnapshots() {
   return window['go']['main']['App']['ListSnapshots']();
 }
@@ -154,24 +346,108 @@ export function ListSubscriptions() {
   return window['go']['main']['App']['ListSubscriptions']();
 }
 
+export function ListSubscriptionsPage(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ListSubscriptionsPage'](arg1, arg2, arg3);
+}
+
 export function ListTopics() {
   return window['go']['main']['App']['ListTopics']();
 }
 
+export function ListTopicsPage(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ListTopicsPage'](arg1, arg2, arg3);
+}
+
+export function NackMessage(arg1, arg2) {
+  return window['go']['main']['App']['NackMessage'](arg1, arg2);
+}
+
 export function OpenReleasesPage(arg1) {
   return window['go']['main']['App']['OpenReleasesPage'](arg1);
 }
 
-export function PublishMessage(arg1, arg2, arg3) {
-  return window['go']['main']['App']['PublishMessage'](arg1, arg2, arg3);
+export function PauseMonitor(arg1) {
+  return window['go']['main']['App']['PauseMonitor'](arg1);
 }
 
-export function SaveConfigFileContent(arg1) {
-  return window['go']['main']['App']['SaveConfigFileContent'](arg1);
+export function PreviewDelete(arg1, arg2) {
+  return window['go']['main']['App']['PreviewDelete'](arg1, arg2);
+}
+
+export function PublishFromFile(arg1, arg2, arg3) {
+  return window['go']['main']['App']['PublishFromFile'](arg1, arg2, arg3);
+}
+
+export function PublishFromTemplate(arg1, arg2) {
+  return window['go']['main']['App']['PublishFromTemplate'](arg1, arg2);
+}
+
+export function PublishMessage(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['PublishMessage'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function PublishMessagesBatch(arg1, arg2, arg3) {
+  return window['go']['main']['App']['PublishMessagesBatch'](arg1, arg2, arg3);
+}
+
+export function PublishToMultiple(arg1, arg2, arg3) {
+  return window['go']['main']['App']['PublishToMultiple'](arg1, arg2, arg3);
+}
+
+export function PublishToTopics(arg1, arg2, arg3) {
+  return window['go']['main']['App']['PublishToTopics'](arg1, arg2, arg3);
+}
+
+export function PurgeSubscription(arg1) {
+  return window['go']['main']['App']['PurgeSubscription'](arg1);
+}
+
+export function RenderTemplate(arg1) {
+  return window['go']['main']['App']['RenderTemplate'](arg1);
+}
+
+export function ReplayBufferedMessages(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ReplayBufferedMessages'](arg1, arg2, arg3);
+}
+
+export function ReplayLast(arg1, arg2) {
+  return window['go']['main']['App']['ReplayLast'](arg1, arg2);
+}
+
+export function RepublishWithEdits(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['RepublishWithEdits'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function ResendFromHistory(arg1) {
+  return window['go']['main']['App']['ResendFromHistory'](arg1);
+}
+
+export function ResendFromHistoryToTopic(arg1, arg2) {
+  return window['go']['main']['App']['ResendFromHistoryToTopic'](arg1, arg2);
+}
+
+export function ResetEmulator(arg1) {
+  return window['go']['main']['App']['ResetEmulator'](arg1);
+}
+
+export function ResolveResourceName(arg1, arg2) {
+  return window['go']['main']['App']['ResolveResourceName'](arg1, arg2);
+}
+
+export function ResumeMonitor(arg1) {
+  return window['go']['main']['App']['ResumeMonitor'](arg1);
+}
+
+export function RevokeOAuthAccount(arg1) {
+  return window['go']['main']['App']['RevokeOAuthAccount'](arg1);
 }
 
-export function SaveCustomTopicSubscriptionTemplate(arg1) {
-  return window['go']['main']['App']['SaveCustomTopicSubscriptionTemplate'](arg1);
+export function SaveAttributeTemplate(arg1) {
+  return window['go']['main']['App']['SaveAttributeTemplate'](arg1);
+}
+
+export function SaveConfigFileContent(arg1) {
+  return window['go']['main']['App']['SaveConfigFileContent'](arg1);
 }
 
 export function SaveProfile(arg1) {
@@ -182,6 +458,22 @@ export function SaveTemplate(arg1) {
   return window['go']['main']['App']['SaveTemplate'](arg1);
 }
 
+export function SaveTopicSubscriptionTemplate(arg1) {
+  return window['go']['main']['App']['SaveTopicSubscriptionTemplate'](arg1);
+}
+
+export function SchedulePublish(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SchedulePublish'](arg1, arg2, arg3, arg4);
+}
+
+export function SearchBufferedMessages(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SearchBufferedMessages'](arg1, arg2, arg3);
+}
+
+export function SeekSubscription(arg1, arg2) {
+  return window['go']['main']['App']['SeekSubscription'](arg1, arg2);
+}
+
 export function SeekToSnapshot(arg1, arg2) {
   return window['go']['main']['App']['SeekToSnapshot'](arg1, arg2);
 }
@@ -190,28 +482,96 @@ export function SeekToTimestamp(arg1, arg2) {
   return window['go']['main']['App']['SeekToTimestamp'](arg1, arg2);
 }
 
+export function SetAPICallPolicy(arg1, arg2) {
+  return window['go']['main']['App']['SetAPICallPolicy'](arg1, arg2);
+}
+
 export function SetAutoAck(arg1) {
   return window['go']['main']['App']['SetAutoAck'](arg1);
 }
 
+export function SetBacklogAgeWarnSeconds(arg1) {
+  return window['go']['main']['App']['SetBacklogAgeWarnSeconds'](arg1);
+}
+
+export function SetEmulatorHealthCheckSeconds(arg1) {
+  return window['go']['main']['App']['SetEmulatorHealthCheckSeconds'](arg1);
+}
+
+export function SetLogRetentionDays(arg1) {
+  return window['go']['main']['App']['SetLogRetentionDays'](arg1);
+}
+
+export function SetMessageLease(arg1, arg2) {
+  return window['go']['main']['App']['SetMessageLease'](arg1, arg2);
+}
+
+export function SetMonitorHighlightRules(arg1) {
+  return window['go']['main']['App']['SetMonitorHighlightRules'](arg1);
+}
+
+export function SetMonitorOptions(arg1) {
+  return window['go']['main']['App']['SetMonitorOptions'](arg1);
+}
+
+export function SetMonitorSubscriptionTTL(arg1) {
+  return window['go']['main']['App']['SetMonitorSubscriptionTTL'](arg1);
+}
+
+export function SetPersistPublishHistory(arg1) {
+  return window['go']['main']['App']['SetPersistPublishHistory'](arg1);
+}
+
+export function SetSubscriptionIAMPolicy(arg1, arg2) {
+  return window['go']['main']['App']['SetSubscriptionIAMPolicy'](arg1, arg2);
+}
+
+export function SetTestMode(arg1) {
+  return window['go']['main']['App']['SetTestMode'](arg1);
+}
+
+export function SetTopicIAMPolicy(arg1, arg2) {
+  return window['go']['main']['App']['SetTopicIAMPolicy'](arg1, arg2);
+}
+
 export function SetVersion(arg1) {
   return window['go']['main']['App']['SetVersion'](arg1);
 }
 
+export function SimulateRedelivery(arg1, arg2) {
+  return window['go']['main']['App']['SimulateRedelivery'](arg1, arg2);
+}
+
+export function SnoozeUpgrade(arg1) {
+  return window['go']['main']['App']['SnoozeUpgrade'](arg1);
+}
+
+export function StartLogTail(arg1) {
+  return window['go']['main']['App']['StartLogTail'](arg1);
+}
+
 export function StartManagedEmulator(arg1) {
   return window['go']['main']['App']['StartManagedEmulator'](arg1);
 }
 
-export function StartMonitor(arg1) {
-  return window['go']['main']['App']['StartMonitor'](arg1);
+export function StartMonitor(arg1, arg2) {
+  return window['go']['main']['App']['StartMonitor'](arg1, arg2);
 }
 
 export function StartPeriodicUpgradeCheck() {
   return window['go']['main']['App']['StartPeriodicUpgradeCheck']();
 }
 
-export function StartTopicMonitor(arg1, arg2) {
-  return window['go']['main']['App']['StartTopicMonitor'](arg1, arg2);
+export function StartPublishLoop(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['StartPublishLoop'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function StartTopicMonitor(arg1, arg2, arg3) {
+  return window['go']['main']['App']['StartTopicMonitor'](arg1, arg2, arg3);
+}
+
+export function StopLogTail() {
+  return window['go']['main']['App']['StopLogTail']();
 }
 
 export function StopManagedEmulator(arg1) {
@@ -222,6 +582,10 @@ export function StopMonitor(arg1) {
   return window['go']['main']['App']['StopMonitor'](arg1);
 }
 
+export function StopPublishLoop(arg1) {
+  return window['go']['main']['App']['StopPublishLoop'](arg1);
+}
+
 export function StopTopicMonitor(arg1) {
   return window['go']['main']['App']['StopTopicMonitor'](arg1);
 }
@@ -230,10 +594,22 @@ export function SwitchProfile(arg1) {
   return window['go']['main']['App']['SwitchProfile'](arg1);
 }
 
+export function SwitchProject(arg1) {
+  return window['go']['main']['App']['SwitchProject'](arg1);
+}
+
 export function SyncResources() {
   return window['go']['main']['App']['SyncResources']();
 }
 
+export function TestProfile(arg1) {
+  return window['go']['main']['App']['TestProfile'](arg1);
+}
+
+export function TestSubscriptionFilter(arg1, arg2, arg3) {
+  return window['go']['main']['App']['TestSubscriptionFilter'](arg1, arg2, arg3);
+}
+
 export function UpdateFontSize(arg1) {
   return window['go']['main']['App']['UpdateFontSize'](arg1);
 }
@@ -249,3 +625,19 @@ export function UpdateTemplate(arg1, arg2) {
 export function UpdateTheme(arg1) {
   return window['go']['main']['App']['UpdateTheme'](arg1);
 }
+
+export function UpdateTopic(arg1, arg2) {
+  return window['go']['main']['App']['UpdateTopic'](arg1, arg2);
+}
+
+export function ValidateAllProfiles() {
+  return window['go']['main']['App']['ValidateAllProfiles']();
+}
+
+export function ValidateMessage(arg1, arg2) {
+  return window['go']['main']['App']['ValidateMessage'](arg1, arg2);
+}
+
+export function ValidateMessageAgainstSchema(arg1, arg2) {
+  return window['go']['main']['App']['ValidateMessageAgainstSchema'](arg1, arg2);
+}
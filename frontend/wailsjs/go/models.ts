@@ -14,54 +14,56 @@ export namespace admin {
 	        this.maxDeliveryAttempts = source["maxDeliveryAttempts"];
 	    }
 	}
-	export class SnapshotInfo {
-	    name: string;
-	    displayName: string;
-	    topic: string;
-	    subscription?: string;
-	    expireTime: string;
-	    labels?: Record<string, string>;
+	export class EnsureResult {
+	    topicId: string;
+	    subscriptionId: string;
+	    topicCreated: boolean;
+	    subscriptionCreated: boolean;
+	    warnings?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new SnapshotInfo(source);
+	        return new EnsureResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.displayName = source["displayName"];
-	        this.topic = source["topic"];
-	        this.subscription = source["subscription"];
-	        this.expireTime = source["expireTime"];
-	        this.labels = source["labels"];
+	        this.topicId = source["topicId"];
+	        this.subscriptionId = source["subscriptionId"];
+	        this.topicCreated = source["topicCreated"];
+	        this.subscriptionCreated = source["subscriptionCreated"];
+	        this.warnings = source["warnings"];
 	    }
 	}
-	export class SubscriptionInfo {
-	    name: string;
-	    displayName: string;
-	    topic: string;
-	    ackDeadline: number;
-	    retentionDuration: string;
-	    filter?: string;
-	    deadLetterPolicy?: DeadLetterPolicyInfo;
-	    subscriptionType: string;
-	    pushEndpoint?: string;
+	export class IAMCondition {
+	    title?: string;
+	    description?: string;
+	    expression: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new SubscriptionInfo(source);
+	        return new IAMCondition(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.displayName = source["displayName"];
-	        this.topic = source["topic"];
-	        this.ackDeadline = source["ackDeadline"];
-	        this.retentionDuration = source["retentionDuration"];
-	        this.filter = source["filter"];
-	        this.deadLetterPolicy = this.convertValues(source["deadLetterPolicy"], DeadLetterPolicyInfo);
-	        this.subscriptionType = source["subscriptionType"];
-	        this.pushEndpoint = source["pushEndpoint"];
+	        this.title = source["title"];
+	        this.description = source["description"];
+	        this.expression = source["expression"];
+	    }
+	}
+	export class IAMBinding {
+	    role: string;
+	    members: string[];
+	    condition?: IAMCondition;
+	
+	    static createFrom(source: any = {}) {
+	        return new IAMBinding(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.role = source["role"];
+	        this.members = source["members"];
+	        this.condition = this.convertValues(source["condition"], IAMCondition);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -82,79 +84,111 @@ export namespace admin {
 		    return a;
 		}
 	}
-	export class TopicInfo {
-	    name: string;
-	    displayName: string;
-	    messageRetention?: string;
+	
+	export class IAMPolicy {
+	    version: number;
+	    bindings: IAMBinding[];
+	    etag: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new TopicInfo(source);
+	        return new IAMPolicy(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.displayName = source["displayName"];
-	        this.messageRetention = source["messageRetention"];
+	        this.version = source["version"];
+	        this.bindings = this.convertValues(source["bindings"], IAMBinding);
+	        this.etag = source["etag"];
 	    }
-	}
-
-}
-
-export namespace app {
 	
-	export class ConnectionStatus {
-	    isConnected: boolean;
-	    projectId: string;
-	    authMethod?: string;
-	    emulatorHost?: string;
-	    emulatorMode?: string;
-	    managedEmulatorRunning?: boolean;
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SchemaSettings {
+	    schema: string;
+	    encoding: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ConnectionStatus(source);
+	        return new SchemaSettings(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.isConnected = source["isConnected"];
-	        this.projectId = source["projectId"];
-	        this.authMethod = source["authMethod"];
-	        this.emulatorHost = source["emulatorHost"];
-	        this.emulatorMode = source["emulatorMode"];
-	        this.managedEmulatorRunning = source["managedEmulatorRunning"];
+	        this.schema = source["schema"];
+	        this.encoding = source["encoding"];
 	    }
 	}
-	export class LogEntry {
-	    time: string;
-	    level: string;
-	    msg: string;
-	    fields?: Record<string, any>;
+	export class SnapshotInfo {
+	    name: string;
+	    displayName: string;
+	    topic: string;
+	    subscription?: string;
+	    expireTime: string;
+	    labels?: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new LogEntry(source);
+	        return new SnapshotInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.time = source["time"];
-	        this.level = source["level"];
-	        this.msg = source["msg"];
-	        this.fields = source["fields"];
+	        this.name = source["name"];
+	        this.displayName = source["displayName"];
+	        this.topic = source["topic"];
+	        this.subscription = source["subscription"];
+	        this.expireTime = source["expireTime"];
+	        this.labels = source["labels"];
 	    }
 	}
-	export class FilteredLogsResult {
-	    entries: LogEntry[];
-	    total: number;
+	export class SubscriptionConfig {
+	    ackDeadline: number;
+	    retentionDuration?: string;
+	    expirationPolicy?: models.ExpirationPolicy;
+	    retryPolicy?: models.RetryPolicy;
+	    enableOrdering: boolean;
+	    enableExactlyOnce: boolean;
+	    filter?: string;
+	    pushConfig?: models.PushConfig;
+	    bigQueryConfig?: models.BigQueryConfig;
+	    cloudStorageConfig?: models.CloudStorageConfig;
+	    deadLetterPolicy?: DeadLetterPolicyInfo;
+	    labels?: Record<string, string>;
+	    retainAckedMessages?: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new FilteredLogsResult(source);
+	        return new SubscriptionConfig(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.entries = this.convertValues(source["entries"], LogEntry);
-	        this.total = source["total"];
+	        this.ackDeadline = source["ackDeadline"];
+	        this.retentionDuration = source["retentionDuration"];
+	        this.expirationPolicy = this.convertValues(source["expirationPolicy"], models.ExpirationPolicy);
+	        this.retryPolicy = this.convertValues(source["retryPolicy"], models.RetryPolicy);
+	        this.enableOrdering = source["enableOrdering"];
+	        this.enableExactlyOnce = source["enableExactlyOnce"];
+	        this.filter = source["filter"];
+	        this.pushConfig = this.convertValues(source["pushConfig"], models.PushConfig);
+	        this.bigQueryConfig = this.convertValues(source["bigQueryConfig"], models.BigQueryConfig);
+	        this.cloudStorageConfig = this.convertValues(source["cloudStorageConfig"], models.CloudStorageConfig);
+	        this.deadLetterPolicy = this.convertValues(source["deadLetterPolicy"], DeadLetterPolicyInfo);
+	        this.labels = source["labels"];
+	        this.retainAckedMessages = source["retainAckedMessages"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -175,14 +209,83 @@ export namespace app {
 		    return a;
 		}
 	}
+	export class SubscriptionInfo {
+	    name: string;
+	    displayName: string;
+	    topic: string;
+	    ackDeadline: number;
+	    retentionDuration: string;
+	    filter?: string;
+	    deadLetterPolicy?: DeadLetterPolicyInfo;
+	    subscriptionType: string;
+	    pushEndpoint?: string;
+	    pushOidcToken?: models.OIDCToken;
+	    bigQueryTable?: string;
+	    cloudStorageBucket?: string;
+	    retainAckedMessages: boolean;
+	    enableOrdering: boolean;
+	    enableExactlyOnce: boolean;
+	    retryPolicy?: models.RetryPolicy;
+	    expirationTtl?: string;
+	    readOnlyFields: string[];
+	    detached?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SubscriptionInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.displayName = source["displayName"];
+	        this.topic = source["topic"];
+	        this.ackDeadline = source["ackDeadline"];
+	        this.retentionDuration = source["retentionDuration"];
+	        this.filter = source["filter"];
+	        this.deadLetterPolicy = this.convertValues(source["deadLetterPolicy"], DeadLetterPolicyInfo);
+	        this.subscriptionType = source["subscriptionType"];
+	        this.pushEndpoint = source["pushEndpoint"];
+	        this.pushOidcToken = this.convertValues(source["pushOidcToken"], models.OIDCToken);
+	        this.bigQueryTable = source["bigQueryTable"];
+	        this.cloudStorageBucket = source["cloudStorageBucket"];
+	        this.retainAckedMessages = source["retainAckedMessages"];
+	        this.enableOrdering = source["enableOrdering"];
+	        this.enableExactlyOnce = source["enableExactlyOnce"];
+	        this.retryPolicy = this.convertValues(source["retryPolicy"], models.RetryPolicy);
+	        this.expirationTtl = source["expirationTtl"];
+	        this.readOnlyFields = source["readOnlyFields"];
+	        this.detached = source["detached"];
+	    }
 	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class SubscriptionUpdateParams {
 	    ackDeadline?: number;
 	    retentionDuration?: string;
 	    filter?: string;
-	    deadLetterPolicy?: admin.DeadLetterPolicyInfo;
+	    deadLetterPolicy?: DeadLetterPolicyInfo;
 	    pushEndpoint?: string;
+	    oidcToken?: models.OIDCToken;
 	    subscriptionType?: string;
+	    retainAckedMessages?: boolean;
+	    retryPolicy?: models.RetryPolicy;
+	    expirationTtl?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new SubscriptionUpdateParams(source);
@@ -193,9 +296,13 @@ export namespace app {
 	        this.ackDeadline = source["ackDeadline"];
 	        this.retentionDuration = source["retentionDuration"];
 	        this.filter = source["filter"];
-	        this.deadLetterPolicy = this.convertValues(source["deadLetterPolicy"], admin.DeadLetterPolicyInfo);
+	        this.deadLetterPolicy = this.convertValues(source["deadLetterPolicy"], DeadLetterPolicyInfo);
 	        this.pushEndpoint = source["pushEndpoint"];
+	        this.oidcToken = this.convertValues(source["oidcToken"], models.OIDCToken);
 	        this.subscriptionType = source["subscriptionType"];
+	        this.retainAckedMessages = source["retainAckedMessages"];
+	        this.retryPolicy = this.convertValues(source["retryPolicy"], models.RetryPolicy);
+	        this.expirationTtl = source["expirationTtl"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -216,106 +323,1342 @@ export namespace app {
 		    return a;
 		}
 	}
-
-}
-
-export namespace main {
-	
-	export class EmulatorStatus {
-	    profileId: string;
-	    containerName: string;
-	    host: string;
-	    port: number;
-	    status: string;
-	    error?: string;
+	export class SubscriptionsPage {
+	    subscriptions: SubscriptionInfo[];
+	    nextPageToken?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new EmulatorStatus(source);
+	        return new SubscriptionsPage(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.profileId = source["profileId"];
-	        this.containerName = source["containerName"];
-	        this.host = source["host"];
-	        this.port = source["port"];
-	        this.status = source["status"];
-	        this.error = source["error"];
+	        this.subscriptions = this.convertValues(source["subscriptions"], SubscriptionInfo);
+	        this.nextPageToken = source["nextPageToken"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class PublishResult {
-	    messageId: string;
-	    timestamp: string;
+	export class TopicInfo {
+	    name: string;
+	    displayName: string;
+	    messageRetention?: string;
+	    labels?: Record<string, string>;
+	    schemaSettings?: SchemaSettings;
+	    messageStoragePolicy?: models.MessageStoragePolicy;
 	
 	    static createFrom(source: any = {}) {
-	        return new PublishResult(source);
+	        return new TopicInfo(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.messageId = source["messageId"];
-	        this.timestamp = source["timestamp"];
+	        this.name = source["name"];
+	        this.displayName = source["displayName"];
+	        this.messageRetention = source["messageRetention"];
+	        this.labels = source["labels"];
+	        this.schemaSettings = this.convertValues(source["schemaSettings"], SchemaSettings);
+	        this.messageStoragePolicy = this.convertValues(source["messageStoragePolicy"], models.MessageStoragePolicy);
 	    }
-	}
-
-}
-
-export namespace models {
-	
-	export class ManagedEmulatorConfig {
-	    port: number;
-	    image?: string;
-	    dataDir?: string;
-	    autoStart: boolean;
-	    autoStop: boolean;
-	    bindAddress?: string;
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TopicUpdateParams {
+	    labels?: Record<string, string>;
+	    messageRetentionDuration?: string;
+	    messageStoragePolicy?: models.MessageStoragePolicy;
+	
+	    static createFrom(source: any = {}) {
+	        return new TopicUpdateParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.labels = source["labels"];
+	        this.messageRetentionDuration = source["messageRetentionDuration"];
+	        this.messageStoragePolicy = this.convertValues(source["messageStoragePolicy"], models.MessageStoragePolicy);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TopicsPage {
+	    topics: TopicInfo[];
+	    nextPageToken?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TopicsPage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.topics = this.convertValues(source["topics"], TopicInfo);
+	        this.nextPageToken = source["nextPageToken"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace app {
+	
+	export class ActiveMonitorInfo {
+	    subscriptionId: string;
+	    topicId?: string;
+	    autoAck: boolean;
+	    exactlyOnce: boolean;
+	    paused: boolean;
+	    bufferedCount: number;
+	    receivedCount: number;
+	    startedAt: string;
+	    subscriptionTtl?: string;
+	    leaseHold?: string;
+	    ackStats: subscriber.AckStats;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActiveMonitorInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.subscriptionId = source["subscriptionId"];
+	        this.topicId = source["topicId"];
+	        this.autoAck = source["autoAck"];
+	        this.exactlyOnce = source["exactlyOnce"];
+	        this.paused = source["paused"];
+	        this.bufferedCount = source["bufferedCount"];
+	        this.receivedCount = source["receivedCount"];
+	        this.startedAt = source["startedAt"];
+	        this.subscriptionTtl = source["subscriptionTtl"];
+	        this.leaseHold = source["leaseHold"];
+	        this.ackStats = this.convertValues(source["ackStats"], subscriber.AckStats);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BacklogInfo {
+	    subscriptionId: string;
+	    metricsAvailable: boolean;
+	    undelivered?: number;
+	    oldestUnackedAgeSeconds?: number;
+	    reason?: string;
+	    monitored: boolean;
+	    bufferedMessages: number;
+	    checkedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new BacklogInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.subscriptionId = source["subscriptionId"];
+	        this.metricsAvailable = source["metricsAvailable"];
+	        this.undelivered = source["undelivered"];
+	        this.oldestUnackedAgeSeconds = source["oldestUnackedAgeSeconds"];
+	        this.reason = source["reason"];
+	        this.monitored = source["monitored"];
+	        this.bufferedMessages = source["bufferedMessages"];
+	        this.checkedAt = source["checkedAt"];
+	    }
+	}
+	export class BulkDeleteItem {
+	    resourceType: string;
+	    id: string;
+	    found: boolean;
+	    deleted: boolean;
+	    error?: string;
+	    warnings?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkDeleteItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.resourceType = source["resourceType"];
+	        this.id = source["id"];
+	        this.found = source["found"];
+	        this.deleted = source["deleted"];
+	        this.error = source["error"];
+	        this.warnings = source["warnings"];
+	    }
+	}
+	export class BulkDeleteResult {
+	    dryRun: boolean;
+	    items: BulkDeleteItem[];
+	    deleted: number;
+	    failed: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkDeleteResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.dryRun = source["dryRun"];
+	        this.items = this.convertValues(source["items"], BulkDeleteItem);
+	        this.deleted = source["deleted"];
+	        this.failed = source["failed"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ConnectionStatus {
+	    isConnected: boolean;
+	    projectId: string;
+	    authMethod?: string;
+	    emulatorHost?: string;
+	    emulatorMode?: string;
+	    managedEmulatorRunning?: boolean;
+	    testMode: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectionStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.isConnected = source["isConnected"];
+	        this.projectId = source["projectId"];
+	        this.authMethod = source["authMethod"];
+	        this.emulatorHost = source["emulatorHost"];
+	        this.emulatorMode = source["emulatorMode"];
+	        this.managedEmulatorRunning = source["managedEmulatorRunning"];
+	        this.testMode = source["testMode"];
+	    }
+	}
+	export class DeadLetterChain {
+	    subscriptionId: string;
+	    configured: boolean;
+	    deadLetterTopic?: string;
+	    deadLetterTopicId?: string;
+	    deadLetterProject?: string;
+	    maxDeliveryAttempts?: number;
+	    crossProject: boolean;
+	    topicFound: boolean;
+	    subscriptions: string[];
+	    note?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DeadLetterChain(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.subscriptionId = source["subscriptionId"];
+	        this.configured = source["configured"];
+	        this.deadLetterTopic = source["deadLetterTopic"];
+	        this.deadLetterTopicId = source["deadLetterTopicId"];
+	        this.deadLetterProject = source["deadLetterProject"];
+	        this.maxDeliveryAttempts = source["maxDeliveryAttempts"];
+	        this.crossProject = source["crossProject"];
+	        this.topicFound = source["topicFound"];
+	        this.subscriptions = source["subscriptions"];
+	        this.note = source["note"];
+	    }
+	}
+	export class DeletePreview {
+	    resourceType: string;
+	    id: string;
+	    found: boolean;
+	    subscriptions?: string[];
+	    monitoredSubscriptions?: string[];
+	    monitored: boolean;
+	    deadLetterFor?: string[];
+	    warnings: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new DeletePreview(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.resourceType = source["resourceType"];
+	        this.id = source["id"];
+	        this.found = source["found"];
+	        this.subscriptions = source["subscriptions"];
+	        this.monitoredSubscriptions = source["monitoredSubscriptions"];
+	        this.monitored = source["monitored"];
+	        this.deadLetterFor = source["deadLetterFor"];
+	        this.warnings = source["warnings"];
+	    }
+	}
+	export class DrainEstimate {
+	    subscriptionId: string;
+	    backlog: number;
+	    consumptionRate: number;
+	    rateSource: string;
+	    rateWindow: string;
+	    drains: boolean;
+	    estimatedSeconds: number;
+	    estimate: string;
+	    note?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DrainEstimate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.subscriptionId = source["subscriptionId"];
+	        this.backlog = source["backlog"];
+	        this.consumptionRate = source["consumptionRate"];
+	        this.rateSource = source["rateSource"];
+	        this.rateWindow = source["rateWindow"];
+	        this.drains = source["drains"];
+	        this.estimatedSeconds = source["estimatedSeconds"];
+	        this.estimate = source["estimate"];
+	        this.note = source["note"];
+	    }
+	}
+	export class LogEntry {
+	    time: string;
+	    level: string;
+	    msg: string;
+	    fields?: Record<string, any>;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = source["time"];
+	        this.level = source["level"];
+	        this.msg = source["msg"];
+	        this.fields = source["fields"];
+	    }
+	}
+	export class FilteredLogsResult {
+	    entries: LogEntry[];
+	    total: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new FilteredLogsResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.entries = this.convertValues(source["entries"], LogEntry);
+	        this.total = source["total"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class MessageSummary {
+	    id: string;
+	    publishTime: string;
+	    receiveTime: string;
+	    size: number;
+	    preview: string;
+	    attributes: Record<string, string>;
+	    orderingKey?: string;
+	    highlightColor?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MessageSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.publishTime = source["publishTime"];
+	        this.receiveTime = source["receiveTime"];
+	        this.size = source["size"];
+	        this.preview = source["preview"];
+	        this.attributes = source["attributes"];
+	        this.orderingKey = source["orderingKey"];
+	        this.highlightColor = source["highlightColor"];
+	    }
+	}
+	export class ProfileTestResult {
+	    profileId: string;
+	    success: boolean;
+	    authMethod: string;
+	    emulatorHost?: string;
+	    latencyMs: number;
+	    containerRuntime?: string;
+	    message?: string;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProfileTestResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.profileId = source["profileId"];
+	        this.success = source["success"];
+	        this.authMethod = source["authMethod"];
+	        this.emulatorHost = source["emulatorHost"];
+	        this.latencyMs = source["latencyMs"];
+	        this.containerRuntime = source["containerRuntime"];
+	        this.message = source["message"];
+	        this.error = source["error"];
+	    }
+	}
+	export class ProfileValidationIssue {
+	    profileId: string;
+	    profileName: string;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProfileValidationIssue(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.profileId = source["profileId"];
+	        this.profileName = source["profileName"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class PublishHistoryEntry {
+	    id: string;
+	    topicId: string;
+	    payload: string;
+	    attributes?: Record<string, string>;
+	    orderingKey?: string;
+	    messageId?: string;
+	    timestamp: string;
+	    success: boolean;
+	    error?: string;
+	    source?: string;
+	    truncated?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PublishHistoryEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.topicId = source["topicId"];
+	        this.payload = source["payload"];
+	        this.attributes = source["attributes"];
+	        this.orderingKey = source["orderingKey"];
+	        this.messageId = source["messageId"];
+	        this.timestamp = source["timestamp"];
+	        this.success = source["success"];
+	        this.error = source["error"];
+	        this.source = source["source"];
+	        this.truncated = source["truncated"];
+	    }
+	}
+	export class RedeliveryResult {
+	    messageId: string;
+	    data: string;
+	    attributes?: Record<string, string>;
+	    nacks: number;
+	    deliveries: number;
+	    deliveryAttempt: number;
+	    redelivered: boolean;
+	    deadLettered: boolean;
+	    subscriptionId: string;
+	    deadLetterTopic?: string;
+	    maxDeliveryAttempts?: number;
+	    landedInDeadLetter: boolean;
+	    notes?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RedeliveryResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.messageId = source["messageId"];
+	        this.data = source["data"];
+	        this.attributes = source["attributes"];
+	        this.nacks = source["nacks"];
+	        this.deliveries = source["deliveries"];
+	        this.deliveryAttempt = source["deliveryAttempt"];
+	        this.redelivered = source["redelivered"];
+	        this.deadLettered = source["deadLettered"];
+	        this.subscriptionId = source["subscriptionId"];
+	        this.deadLetterTopic = source["deadLetterTopic"];
+	        this.maxDeliveryAttempts = source["maxDeliveryAttempts"];
+	        this.landedInDeadLetter = source["landedInDeadLetter"];
+	        this.notes = source["notes"];
+	    }
+	}
+	export class ReplayResult {
+	    subscriptionId: string;
+	    seekTime: string;
+	    window: string;
+	    enabledRetainAcked: boolean;
+	    expectedRedelivered: number;
+	    estimateAvailable: boolean;
+	    notes?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ReplayResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.subscriptionId = source["subscriptionId"];
+	        this.seekTime = source["seekTime"];
+	        this.window = source["window"];
+	        this.enabledRetainAcked = source["enabledRetainAcked"];
+	        this.expectedRedelivered = source["expectedRedelivered"];
+	        this.estimateAvailable = source["estimateAvailable"];
+	        this.notes = source["notes"];
+	    }
+	}
+	export class ResourceCounts {
+	    topics: number;
+	    pullSubscriptions: number;
+	    pushSubscriptions: number;
+	    bigQuerySubscriptions: number;
+	    cloudStorageSubscriptions: number;
+	    detachedSubscriptions: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResourceCounts(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.topics = source["topics"];
+	        this.pullSubscriptions = source["pullSubscriptions"];
+	        this.pushSubscriptions = source["pushSubscriptions"];
+	        this.bigQuerySubscriptions = source["bigQuerySubscriptions"];
+	        this.cloudStorageSubscriptions = source["cloudStorageSubscriptions"];
+	        this.detachedSubscriptions = source["detachedSubscriptions"];
+	    }
+	}
+	export class ResourceName {
+	    short: string;
+	    full: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResourceName(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.short = source["short"];
+	        this.full = source["full"];
+	    }
+	}
+	export class SubscriptionOps {
+	    subscriptionId: string;
+	    backlog?: number;
+	    oldestUnackedAgeSeconds?: number;
+	    monitored: boolean;
+	    receivedCount?: number;
+	    throughputPerSecond?: number;
+	    throughputWindow?: string;
+	    ackStats?: subscriber.AckStats;
+	    drain?: DrainEstimate;
+	    notes?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SubscriptionOps(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.subscriptionId = source["subscriptionId"];
+	        this.backlog = source["backlog"];
+	        this.oldestUnackedAgeSeconds = source["oldestUnackedAgeSeconds"];
+	        this.monitored = source["monitored"];
+	        this.receivedCount = source["receivedCount"];
+	        this.throughputPerSecond = source["throughputPerSecond"];
+	        this.throughputWindow = source["throughputWindow"];
+	        this.ackStats = this.convertValues(source["ackStats"], subscriber.AckStats);
+	        this.drain = this.convertValues(source["drain"], DrainEstimate);
+	        this.notes = source["notes"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SubscriptionUpdateParams {
+	    ackDeadline?: number;
+	    retentionDuration?: string;
+	    filter?: string;
+	    deadLetterPolicy?: admin.DeadLetterPolicyInfo;
+	    pushEndpoint?: string;
+	    oidcToken?: models.OIDCToken;
+	    subscriptionType?: string;
+	    retainAckedMessages?: boolean;
+	    retryPolicy?: models.RetryPolicy;
+	    expirationTtl?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SubscriptionUpdateParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ackDeadline = source["ackDeadline"];
+	        this.retentionDuration = source["retentionDuration"];
+	        this.filter = source["filter"];
+	        this.deadLetterPolicy = this.convertValues(source["deadLetterPolicy"], admin.DeadLetterPolicyInfo);
+	        this.pushEndpoint = source["pushEndpoint"];
+	        this.oidcToken = this.convertValues(source["oidcToken"], models.OIDCToken);
+	        this.subscriptionType = source["subscriptionType"];
+	        this.retainAckedMessages = source["retainAckedMessages"];
+	        this.retryPolicy = this.convertValues(source["retryPolicy"], models.RetryPolicy);
+	        this.expirationTtl = source["expirationTtl"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace audit {
+	
+	export class Entry {
+	    time: string;
+	    operation: string;
+	    resourceType: string;
+	    resource: string;
+	    profileId?: string;
+	    profileName?: string;
+	    projectId?: string;
+	    identity?: string;
+	    outcome: string;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Entry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.time = source["time"];
+	        this.operation = source["operation"];
+	        this.resourceType = source["resourceType"];
+	        this.resource = source["resource"];
+	        this.profileId = source["profileId"];
+	        this.profileName = source["profileName"];
+	        this.projectId = source["projectId"];
+	        this.identity = source["identity"];
+	        this.outcome = source["outcome"];
+	        this.error = source["error"];
+	    }
+	}
+
+}
+
+export namespace auth {
+	
+	export class OAuthAccount {
+	    email: string;
+	    profileId: string;
+	    // Go type: time
+	    expiry: any;
+	    expired: boolean;
+	    canRefresh: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new OAuthAccount(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.email = source["email"];
+	        this.profileId = source["profileId"];
+	        this.expiry = this.convertValues(source["expiry"], null);
+	        this.expired = source["expired"];
+	        this.canRefresh = source["canRefresh"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace main {
+	
+	export class EmulatorStatus {
+	    profileId: string;
+	    containerName: string;
+	    host: string;
+	    port: number;
+	    configuredPort?: number;
+	    status: string;
+	    error?: string;
+	    imageId?: string;
+	    imageWarning?: string;
+	    persistent?: boolean;
+	    runtime?: string;
+	    dataDirConfigured: boolean;
+	    dataDir?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EmulatorStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.profileId = source["profileId"];
+	        this.containerName = source["containerName"];
+	        this.host = source["host"];
+	        this.port = source["port"];
+	        this.configuredPort = source["configuredPort"];
+	        this.status = source["status"];
+	        this.error = source["error"];
+	        this.imageId = source["imageId"];
+	        this.imageWarning = source["imageWarning"];
+	        this.persistent = source["persistent"];
+	        this.runtime = source["runtime"];
+	        this.dataDirConfigured = source["dataDirConfigured"];
+	        this.dataDir = source["dataDir"];
+	    }
+	}
+	export class PublishResult {
+	    messageId: string;
+	    timestamp: string;
+	    attributes?: publisher.Attribute[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PublishResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.messageId = source["messageId"];
+	        this.timestamp = source["timestamp"];
+	        this.attributes = this.convertValues(source["attributes"], publisher.Attribute);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpgradePreferences {
+	    autoCheckUpgrades: boolean;
+	    upgradeCheckInterval: number;
+	    // Go type: time
+	    lastUpgradeCheck: any;
+	    dismissedUpgradeVersion: string;
+	    // Go type: time
+	    snoozedUntil: any;
+	    snoozed: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpgradePreferences(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.autoCheckUpgrades = source["autoCheckUpgrades"];
+	        this.upgradeCheckInterval = source["upgradeCheckInterval"];
+	        this.lastUpgradeCheck = this.convertValues(source["lastUpgradeCheck"], null);
+	        this.dismissedUpgradeVersion = source["dismissedUpgradeVersion"];
+	        this.snoozedUntil = this.convertValues(source["snoozedUntil"], null);
+	        this.snoozed = source["snoozed"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace models {
+	
+	export class APIRetryPolicy {
+	    maxAttempts?: number;
+	    initialBackoffMs?: number;
+	    maxBackoffMs?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new APIRetryPolicy(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.maxAttempts = source["maxAttempts"];
+	        this.initialBackoffMs = source["initialBackoffMs"];
+	        this.maxBackoffMs = source["maxBackoffMs"];
+	    }
+	}
+	export class AttributeTemplate {
+	    id: string;
+	    name: string;
+	    attributes: Record<string, string>;
+	    createdAt: string;
+	    updatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new AttributeTemplate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.attributes = source["attributes"];
+	        this.createdAt = source["createdAt"];
+	        this.updatedAt = source["updatedAt"];
+	    }
+	}
+	export class BigQueryConfig {
+	    table: string;
+	    useTopicSchema: boolean;
+	    writeMetadata: boolean;
+	    dropUnknownFields: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new BigQueryConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.table = source["table"];
+	        this.useTopicSchema = source["useTopicSchema"];
+	        this.writeMetadata = source["writeMetadata"];
+	        this.dropUnknownFields = source["dropUnknownFields"];
+	    }
+	}
+	export class CloudStorageConfig {
+	    bucket: string;
+	    filenamePrefix?: string;
+	    filenameSuffix?: string;
+	    maxDuration?: string;
+	    maxBytes?: number;
+	    format?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CloudStorageConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.bucket = source["bucket"];
+	        this.filenamePrefix = source["filenamePrefix"];
+	        this.filenameSuffix = source["filenameSuffix"];
+	        this.maxDuration = source["maxDuration"];
+	        this.maxBytes = source["maxBytes"];
+	        this.format = source["format"];
+	    }
+	}
+	export class SeedTopic {
+	    id: string;
+	    subscriptions?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SeedTopic(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.subscriptions = source["subscriptions"];
+	    }
+	}
+	export class EmulatorSeedConfig {
+	    topics: SeedTopic[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EmulatorSeedConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.topics = this.convertValues(source["topics"], SeedTopic);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ManagedEmulatorConfig {
+	    port: number;
+	    image?: string;
+	    imageDigest?: string;
+	    dataDir?: string;
+	    autoStart: boolean;
+	    autoStop: boolean;
+	    bindAddress?: string;
+	    persistent?: boolean;
+	    runtime?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ManagedEmulatorConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.port = source["port"];
+	        this.image = source["image"];
+	        this.imageDigest = source["imageDigest"];
+	        this.dataDir = source["dataDir"];
+	        this.autoStart = source["autoStart"];
+	        this.autoStop = source["autoStop"];
+	        this.bindAddress = source["bindAddress"];
+	        this.persistent = source["persistent"];
+	        this.runtime = source["runtime"];
+	    }
+	}
+	export class ConnectionProfile {
+	    id: string;
+	    name: string;
+	    projectId: string;
+	    authMethod: string;
+	    serviceAccountPath?: string;
+	    oauthClientPath?: string;
+	    oauthEmail?: string;
+	    emulatorHost?: string;
+	    emulatorMode?: string;
+	    managedEmulator?: ManagedEmulatorConfig;
+	    color?: string;
+	    icon?: string;
+	    isDefault: boolean;
+	    createdAt: string;
+	    seedConfig?: EmulatorSeedConfig;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectionProfile(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.projectId = source["projectId"];
+	        this.authMethod = source["authMethod"];
+	        this.serviceAccountPath = source["serviceAccountPath"];
+	        this.oauthClientPath = source["oauthClientPath"];
+	        this.oauthEmail = source["oauthEmail"];
+	        this.emulatorHost = source["emulatorHost"];
+	        this.emulatorMode = source["emulatorMode"];
+	        this.managedEmulator = this.convertValues(source["managedEmulator"], ManagedEmulatorConfig);
+	        this.color = source["color"];
+	        this.icon = source["icon"];
+	        this.isDefault = source["isDefault"];
+	        this.createdAt = source["createdAt"];
+	        this.seedConfig = this.convertValues(source["seedConfig"], EmulatorSeedConfig);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DeadLetterTemplateConfig {
+	    maxDeliveryAttempts: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DeadLetterTemplateConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.maxDeliveryAttempts = source["maxDeliveryAttempts"];
+	    }
+	}
+	export class DriftField {
+	    field: string;
+	    expected: string;
+	    actual: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DriftField(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field = source["field"];
+	        this.expected = source["expected"];
+	        this.actual = source["actual"];
+	    }
+	}
+	
+	export class ExpirationPolicy {
+	    ttl: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ExpirationPolicy(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ttl = source["ttl"];
+	    }
+	}
+	export class HighlightRule {
+	    attributeKey: string;
+	    equals: string;
+	    color: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new HighlightRule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.attributeKey = source["attributeKey"];
+	        this.equals = source["equals"];
+	        this.color = source["color"];
+	    }
+	}
+	
+	export class MessageStoragePolicy {
+	    allowedPersistenceRegions?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MessageStoragePolicy(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.allowedPersistenceRegions = source["allowedPersistenceRegions"];
+	    }
+	}
+	export class MessageTemplate {
+	    id: string;
+	    name: string;
+	    topicId?: string;
+	    payload: string;
+	    attributes: Record<string, string>;
+	    createdAt: string;
+	    updatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MessageTemplate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.topicId = source["topicId"];
+	        this.payload = source["payload"];
+	        this.attributes = source["attributes"];
+	        this.createdAt = source["createdAt"];
+	        this.updatedAt = source["updatedAt"];
+	    }
+	}
+	export class MonitorOptions {
+	    maxOutstandingMessages?: number;
+	    maxOutstandingBytes?: number;
+	    numGoroutines?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MonitorOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.maxOutstandingMessages = source["maxOutstandingMessages"];
+	        this.maxOutstandingBytes = source["maxOutstandingBytes"];
+	        this.numGoroutines = source["numGoroutines"];
+	    }
+	}
+	export class OIDCToken {
+	    serviceAccountEmail: string;
+	    audience?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new OIDCToken(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.serviceAccountEmail = source["serviceAccountEmail"];
+	        this.audience = source["audience"];
+	    }
+	}
+	export class PushConfig {
+	    endpoint: string;
+	    attributes?: Record<string, string>;
+	    oidcToken?: OIDCToken;
+	
+	    static createFrom(source: any = {}) {
+	        return new PushConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.endpoint = source["endpoint"];
+	        this.attributes = source["attributes"];
+	        this.oidcToken = this.convertValues(source["oidcToken"], OIDCToken);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RetryPolicy {
+	    minimumBackoff: string;
+	    maximumBackoff: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ManagedEmulatorConfig(source);
+	        return new RetryPolicy(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.port = source["port"];
-	        this.image = source["image"];
-	        this.dataDir = source["dataDir"];
-	        this.autoStart = source["autoStart"];
-	        this.autoStop = source["autoStop"];
-	        this.bindAddress = source["bindAddress"];
+	        this.minimumBackoff = source["minimumBackoff"];
+	        this.maximumBackoff = source["maximumBackoff"];
 	    }
 	}
-	export class ConnectionProfile {
-	    id: string;
+	
+	export class SubscriptionTemplateConfig {
 	    name: string;
-	    projectId: string;
-	    authMethod: string;
-	    serviceAccountPath?: string;
-	    oauthClientPath?: string;
-	    oauthEmail?: string;
-	    emulatorHost?: string;
-	    emulatorMode?: string;
-	    managedEmulator?: ManagedEmulatorConfig;
-	    isDefault: boolean;
-	    createdAt: string;
+	    ackDeadline: number;
+	    retentionDuration?: string;
+	    expirationPolicy?: ExpirationPolicy;
+	    retryPolicy?: RetryPolicy;
+	    enableOrdering: boolean;
+	    enableExactlyOnce: boolean;
+	    filter?: string;
+	    pushConfig?: PushConfig;
+	    labels?: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new ConnectionProfile(source);
+	        return new SubscriptionTemplateConfig(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
 	        this.name = source["name"];
-	        this.projectId = source["projectId"];
-	        this.authMethod = source["authMethod"];
-	        this.serviceAccountPath = source["serviceAccountPath"];
-	        this.oauthClientPath = source["oauthClientPath"];
-	        this.oauthEmail = source["oauthEmail"];
-	        this.emulatorHost = source["emulatorHost"];
-	        this.emulatorMode = source["emulatorMode"];
-	        this.managedEmulator = this.convertValues(source["managedEmulator"], ManagedEmulatorConfig);
-	        this.isDefault = source["isDefault"];
-	        this.createdAt = source["createdAt"];
+	        this.ackDeadline = source["ackDeadline"];
+	        this.retentionDuration = source["retentionDuration"];
+	        this.expirationPolicy = this.convertValues(source["expirationPolicy"], ExpirationPolicy);
+	        this.retryPolicy = this.convertValues(source["retryPolicy"], RetryPolicy);
+	        this.enableOrdering = source["enableOrdering"];
+	        this.enableExactlyOnce = source["enableExactlyOnce"];
+	        this.filter = source["filter"];
+	        this.pushConfig = this.convertValues(source["pushConfig"], PushConfig);
+	        this.labels = source["labels"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -336,123 +1679,268 @@ export namespace models {
 		    return a;
 		}
 	}
-	export class DeadLetterTemplateConfig {
-	    maxDeliveryAttempts: number;
+	export class TemplateOverrides {
+	    messageRetentionDuration?: string;
+	    ackDeadline?: number;
+	    maxDeliveryAttempts?: number;
+	    disableDeadLetter: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new DeadLetterTemplateConfig(source);
+	        return new TemplateOverrides(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.messageRetentionDuration = source["messageRetentionDuration"];
+	        this.ackDeadline = source["ackDeadline"];
 	        this.maxDeliveryAttempts = source["maxDeliveryAttempts"];
+	        this.disableDeadLetter = source["disableDeadLetter"];
 	    }
 	}
-	export class ExpirationPolicy {
-	    ttl: string;
+	export class TemplateCreateRequest {
+	    templateId: string;
+	    baseName: string;
+	    environment?: string;
+	    overrides?: TemplateOverrides;
 	
 	    static createFrom(source: any = {}) {
-	        return new ExpirationPolicy(source);
+	        return new TemplateCreateRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.ttl = source["ttl"];
+	        this.templateId = source["templateId"];
+	        this.baseName = source["baseName"];
+	        this.environment = source["environment"];
+	        this.overrides = this.convertValues(source["overrides"], TemplateOverrides);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TemplateCreateResult {
+	    success: boolean;
+	    topicId: string;
+	    subscriptionIds: string[];
+	    deadLetterTopicId?: string;
+	    deadLetterSubId?: string;
+	    warnings?: string[];
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TemplateCreateResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.success = source["success"];
+	        this.topicId = source["topicId"];
+	        this.subscriptionIds = source["subscriptionIds"];
+	        this.deadLetterTopicId = source["deadLetterTopicId"];
+	        this.deadLetterSubId = source["deadLetterSubId"];
+	        this.warnings = source["warnings"];
+	        this.error = source["error"];
 	    }
 	}
+	export class TemplateEnvironmentResult {
+	    environment: string;
+	    result: TemplateCreateResult;
 	
-	export class MessageStoragePolicy {
-	    allowedPersistenceRegions?: string[];
+	    static createFrom(source: any = {}) {
+	        return new TemplateEnvironmentResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.environment = source["environment"];
+	        this.result = this.convertValues(source["result"], TemplateCreateResult);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class TopicTemplateConfig {
+	    messageRetentionDuration?: string;
+	    labels?: Record<string, string>;
+	    kmsKeyName?: string;
+	    messageStoragePolicy?: MessageStoragePolicy;
 	
 	    static createFrom(source: any = {}) {
-	        return new MessageStoragePolicy(source);
+	        return new TopicTemplateConfig(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.allowedPersistenceRegions = source["allowedPersistenceRegions"];
+	        this.messageRetentionDuration = source["messageRetentionDuration"];
+	        this.labels = source["labels"];
+	        this.kmsKeyName = source["kmsKeyName"];
+	        this.messageStoragePolicy = this.convertValues(source["messageStoragePolicy"], MessageStoragePolicy);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class MessageTemplate {
+	export class TopicSubscriptionTemplate {
 	    id: string;
 	    name: string;
-	    topicId?: string;
-	    payload: string;
-	    attributes: Record<string, string>;
-	    createdAt: string;
-	    updatedAt: string;
+	    description: string;
+	    category: string;
+	    isBuiltIn: boolean;
+	    topic: TopicTemplateConfig;
+	    subscriptions: SubscriptionTemplateConfig[];
+	    deadLetter?: DeadLetterTemplateConfig;
 	
 	    static createFrom(source: any = {}) {
-	        return new MessageTemplate(source);
+	        return new TopicSubscriptionTemplate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.category = source["category"];
+	        this.isBuiltIn = source["isBuiltIn"];
+	        this.topic = this.convertValues(source["topic"], TopicTemplateConfig);
+	        this.subscriptions = this.convertValues(source["subscriptions"], SubscriptionTemplateConfig);
+	        this.deadLetter = this.convertValues(source["deadLetter"], DeadLetterTemplateConfig);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace publisher {
+	
+	export class Attribute {
+	    key: string;
+	    value: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Attribute(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.name = source["name"];
-	        this.topicId = source["topicId"];
-	        this.payload = source["payload"];
-	        this.attributes = source["attributes"];
-	        this.createdAt = source["createdAt"];
-	        this.updatedAt = source["updatedAt"];
+	        this.key = source["key"];
+	        this.value = source["value"];
 	    }
 	}
-	export class PushConfig {
-	    endpoint: string;
-	    attributes?: Record<string, string>;
+	export class BatchFailure {
+	    index: number;
+	    line?: number;
+	    error: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new PushConfig(source);
+	        return new BatchFailure(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.endpoint = source["endpoint"];
-	        this.attributes = source["attributes"];
+	        this.index = source["index"];
+	        this.line = source["line"];
+	        this.error = source["error"];
 	    }
 	}
-	export class RetryPolicy {
-	    minimumBackoff: string;
-	    maximumBackoff: string;
+	export class BatchMessageInput {
+	    payload: string;
+	    attributes?: Record<string, string>;
 	
 	    static createFrom(source: any = {}) {
-	        return new RetryPolicy(source);
+	        return new BatchMessageInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.minimumBackoff = source["minimumBackoff"];
-	        this.maximumBackoff = source["maximumBackoff"];
+	        this.payload = source["payload"];
+	        this.attributes = source["attributes"];
 	    }
 	}
-	export class SubscriptionTemplateConfig {
-	    name: string;
-	    ackDeadline: number;
-	    retentionDuration?: string;
-	    expirationPolicy?: ExpirationPolicy;
-	    retryPolicy?: RetryPolicy;
-	    enableOrdering: boolean;
-	    enableExactlyOnce: boolean;
-	    filter?: string;
-	    pushConfig?: PushConfig;
-	    labels?: Record<string, string>;
+	export class BatchPublishResult {
+	    total: number;
+	    succeeded: number;
+	    failed: number;
+	    messageIds: string[];
+	    failures: BatchFailure[];
+	    durationMs: number;
+	    sourceMessageIds?: string[];
 	
 	    static createFrom(source: any = {}) {
-	        return new SubscriptionTemplateConfig(source);
+	        return new BatchPublishResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.ackDeadline = source["ackDeadline"];
-	        this.retentionDuration = source["retentionDuration"];
-	        this.expirationPolicy = this.convertValues(source["expirationPolicy"], ExpirationPolicy);
-	        this.retryPolicy = this.convertValues(source["retryPolicy"], RetryPolicy);
-	        this.enableOrdering = source["enableOrdering"];
-	        this.enableExactlyOnce = source["enableExactlyOnce"];
-	        this.filter = source["filter"];
-	        this.pushConfig = this.convertValues(source["pushConfig"], PushConfig);
-	        this.labels = source["labels"];
+	        this.total = source["total"];
+	        this.succeeded = source["succeeded"];
+	        this.failed = source["failed"];
+	        this.messageIds = source["messageIds"];
+	        this.failures = this.convertValues(source["failures"], BatchFailure);
+	        this.durationMs = source["durationMs"];
+	        this.sourceMessageIds = source["sourceMessageIds"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -473,40 +1961,54 @@ export namespace models {
 		    return a;
 		}
 	}
-	export class TemplateOverrides {
-	    messageRetentionDuration?: string;
-	    ackDeadline?: number;
-	    maxDeliveryAttempts?: number;
-	    disableDeadLetter: boolean;
+	export class PublishResult {
+	    messageId: string;
+	    timestamp: string;
+	    attributes?: Attribute[];
 	
 	    static createFrom(source: any = {}) {
-	        return new TemplateOverrides(source);
+	        return new PublishResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.messageRetentionDuration = source["messageRetentionDuration"];
-	        this.ackDeadline = source["ackDeadline"];
-	        this.maxDeliveryAttempts = source["maxDeliveryAttempts"];
-	        this.disableDeadLetter = source["disableDeadLetter"];
+	        this.messageId = source["messageId"];
+	        this.timestamp = source["timestamp"];
+	        this.attributes = this.convertValues(source["attributes"], Attribute);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class TemplateCreateRequest {
-	    templateId: string;
-	    baseName: string;
-	    environment?: string;
-	    overrides?: TemplateOverrides;
+	export class TopicPublishResult {
+	    topicId: string;
+	    result?: PublishResult;
+	    error?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new TemplateCreateRequest(source);
+	        return new TopicPublishResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.templateId = source["templateId"];
-	        this.baseName = source["baseName"];
-	        this.environment = source["environment"];
-	        this.overrides = this.convertValues(source["overrides"], TemplateOverrides);
+	        this.topicId = source["topicId"];
+	        this.result = this.convertValues(source["result"], PublishResult);
+	        this.error = source["error"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -527,47 +2029,67 @@ export namespace models {
 		    return a;
 		}
 	}
-	export class TemplateCreateResult {
-	    success: boolean;
-	    topicId: string;
-	    subscriptionIds: string[];
-	    deadLetterTopicId?: string;
-	    deadLetterSubId?: string;
-	    warnings?: string[];
-	    error?: string;
+	export class MultiPublishResult {
+	    results: TopicPublishResult[];
+	    succeeded: number;
+	    failed: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new TemplateCreateResult(source);
+	        return new MultiPublishResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.success = source["success"];
-	        this.topicId = source["topicId"];
-	        this.subscriptionIds = source["subscriptionIds"];
-	        this.deadLetterTopicId = source["deadLetterTopicId"];
-	        this.deadLetterSubId = source["deadLetterSubId"];
-	        this.warnings = source["warnings"];
-	        this.error = source["error"];
+	        this.results = this.convertValues(source["results"], TopicPublishResult);
+	        this.succeeded = source["succeeded"];
+	        this.failed = source["failed"];
 	    }
-	}
 	
-	export class TopicTemplateConfig {
-	    messageRetentionDuration?: string;
-	    labels?: Record<string, string>;
-	    kmsKeyName?: string;
-	    messageStoragePolicy?: MessageStoragePolicy;
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PublishLoopStatus {
+	    id: string;
+	    topicId: string;
+	    intervalMs: number;
+	    count: number;
+	    sent: number;
+	    failed: number;
+	    running: boolean;
+	    // Go type: time
+	    startedAt: any;
+	    lastError?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new TopicTemplateConfig(source);
+	        return new PublishLoopStatus(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.messageRetentionDuration = source["messageRetentionDuration"];
-	        this.labels = source["labels"];
-	        this.kmsKeyName = source["kmsKeyName"];
-	        this.messageStoragePolicy = this.convertValues(source["messageStoragePolicy"], MessageStoragePolicy);
+	        this.id = source["id"];
+	        this.topicId = source["topicId"];
+	        this.intervalMs = source["intervalMs"];
+	        this.count = source["count"];
+	        this.sent = source["sent"];
+	        this.failed = source["failed"];
+	        this.running = source["running"];
+	        this.startedAt = this.convertValues(source["startedAt"], null);
+	        this.lastError = source["lastError"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -588,30 +2110,45 @@ export namespace models {
 		    return a;
 		}
 	}
-	export class TopicSubscriptionTemplate {
+	
+	export class RenderedMessage {
+	    payload: string;
+	    attributes?: Record<string, string>;
+	    warnings?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RenderedMessage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.payload = source["payload"];
+	        this.attributes = source["attributes"];
+	        this.warnings = source["warnings"];
+	    }
+	}
+	export class ScheduledPublish {
 	    id: string;
-	    name: string;
-	    description: string;
-	    category: string;
-	    isBuiltIn: boolean;
-	    topic: TopicTemplateConfig;
-	    subscriptions: SubscriptionTemplateConfig[];
-	    deadLetter?: DeadLetterTemplateConfig;
+	    topicId: string;
+	    payload: string;
+	    attributes?: Record<string, string>;
+	    // Go type: time
+	    publishAt: any;
+	    // Go type: time
+	    createdAt: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new TopicSubscriptionTemplate(source);
+	        return new ScheduledPublish(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.name = source["name"];
-	        this.description = source["description"];
-	        this.category = source["category"];
-	        this.isBuiltIn = source["isBuiltIn"];
-	        this.topic = this.convertValues(source["topic"], TopicTemplateConfig);
-	        this.subscriptions = this.convertValues(source["subscriptions"], SubscriptionTemplateConfig);
-	        this.deadLetter = this.convertValues(source["deadLetter"], DeadLetterTemplateConfig);
+	        this.topicId = source["topicId"];
+	        this.payload = source["payload"];
+	        this.attributes = source["attributes"];
+	        this.publishAt = this.convertValues(source["publishAt"], null);
+	        this.createdAt = this.convertValues(source["createdAt"], null);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -635,8 +2172,55 @@ export namespace models {
 
 }
 
+export namespace schema {
+	
+	export class SchemaInfo {
+	    name: string;
+	    displayName: string;
+	    type: string;
+	    definition?: string;
+	    revisionId?: string;
+	    revisionCreateTime?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SchemaInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.displayName = source["displayName"];
+	        this.type = source["type"];
+	        this.definition = source["definition"];
+	        this.revisionId = source["revisionId"];
+	        this.revisionCreateTime = source["revisionCreateTime"];
+	    }
+	}
+
+}
+
 export namespace subscriber {
 	
+	export class AckStats {
+	    acked: number;
+	    nacked: number;
+	    expired: number;
+	    redelivered: number;
+	    ackFailed: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AckStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.acked = source["acked"];
+	        this.nacked = source["nacked"];
+	        this.expired = source["expired"];
+	        this.redelivered = source["redelivered"];
+	        this.ackFailed = source["ackFailed"];
+	    }
+	}
 	export class PubSubMessage {
 	    id: string;
 	    publishTime: string;
@@ -645,6 +2229,9 @@ export namespace subscriber {
 	    attributes: Record<string, string>;
 	    deliveryAttempt?: number;
 	    orderingKey?: string;
+	    deliveryCount?: number;
+	    encoding?: string;
+	    contentType?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new PubSubMessage(source);
@@ -659,13 +2246,92 @@ export namespace subscriber {
 	        this.attributes = source["attributes"];
 	        this.deliveryAttempt = source["deliveryAttempt"];
 	        this.orderingKey = source["orderingKey"];
+	        this.deliveryCount = source["deliveryCount"];
+	        this.encoding = source["encoding"];
+	        this.contentType = source["contentType"];
+	    }
+	}
+	export class SizeBucket {
+	    label: string;
+	    minBytes: number;
+	    maxBytes: number;
+	    count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SizeBucket(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.label = source["label"];
+	        this.minBytes = source["minBytes"];
+	        this.maxBytes = source["maxBytes"];
+	        this.count = source["count"];
+	    }
+	}
+	export class SizeHistogram {
+	    buckets: SizeBucket[];
+	    total: number;
+	    maxBytes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SizeHistogram(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.buckets = this.convertValues(source["buckets"], SizeBucket);
+	        this.total = source["total"];
+	        this.maxBytes = source["maxBytes"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 
 }
 
 export namespace version {
 	
+	export class ReleaseInfo {
+	    tagName: string;
+	    name: string;
+	    publishedAt: string;
+	    htmlUrl: string;
+	    body: string;
+	    currentVersion: string;
+	    isNewer: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ReleaseInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tagName = source["tagName"];
+	        this.name = source["name"];
+	        this.publishedAt = source["publishedAt"];
+	        this.htmlUrl = source["htmlUrl"];
+	        this.body = source["body"];
+	        this.currentVersion = source["currentVersion"];
+	        this.isNewer = source["isNewer"];
+	    }
+	}
 	export class UpdateInfo {
 	    currentVersion: string;
 	    latestVersion: string;
@@ -0,0 +1,121 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"pubsub-gui/internal/models"
+)
+
+// ListAttributeTemplates returns all attribute templates
+func (h *TemplateHandler) ListAttributeTemplates() []models.AttributeTemplate {
+	if h.config == nil || h.config.AttributeTemplates == nil {
+		return []models.AttributeTemplate{}
+	}
+	return h.config.AttributeTemplates
+}
+
+// SaveAttributeTemplate adds an attribute template, or replaces the one with the same ID, and returns it
+func (h *TemplateHandler) SaveAttributeTemplate(template models.AttributeTemplate) (models.AttributeTemplate, error) {
+	if h.config == nil {
+		return models.AttributeTemplate{}, fmt.Errorf("config is nil")
+	}
+	if template.ID == "" {
+		template.ID = models.GenerateID()
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	if template.CreatedAt == "" {
+		template.CreatedAt = now
+	}
+	template.UpdatedAt = now
+
+	if err := template.Validate(); err != nil {
+		return models.AttributeTemplate{}, fmt.Errorf("%w: %v", models.ErrInvalidTemplate, err)
+	}
+
+	// Check for duplicate names (excluding the template itself if updating)
+	for _, t := range h.config.AttributeTemplates {
+		if t.Name == template.Name && t.ID != template.ID {
+			return models.AttributeTemplate{}, models.ErrDuplicateTemplate
+		}
+	}
+
+	found := false
+	for i, t := range h.config.AttributeTemplates {
+		if t.ID == template.ID {
+			template.CreatedAt = t.CreatedAt
+			h.config.AttributeTemplates[i] = template
+			found = true
+			break
+		}
+	}
+	if !found {
+		h.config.AttributeTemplates = append(h.config.AttributeTemplates, template)
+	}
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return models.AttributeTemplate{}, fmt.Errorf("failed to save config: %w", err)
+	}
+	return template, nil
+}
+
+// DeleteAttributeTemplate removes an attribute template
+func (h *TemplateHandler) DeleteAttributeTemplate(templateID string) error {
+	if h.config == nil {
+		return fmt.Errorf("config is nil")
+	}
+
+	remaining := make([]models.AttributeTemplate, 0, len(h.config.AttributeTemplates))
+	for _, t := range h.config.AttributeTemplates {
+		if t.ID != templateID {
+			remaining = append(remaining, t)
+		}
+	}
+	if len(remaining) == len(h.config.AttributeTemplates) {
+		return fmt.Errorf("%w: %s", models.ErrTemplateNotFound, templateID)
+	}
+
+	h.config.AttributeTemplates = remaining
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	return nil
+}
+
+// MergeAttributeTemplates returns attributes with the attributes of the given templates added
+// Templates are applied in order (later templates win), and keys in attributes win over every template.
+func (h *TemplateHandler) MergeAttributeTemplates(templateIDs []string, attributes map[string]string) (map[string]string, error) {
+	if len(templateIDs) == 0 {
+		return attributes, nil
+	}
+
+	merged := make(map[string]string)
+	for _, id := range templateIDs {
+		template, ok := h.findAttributeTemplate(id)
+		if !ok {
+			return nil, fmt.Errorf("%w: attribute template %s", models.ErrTemplateNotFound, id)
+		}
+		for key, value := range template.Attributes {
+			merged[key] = value
+		}
+	}
+	for key, value := range attributes {
+		merged[key] = value
+	}
+	return merged, nil
+}
+
+// findAttributeTemplate returns the attribute template with the given ID
+func (h *TemplateHandler) findAttributeTemplate(id string) (models.AttributeTemplate, bool) {
+	if h.config == nil {
+		return models.AttributeTemplate{}, false
+	}
+	for _, t := range h.config.AttributeTemplates {
+		if t.ID == id {
+			return t, true
+		}
+	}
+	return models.AttributeTemplate{}, false
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"pubsub-gui/internal/config"
+	"pubsub-gui/internal/models"
+)
+
+func newTestTemplateHandler(t *testing.T) (*TemplateHandler, *models.AppConfig) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	configManager, err := config.NewManager()
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+	cfg := models.NewDefaultConfig()
+	return NewTemplateHandler(cfg, configManager), cfg
+}
+
+func TestTemplateHandler_AttributeTemplates(t *testing.T) {
+	h, cfg := newTestTemplateHandler(t)
+
+	tracing, err := h.SaveAttributeTemplate(models.AttributeTemplate{Name: "Tracing", Attributes: map[string]string{"traceparent": "00-abc", "source": "gui"}})
+	if err != nil {
+		t.Fatalf("SaveAttributeTemplate() error = %v", err)
+	}
+	if tracing.ID == "" || tracing.CreatedAt == "" || len(cfg.AttributeTemplates) != 1 {
+		t.Fatalf("SaveAttributeTemplate() = %+v, want persisted template with generated ID", tracing)
+	}
+
+	if _, err := h.SaveAttributeTemplate(models.AttributeTemplate{ID: "other", Name: "Tracing", Attributes: map[string]string{"a": "b"}}); !errors.Is(err, models.ErrDuplicateTemplate) {
+		t.Errorf("SaveAttributeTemplate(duplicate name) error = %v, want ErrDuplicateTemplate", err)
+	}
+	if _, err := h.SaveAttributeTemplate(models.AttributeTemplate{Name: "Empty key", Attributes: map[string]string{" ": "x"}}); !errors.Is(err, models.ErrInvalidTemplate) {
+		t.Errorf("SaveAttributeTemplate(empty key) error = %v, want ErrInvalidTemplate", err)
+	}
+
+	tracing.Attributes["env"] = "dev"
+	if _, err := h.SaveAttributeTemplate(tracing); err != nil {
+		t.Fatalf("SaveAttributeTemplate(update) error = %v", err)
+	}
+	if list := h.ListAttributeTemplates(); len(list) != 1 || list[0].Attributes["env"] != "dev" {
+		t.Errorf("ListAttributeTemplates() = %+v, want the updated template only", list)
+	}
+
+	if err := h.DeleteAttributeTemplate(tracing.ID); err != nil {
+		t.Fatalf("DeleteAttributeTemplate() error = %v", err)
+	}
+	if err := h.DeleteAttributeTemplate(tracing.ID); !errors.Is(err, models.ErrTemplateNotFound) {
+		t.Errorf("DeleteAttributeTemplate(missing) error = %v, want ErrTemplateNotFound", err)
+	}
+}
+
+func TestTemplateHandler_MergeAttributeTemplates(t *testing.T) {
+	h, cfg := newTestTemplateHandler(t)
+	cfg.AttributeTemplates = []models.AttributeTemplate{
+		{ID: "a", Name: "A", Attributes: map[string]string{"source": "a", "team": "a"}},
+		{ID: "b", Name: "B", Attributes: map[string]string{"team": "b", "env": "b"}},
+	}
+
+	merged, err := h.MergeAttributeTemplates([]string{"a", "b"}, map[string]string{"env": "user"})
+	if err != nil {
+		t.Fatalf("MergeAttributeTemplates() error = %v", err)
+	}
+	want := map[string]string{"source": "a", "team": "b", "env": "user"}
+	if len(merged) != len(want) {
+		t.Fatalf("MergeAttributeTemplates() = %v, want %v", merged, want)
+	}
+	for key, value := range want {
+		if merged[key] != value {
+			t.Errorf("merged[%q] = %q, want %q", key, merged[key], value)
+		}
+	}
+
+	if _, err := h.MergeAttributeTemplates([]string{"missing"}, nil); !errors.Is(err, models.ErrTemplateNotFound) {
+		t.Errorf("MergeAttributeTemplates(missing) error = %v, want ErrTemplateNotFound", err)
+	}
+}
@@ -0,0 +1,204 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	monitoringapi "google.golang.org/api/monitoring/v3"
+
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/metrics"
+)
+
+// backlogHealthCheckInterval is how often monitored subscriptions are checked for stuck backlogs
+// Pub/Sub metrics are sampled every 60s, so checking more often would not surface anything new
+const backlogHealthCheckInterval = time.Minute
+
+// BacklogWarning is emitted as "subscription:backlog-warning" when a monitored subscription's
+// oldest unacked message is older than the configured threshold
+type BacklogWarning struct {
+	SubscriptionID   string `json:"subscriptionId"`
+	AgeSeconds       int64  `json:"ageSeconds"`
+	ThresholdSeconds int    `json:"thresholdSeconds"`
+}
+
+// RunBacklogHealthCheck periodically checks monitored subscriptions until the app context is done
+func (h *MonitoringHandler) RunBacklogHealthCheck() {
+	ticker := time.NewTicker(backlogHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-ticker.C:
+			h.CheckBacklogAges()
+		}
+	}
+}
+
+// CheckBacklogAges emits "subscription:backlog-warning" for every monitored subscription whose
+// oldest unacked message age exceeds AppConfig.BacklogAgeWarnSeconds
+// Does nothing when the threshold is 0, nothing is monitored, or metrics are unavailable (emulator).
+func (h *MonitoringHandler) CheckBacklogAges() {
+	if h.config == nil || h.config.BacklogAgeWarnSeconds <= 0 || !h.clientManager.IsConnected() {
+		return
+	}
+	threshold := h.config.BacklogAgeWarnSeconds
+
+	h.monitorsMu.RLock()
+	subscriptionIDs := make([]string, 0, len(h.activeMonitors))
+	for subID := range h.activeMonitors {
+		subscriptionIDs = append(subscriptionIDs, subID)
+	}
+	h.monitorsMu.RUnlock()
+	if len(subscriptionIDs) == 0 {
+		return
+	}
+
+	opts, ok := h.clientManager.GetCredentialOptions()
+	if !ok {
+		return
+	}
+	svc, err := metrics.NewService(h.ctx, opts...)
+	if err != nil {
+		logger.Warn("Backlog health check skipped", "error", err)
+		return
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	for _, subID := range subscriptionIDs {
+		age, err := metrics.GetSubscriptionOldestUnackedAge(h.ctx, svc, projectID, subID)
+		if err != nil {
+			if !errors.Is(err, metrics.ErrNoData) {
+				logger.Warn("Backlog health check failed", "subscriptionID", subID, "error", err)
+			}
+			continue
+		}
+		if age <= time.Duration(threshold)*time.Second {
+			continue
+		}
+
+		warning := BacklogWarning{
+			SubscriptionID:   subID,
+			AgeSeconds:       int64(age.Seconds()),
+			ThresholdSeconds: threshold,
+		}
+		logger.Warn("Oldest unacked message exceeds threshold", "subscriptionID", subID, "age", age, "threshold", threshold)
+		runtime.EventsEmit(h.ctx, "subscription:backlog-warning", warning)
+	}
+}
+
+// BacklogInfo is the approximate backlog of a subscription, emitted as "subscription:backlog"
+// Counts come from Cloud Monitoring; when they cannot be read MetricsAvailable is false and Reason says why.
+type BacklogInfo struct {
+	SubscriptionID          string `json:"subscriptionId"`
+	MetricsAvailable        bool   `json:"metricsAvailable"`
+	Undelivered             *int64 `json:"undelivered,omitempty"`             // num_undelivered_messages
+	OldestUnackedAgeSeconds *int64 `json:"oldestUnackedAgeSeconds,omitempty"` // oldest_unacked_message_age
+	Reason                  string `json:"reason,omitempty"`
+	Monitored               bool   `json:"monitored"`
+	BufferedMessages        int    `json:"bufferedMessages"` // Messages in the live monitor's buffer
+	CheckedAt               string `json:"checkedAt"`        // RFC3339
+}
+
+// GetSubscriptionBacklog reports a subscription's undelivered message count and oldest unacked age
+// Emits "subscription:backlog" with the result. Missing metrics (emulator, no data yet) are flagged in the
+// result instead of failing the call.
+func (h *MonitoringHandler) GetSubscriptionBacklog(subscriptionID string) (BacklogInfo, error) {
+	info, err := h.subscriptionBacklog(subscriptionID)
+	if err != nil {
+		return BacklogInfo{}, err
+	}
+	runtime.EventsEmit(h.ctx, "subscription:backlog", info)
+	return info, nil
+}
+
+// subscriptionBacklog builds the backlog report of GetSubscriptionBacklog
+func (h *MonitoringHandler) subscriptionBacklog(subscriptionID string) (BacklogInfo, error) {
+	if subscriptionID == "" {
+		return BacklogInfo{}, fmt.Errorf("subscription ID cannot be empty")
+	}
+	if !h.clientManager.IsConnected() {
+		return BacklogInfo{}, models.ErrNotConnected
+	}
+
+	info := BacklogInfo{
+		SubscriptionID: subscriptionID,
+		CheckedAt:      time.Now().Format(time.RFC3339),
+	}
+
+	h.monitorsMu.RLock()
+	streamer, monitored := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+	if monitored {
+		info.Monitored = true
+		info.BufferedMessages = streamer.GetBuffer().Size()
+	}
+
+	svc, projectID, err := h.metricsService()
+	if err != nil {
+		info.Reason = err.Error()
+		return info, nil
+	}
+	h.readBacklogMetrics(svc, projectID, &info)
+	return info, nil
+}
+
+// metricsService returns a Cloud Monitoring client and the project of the current connection
+// Fails on emulator connections, which have no metrics.
+func (h *MonitoringHandler) metricsService() (*monitoringapi.Service, string, error) {
+	opts, ok := h.clientManager.GetCredentialOptions()
+	if !ok {
+		return nil, "", fmt.Errorf("backlog metrics are not available for emulator connections")
+	}
+	svc, err := metrics.NewService(h.ctx, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	return svc, h.clientManager.GetProjectID(), nil
+}
+
+// readBacklogMetrics sets the undelivered count and oldest unacked age of info.SubscriptionID from Cloud Monitoring
+func (h *MonitoringHandler) readBacklogMetrics(svc *monitoringapi.Service, projectID string, info *BacklogInfo) {
+	fillBacklogMetrics(info,
+		func() (int64, error) {
+			return metrics.GetSubscriptionBacklog(h.ctx, svc, projectID, info.SubscriptionID)
+		},
+		func() (time.Duration, error) {
+			return metrics.GetSubscriptionOldestUnackedAge(h.ctx, svc, projectID, info.SubscriptionID)
+		},
+	)
+}
+
+// fillBacklogMetrics sets the metric fields of info from the two metric reads
+// Metrics are available when at least one of them returned data.
+func fillBacklogMetrics(info *BacklogInfo, undelivered func() (int64, error), oldestAge func() (time.Duration, error)) {
+	var reasons []error
+
+	if count, err := undelivered(); err == nil {
+		info.Undelivered = &count
+	} else {
+		reasons = append(reasons, err)
+	}
+	if age, err := oldestAge(); err == nil {
+		seconds := int64(age.Seconds())
+		info.OldestUnackedAgeSeconds = &seconds
+	} else {
+		reasons = append(reasons, err)
+	}
+
+	info.MetricsAvailable = info.Undelivered != nil || info.OldestUnackedAgeSeconds != nil
+	if info.MetricsAvailable || len(reasons) == 0 {
+		return
+	}
+	if errors.Is(reasons[0], metrics.ErrNoData) {
+		info.Reason = "no backlog data yet (metrics can take a few minutes to appear)"
+		return
+	}
+	info.Reason = reasons[0].Error()
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2/pstest"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/metrics"
+	"pubsub-gui/internal/pubsub/subscriber"
+)
+
+func TestMonitoringHandler_SubscriptionBacklog_Emulator(t *testing.T) {
+	srv := pstest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	clientManager := auth.NewClientManager(ctx)
+	defer clientManager.Close()
+	var monitorsMu sync.RWMutex
+	h := NewMonitoringHandler(ctx, models.NewDefaultConfig(), clientManager, make(map[string]*subscriber.MessageStreamer), make(map[string]string), &monitorsMu, nil)
+
+	if _, err := h.subscriptionBacklog("orders-sub"); !errors.Is(err, models.ErrNotConnected) {
+		t.Errorf("subscriptionBacklog() while disconnected error = %v, want ErrNotConnected", err)
+	}
+
+	client, err := auth.ConnectWithADC(ctx, "test-project", srv.Addr)
+	if err != nil {
+		t.Fatalf("ConnectWithADC() error = %v", err)
+	}
+	clientManager.SetClient(client, "test-project")
+
+	info, err := h.subscriptionBacklog("orders-sub")
+	if err != nil {
+		t.Fatalf("subscriptionBacklog() error = %v", err)
+	}
+	if info.MetricsAvailable || info.Reason == "" || info.Undelivered != nil {
+		t.Errorf("subscriptionBacklog() on the emulator = %+v, want flagged as unavailable", info)
+	}
+
+	// The ops view reports the same reason as the backlog report
+	ops, err := h.GetSubscriptionOps("orders-sub")
+	if err != nil {
+		t.Fatalf("GetSubscriptionOps() error = %v", err)
+	}
+	if ops.Backlog != nil || ops.Drain != nil || !slices.Contains(ops.Notes, info.Reason) {
+		t.Errorf("GetSubscriptionOps() on the emulator = %+v, want no backlog and the note %q", ops, info.Reason)
+	}
+}
+
+func TestFillBacklogMetrics(t *testing.T) {
+	noData := fmt.Errorf("metric: %w", metrics.ErrNoData)
+	count := func(n int64, err error) func() (int64, error) {
+		return func() (int64, error) { return n, err }
+	}
+	age := func(d time.Duration, err error) func() (time.Duration, error) {
+		return func() (time.Duration, error) { return d, err }
+	}
+
+	var info BacklogInfo
+	fillBacklogMetrics(&info, count(42, nil), age(90*time.Second, nil))
+	if !info.MetricsAvailable || *info.Undelivered != 42 || *info.OldestUnackedAgeSeconds != 90 || info.Reason != "" {
+		t.Errorf("fillBacklogMetrics() with both metrics = %+v", info)
+	}
+
+	info = BacklogInfo{}
+	fillBacklogMetrics(&info, count(7, nil), age(0, noData))
+	if !info.MetricsAvailable || *info.Undelivered != 7 || info.OldestUnackedAgeSeconds != nil {
+		t.Errorf("fillBacklogMetrics() with partial data = %+v", info)
+	}
+
+	info = BacklogInfo{}
+	fillBacklogMetrics(&info, count(0, noData), age(0, noData))
+	if info.MetricsAvailable || info.Reason == "" {
+		t.Errorf("fillBacklogMetrics() without data = %+v, want unavailable with a reason", info)
+	}
+
+	info = BacklogInfo{}
+	fillBacklogMetrics(&info, count(0, errors.New("permission denied")), age(0, noData))
+	if info.MetricsAvailable || info.Reason != "permission denied" {
+		t.Errorf("fillBacklogMetrics() with an API error = %+v, want the error as reason", info)
+	}
+}
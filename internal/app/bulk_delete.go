@@ -0,0 +1,164 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+// BulkDeleteItem is the outcome (or, in a dry run, the plan) for one resource of a bulk delete
+type BulkDeleteItem struct {
+	ResourceType string   `json:"resourceType"` // "topic" | "subscription"
+	ID           string   `json:"id"`           // Short resource ID
+	Found        bool     `json:"found"`        // False when the resource is not in the cache
+	Deleted      bool     `json:"deleted"`
+	Error        string   `json:"error,omitempty"`
+	Warnings     []string `json:"warnings,omitempty"` // Dependencies outside the bulk delete that are affected
+}
+
+// BulkDeleteResult lists every resource of a bulk delete, subscriptions first (the order they are deleted in)
+type BulkDeleteResult struct {
+	DryRun  bool             `json:"dryRun"`
+	Items   []BulkDeleteItem `json:"items"`
+	Deleted int              `json:"deleted"`
+	Failed  int              `json:"failed"`
+}
+
+// BulkDelete deletes subscriptions and then topics, continuing past failures, or only plans it when dryRun is set
+// beforeDelete (optional) runs before each subscription is deleted, e.g. to stop its monitor; onDeleted (optional)
+// is called with every attempted item. Resources are synced once at the end and "resources:bulk-deleted" is emitted.
+func (h *ResourceHandler) BulkDelete(topicIDs, subscriptionIDs []string, dryRun bool, monitored map[string]bool, beforeDelete func(subID string), onDeleted func(BulkDeleteItem), syncResources func()) (BulkDeleteResult, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return BulkDeleteResult{}, models.ErrNotConnected
+	}
+	if len(topicIDs) == 0 && len(subscriptionIDs) == 0 {
+		return BulkDeleteResult{}, fmt.Errorf("nothing to delete: no topics or subscriptions given")
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	result := planBulkDelete(projectID, topicIDs, subscriptionIDs, h.store.Topics(), h.store.Subscriptions(), monitored)
+	result.DryRun = dryRun
+	if dryRun {
+		return result, nil
+	}
+
+	h.deleteBulk(client, projectID, &result, beforeDelete, onDeleted)
+
+	if syncResources != nil {
+		go syncResources()
+	}
+
+	logger.Info("Bulk delete finished", "deleted", result.Deleted, "failed", result.Failed)
+	runtime.EventsEmit(h.ctx, "resources:bulk-deleted", map[string]interface{}{
+		"deleted": result.Deleted,
+		"failed":  result.Failed,
+		"items":   result.Items,
+	})
+	return result, nil
+}
+
+// deleteBulk deletes the planned items in order, recording each outcome in result
+func (h *ResourceHandler) deleteBulk(client *pubsub.Client, projectID string, result *BulkDeleteResult, beforeDelete func(subID string), onDeleted func(BulkDeleteItem)) {
+	for i := range result.Items {
+		item := &result.Items[i]
+		var err error
+		if item.ResourceType == "subscription" {
+			if beforeDelete != nil {
+				beforeDelete(item.ID)
+			}
+			err = admin.DeleteSubscriptionAdmin(h.ctx, client, projectID, item.ID)
+		} else {
+			err = admin.DeleteTopicAdmin(h.ctx, client, projectID, item.ID)
+		}
+
+		if err != nil {
+			item.Error = err.Error()
+			result.Failed++
+			logger.Warn("Bulk delete failed for resource", "type", item.ResourceType, "id", item.ID, "error", err)
+		} else {
+			item.Deleted = true
+			result.Deleted++
+		}
+		if onDeleted != nil {
+			onDeleted(*item)
+		}
+	}
+}
+
+// planBulkDelete lists the resources of a bulk delete, subscriptions first, with dependency warnings
+// Dependencies that are deleted in the same run (a topic's subscriptions, dead letter users) are not warned about.
+func planBulkDelete(projectID string, topicIDs, subscriptionIDs []string, topics []admin.TopicInfo, subscriptions []admin.SubscriptionInfo, monitored map[string]bool) BulkDeleteResult {
+	deletedSubs := make(map[string]bool)
+	var subIDs []string
+	for _, id := range subscriptionIDs {
+		shortID, fullName := admin.NormalizeName(projectID, "subscription", id)
+		if shortID != "" && !deletedSubs[fullName] {
+			deletedSubs[fullName] = true
+			subIDs = append(subIDs, shortID)
+		}
+	}
+	deletedTopics := make(map[string]bool)
+	var topicShortIDs []string
+	for _, id := range topicIDs {
+		shortID, fullName := admin.NormalizeName(projectID, "topic", id)
+		if shortID != "" && !deletedTopics[fullName] {
+			deletedTopics[fullName] = true
+			topicShortIDs = append(topicShortIDs, shortID)
+		}
+	}
+	sort.Strings(subIDs)
+	sort.Strings(topicShortIDs)
+
+	result := BulkDeleteResult{Items: make([]BulkDeleteItem, 0, len(subIDs)+len(topicShortIDs))}
+	for _, id := range subIDs {
+		preview := buildDeletePreview(projectID, "subscription", id, topics, subscriptions, monitored)
+		item := BulkDeleteItem{ResourceType: "subscription", ID: preview.ID, Found: preview.Found}
+		if !preview.Found {
+			item.Warnings = append(item.Warnings, "not found in the resource cache")
+		}
+		if preview.Monitored {
+			item.Warnings = append(item.Warnings, "subscription is being monitored; the monitor will stop")
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	for _, id := range topicShortIDs {
+		preview := buildDeletePreview(projectID, "topic", id, topics, subscriptions, monitored)
+		item := BulkDeleteItem{ResourceType: "topic", ID: preview.ID, Found: preview.Found}
+		if !preview.Found {
+			item.Warnings = append(item.Warnings, "not found in the resource cache")
+		}
+
+		_, fullName := admin.NormalizeName(projectID, "topic", id)
+		var attached, deadLetterUsers []string
+		for _, sub := range subscriptions {
+			if deletedSubs[sub.Name] {
+				continue
+			}
+			if sub.Topic == fullName {
+				attached = append(attached, sub.DisplayName)
+			}
+			if sub.DeadLetterPolicy != nil && sub.DeadLetterPolicy.DeadLetterTopic == fullName {
+				deadLetterUsers = append(deadLetterUsers, sub.DisplayName)
+			}
+		}
+		sort.Strings(attached)
+		sort.Strings(deadLetterUsers)
+		if len(attached) > 0 {
+			item.Warnings = append(item.Warnings, fmt.Sprintf("%d subscription(s) not being deleted will be detached: %v", len(attached), attached))
+		}
+		if len(deadLetterUsers) > 0 {
+			item.Warnings = append(item.Warnings, fmt.Sprintf("still the dead letter topic of %d subscription(s): %v; dead-lettered messages will be lost", len(deadLetterUsers), deadLetterUsers))
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result
+}
@@ -0,0 +1,102 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/pubsub/v2/pstest"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+func TestPlanBulkDelete(t *testing.T) {
+	topics := []admin.TopicInfo{
+		{Name: "projects/p/topics/orders", DisplayName: "orders"},
+		{Name: "projects/p/topics/orders-dlq", DisplayName: "orders-dlq"},
+	}
+	dlq := &admin.DeadLetterPolicyInfo{DeadLetterTopic: "projects/p/topics/orders-dlq", MaxDeliveryAttempts: 5}
+	subscriptions := []admin.SubscriptionInfo{
+		{Name: "projects/p/subscriptions/load-a", DisplayName: "load-a", Topic: "projects/p/topics/orders"},
+		{Name: "projects/p/subscriptions/load-b", DisplayName: "load-b", Topic: "projects/p/topics/orders", DeadLetterPolicy: dlq},
+		{Name: "projects/p/subscriptions/billing", DisplayName: "billing", Topic: "projects/p/topics/other", DeadLetterPolicy: dlq},
+	}
+
+	result := planBulkDelete("p",
+		[]string{"orders", "projects/p/topics/orders-dlq", "orders", ""},
+		[]string{"load-b", "load-a", "projects/p/subscriptions/load-a", "ghost"},
+		topics, subscriptions, map[string]bool{"load-a": true})
+
+	var order []string
+	for _, item := range result.Items {
+		order = append(order, item.ResourceType+":"+item.ID)
+	}
+	want := []string{"subscription:ghost", "subscription:load-a", "subscription:load-b", "topic:orders", "topic:orders-dlq"}
+	if len(order) != len(want) {
+		t.Fatalf("planned %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("planned %v, want %v (subscriptions first, deduplicated)", order, want)
+		}
+	}
+
+	warnings := func(i int) int { return len(result.Items[i].Warnings) }
+	if result.Items[0].Found || warnings(0) != 1 {
+		t.Errorf("ghost = %+v, want not found with one warning", result.Items[0])
+	}
+	if warnings(1) != 1 || warnings(2) != 0 {
+		t.Errorf("load-a warnings %v, load-b warnings %v; want only the monitor warning on load-a", result.Items[1].Warnings, result.Items[2].Warnings)
+	}
+	if warnings(3) != 0 {
+		t.Errorf("orders warnings = %v, want none: all its subscriptions are deleted too", result.Items[3].Warnings)
+	}
+	if warnings(4) != 1 {
+		t.Errorf("orders-dlq warnings = %v, want the dead letter warning for billing", result.Items[4].Warnings)
+	}
+}
+
+func TestResourceHandler_DeleteBulkContinuesPastFailures(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := logger.InitLogger(); err != nil {
+		t.Fatalf("InitLogger() error = %v", err)
+	}
+	srv := pstest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client, err := auth.ConnectWithADC(ctx, "p", srv.Addr)
+	if err != nil {
+		t.Fatalf("ConnectWithADC() error = %v", err)
+	}
+	defer client.Close()
+	if err := admin.CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	if err := admin.CreateSubscriptionAdmin(ctx, client, "p", "orders", "load-a", 0); err != nil {
+		t.Fatalf("CreateSubscriptionAdmin() error = %v", err)
+	}
+
+	h := &ResourceHandler{ctx: ctx}
+	result := planBulkDelete("p", []string{"orders"}, []string{"ghost", "load-a"}, nil, nil, nil)
+	var stopped, reported []string
+	h.deleteBulk(client, "p", &result,
+		func(subID string) { stopped = append(stopped, subID) },
+		func(item BulkDeleteItem) { reported = append(reported, item.ID) })
+
+	if result.Deleted != 2 || result.Failed != 1 {
+		t.Errorf("deleted %d, failed %d; want 2 deleted and 1 failed", result.Deleted, result.Failed)
+	}
+	if result.Items[0].Deleted || result.Items[0].Error == "" {
+		t.Errorf("ghost = %+v, want a per-resource error", result.Items[0])
+	}
+	if len(stopped) != 2 || len(reported) != 3 {
+		t.Errorf("beforeDelete calls %v, onDeleted calls %v; want 2 and 3", stopped, reported)
+	}
+
+	topics, err := admin.ListTopicsAdmin(ctx, client, "p")
+	if err != nil || len(topics) != 0 {
+		t.Errorf("topics after bulk delete = %v, %v; want none", topics, err)
+	}
+}
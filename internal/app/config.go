@@ -72,6 +72,165 @@ func (h *ConfigHandler) GetAutoAck() (bool, error) {
 	return h.config.AutoAck, nil
 }
 
+// SetMonitorSubscriptionTTL updates the TTL (in hours) used for auto-created monitor subscriptions
+// Only affects subscriptions created after the change
+func (h *ConfigHandler) SetMonitorSubscriptionTTL(hours int) error {
+	if h.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+
+	if err := models.ValidateMonitorSubscriptionTTLHours(hours); err != nil {
+		return err
+	}
+
+	h.config.MonitorSubscriptionTTLHours = hours
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SetBacklogAgeWarnSeconds sets the oldest-unacked-age threshold (seconds) above which monitored
+// subscriptions emit backlog warnings (0 disables the check)
+func (h *ConfigHandler) SetBacklogAgeWarnSeconds(seconds int) error {
+	if h.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+
+	if err := models.ValidateBacklogAgeWarnSeconds(seconds); err != nil {
+		return err
+	}
+
+	h.config.BacklogAgeWarnSeconds = seconds
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SetEmulatorHealthCheckSeconds sets the interval (in seconds) of managed emulator health checks
+func (h *ConfigHandler) SetEmulatorHealthCheckSeconds(seconds int) error {
+	if h.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+
+	if err := models.ValidateEmulatorHealthCheckSeconds(seconds); err != nil {
+		return err
+	}
+
+	h.config.EmulatorHealthCheckSeconds = seconds
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SetPersistPublishHistory sets whether each profile's publish history is kept on disk
+func (h *ConfigHandler) SetPersistPublishHistory(enabled bool) error {
+	if h.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+
+	h.config.PersistPublishHistory = enabled
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SetLogRetentionDays sets how many days daily log files are kept; 0 keeps them all
+func (h *ConfigHandler) SetLogRetentionDays(days int) error {
+	if h.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+
+	if days != 0 {
+		if err := models.ValidateLogRetentionDays(days); err != nil {
+			return err
+		}
+	}
+
+	h.config.LogRetentionDays = days
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SetMonitorOptions sets the default flow control of monitors
+// Only affects monitors started after the change.
+func (h *ConfigHandler) SetMonitorOptions(options models.MonitorOptions) error {
+	if h.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	h.config.MonitorOptions = options
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SetAPICallPolicy sets the per-call timeout (0 keeps the default) and retry policy of admin API calls
+// Resource syncs use the timeout right away; the call options apply to connections made afterwards.
+func (h *ConfigHandler) SetAPICallPolicy(timeoutSeconds int, retry models.APIRetryPolicy) error {
+	if h.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+
+	if err := models.ValidateAPITimeoutSeconds(timeoutSeconds); err != nil {
+		return err
+	}
+	if err := retry.Validate(); err != nil {
+		return err
+	}
+
+	h.config.APITimeoutSeconds = timeoutSeconds
+	h.config.APIRetry = retry
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// SetMonitorHighlightRules replaces the attribute-based coloring rules used by the monitor
+// Rules are evaluated in order; the first match determines a message's color
+func (h *ConfigHandler) SetMonitorHighlightRules(rules []models.HighlightRule) error {
+	if h.config == nil {
+		return fmt.Errorf("config not initialized")
+	}
+
+	if err := models.ValidateHighlightRules(rules); err != nil {
+		return err
+	}
+
+	h.config.MonitorHighlightRules = rules
+
+	if err := h.configManager.SaveConfig(h.config); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateTheme updates the theme setting and saves it to config
 func (h *ConfigHandler) UpdateTheme(theme string) error {
 	if h.configManager == nil {
@@ -171,6 +330,56 @@ func (h *ConfigHandler) GetConfigFileContent() (string, error) {
 	return string(data), nil
 }
 
+// validateConfigSettings checks the general settings of a config edited or imported as a whole
+func validateConfigSettings(cfg *models.AppConfig) error {
+	if cfg.MessageBufferSize < 100 || cfg.MessageBufferSize > 10000 {
+		return fmt.Errorf("messageBufferSize must be between 100 and 10000")
+	}
+
+	if cfg.Theme != "light" && cfg.Theme != "dark" && cfg.Theme != "auto" && cfg.Theme != "dracula" && cfg.Theme != "monokai" && cfg.Theme != "nord" && cfg.Theme != "sienna" {
+		return fmt.Errorf("theme must be 'light', 'dark', 'auto', 'dracula', 'monokai', 'nord', or 'sienna'")
+	}
+
+	if cfg.FontSize != "small" && cfg.FontSize != "medium" && cfg.FontSize != "large" {
+		return fmt.Errorf("fontSize must be 'small', 'medium', or 'large'")
+	}
+
+	// Zero means unset (default applies)
+	if cfg.MonitorSubscriptionTTLHours != 0 {
+		if err := models.ValidateMonitorSubscriptionTTLHours(cfg.MonitorSubscriptionTTLHours); err != nil {
+			return fmt.Errorf("monitorSubscriptionTTLHours: %w", err)
+		}
+	}
+
+	if err := models.ValidateHighlightRules(cfg.MonitorHighlightRules); err != nil {
+		return fmt.Errorf("monitorHighlightRules: %w", err)
+	}
+
+	if err := cfg.MonitorOptions.Validate(); err != nil {
+		return fmt.Errorf("monitorOptions: %w", err)
+	}
+
+	if cfg.EmulatorHealthCheckSeconds != 0 {
+		if err := models.ValidateEmulatorHealthCheckSeconds(cfg.EmulatorHealthCheckSeconds); err != nil {
+			return fmt.Errorf("emulatorHealthCheckSeconds: %w", err)
+		}
+	}
+
+	if cfg.LogRetentionDays != 0 {
+		if err := models.ValidateLogRetentionDays(cfg.LogRetentionDays); err != nil {
+			return fmt.Errorf("logRetentionDays: %w", err)
+		}
+	}
+
+	if err := models.ValidateAPITimeoutSeconds(cfg.APITimeoutSeconds); err != nil {
+		return fmt.Errorf("apiTimeoutSeconds: %w", err)
+	}
+	if err := cfg.APIRetry.Validate(); err != nil {
+		return fmt.Errorf("apiRetry: %w", err)
+	}
+	return nil
+}
+
 // SaveConfigFileContent saves the raw JSON content to the config file
 func (h *ConfigHandler) SaveConfigFileContent(content string) error {
 	if h.configManager == nil {
@@ -184,16 +393,8 @@ func (h *ConfigHandler) SaveConfigFileContent(content string) error {
 	}
 
 	// Validate config structure
-	if tempConfig.MessageBufferSize < 100 || tempConfig.MessageBufferSize > 10000 {
-		return fmt.Errorf("messageBufferSize must be between 100 and 10000")
-	}
-
-	if tempConfig.Theme != "light" && tempConfig.Theme != "dark" && tempConfig.Theme != "auto" && tempConfig.Theme != "dracula" && tempConfig.Theme != "monokai" && tempConfig.Theme != "nord" && tempConfig.Theme != "sienna" {
-		return fmt.Errorf("theme must be 'light', 'dark', 'auto', 'dracula', 'monokai', 'nord', or 'sienna'")
-	}
-
-	if tempConfig.FontSize != "small" && tempConfig.FontSize != "medium" && tempConfig.FontSize != "large" {
-		return fmt.Errorf("fontSize must be 'small', 'medium', or 'large'")
+	if err := validateConfigSettings(&tempConfig); err != nil {
+		return err
 	}
 
 	// Store old values to detect changes
@@ -0,0 +1,375 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/templates"
+)
+
+// RedactedSecret replaces credential file paths in exported configs
+// On import, a redacted path is restored from the local profile with the same ID when there is one,
+// and cleared otherwise.
+const RedactedSecret = "<redacted>"
+
+// ConfigImportSummary is emitted as "config:imported" after a successful import
+type ConfigImportSummary struct {
+	Merge                      bool `json:"merge"`
+	Profiles                   int  `json:"profiles"`                   // Profiles taken from the import
+	Templates                  int  `json:"templates"`                  // Message templates taken from the import
+	TopicSubscriptionTemplates int  `json:"topicSubscriptionTemplates"` // Topic/subscription templates taken from the import
+	AttributeTemplates         int  `json:"attributeTemplates"`         // Attribute templates taken from the import
+
+	NeedsCredentials []string `json:"needsCredentials"` // Names of imported profiles whose redacted credential path could not be restored
+}
+
+// ExportConfig returns the config as JSON for moving it to another machine
+// Service account and OAuth client paths are redacted; OAuth tokens live outside the config and are never exported.
+// Machine-local state (active profile, upgrade check bookkeeping) is left out.
+func (h *ConfigHandler) ExportConfig() (string, error) {
+	if h.config == nil {
+		return "", fmt.Errorf("config is nil")
+	}
+
+	exported := *h.config
+	exported.ActiveProfileID = ""
+	exported.LastUpgradeCheck = time.Time{}
+	exported.DismissedUpgradeVersion = ""
+	exported.UpgradeSnoozedUntil = time.Time{}
+	exported.Profiles = make([]models.ConnectionProfile, len(h.config.Profiles))
+	for i, profile := range h.config.Profiles {
+		if profile.ServiceAccountPath != "" {
+			profile.ServiceAccountPath = RedactedSecret
+		}
+		if profile.OAuthClientPath != "" {
+			profile.OAuthClientPath = RedactedSecret
+		}
+		exported.Profiles[i] = profile
+	}
+
+	data, err := json.MarshalIndent(&exported, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+	return string(data), nil
+}
+
+// ImportConfig applies an exported config
+// With merge, imported profiles and templates are added to the local ones: an item with the same ID and name
+// updates the local item, an item whose ID is taken by a different item gets a new ID, and clashing names get
+// an " (imported)" suffix. Without merge, profiles, templates and settings are replaced by the import.
+// The profile of the active connection (activeProfileID) is never replaced or removed.
+func (h *ConfigHandler) ImportConfig(content string, merge bool, activeProfileID string) (*ConfigImportSummary, error) {
+	if h.config == nil {
+		return nil, fmt.Errorf("config is nil")
+	}
+	if h.configManager == nil {
+		return nil, fmt.Errorf("config manager not initialized")
+	}
+
+	var imported models.AppConfig
+	decoder := json.NewDecoder(bytes.NewReader([]byte(content)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&imported); err != nil {
+		return nil, fmt.Errorf("invalid config JSON: %w", err)
+	}
+
+	result, needsCredentials, err := mergeConfig(h.config, &imported, merge, activeProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.configManager.SaveConfig(result); err != nil {
+		return nil, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	oldTheme, oldFontSize := h.config.Theme, h.config.FontSize
+	// Update in place: every handler shares this config
+	*h.config = *result
+
+	if oldTheme != result.Theme {
+		runtime.EventsEmit(h.ctx, "config:theme-changed", result.Theme)
+	}
+	if oldFontSize != result.FontSize {
+		runtime.EventsEmit(h.ctx, "config:font-size-changed", result.FontSize)
+	}
+
+	summary := &ConfigImportSummary{
+		Merge:                      merge,
+		Profiles:                   len(imported.Profiles),
+		Templates:                  len(imported.Templates),
+		TopicSubscriptionTemplates: len(imported.TopicSubscriptionTemplates),
+		AttributeTemplates:         len(imported.AttributeTemplates),
+		NeedsCredentials:           needsCredentials,
+	}
+	runtime.EventsEmit(h.ctx, "config:imported", summary)
+	return summary, nil
+}
+
+// mergeConfig builds the config resulting from importing imported into local
+// It also returns the names of the imported profiles that need their credential path set again.
+func mergeConfig(local, imported *models.AppConfig, merge bool, activeProfileID string) (*models.AppConfig, []string, error) {
+	result := *local
+	if !merge {
+		if err := validateConfigSettings(imported); err != nil {
+			return nil, nil, err
+		}
+		result = *imported
+		result.LastUpgradeCheck = local.LastUpgradeCheck
+		result.DismissedUpgradeVersion = local.DismissedUpgradeVersion
+		result.UpgradeSnoozedUntil = local.UpgradeSnoozedUntil
+	}
+
+	// Replacing starts from an empty list, except that the active connection's profile is kept
+	var baseProfiles []models.ConnectionProfile
+	var baseTemplates []models.MessageTemplate
+	var baseTopicTemplates []models.TopicSubscriptionTemplate
+	var baseAttributeTemplates []models.AttributeTemplate
+	if merge {
+		baseProfiles = local.Profiles
+		baseTemplates = local.Templates
+		baseTopicTemplates = local.TopicSubscriptionTemplates
+		baseAttributeTemplates = local.AttributeTemplates
+	} else {
+		for _, profile := range local.Profiles {
+			if profile.ID == activeProfileID {
+				baseProfiles = append(baseProfiles, profile)
+			}
+		}
+	}
+
+	profiles, needsCredentials, err := mergeProfiles(baseProfiles, local.Profiles, imported.Profiles, merge, activeProfileID)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.Profiles = profiles
+
+	messageTemplates, err := mergeMessageTemplates(baseTemplates, imported.Templates)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.Templates = messageTemplates
+
+	topicTemplates, err := mergeTopicSubscriptionTemplates(baseTopicTemplates, imported.TopicSubscriptionTemplates)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.TopicSubscriptionTemplates = topicTemplates
+
+	attributeTemplates, err := mergeAttributeTemplates(baseAttributeTemplates, imported.AttributeTemplates)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.AttributeTemplates = attributeTemplates
+
+	// Keep pointing at the active connection, or at a remembered profile that still exists
+	result.ActiveProfileID = ""
+	for _, candidate := range []string{activeProfileID, local.ActiveProfileID} {
+		if candidate != "" && containsProfile(profiles, candidate) {
+			result.ActiveProfileID = candidate
+			break
+		}
+	}
+	return &result, needsCredentials, nil
+}
+
+// mergeProfiles adds imported profiles to base
+// localProfiles are used to restore redacted credential paths by profile ID; the names of the imported
+// profiles whose paths could not be restored are returned.
+func mergeProfiles(base, localProfiles, imported []models.ConnectionProfile, merge bool, activeProfileID string) ([]models.ConnectionProfile, []string, error) {
+	result := append([]models.ConnectionProfile(nil), base...)
+	names := make(map[string]bool)
+	for _, profile := range result {
+		names[profile.Name] = true
+	}
+	hasDefault := false
+	for _, profile := range result {
+		hasDefault = hasDefault || profile.IsDefault
+	}
+
+	needsCredentials := []string{}
+	for _, profile := range imported {
+		// A redacted path counts as set, so validate before it is restored or cleared
+		if err := profile.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("invalid profile %q: %w", profile.Name, err)
+		}
+		missingCredentials := !restoreRedactedPaths(&profile, localProfiles)
+
+		i := indexOfProfile(result, profile.ID)
+		if i >= 0 && profile.ID != activeProfileID && result[i].Name == profile.Name {
+			profile.IsDefault = result[i].IsDefault
+			result[i] = profile
+			if missingCredentials {
+				needsCredentials = append(needsCredentials, profile.Name)
+			}
+			continue
+		}
+		if i >= 0 {
+			profile.ID = newImportID()
+		}
+		profile.Name = uniqueImportName(profile.Name, names)
+		// Only a replacing import may bring the default profile, and only one
+		if !merge && profile.IsDefault && !hasDefault {
+			hasDefault = true
+		} else {
+			profile.IsDefault = false
+		}
+		names[profile.Name] = true
+		result = append(result, profile)
+		if missingCredentials {
+			needsCredentials = append(needsCredentials, profile.Name)
+		}
+	}
+	return result, needsCredentials, nil
+}
+
+// mergeMessageTemplates adds imported message templates to base
+func mergeMessageTemplates(base, imported []models.MessageTemplate) ([]models.MessageTemplate, error) {
+	return mergeByID(base, imported,
+		func(t *models.MessageTemplate) *string { return &t.ID },
+		func(t *models.MessageTemplate) *string { return &t.Name },
+		func(t models.MessageTemplate) error {
+			if err := t.Validate(); err != nil {
+				return fmt.Errorf("invalid message template %q: %w", t.Name, err)
+			}
+			return nil
+		}, nil)
+}
+
+// mergeTopicSubscriptionTemplates adds imported custom topic/subscription templates to base
+// IDs of built-in templates count as taken.
+func mergeTopicSubscriptionTemplates(base, imported []models.TopicSubscriptionTemplate) ([]models.TopicSubscriptionTemplate, error) {
+	custom := make([]models.TopicSubscriptionTemplate, len(imported))
+	for i, template := range imported {
+		template.IsBuiltIn = false
+		custom[i] = template
+	}
+
+	builtIn := templates.NewRegistry()
+	return mergeByID(base, custom,
+		func(t *models.TopicSubscriptionTemplate) *string { return &t.ID },
+		func(t *models.TopicSubscriptionTemplate) *string { return &t.Name },
+		func(t models.TopicSubscriptionTemplate) error {
+			if err := t.Validate(); err != nil {
+				return fmt.Errorf("invalid topic/subscription template %q: %w", t.Name, err)
+			}
+			return nil
+		},
+		func(id string) bool {
+			_, err := builtIn.GetTemplate(id)
+			return err == nil
+		})
+}
+
+// mergeAttributeTemplates adds imported attribute templates to base
+func mergeAttributeTemplates(base, imported []models.AttributeTemplate) ([]models.AttributeTemplate, error) {
+	return mergeByID(base, imported,
+		func(t *models.AttributeTemplate) *string { return &t.ID },
+		func(t *models.AttributeTemplate) *string { return &t.Name },
+		func(t models.AttributeTemplate) error {
+			if err := t.Validate(); err != nil {
+				return fmt.Errorf("invalid attribute template %q: %w", t.Name, err)
+			}
+			return nil
+		}, nil)
+}
+
+// mergeByID adds imported items to base after validating each of them
+// An item with the ID and name of a base item replaces it; otherwise it is appended with a new ID when
+// its ID is already used (or taken reports it as reserved) and an ` (imported)` suffix when its name clashes.
+func mergeByID[T any](base, imported []T, id func(*T) *string, name func(*T) *string, validate func(T) error, taken func(string) bool) ([]T, error) {
+	result := append([]T(nil), base...)
+	names := make(map[string]bool)
+	for i := range result {
+		names[*name(&result[i])] = true
+	}
+
+	for _, item := range imported {
+		if err := validate(item); err != nil {
+			return nil, err
+		}
+
+		i := -1
+		for j := range result {
+			if *id(&result[j]) == *id(&item) {
+				i = j
+				break
+			}
+		}
+		if i >= 0 && *name(&result[i]) == *name(&item) {
+			result[i] = item
+			continue
+		}
+		if i >= 0 || (taken != nil && taken(*id(&item))) {
+			*id(&item) = newImportID()
+		}
+		*name(&item) = uniqueImportName(*name(&item), names)
+		names[*name(&item)] = true
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// restoreRedactedPaths replaces redacted credential paths with those of the local profile with the same ID
+// Paths that cannot be restored are cleared, so connecting asks for them instead of opening "<redacted>".
+// Returns false when a path was cleared.
+func restoreRedactedPaths(profile *models.ConnectionProfile, localProfiles []models.ConnectionProfile) bool {
+	var local models.ConnectionProfile
+	if i := indexOfProfile(localProfiles, profile.ID); i >= 0 {
+		local = localProfiles[i]
+	}
+
+	restored := true
+	if profile.ServiceAccountPath == RedactedSecret {
+		profile.ServiceAccountPath = local.ServiceAccountPath
+		restored = restored && local.ServiceAccountPath != ""
+	}
+	if profile.OAuthClientPath == RedactedSecret {
+		profile.OAuthClientPath = local.OAuthClientPath
+		restored = restored && local.OAuthClientPath != ""
+	}
+	return restored
+}
+
+// indexOfProfile returns the index of the profile with the given ID, or -1
+func indexOfProfile(profiles []models.ConnectionProfile, id string) int {
+	for i, profile := range profiles {
+		if profile.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// containsProfile reports whether a profile with the given ID exists
+func containsProfile(profiles []models.ConnectionProfile, id string) bool {
+	return indexOfProfile(profiles, id) >= 0
+}
+
+// uniqueImportName returns name, or name with an " (imported)" suffix that is not in taken
+func uniqueImportName(name string, taken map[string]bool) string {
+	if !taken[name] {
+		return name
+	}
+	candidate := name + " (imported)"
+	for n := 2; taken[candidate]; n++ {
+		candidate = fmt.Sprintf("%s (imported %d)", name, n)
+	}
+	return candidate
+}
+
+// newImportID generates an ID for an imported item whose ID is already taken
+// UUIDv7 keeps IDs unique when several items are imported within the same second
+func newImportID() string {
+	if id, err := uuid.NewV7(); err == nil {
+		return id.String()
+	}
+	return uuid.NewString()
+}
@@ -0,0 +1,194 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/templates"
+)
+
+func TestConfigHandler_ExportConfigRedactsSecrets(t *testing.T) {
+	cfg := models.NewDefaultConfig()
+	cfg.ActiveProfileID = "p1"
+	cfg.Profiles = []models.ConnectionProfile{
+		{ID: "p1", Name: "Prod", ProjectID: "prod", AuthMethod: "ServiceAccount", ServiceAccountPath: "/secret/key.json"},
+		{ID: "p2", Name: "Dev", ProjectID: "dev", AuthMethod: "OAuth", OAuthClientPath: "/secret/client.json"},
+	}
+	h := NewConfigHandler(context.Background(), cfg, nil, nil, nil)
+
+	content, err := h.ExportConfig()
+	if err != nil {
+		t.Fatalf("ExportConfig() error = %v", err)
+	}
+	if strings.Contains(content, "/secret/") {
+		t.Errorf("ExportConfig() leaked a credential path:\n%s", content)
+	}
+
+	var exported models.AppConfig
+	if err := json.Unmarshal([]byte(content), &exported); err != nil {
+		t.Fatalf("exported config is not valid JSON: %v", err)
+	}
+	if exported.ActiveProfileID != "" {
+		t.Errorf("ActiveProfileID = %q, want empty", exported.ActiveProfileID)
+	}
+	if exported.Profiles[0].ServiceAccountPath != RedactedSecret || exported.Profiles[1].OAuthClientPath != RedactedSecret {
+		t.Errorf("profiles = %+v, want redacted paths", exported.Profiles)
+	}
+	if cfg.Profiles[0].ServiceAccountPath != "/secret/key.json" {
+		t.Error("ExportConfig() modified the live config")
+	}
+}
+
+func TestMergeConfig(t *testing.T) {
+	local := models.NewDefaultConfig()
+	local.Profiles = []models.ConnectionProfile{
+		{ID: "p1", Name: "Prod", ProjectID: "prod", AuthMethod: "ServiceAccount", ServiceAccountPath: "/secret/key.json", IsDefault: true},
+		{ID: "p2", Name: "Dev", ProjectID: "dev", AuthMethod: "ADC"},
+		{ID: "p3", Name: "Active", ProjectID: "active", AuthMethod: "ADC"},
+	}
+	local.Templates = []models.MessageTemplate{{ID: "t1", Name: "Order", Payload: "{}"}}
+
+	imported := models.NewDefaultConfig()
+	imported.Theme = "dark"
+	imported.Profiles = []models.ConnectionProfile{
+		// Same ID and name: updates the local profile, redacted path restored
+		{ID: "p1", Name: "Prod", ProjectID: "prod-2", AuthMethod: "ServiceAccount", ServiceAccountPath: RedactedSecret},
+		// Same ID, different name: new ID, name kept
+		{ID: "p2", Name: "Staging", ProjectID: "staging", AuthMethod: "ADC", IsDefault: true},
+		// New ID, clashing name: renamed
+		{ID: "p9", Name: "Dev", ProjectID: "dev-2", AuthMethod: "ADC"},
+		// Active profile: never replaced
+		{ID: "p3", Name: "Active", ProjectID: "other", AuthMethod: "ADC"},
+		// Unknown ID: redacted path cleared, credentials needed
+		{ID: "p8", Name: "Shared", ProjectID: "shared", AuthMethod: "OAuth", OAuthClientPath: RedactedSecret},
+	}
+	imported.Templates = []models.MessageTemplate{{ID: "t2", Name: "Order", Payload: "[]"}}
+
+	t.Run("merge", func(t *testing.T) {
+		result, needsCredentials, err := mergeConfig(local, imported, true, "p3")
+		if err != nil {
+			t.Fatalf("mergeConfig() error = %v", err)
+		}
+		if result.Theme != local.Theme {
+			t.Errorf("Theme = %q, merge must keep local settings", result.Theme)
+		}
+		if len(result.Profiles) != 7 {
+			t.Fatalf("len(Profiles) = %d, want 7: %+v", len(result.Profiles), result.Profiles)
+		}
+
+		p1 := result.Profiles[0]
+		if p1.ProjectID != "prod-2" || p1.ServiceAccountPath != "/secret/key.json" || !p1.IsDefault {
+			t.Errorf("updated profile = %+v", p1)
+		}
+		if result.Profiles[2].ProjectID != "active" {
+			t.Errorf("active profile was replaced: %+v", result.Profiles[2])
+		}
+		if shared := result.Profiles[6]; shared.OAuthClientPath != "" {
+			t.Errorf("unrestorable redacted path kept: %+v", shared)
+		}
+		if !reflect.DeepEqual(needsCredentials, []string{"Shared"}) {
+			t.Errorf("needsCredentials = %v, want [Shared]", needsCredentials)
+		}
+
+		ids := make(map[string]bool)
+		names := make(map[string]bool)
+		for _, profile := range result.Profiles {
+			if ids[profile.ID] || names[profile.Name] {
+				t.Errorf("duplicate profile ID or name: %+v", profile)
+			}
+			ids[profile.ID], names[profile.Name] = true, true
+			if profile.IsDefault && profile.ID != "p1" {
+				t.Errorf("merge imported a default profile: %+v", profile)
+			}
+		}
+		for _, name := range []string{"Staging", "Dev (imported)", "Active (imported)"} {
+			if !names[name] {
+				t.Errorf("missing profile %q in %+v", name, result.Profiles)
+			}
+		}
+
+		if len(result.Templates) != 2 || result.Templates[1].Name != "Order (imported)" {
+			t.Errorf("Templates = %+v", result.Templates)
+		}
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		result, needsCredentials, err := mergeConfig(local, imported, false, "p3")
+		if err != nil {
+			t.Fatalf("mergeConfig() error = %v", err)
+		}
+		if result.Theme != "dark" {
+			t.Errorf("Theme = %q, want imported settings", result.Theme)
+		}
+		if result.ActiveProfileID != "p3" {
+			t.Errorf("ActiveProfileID = %q, want p3", result.ActiveProfileID)
+		}
+		if len(result.Profiles) != 6 || result.Profiles[0].ID != "p3" || result.Profiles[0].ProjectID != "active" {
+			t.Errorf("Profiles = %+v, want the active profile kept first", result.Profiles)
+		}
+		// Replacing still restores paths from the local profiles
+		if result.Profiles[1].ServiceAccountPath != "/secret/key.json" || result.Profiles[5].OAuthClientPath != "" {
+			t.Errorf("Profiles = %+v, want p1 path restored and p8 path cleared", result.Profiles)
+		}
+		if !reflect.DeepEqual(needsCredentials, []string{"Shared"}) {
+			t.Errorf("needsCredentials = %v, want [Shared]", needsCredentials)
+		}
+		if len(result.Templates) != 1 || result.Templates[0].ID != "t2" {
+			t.Errorf("Templates = %+v, want only the imported template", result.Templates)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		bad := models.NewDefaultConfig()
+		bad.Profiles = []models.ConnectionProfile{{ID: "x", Name: "X", AuthMethod: "ADC"}}
+		if _, _, err := mergeConfig(local, bad, true, ""); err == nil {
+			t.Error("mergeConfig() accepted a profile without project ID")
+		}
+	})
+}
+
+func TestUniqueImportName(t *testing.T) {
+	taken := map[string]bool{"A": true, "A (imported)": true}
+	if got := uniqueImportName("B", taken); got != "B" {
+		t.Errorf("uniqueImportName(B) = %q", got)
+	}
+	if got := uniqueImportName("A", taken); got != "A (imported 2)" {
+		t.Errorf("uniqueImportName(A) = %q, want A (imported 2)", got)
+	}
+}
+
+func TestMergeAttributeTemplates(t *testing.T) {
+	local := []models.AttributeTemplate{{ID: "t1", Name: "Tracing", Attributes: map[string]string{"a": "1"}}}
+	imported := []models.AttributeTemplate{
+		{ID: "t1", Name: "Tracing", Attributes: map[string]string{"a": "2"}},
+		{ID: "t2", Name: "Tracing", Attributes: map[string]string{"b": "1"}},
+	}
+
+	merged, err := mergeAttributeTemplates(local, imported)
+	if err != nil {
+		t.Fatalf("mergeAttributeTemplates() error = %v", err)
+	}
+	if len(merged) != 2 || merged[0].Attributes["a"] != "2" || merged[1].Name != "Tracing (imported)" {
+		t.Errorf("mergeAttributeTemplates() = %+v, want t1 updated and t2 renamed", merged)
+	}
+
+	if _, err := mergeAttributeTemplates(nil, []models.AttributeTemplate{{ID: "x", Name: "Empty"}}); err == nil {
+		t.Error("mergeAttributeTemplates(invalid) error = nil, want error")
+	}
+}
+
+func TestMergeTopicSubscriptionTemplates_BuiltInIDTaken(t *testing.T) {
+	builtIn := *templates.GetBuiltInTemplates()[0]
+
+	merged, err := mergeTopicSubscriptionTemplates(nil, []models.TopicSubscriptionTemplate{builtIn})
+	if err != nil {
+		t.Fatalf("mergeTopicSubscriptionTemplates() error = %v", err)
+	}
+	if len(merged) != 1 || merged[0].ID == builtIn.ID || merged[0].IsBuiltIn || merged[0].Name != builtIn.Name {
+		t.Errorf("mergeTopicSubscriptionTemplates() = %+v, want a custom copy with a new ID", merged)
+	}
+}
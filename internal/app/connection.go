@@ -3,15 +3,22 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
+	"cloud.google.com/go/pubsub/v2"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"google.golang.org/api/option"
 	"pubsub-gui/internal/auth"
 	"pubsub-gui/internal/config"
+	"pubsub-gui/internal/logger"
 	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
 )
 
 // ConnectionStatus represents the current connection status
@@ -22,6 +29,7 @@ type ConnectionStatus struct {
 	EmulatorHost           string `json:"emulatorHost,omitempty"`
 	EmulatorMode           string `json:"emulatorMode,omitempty"`
 	ManagedEmulatorRunning bool   `json:"managedEmulatorRunning,omitempty"`
+	TestMode               bool   `json:"testMode"` // All connections are forced to the test-mode emulator host
 }
 
 // ConnectionHandler handles connection and profile management
@@ -30,13 +38,33 @@ type ConnectionHandler struct {
 	config              *models.AppConfig
 	configManager       *config.Manager
 	clientManager       *auth.ClientManager
-	syncResources       func() // Callback to trigger resource sync
-	currentEmulatorHost string // Track emulator host from current connection (for status display)
-	currentAuthMethod   string // Track auth method from current connection (for status display)
-	currentEmulatorMode string // Track emulator mode from current connection
+	syncResources       func()          // Callback to trigger resource sync
+	currentEmulatorHost string          // Track emulator host from current connection (for status display)
+	currentAuthMethod   string          // Track auth method from current connection (for status display)
+	currentIdentity     string          // Track connected identity (OAuth email or service account email)
+	currentEmulatorMode string          // Track emulator mode from current connection
+	currentOAuth        oauthConnection // OAuth settings of the current connection, reused when switching projects
 	emulatorHostMu      sync.RWMutex
 	authMethodMu        sync.RWMutex
 	emulatorModeMu      sync.RWMutex
+
+	testModeMu   sync.RWMutex
+	testModeHost string // When set, every connection uses this emulator host regardless of the profile
+}
+
+// oauthConnection holds what is needed to rebuild an OAuth connection without signing in again
+type oauthConnection struct {
+	clientPath string
+	profileID  string // Token store key of the signed-in account
+	email      string // Account hint the connection was made with
+}
+
+// applyCallPolicy sets the configured admin call timeout and retries on a new client
+func (h *ConnectionHandler) applyCallPolicy(client *pubsub.Client) {
+	if h.config == nil {
+		return
+	}
+	admin.ApplyCallPolicy(client, h.config.GetAPITimeout(), h.config.APIRetry)
 }
 
 // ClearEmulatorHost clears the tracked emulator host (called on disconnect)
@@ -46,6 +74,8 @@ func (h *ConnectionHandler) ClearEmulatorHost() {
 	h.emulatorHostMu.Unlock()
 	h.authMethodMu.Lock()
 	h.currentAuthMethod = ""
+	h.currentIdentity = ""
+	h.currentOAuth = oauthConnection{}
 	h.authMethodMu.Unlock()
 	h.emulatorModeMu.Lock()
 	h.currentEmulatorMode = ""
@@ -66,6 +96,43 @@ func (h *ConnectionHandler) GetEmulatorMode() string {
 	return h.currentEmulatorMode
 }
 
+// GetIdentity returns the identity used by the current connection
+// Empty for ADC and emulator connections where the identity is not known locally
+func (h *ConnectionHandler) GetIdentity() string {
+	h.authMethodMu.RLock()
+	defer h.authMethodMu.RUnlock()
+	return h.currentIdentity
+}
+
+// SetTestMode forces every subsequent connection to the given emulator host
+func (h *ConnectionHandler) SetTestMode(emulatorHost string) {
+	h.testModeMu.Lock()
+	h.testModeHost = emulatorHost
+	h.testModeMu.Unlock()
+}
+
+// ClearTestMode stops forcing connections to the test-mode emulator host
+func (h *ConnectionHandler) ClearTestMode() {
+	h.testModeMu.Lock()
+	h.testModeHost = ""
+	h.testModeMu.Unlock()
+}
+
+// TestModeHost returns the test-mode emulator host, empty when test mode is off
+func (h *ConnectionHandler) TestModeHost() string {
+	h.testModeMu.RLock()
+	defer h.testModeMu.RUnlock()
+	return h.testModeHost
+}
+
+// resolveEmulatorHost returns the emulator host to connect with, applying the test-mode override
+func (h *ConnectionHandler) resolveEmulatorHost(emulatorHost string) string {
+	if testHost := h.TestModeHost(); testHost != "" {
+		return testHost
+	}
+	return emulatorHost
+}
+
 // NewConnectionHandler creates a new connection handler
 func NewConnectionHandler(
 	ctx context.Context,
@@ -117,6 +184,7 @@ func (h *ConnectionHandler) GetConnectionStatus() ConnectionStatus {
 		AuthMethod:   authMethod,
 		EmulatorHost: emulatorHost,
 		EmulatorMode: emulatorMode,
+		TestMode:     h.TestModeHost() != "",
 	}
 }
 
@@ -125,15 +193,28 @@ func (h *ConnectionHandler) ConnectWithADC(projectID string, emulatorHost string
 	if projectID == "" {
 		return fmt.Errorf("project ID cannot be empty")
 	}
+	emulatorHost = h.resolveEmulatorHost(emulatorHost)
 
 	client, err := auth.ConnectWithADC(h.ctx, projectID, emulatorHost)
 	if err != nil {
 		return fmt.Errorf("failed to connect with ADC: %w", err)
 	}
 
+	// Probe the project before reporting connected
+	if err := auth.ValidateConnection(h.ctx, client, projectID); err != nil {
+		client.Close()
+		return err
+	}
+
+	h.applyCallPolicy(client)
 	if err := h.clientManager.SetClient(client, projectID); err != nil {
 		return fmt.Errorf("failed to set client: %w", err)
 	}
+	if emulatorHost == "" {
+		// Default credentials are picked up automatically by other Google API clients
+		h.clientManager.SetCredentialOptions()
+	}
+	h.attachSchemaClient(emulatorHost)
 
 	// Track emulator host and auth method for status display
 	h.emulatorHostMu.Lock()
@@ -141,6 +222,7 @@ func (h *ConnectionHandler) ConnectWithADC(projectID string, emulatorHost string
 	h.emulatorHostMu.Unlock()
 	h.authMethodMu.Lock()
 	h.currentAuthMethod = "ADC"
+	h.currentIdentity = ""
 	h.authMethodMu.Unlock()
 
 	// Sync resources after successful connection
@@ -161,14 +243,34 @@ func (h *ConnectionHandler) ConnectWithServiceAccount(projectID, keyPath string,
 		return fmt.Errorf("service account key path cannot be empty")
 	}
 
+	if h.TestModeHost() != "" {
+		return h.connectTestMode(projectID, "ServiceAccount")
+	}
+
+	// Check the key file up front: a moved or deleted file otherwise surfaces as an opaque client init error
+	if err := checkServiceAccountKey(keyPath); err != nil {
+		return err
+	}
+
 	client, err := auth.ConnectWithServiceAccount(h.ctx, projectID, keyPath, emulatorHost)
 	if err != nil {
 		return fmt.Errorf("failed to connect with service account: %w", err)
 	}
 
+	// Probe the project before reporting connected
+	if err := auth.ValidateConnection(h.ctx, client, projectID); err != nil {
+		client.Close()
+		return err
+	}
+
+	h.applyCallPolicy(client)
 	if err := h.clientManager.SetClient(client, projectID); err != nil {
 		return fmt.Errorf("failed to set client: %w", err)
 	}
+	if emulatorHost == "" {
+		h.clientManager.SetCredentialOptions(option.WithAuthCredentialsFile(option.ServiceAccount, keyPath))
+	}
+	h.attachSchemaClient(emulatorHost)
 
 	// Track emulator host and auth method for status display
 	h.emulatorHostMu.Lock()
@@ -176,6 +278,7 @@ func (h *ConnectionHandler) ConnectWithServiceAccount(projectID, keyPath string,
 	h.emulatorHostMu.Unlock()
 	h.authMethodMu.Lock()
 	h.currentAuthMethod = "ServiceAccount"
+	h.currentIdentity = auth.ServiceAccountEmail(keyPath)
 	h.authMethodMu.Unlock()
 
 	// Sync resources after successful connection
@@ -186,8 +289,48 @@ func (h *ConnectionHandler) ConnectWithServiceAccount(projectID, keyPath string,
 	return nil
 }
 
+// connectTestMode connects to the test-mode emulator without touching the profile's credentials
+// The emulator ignores credentials, so the connection is made like ADC but reported under the profile's auth method
+func (h *ConnectionHandler) connectTestMode(projectID, authMethod string) error {
+	if err := h.ConnectWithADC(projectID, ""); err != nil {
+		return err
+	}
+	h.authMethodMu.Lock()
+	h.currentAuthMethod = authMethod
+	h.authMethodMu.Unlock()
+	return nil
+}
+
+// attachSchemaClient creates the schema client of a new connection with its endpoint and credentials
+// Failures are logged: the connection still works, only schema operations are unavailable.
+func (h *ConnectionHandler) attachSchemaClient(emulatorHost string) {
+	opts, _ := h.clientManager.GetCredentialOptions()
+	sc, err := auth.NewSchemaClient(h.ctx, emulatorHost, opts...)
+	if err != nil {
+		logger.Warn("Failed to create schema client", "error", err)
+		return
+	}
+	h.clientManager.SetSchemaClient(sc)
+}
+
+// checkServiceAccountKey verifies that a service account key file exists and is readable
+func checkServiceAccountKey(keyPath string) error {
+	info, err := os.Stat(keyPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%w at %s", models.ErrServiceAccountNotFound, keyPath)
+		}
+		return fmt.Errorf("cannot access service account key file at %s: %w", keyPath, err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("service account key path %s is a directory, not a key file", keyPath)
+	}
+	return nil
+}
+
 // ConnectWithOAuth connects to Pub/Sub using OAuth2 credentials
-func (h *ConnectionHandler) ConnectWithOAuth(projectID, oauthClientPath string, emulatorHost string) error {
+// oauthEmail (optional) selects the Google account: its token is used, and signing in preselects it.
+func (h *ConnectionHandler) ConnectWithOAuth(projectID, oauthClientPath, oauthEmail, emulatorHost string) error {
 	if projectID == "" {
 		return fmt.Errorf("project ID cannot be empty")
 	}
@@ -196,22 +339,70 @@ func (h *ConnectionHandler) ConnectWithOAuth(projectID, oauthClientPath string,
 		return fmt.Errorf("OAuth client path cannot be empty")
 	}
 
+	if h.TestModeHost() != "" {
+		return h.connectTestMode(projectID, "OAuth")
+	}
+
+	// Get or create profile ID for token storage
+	profileID := h.getOrCreateOAuthProfileID(projectID, oauthClientPath, oauthEmail)
+	return h.connectOAuthAndNotify(projectID, oauthClientPath, profileID, oauthEmail, emulatorHost)
+}
+
+// ConnectOAuthProfile connects with a saved OAuth profile, using the tokens stored for that profile
+// A profile without an account is signed in and then records the account it was signed in with.
+func (h *ConnectionHandler) ConnectOAuthProfile(profile models.ConnectionProfile, emulatorHost string) error {
+	if profile.ProjectID == "" {
+		return fmt.Errorf("project ID cannot be empty")
+	}
+	if profile.OAuthClientPath == "" {
+		return fmt.Errorf("OAuth client path cannot be empty")
+	}
+	if h.TestModeHost() != "" {
+		return h.connectTestMode(profile.ProjectID, "OAuth")
+	}
+	return h.connectOAuthAndNotify(profile.ProjectID, profile.OAuthClientPath, profile.ID, profile.OAuthEmail, emulatorHost)
+}
+
+// connectOAuthAndNotify connects with OAuth, records the signed-in account on the profile and emits connection:success
+func (h *ConnectionHandler) connectOAuthAndNotify(projectID, oauthClientPath, profileID, oauthEmail, emulatorHost string) error {
+	userEmail, err := h.connectOAuth(projectID, oauthClientPath, profileID, oauthEmail, emulatorHost, true)
+	if err != nil {
+		return err
+	}
+	h.recordOAuthEmail(profileID, userEmail)
+
+	// Emit connection success event with OAuth metadata
+	runtime.EventsEmit(h.ctx, "connection:success", map[string]interface{}{
+		"projectId":  projectID,
+		"authMethod": "OAuth",
+		"userEmail":  userEmail,
+	})
+
+	return nil
+}
+
+// connectOAuth connects with the OAuth token stored under profileID and returns the signed-in account
+// The browser only opens when no usable token is stored and interactive is set.
+func (h *ConnectionHandler) connectOAuth(projectID, oauthClientPath, profileID, oauthEmail, emulatorHost string, interactive bool) (string, error) {
 	// Get config directory for token store
 	configDir := filepath.Dir(h.configManager.GetConfigPath())
 
 	// Create token store
 	tokenStore, err := auth.NewTokenStore(configDir)
 	if err != nil {
-		return fmt.Errorf("failed to initialize token store: %w", err)
+		return "", fmt.Errorf("failed to initialize token store: %w", err)
 	}
 
-	// Get or create profile ID for token storage
-	profileID := h.getOrCreateOAuthProfileID(projectID, oauthClientPath)
-
 	// Connect with OAuth
-	client, userEmail, err := auth.ConnectWithOAuth(h.ctx, projectID, oauthClientPath, profileID, tokenStore, emulatorHost)
+	client, userEmail, err := auth.ConnectWithOAuth(h.ctx, projectID, oauthClientPath, profileID, oauthEmail, tokenStore, emulatorHost, interactive)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	// Probe the project before reporting connected
+	if err := auth.ValidateConnection(h.ctx, client, projectID); err != nil {
+		client.Close()
+		return "", err
 	}
 
 	// Track emulator host and auth method for status display
@@ -220,35 +411,205 @@ func (h *ConnectionHandler) ConnectWithOAuth(projectID, oauthClientPath string,
 	h.emulatorHostMu.Unlock()
 	h.authMethodMu.Lock()
 	h.currentAuthMethod = "OAuth"
+	h.currentIdentity = userEmail
+	// Tokens are keyed by the signed-in account, so later connections look them up with it
+	accountEmail := oauthEmail
+	if userEmail != "" && userEmail != "unknown" {
+		accountEmail = userEmail
+	}
+	h.currentOAuth = oauthConnection{clientPath: oauthClientPath, profileID: profileID, email: accountEmail}
 	h.authMethodMu.Unlock()
 
+	h.applyCallPolicy(client)
 	if err := h.clientManager.SetClient(client, projectID); err != nil {
 		client.Close()
-		return fmt.Errorf("failed to set client: %w", err)
+		return "", fmt.Errorf("failed to set client: %w", err)
 	}
+	if emulatorHost == "" {
+		if opts, err := auth.OAuthCredentialOptions(h.ctx, oauthClientPath, profileID, accountEmail, tokenStore); err == nil {
+			h.clientManager.SetCredentialOptions(opts...)
+		}
+	}
+	h.attachSchemaClient(emulatorHost)
 
 	// Sync resources after successful connection
 	if h.syncResources != nil {
 		go h.syncResources()
 	}
 
-	// Emit connection success event with OAuth metadata
-	runtime.EventsEmit(h.ctx, "connection:success", map[string]interface{}{
-		"projectId":  projectID,
-		"authMethod": "OAuth",
-		"userEmail":  userEmail,
-	})
+	return userEmail, nil
+}
+
+// Reconnect rebuilds the client of the current connection with a profile's auth settings
+// The connection's emulator host is kept and managed emulators are not started, so it only
+// replaces a client that stopped working (e.g. after the network dropped). It runs in the background,
+// so an OAuth profile whose token is gone fails with models.ErrOAuthSignInRequired instead of opening the browser.
+func (h *ConnectionHandler) Reconnect(profile models.ConnectionProfile) error {
+	h.emulatorHostMu.RLock()
+	emulatorHost := h.currentEmulatorHost
+	h.emulatorHostMu.RUnlock()
+
+	switch profile.AuthMethod {
+	case "ADC":
+		return h.ConnectWithADC(profile.ProjectID, emulatorHost)
+	case "ServiceAccount":
+		return h.ConnectWithServiceAccount(profile.ProjectID, profile.ServiceAccountPath, emulatorHost)
+	case "OAuth":
+		if h.TestModeHost() != "" {
+			return h.connectTestMode(profile.ProjectID, "OAuth")
+		}
+		// The profile's own token, even when the connection was switched to another project
+		email := profile.OAuthEmail
+		h.authMethodMu.RLock()
+		if email == "" && h.currentOAuth.profileID == profile.ID {
+			email = h.currentOAuth.email
+		}
+		h.authMethodMu.RUnlock()
+		_, err := h.connectOAuth(profile.ProjectID, profile.OAuthClientPath, profile.ID, email, emulatorHost, false)
+		return err
+	default:
+		return fmt.Errorf("unsupported auth method: %s", profile.AuthMethod)
+	}
+}
+
+// CheckProjectSwitch returns why the current connection cannot be switched to projectID, or nil
+func (h *ConnectionHandler) CheckProjectSwitch(projectID string) error {
+	if projectID == "" {
+		return fmt.Errorf("project ID cannot be empty")
+	}
+	if !h.clientManager.IsConnected() {
+		return models.ErrNotConnected
+	}
+
+	h.authMethodMu.RLock()
+	authMethod := h.currentAuthMethod
+	oauth := h.currentOAuth
+	h.authMethodMu.RUnlock()
+
+	switch authMethod {
+	case "ADC":
+		return nil
+	case "OAuth":
+		if oauth.clientPath == "" && h.TestModeHost() == "" {
+			return fmt.Errorf("the OAuth settings of the current connection are unknown: reconnect before switching projects")
+		}
+		return nil
+	case "ServiceAccount":
+		return fmt.Errorf("cannot switch projects on a service account connection: the key belongs to project %s; create a profile for the other project instead", h.clientManager.GetProjectID())
+	default:
+		return fmt.Errorf("cannot switch projects with auth method %q", authMethod)
+	}
+}
+
+// SwitchProject rebuilds the client of the current connection for another project
+// The auth method, credentials and emulator host are kept; resources of the new project are synced.
+// On failure the previous client stays in place.
+func (h *ConnectionHandler) SwitchProject(projectID string) error {
+	if err := h.CheckProjectSwitch(projectID); err != nil {
+		return err
+	}
+
+	h.emulatorHostMu.RLock()
+	emulatorHost := h.currentEmulatorHost
+	h.emulatorHostMu.RUnlock()
+	h.authMethodMu.RLock()
+	authMethod := h.currentAuthMethod
+	identity := h.currentIdentity
+	oauth := h.currentOAuth
+	h.authMethodMu.RUnlock()
+
+	var err error
+	switch {
+	case h.TestModeHost() != "":
+		err = h.connectTestMode(projectID, authMethod)
+	case authMethod == "ADC":
+		err = h.ConnectWithADC(projectID, emulatorHost)
+	default:
+		_, err = h.connectOAuth(projectID, oauth.clientPath, oauth.profileID, oauth.email, emulatorHost, true)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to switch to project %s: %w", projectID, err)
+	}
+
+	if authMethod == "OAuth" {
+		// connectTestMode clears the identity, but the account did not change
+		h.authMethodMu.Lock()
+		h.currentIdentity = identity
+		h.currentOAuth = oauth
+		h.authMethodMu.Unlock()
+	}
+	return nil
+}
+
+// oauthRevokeTimeout bounds revoking an account's tokens with Google
+const oauthRevokeTimeout = 30 * time.Second
+
+// ListOAuthAccounts returns the stored OAuth tokens with their Google accounts
+func (h *ConnectionHandler) ListOAuthAccounts() ([]auth.OAuthAccount, error) {
+	tokenStore, err := auth.NewTokenStore(filepath.Dir(h.configManager.GetConfigPath()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize token store: %w", err)
+	}
+	return tokenStore.ListAccounts()
+}
+
+// RevokeOAuthAccount deletes every stored token of a Google account and revokes them with Google
+// The tokens are deleted even when revoking fails (e.g. offline); the error then says so.
+func (h *ConnectionHandler) RevokeOAuthAccount(email string) error {
+	tokenStore, err := auth.NewTokenStore(filepath.Dir(h.configManager.GetConfigPath()))
+	if err != nil {
+		return fmt.Errorf("failed to initialize token store: %w", err)
+	}
+
+	tokens, err := tokenStore.DeleteAccountTokens(email)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(h.ctx, oauthRevokeTimeout)
+	defer cancel()
 
+	var errs []error
+	for _, token := range tokens {
+		if err := auth.RevokeToken(ctx, token); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("tokens of %s were deleted, but revoking them with Google failed: %w", email, err)
+	}
 	return nil
 }
 
+// recordOAuthEmail saves the signed-in account on a saved profile that does not name one yet
+// The profile then always uses that account's token, so another profile on the same project and client is
+// signed in separately.
+func (h *ConnectionHandler) recordOAuthEmail(profileID, userEmail string) {
+	if h.config == nil || userEmail == "" || userEmail == "unknown" {
+		return
+	}
+	for i := range h.config.Profiles {
+		profile := &h.config.Profiles[i]
+		if profile.ID != profileID || profile.AuthMethod != "OAuth" || profile.OAuthEmail != "" {
+			continue
+		}
+		profile.OAuthEmail = userEmail
+		if err := h.configManager.SaveConfig(h.config); err != nil {
+			logger.Warn("Failed to save the OAuth account of a profile", "profileId", profileID, "error", err)
+		}
+		return
+	}
+}
+
 // getOrCreateOAuthProfileID finds existing profile or generates new ID for OAuth connection
-func (h *ConnectionHandler) getOrCreateOAuthProfileID(projectID, oauthClientPath string) string {
-	// Find existing profile with matching project and OAuth client
+// With an account email only a profile using that account matches.
+func (h *ConnectionHandler) getOrCreateOAuthProfileID(projectID, oauthClientPath, oauthEmail string) string {
+	// Find existing profile with matching project, OAuth client and account
 	for _, profile := range h.config.Profiles {
 		if profile.AuthMethod == "OAuth" &&
 			profile.ProjectID == projectID &&
-			profile.OAuthClientPath == oauthClientPath {
+			profile.OAuthClientPath == oauthClientPath &&
+			(oauthEmail == "" || strings.EqualFold(profile.OAuthEmail, oauthEmail)) {
 			return profile.ID
 		}
 	}
@@ -265,6 +626,41 @@ func (h *ConnectionHandler) GetProfiles() []models.ConnectionProfile {
 	return h.config.Profiles
 }
 
+// ProfileValidationIssue describes a stored profile that would fail to connect
+type ProfileValidationIssue struct {
+	ProfileID   string `json:"profileId"`
+	ProfileName string `json:"profileName"`
+	Reason      string `json:"reason"`
+}
+
+// ValidateAllProfiles validates every stored profile and emits "profiles:validation" with the issues found
+// Catches problems introduced by hand-editing the config file or moving credential files before the user tries to connect
+func (h *ConnectionHandler) ValidateAllProfiles() []ProfileValidationIssue {
+	issues := []ProfileValidationIssue{}
+	if h.config != nil {
+		seen := make(map[string]bool, len(h.config.Profiles))
+		for _, profile := range h.config.Profiles {
+			if err := profile.Validate(); err != nil {
+				issues = append(issues, ProfileValidationIssue{ProfileID: profile.ID, ProfileName: profile.Name, Reason: err.Error()})
+			} else if seen[profile.ID] {
+				issues = append(issues, ProfileValidationIssue{ProfileID: profile.ID, ProfileName: profile.Name, Reason: "duplicate profile ID"})
+			} else if profile.AuthMethod == "ServiceAccount" {
+				if err := checkServiceAccountKey(profile.ServiceAccountPath); err != nil {
+					issues = append(issues, ProfileValidationIssue{ProfileID: profile.ID, ProfileName: profile.Name, Reason: err.Error()})
+				}
+			}
+			seen[profile.ID] = true
+		}
+	}
+
+	for _, issue := range issues {
+		logger.Warn("Invalid connection profile", "profileId", issue.ProfileID, "profileName", issue.ProfileName, "reason", issue.Reason)
+	}
+
+	runtime.EventsEmit(h.ctx, "profiles:validation", issues)
+	return issues
+}
+
 // SaveProfile saves a connection profile to the configuration
 func (h *ConnectionHandler) SaveProfile(profile models.ConnectionProfile) error {
 	// Validate profile
@@ -312,7 +708,7 @@ func (h *ConnectionHandler) SaveProfile(profile models.ConnectionProfile) error
 
 // DeleteProfile removes a connection profile from the configuration
 // disconnect callback should be provided to handle disconnection if needed
-func (h *ConnectionHandler) DeleteProfile(profileID string, disconnect func() error) error {
+func (h *ConnectionHandler) DeleteProfile(profileID string, disconnect func() error, removeEmulator func(profileID string, config *models.ManagedEmulatorConfig) error) error {
 	if profileID == "" {
 		return fmt.Errorf("profile ID cannot be empty")
 	}
@@ -347,7 +743,17 @@ func (h *ConnectionHandler) DeleteProfile(profileID string, disconnect func() er
 		tokenStore, err := auth.NewTokenStore(configDir)
 		if err == nil {
 			// Non-fatal error - continue even if token store creation fails
-			tokenStore.DeleteToken(profileID)
+			if err := tokenStore.DeleteProfileTokens(profileID); err != nil {
+				logger.Warn("Failed to delete OAuth tokens of deleted profile", "profileId", profileID, "error", err)
+			}
+		}
+	}
+
+	// Stop and remove any managed emulator container so it isn't orphaned
+	if removeEmulator != nil {
+		if err := removeEmulator(profileID, deletedProfile.ManagedEmulator); err != nil {
+			// Non-fatal - the profile is still deleted
+			logger.Warn("Failed to remove managed emulator for deleted profile", "profileId", profileID, "error", err)
 		}
 	}
 
@@ -406,16 +812,25 @@ func (h *ConnectionHandler) SwitchProfile(profileID string, disconnect func() er
 // connectWithProfile is a helper method to connect using a profile's settings
 func (h *ConnectionHandler) connectWithProfile(profile *models.ConnectionProfile) error {
 	// Get emulator host from profile (no global config fallback)
-	emulatorHost := profile.EmulatorHost
+	return h.ConnectProfile(profile, profile.EmulatorHost)
+}
 
+// ConnectProfile connects with a profile's auth method through emulatorHost (empty for production)
+// A missing service account key re-validates all profiles so the frontend flags the moved file.
+func (h *ConnectionHandler) ConnectProfile(profile *models.ConnectionProfile, emulatorHost string) error {
 	// Pass emulator host directly to connection methods (don't modify global env var)
 	switch profile.AuthMethod {
 	case "ADC":
 		return h.ConnectWithADC(profile.ProjectID, emulatorHost)
 	case "ServiceAccount":
-		return h.ConnectWithServiceAccount(profile.ProjectID, profile.ServiceAccountPath, emulatorHost)
+		err := h.ConnectWithServiceAccount(profile.ProjectID, profile.ServiceAccountPath, emulatorHost)
+		if errors.Is(err, models.ErrServiceAccountNotFound) {
+			// A missing key file usually means the file was moved; re-validate so the frontend flags the profile
+			h.ValidateAllProfiles()
+		}
+		return err
 	case "OAuth":
-		return h.ConnectWithOAuth(profile.ProjectID, profile.OAuthClientPath, emulatorHost)
+		return h.ConnectOAuthProfile(*profile, emulatorHost)
 	default:
 		return fmt.Errorf("unsupported auth method: %s", profile.AuthMethod)
 	}
@@ -0,0 +1,185 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/pubsub/v2/pstest"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/config"
+	"pubsub-gui/internal/models"
+)
+
+func TestConnectionHandler_Reconnect(t *testing.T) {
+	srv := pstest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	clientManager := auth.NewClientManager(ctx)
+	defer clientManager.Close()
+	h := NewConnectionHandler(ctx, models.NewDefaultConfig(), nil, clientManager, nil)
+	profile := models.ConnectionProfile{ID: "p1", ProjectID: "test-project", AuthMethod: "ADC"}
+
+	if err := h.ConnectWithADC(profile.ProjectID, srv.Addr); err != nil {
+		t.Fatalf("ConnectWithADC() error = %v", err)
+	}
+	lost := clientManager.GetClient()
+
+	if err := h.Reconnect(profile); err != nil {
+		t.Fatalf("Reconnect() error = %v", err)
+	}
+	if client := clientManager.GetClient(); client == nil || client == lost {
+		t.Error("Reconnect() did not replace the client")
+	}
+	if status := h.GetConnectionStatus(); status.EmulatorHost != srv.Addr || status.ProjectID != profile.ProjectID {
+		t.Errorf("GetConnectionStatus() after Reconnect() = %+v, want emulator %s and project %s", status, srv.Addr, profile.ProjectID)
+	}
+
+	profile.AuthMethod = "Kerberos"
+	if err := h.Reconnect(profile); err == nil {
+		t.Error("Reconnect(unsupported auth) error = nil, want error")
+	}
+}
+
+func TestConnectionHandler_GetOrCreateOAuthProfileID(t *testing.T) {
+	config := models.NewDefaultConfig()
+	config.Profiles = []models.ConnectionProfile{
+		{ID: "work", ProjectID: "proj", AuthMethod: "OAuth", OAuthClientPath: "/client.json", OAuthEmail: "me@work.example"},
+		{ID: "personal", ProjectID: "proj", AuthMethod: "OAuth", OAuthClientPath: "/client.json", OAuthEmail: "me@home.example"},
+	}
+	h := NewConnectionHandler(context.Background(), config, nil, nil, nil)
+
+	tests := []struct {
+		name  string
+		email string
+		want  string
+	}{
+		{name: "no hint picks first match", email: "", want: "work"},
+		{name: "hint selects account", email: "me@home.example", want: "personal"},
+		{name: "hint is case-insensitive", email: "ME@Work.Example", want: "work"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := h.getOrCreateOAuthProfileID("proj", "/client.json", tt.email); got != tt.want {
+				t.Errorf("getOrCreateOAuthProfileID(%q) = %q, want %q", tt.email, got, tt.want)
+			}
+		})
+	}
+
+	if got := h.getOrCreateOAuthProfileID("proj", "/client.json", "other@example.com"); got == "work" || got == "personal" {
+		t.Errorf("getOrCreateOAuthProfileID(unknown account) = %q, want a new ID", got)
+	}
+}
+
+func TestConnectionHandler_SwitchProject(t *testing.T) {
+	srv := pstest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	clientManager := auth.NewClientManager(ctx)
+	defer clientManager.Close()
+	h := NewConnectionHandler(ctx, models.NewDefaultConfig(), nil, clientManager, nil)
+
+	if err := h.SwitchProject("other-project"); err != models.ErrNotConnected {
+		t.Errorf("SwitchProject(disconnected) error = %v, want ErrNotConnected", err)
+	}
+
+	if err := h.ConnectWithADC("test-project", srv.Addr); err != nil {
+		t.Fatalf("ConnectWithADC() error = %v", err)
+	}
+	if err := h.SwitchProject(""); err == nil {
+		t.Error("SwitchProject(\"\") error = nil, want error")
+	}
+	if err := h.SwitchProject("other-project"); err != nil {
+		t.Fatalf("SwitchProject() error = %v", err)
+	}
+	if status := h.GetConnectionStatus(); status.ProjectID != "other-project" || status.AuthMethod != "ADC" || status.EmulatorHost != srv.Addr {
+		t.Errorf("GetConnectionStatus() after SwitchProject() = %+v, want ADC on other-project via %s", status, srv.Addr)
+	}
+
+	h.authMethodMu.Lock()
+	h.currentAuthMethod = "ServiceAccount"
+	h.authMethodMu.Unlock()
+	if err := h.SwitchProject("third-project"); err == nil {
+		t.Error("SwitchProject(service account) error = nil, want error")
+	}
+	if projectID := clientManager.GetProjectID(); projectID != "other-project" {
+		t.Errorf("project after rejected switch = %s, want other-project", projectID)
+	}
+}
+
+func TestConnectionHandler_RecordOAuthEmail(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	configManager, err := config.NewManager()
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+	cfg := models.NewDefaultConfig()
+	cfg.Profiles = []models.ConnectionProfile{
+		{ID: "new", ProjectID: "proj", AuthMethod: "OAuth", OAuthClientPath: "/client.json"},
+		{ID: "named", ProjectID: "proj", AuthMethod: "OAuth", OAuthClientPath: "/client.json", OAuthEmail: "me@work.example"},
+	}
+	h := NewConnectionHandler(context.Background(), cfg, configManager, nil, nil)
+
+	h.recordOAuthEmail("new", "unknown")
+	if got := cfg.Profiles[0].OAuthEmail; got != "" {
+		t.Errorf("OAuthEmail after an unknown account = %q, want empty", got)
+	}
+	h.recordOAuthEmail("new", "me@home.example")
+	h.recordOAuthEmail("named", "me@home.example")
+	if got := cfg.Profiles[0].OAuthEmail; got != "me@home.example" {
+		t.Errorf("OAuthEmail of a profile without an account = %q, want me@home.example", got)
+	}
+	if got := cfg.Profiles[1].OAuthEmail; got != "me@work.example" {
+		t.Errorf("OAuthEmail of a profile with an account = %q, want it unchanged", got)
+	}
+
+	saved, err := configManager.LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if len(saved.Profiles) != 2 || saved.Profiles[0].OAuthEmail != "me@home.example" {
+		t.Errorf("saved profiles = %+v, want the recorded account", saved.Profiles)
+	}
+}
+
+func TestCheckServiceAccountKey(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "key.json")
+	if err := os.WriteFile(keyPath, []byte(`{"type": "service_account"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		wantErr  error  // nil when only contains is checked
+		contains string // empty when the key is usable
+	}{
+		{name: "key file", path: keyPath},
+		{name: "missing file", path: filepath.Join(dir, "moved.json"), wantErr: models.ErrServiceAccountNotFound, contains: "moved.json"},
+		{name: "directory", path: dir, contains: "is a directory"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkServiceAccountKey(tt.path)
+			if tt.contains == "" {
+				if err != nil {
+					t.Errorf("checkServiceAccountKey() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("checkServiceAccountKey() error = %v, want it to contain %q", err, tt.contains)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkServiceAccountKey() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+// DeadLetterChain links a subscription to its dead letter topic and the subscriptions reading it
+type DeadLetterChain struct {
+	SubscriptionID      string   `json:"subscriptionId"`
+	Configured          bool     `json:"configured"`                    // False when the subscription has no dead letter policy
+	DeadLetterTopic     string   `json:"deadLetterTopic,omitempty"`     // Full resource name
+	DeadLetterTopicID   string   `json:"deadLetterTopicId,omitempty"`   // Short ID
+	DeadLetterProject   string   `json:"deadLetterProject,omitempty"`   // Project of the dead letter topic
+	MaxDeliveryAttempts int      `json:"maxDeliveryAttempts,omitempty"` // Deliveries before a message is dead-lettered
+	CrossProject        bool     `json:"crossProject"`                  // Dead letter topic lives in another project
+	TopicFound          bool     `json:"topicFound"`                    // Dead letter topic is in the resource cache
+	Subscriptions       []string `json:"subscriptions"`                 // Short IDs of subscriptions on the dead letter topic
+	Note                string   `json:"note,omitempty"`                // Explanation when the chain is incomplete
+}
+
+// GetDeadLetterChain resolves the dead letter topic of a subscription and the subscriptions attached to it
+// from the resource cache
+func (h *ResourceHandler) GetDeadLetterChain(subID string) (DeadLetterChain, error) {
+	projectID := h.clientManager.GetProjectID()
+	return buildDeadLetterChain(projectID, subID, h.store.Topics(), h.store.Subscriptions())
+}
+
+// buildDeadLetterChain computes a DeadLetterChain from cached resources
+func buildDeadLetterChain(projectID, subID string, topics []admin.TopicInfo, subscriptions []admin.SubscriptionInfo) (DeadLetterChain, error) {
+	shortID, fullName := admin.NormalizeName(projectID, "subscription", subID)
+
+	var source *admin.SubscriptionInfo
+	for i := range subscriptions {
+		if subscriptions[i].Name == fullName {
+			source = &subscriptions[i]
+			break
+		}
+	}
+	if source == nil {
+		return DeadLetterChain{}, fmt.Errorf("subscription %s not found: refresh the resource list and try again", shortID)
+	}
+
+	chain := DeadLetterChain{SubscriptionID: shortID, Subscriptions: []string{}}
+	if source.DeadLetterPolicy == nil || source.DeadLetterPolicy.DeadLetterTopic == "" {
+		return chain, nil
+	}
+
+	chain.Configured = true
+	chain.DeadLetterTopic = source.DeadLetterPolicy.DeadLetterTopic
+	chain.MaxDeliveryAttempts = source.DeadLetterPolicy.MaxDeliveryAttempts
+	chain.DeadLetterTopicID, _ = admin.NormalizeName(projectID, "topic", chain.DeadLetterTopic)
+	chain.DeadLetterProject = projectOfResource(chain.DeadLetterTopic)
+
+	if chain.DeadLetterProject != "" && chain.DeadLetterProject != projectID {
+		chain.CrossProject = true
+		chain.Note = fmt.Sprintf("dead letter topic is in project %s: connect to that project to browse or monitor it", chain.DeadLetterProject)
+		return chain, nil
+	}
+
+	for _, topic := range topics {
+		if topic.Name == chain.DeadLetterTopic {
+			chain.TopicFound = true
+			break
+		}
+	}
+	for _, sub := range subscriptions {
+		if sub.Topic == chain.DeadLetterTopic {
+			chain.Subscriptions = append(chain.Subscriptions, sub.DisplayName)
+		}
+	}
+	sort.Strings(chain.Subscriptions)
+
+	switch {
+	case !chain.TopicFound:
+		chain.Note = "dead letter topic does not exist: dead-lettered messages are dropped until it is created"
+	case len(chain.Subscriptions) == 0:
+		chain.Note = "no subscription reads the dead letter topic: dead-lettered messages are dropped until one is created"
+	}
+	return chain, nil
+}
+
+// projectOfResource returns the project of a full resource name (projects/{project}/...), or ""
+func projectOfResource(name string) string {
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) < 3 || parts[0] != "projects" {
+		return ""
+	}
+	return parts[1]
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+func TestBuildDeadLetterChain(t *testing.T) {
+	topics := []admin.TopicInfo{
+		{Name: "projects/p/topics/orders", DisplayName: "orders"},
+		{Name: "projects/p/topics/orders-dlq", DisplayName: "orders-dlq"},
+	}
+	subscriptions := []admin.SubscriptionInfo{
+		{Name: "projects/p/subscriptions/orders-worker", DisplayName: "orders-worker", Topic: "projects/p/topics/orders",
+			DeadLetterPolicy: &admin.DeadLetterPolicyInfo{DeadLetterTopic: "projects/p/topics/orders-dlq", MaxDeliveryAttempts: 5}},
+		{Name: "projects/p/subscriptions/plain", DisplayName: "plain", Topic: "projects/p/topics/orders"},
+		{Name: "projects/p/subscriptions/remote", DisplayName: "remote", Topic: "projects/p/topics/orders",
+			DeadLetterPolicy: &admin.DeadLetterPolicyInfo{DeadLetterTopic: "projects/other/topics/dlq", MaxDeliveryAttempts: 10}},
+		{Name: "projects/p/subscriptions/missing", DisplayName: "missing", Topic: "projects/p/topics/orders",
+			DeadLetterPolicy: &admin.DeadLetterPolicyInfo{DeadLetterTopic: "projects/p/topics/gone"}},
+		{Name: "projects/p/subscriptions/dlq-reader-b", DisplayName: "dlq-reader-b", Topic: "projects/p/topics/orders-dlq"},
+		{Name: "projects/p/subscriptions/dlq-reader-a", DisplayName: "dlq-reader-a", Topic: "projects/p/topics/orders-dlq"},
+	}
+
+	chain, err := buildDeadLetterChain("p", "projects/p/subscriptions/orders-worker", topics, subscriptions)
+	if err != nil {
+		t.Fatalf("buildDeadLetterChain() error = %v", err)
+	}
+	if !chain.Configured || !chain.TopicFound || chain.CrossProject || chain.DeadLetterTopicID != "orders-dlq" || chain.MaxDeliveryAttempts != 5 {
+		t.Errorf("chain = %+v, want configured same-project chain to orders-dlq", chain)
+	}
+	if strings.Join(chain.Subscriptions, ",") != "dlq-reader-a,dlq-reader-b" || chain.Note != "" {
+		t.Errorf("chain subscriptions = %v (note %q), want sorted DLQ readers and no note", chain.Subscriptions, chain.Note)
+	}
+
+	plain, err := buildDeadLetterChain("p", "plain", topics, subscriptions)
+	if err != nil {
+		t.Fatalf("buildDeadLetterChain(no policy) error = %v", err)
+	}
+	if plain.Configured || plain.DeadLetterTopic != "" || plain.Subscriptions == nil || len(plain.Subscriptions) != 0 {
+		t.Errorf("chain without policy = %+v, want empty chain", plain)
+	}
+
+	remote, err := buildDeadLetterChain("p", "remote", topics, subscriptions)
+	if err != nil {
+		t.Fatalf("buildDeadLetterChain(cross-project) error = %v", err)
+	}
+	if !remote.CrossProject || remote.DeadLetterProject != "other" || remote.DeadLetterTopicID != "dlq" || !strings.Contains(remote.Note, "project other") {
+		t.Errorf("cross-project chain = %+v, want project other surfaced", remote)
+	}
+
+	missing, err := buildDeadLetterChain("p", "missing", topics, subscriptions)
+	if err != nil {
+		t.Fatalf("buildDeadLetterChain(missing topic) error = %v", err)
+	}
+	if missing.TopicFound || !strings.Contains(missing.Note, "does not exist") {
+		t.Errorf("chain to missing topic = %+v, want topicFound false with note", missing)
+	}
+
+	if _, err := buildDeadLetterChain("p", "nope", topics, subscriptions); err == nil {
+		t.Error("buildDeadLetterChain(unknown subscription) error = nil, want error")
+	}
+}
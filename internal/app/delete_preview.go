@@ -0,0 +1,99 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+// DeletePreview lists what deleting a topic or subscription would affect, derived from the resource cache
+type DeletePreview struct {
+	ResourceType           string   `json:"resourceType"`                     // "topic" | "subscription"
+	ID                     string   `json:"id"`                               // Short resource ID
+	Found                  bool     `json:"found"`                            // False when the resource is not in the cache
+	Subscriptions          []string `json:"subscriptions,omitempty"`          // Topic: subscriptions attached to it
+	MonitoredSubscriptions []string `json:"monitoredSubscriptions,omitempty"` // Topic: attached subscriptions being monitored
+	Monitored              bool     `json:"monitored"`                        // Subscription: currently monitored
+	DeadLetterFor          []string `json:"deadLetterFor,omitempty"`          // Subscriptions dead-lettering into the topic (for a subscription: into its topic)
+	Warnings               []string `json:"warnings"`                         // Human-readable consequences for the confirmation dialog
+}
+
+// PreviewDelete describes the impact of deleting a topic or subscription
+// monitored holds the short IDs of subscriptions with an active monitor
+func (h *ResourceHandler) PreviewDelete(resourceType, id string, monitored map[string]bool) (*DeletePreview, error) {
+	if resourceType != "topic" && resourceType != "subscription" {
+		return nil, fmt.Errorf("unsupported resource type %q: must be topic or subscription", resourceType)
+	}
+	projectID := h.clientManager.GetProjectID()
+	return buildDeletePreview(projectID, resourceType, id, h.store.Topics(), h.store.Subscriptions(), monitored), nil
+}
+
+// buildDeletePreview computes a DeletePreview from cached resources
+func buildDeletePreview(projectID, resourceType, id string, topics []admin.TopicInfo, subscriptions []admin.SubscriptionInfo, monitored map[string]bool) *DeletePreview {
+	shortID, fullName := admin.NormalizeName(projectID, resourceType, id)
+	preview := &DeletePreview{ResourceType: resourceType, ID: shortID, Warnings: []string{}}
+
+	// deadLetterFor lists subscriptions whose dead letter topic is topicName
+	deadLetterFor := func(topicName string) []string {
+		var subs []string
+		for _, sub := range subscriptions {
+			if sub.DeadLetterPolicy != nil && sub.DeadLetterPolicy.DeadLetterTopic == topicName {
+				subs = append(subs, sub.DisplayName)
+			}
+		}
+		sort.Strings(subs)
+		return subs
+	}
+
+	if resourceType == "topic" {
+		for _, topic := range topics {
+			if topic.Name == fullName {
+				preview.Found = true
+				break
+			}
+		}
+		for _, sub := range subscriptions {
+			if sub.Topic != fullName {
+				continue
+			}
+			preview.Subscriptions = append(preview.Subscriptions, sub.DisplayName)
+			if monitored[sub.DisplayName] {
+				preview.MonitoredSubscriptions = append(preview.MonitoredSubscriptions, sub.DisplayName)
+			}
+		}
+		sort.Strings(preview.Subscriptions)
+		sort.Strings(preview.MonitoredSubscriptions)
+		preview.DeadLetterFor = deadLetterFor(fullName)
+
+		if n := len(preview.Subscriptions); n > 0 {
+			preview.Warnings = append(preview.Warnings, fmt.Sprintf("%d subscription(s) will be detached and stop receiving messages", n))
+		}
+		if n := len(preview.MonitoredSubscriptions); n > 0 {
+			preview.Warnings = append(preview.Warnings, fmt.Sprintf("%d attached subscription(s) are being monitored", n))
+		}
+		if n := len(preview.DeadLetterFor); n > 0 {
+			preview.Warnings = append(preview.Warnings, fmt.Sprintf("%d subscription(s) use this topic as their dead letter topic; dead-lettered messages will be lost", n))
+		}
+		return preview
+	}
+
+	for _, sub := range subscriptions {
+		if sub.Name != fullName {
+			continue
+		}
+		preview.Found = true
+		preview.DeadLetterFor = deadLetterFor(sub.Topic)
+		break
+	}
+	preview.Monitored = monitored[shortID]
+
+	if preview.Monitored {
+		preview.Warnings = append(preview.Warnings, "subscription is being monitored; the monitor will stop")
+	}
+	if n := len(preview.DeadLetterFor); n > 0 {
+		preview.Warnings = append(preview.Warnings, fmt.Sprintf("subscription reads the dead letter topic of %d subscription(s); dead-lettered messages will no longer be retained for it", n))
+	}
+	return preview
+}
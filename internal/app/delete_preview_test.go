@@ -0,0 +1,44 @@
+package app
+
+import (
+	"testing"
+
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+func TestBuildDeletePreview(t *testing.T) {
+	topics := []admin.TopicInfo{
+		{Name: "projects/p/topics/orders", DisplayName: "orders"},
+		{Name: "projects/p/topics/orders-dlq", DisplayName: "orders-dlq"},
+	}
+	dlq := &admin.DeadLetterPolicyInfo{DeadLetterTopic: "projects/p/topics/orders-dlq", MaxDeliveryAttempts: 5}
+	subscriptions := []admin.SubscriptionInfo{
+		{Name: "projects/p/subscriptions/orders-b", DisplayName: "orders-b", Topic: "projects/p/topics/orders", DeadLetterPolicy: dlq},
+		{Name: "projects/p/subscriptions/orders-a", DisplayName: "orders-a", Topic: "projects/p/topics/orders"},
+		{Name: "projects/p/subscriptions/dlq-reader", DisplayName: "dlq-reader", Topic: "projects/p/topics/orders-dlq"},
+	}
+	monitored := map[string]bool{"orders-a": true, "dlq-reader": true}
+
+	topic := buildDeletePreview("p", "topic", "orders", topics, subscriptions, monitored)
+	if !topic.Found || len(topic.Subscriptions) != 2 || topic.Subscriptions[0] != "orders-a" {
+		t.Errorf("topic preview = %+v, want found with sorted subscriptions [orders-a orders-b]", topic)
+	}
+	if len(topic.MonitoredSubscriptions) != 1 || len(topic.DeadLetterFor) != 0 || len(topic.Warnings) != 2 {
+		t.Errorf("topic preview = %+v, want 1 monitored, no dead letter users, 2 warnings", topic)
+	}
+
+	dlqTopic := buildDeletePreview("p", "topic", "projects/p/topics/orders-dlq", topics, subscriptions, monitored)
+	if len(dlqTopic.DeadLetterFor) != 1 || dlqTopic.DeadLetterFor[0] != "orders-b" {
+		t.Errorf("dead letter topic preview DeadLetterFor = %v, want [orders-b]", dlqTopic.DeadLetterFor)
+	}
+
+	reader := buildDeletePreview("p", "subscription", "dlq-reader", topics, subscriptions, monitored)
+	if !reader.Found || !reader.Monitored || len(reader.DeadLetterFor) != 1 || len(reader.Warnings) != 2 {
+		t.Errorf("subscription preview = %+v, want found, monitored, reading the dead letters of orders-b", reader)
+	}
+
+	missing := buildDeletePreview("p", "subscription", "nope", topics, subscriptions, monitored)
+	if missing.Found || missing.Monitored || len(missing.Warnings) != 0 {
+		t.Errorf("missing subscription preview = %+v, want not found with no warnings", missing)
+	}
+}
@@ -0,0 +1,175 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+)
+
+// gcloudConfiguration is the part of a gcloud named configuration used to propose profiles
+type gcloudConfiguration struct {
+	Name    string
+	Account string
+	Project string
+	Active  bool
+}
+
+// ImportGcloudConfigurations proposes ADC profiles for the projects of the user's gcloud configurations
+// Nothing is saved. Projects that already have a profile are skipped, and a missing gcloud config yields no proposals.
+func (h *ConnectionHandler) ImportGcloudConfigurations() ([]models.ConnectionProfile, error) {
+	dir, err := gcloudConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := readGcloudConfigurations(dir, os.Getenv("CLOUDSDK_ACTIVE_CONFIG_NAME"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gcloud configurations: %w", err)
+	}
+
+	var existing []models.ConnectionProfile
+	if h.config != nil {
+		existing = h.config.Profiles
+	}
+	profiles := gcloudProfiles(configs, existing, time.Now())
+	logger.Info("Read gcloud configurations", "dir", dir, "configurations", len(configs), "proposed", len(profiles))
+	return profiles, nil
+}
+
+// gcloudConfigDir returns gcloud's config directory: $CLOUDSDK_CONFIG, %APPDATA%\gcloud on Windows, ~/.config/gcloud elsewhere
+func gcloudConfigDir() (string, error) {
+	if dir := os.Getenv("CLOUDSDK_CONFIG"); dir != "" {
+		return dir, nil
+	}
+	if goruntime.GOOS == "windows" {
+		if appData := os.Getenv("APPDATA"); appData != "" {
+			return filepath.Join(appData, "gcloud"), nil
+		}
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "gcloud"), nil
+}
+
+// readGcloudConfigurations reads the named configurations in a gcloud config directory, active one first
+// activeOverride replaces the active_config file when set. A missing directory returns no configurations.
+func readGcloudConfigurations(dir, activeOverride string) ([]gcloudConfiguration, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "configurations", "config_*"))
+	if err != nil {
+		return nil, err
+	}
+
+	active := activeOverride
+	if active == "" {
+		data, err := os.ReadFile(filepath.Join(dir, "active_config"))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		active = strings.TrimSpace(string(data))
+	}
+	if active == "" {
+		active = "default"
+	}
+
+	configs := make([]gcloudConfiguration, 0, len(paths))
+	for _, path := range paths {
+		values, err := readGcloudCoreProperties(path)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimPrefix(filepath.Base(path), "config_")
+		configs = append(configs, gcloudConfiguration{
+			Name:    name,
+			Account: values["account"],
+			Project: values["project"],
+			Active:  name == active,
+		})
+	}
+
+	sort.SliceStable(configs, func(i, j int) bool {
+		if configs[i].Active != configs[j].Active {
+			return configs[i].Active
+		}
+		return configs[i].Name < configs[j].Name
+	})
+	return configs, nil
+}
+
+// readGcloudCoreProperties returns the [core] properties of a gcloud configuration file (INI format)
+func readGcloudCoreProperties(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values := make(map[string]string)
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		if section != "core" {
+			continue
+		}
+		// configparser accepts both "key = value" and "key: value"; the first separator wins
+		if i := strings.IndexAny(line, "=:"); i > 0 {
+			values[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return values, nil
+}
+
+// gcloudProfiles builds unsaved ADC profiles for configurations with a project
+// Configurations without a project, and projects that already have a profile or were proposed earlier in the list, are skipped.
+func gcloudProfiles(configs []gcloudConfiguration, existing []models.ConnectionProfile, now time.Time) []models.ConnectionProfile {
+	seen := make(map[string]bool, len(existing))
+	for _, profile := range existing {
+		seen[profile.ProjectID] = true
+	}
+
+	profiles := []models.ConnectionProfile{}
+	for _, config := range configs {
+		if config.Project == "" || seen[config.Project] {
+			continue
+		}
+		seen[config.Project] = true
+
+		name := config.Project
+		if config.Account != "" {
+			name = fmt.Sprintf("%s (%s)", config.Project, config.Account)
+		}
+		profiles = append(profiles, models.ConnectionProfile{
+			ID:         uuid.NewString(),
+			Name:       name,
+			ProjectID:  config.Project,
+			AuthMethod: "ADC",
+			CreatedAt:  now.UTC().Format(time.RFC3339),
+		})
+	}
+	return profiles
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"pubsub-gui/internal/models"
+)
+
+func writeGcloudConfig(t *testing.T, dir, name, content string) {
+	t.Helper()
+	configDir := filepath.Join(dir, "configurations")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "config_"+name), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadGcloudConfigurations(t *testing.T) {
+	dir := t.TempDir()
+	writeGcloudConfig(t, dir, "default", "[core]\naccount = dev@example.com\nproject = sandbox\n")
+	writeGcloudConfig(t, dir, "prod", "# production\n[compute]\nregion = europe-west1\n\n[core]\naccount: ops@example.com\nproject = example.com:orders\n")
+	writeGcloudConfig(t, dir, "empty", "[core]\naccount = dev@example.com\n")
+	if err := os.WriteFile(filepath.Join(dir, "active_config"), []byte("prod\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	configs, err := readGcloudConfigurations(dir, "")
+	if err != nil {
+		t.Fatalf("readGcloudConfigurations() error = %v", err)
+	}
+	want := []gcloudConfiguration{
+		{Name: "prod", Account: "ops@example.com", Project: "example.com:orders", Active: true},
+		{Name: "default", Account: "dev@example.com", Project: "sandbox"},
+		{Name: "empty", Account: "dev@example.com"},
+	}
+	if !reflect.DeepEqual(configs, want) {
+		t.Errorf("readGcloudConfigurations() = %+v, want %+v", configs, want)
+	}
+
+	configs, err = readGcloudConfigurations(dir, "default")
+	if err != nil || len(configs) == 0 || configs[0].Name != "default" || !configs[0].Active {
+		t.Errorf("readGcloudConfigurations(override) = %+v, %v, want default first and active", configs, err)
+	}
+
+	configs, err = readGcloudConfigurations(filepath.Join(dir, "missing"), "")
+	if err != nil || len(configs) != 0 {
+		t.Errorf("readGcloudConfigurations(missing dir) = %+v, %v, want no configurations", configs, err)
+	}
+}
+
+func TestGcloudProfiles(t *testing.T) {
+	configs := []gcloudConfiguration{
+		{Name: "prod", Account: "ops@example.com", Project: "orders", Active: true},
+		{Name: "default", Project: "sandbox"},
+		{Name: "other", Account: "dev@example.com", Project: "orders"},
+		{Name: "existing", Project: "legacy"},
+		{Name: "empty", Account: "dev@example.com"},
+	}
+	existing := []models.ConnectionProfile{{ID: "1", Name: "Legacy", ProjectID: "legacy", AuthMethod: "ADC"}}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	profiles := gcloudProfiles(configs, existing, now)
+	if len(profiles) != 2 {
+		t.Fatalf("gcloudProfiles() = %+v, want 2 profiles", profiles)
+	}
+	if profiles[0].Name != "orders (ops@example.com)" || profiles[1].Name != "sandbox" {
+		t.Errorf("gcloudProfiles() names = %q, %q, want active configuration first", profiles[0].Name, profiles[1].Name)
+	}
+	for _, profile := range profiles {
+		if err := profile.Validate(); err != nil {
+			t.Errorf("gcloudProfiles() profile %q is invalid: %v", profile.Name, err)
+		}
+		if profile.AuthMethod != "ADC" || profile.IsDefault || profile.CreatedAt != "2024-05-01T12:00:00Z" {
+			t.Errorf("gcloudProfiles() profile = %+v, want a non-default ADC profile created now", profile)
+		}
+	}
+	if profiles[0].ID == profiles[1].ID {
+		t.Errorf("gcloudProfiles() IDs are not unique: %q", profiles[0].ID)
+	}
+}
@@ -0,0 +1,50 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+// GetTopicIAMPolicy returns a topic's IAM policy (real GCP only)
+func (h *ResourceHandler) GetTopicIAMPolicy(topicID string) (admin.IAMPolicy, error) {
+	if err := h.checkIAMAvailable(); err != nil {
+		return admin.IAMPolicy{}, err
+	}
+	return admin.GetTopicIAMPolicy(h.ctx, h.clientManager.GetClient(), h.clientManager.GetProjectID(), topicID)
+}
+
+// SetTopicIAMPolicy replaces a topic's IAM policy, failing with models.ErrIAMPolicyChanged on a stale etag
+func (h *ResourceHandler) SetTopicIAMPolicy(topicID string, policy admin.IAMPolicy) (admin.IAMPolicy, error) {
+	if err := h.checkIAMAvailable(); err != nil {
+		return admin.IAMPolicy{}, err
+	}
+	return admin.SetTopicIAMPolicy(h.ctx, h.clientManager.GetClient(), h.clientManager.GetProjectID(), topicID, policy)
+}
+
+// GetSubscriptionIAMPolicy returns a subscription's IAM policy (real GCP only)
+func (h *ResourceHandler) GetSubscriptionIAMPolicy(subID string) (admin.IAMPolicy, error) {
+	if err := h.checkIAMAvailable(); err != nil {
+		return admin.IAMPolicy{}, err
+	}
+	return admin.GetSubscriptionIAMPolicy(h.ctx, h.clientManager.GetClient(), h.clientManager.GetProjectID(), subID)
+}
+
+// SetSubscriptionIAMPolicy replaces a subscription's IAM policy, failing with models.ErrIAMPolicyChanged on a stale etag
+func (h *ResourceHandler) SetSubscriptionIAMPolicy(subID string, policy admin.IAMPolicy) (admin.IAMPolicy, error) {
+	if err := h.checkIAMAvailable(); err != nil {
+		return admin.IAMPolicy{}, err
+	}
+	return admin.SetSubscriptionIAMPolicy(h.ctx, h.clientManager.GetClient(), h.clientManager.GetProjectID(), subID, policy)
+}
+
+// checkIAMAvailable fails fast when not connected or connected to an emulator, which has no IAM
+func (h *ResourceHandler) checkIAMAvailable() error {
+	if h.clientManager.GetClient() == nil {
+		return models.ErrNotConnected
+	}
+	if h.isEmulatorEnabled != nil && h.isEmulatorEnabled() {
+		return models.ErrIAMNotSupported
+	}
+	return nil
+}
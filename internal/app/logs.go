@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"pubsub-gui/internal/logger"
@@ -28,9 +29,15 @@ type FilteredLogsResult struct {
 	Total   int        `json:"total"`
 }
 
+// logTailBuffer bounds the log records queued for a tail; records beyond it are dropped
+const logTailBuffer = 256
+
 // LogsHandler handles log reading operations
 type LogsHandler struct {
 	logsDir string
+
+	tailMu   sync.Mutex
+	stopTail func() // Stops the running log tail; nil when not tailing
 }
 
 // NewLogsHandler creates a new LogsHandler
@@ -53,7 +60,7 @@ func (h *LogsHandler) GetLogs(date string, limit, offset int) ([]LogEntry, error
 	filePath := filepath.Join(h.logsDir, fileName)
 
 	// Read file line by line
-	entries, err := h.readLogFile(filePath, "", "", "", "", limit, offset)
+	entries, err := h.readLogFile(filePath, "", "", "", "", nil, limit, offset)
 	if err != nil {
 		// If file doesn't exist, return empty slice (no logs for that date)
 		if os.IsNotExist(err) {
@@ -66,7 +73,8 @@ func (h *LogsHandler) GetLogs(date string, limit, offset int) ([]LogEntry, error
 }
 
 // GetLogsFiltered returns filtered logs across a date range
-func (h *LogsHandler) GetLogsFiltered(startDate, endDate, levelFilter, searchTerm string, limit, offset int) (FilteredLogsResult, error) {
+// fieldFilters requires exact matches on structured log fields (e.g. profileId=test-profile); empty means no field filtering
+func (h *LogsHandler) GetLogsFiltered(startDate, endDate, levelFilter, searchTerm string, fieldFilters map[string]string, limit, offset int) (FilteredLogsResult, error) {
 	result := FilteredLogsResult{
 		Entries: []LogEntry{},
 		Total:   0,
@@ -110,7 +118,7 @@ func (h *LogsHandler) GetLogsFiltered(startDate, endDate, levelFilter, searchTer
 	// Read and filter entries from all files
 	allEntries := []LogEntry{}
 	for _, filePath := range logFiles {
-		entries, err := h.readLogFile(filePath, startDate, endDate, levelFilter, searchTerm, 0, 0) // 0,0 = no limit
+		entries, err := h.readLogFile(filePath, startDate, endDate, levelFilter, searchTerm, fieldFilters, 0, 0) // 0,0 = no limit
 		if err != nil {
 			// Skip files that don't exist or can't be read
 			continue
@@ -149,7 +157,7 @@ func (h *LogsHandler) GetLogsFiltered(startDate, endDate, levelFilter, searchTer
 }
 
 // readLogFile reads a log file and filters entries
-func (h *LogsHandler) readLogFile(filePath, startDate, endDate, levelFilter, searchTerm string, limit, offset int) ([]LogEntry, error) {
+func (h *LogsHandler) readLogFile(filePath, startDate, endDate, levelFilter, searchTerm string, fieldFilters map[string]string, limit, offset int) ([]LogEntry, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, err
@@ -166,55 +174,13 @@ func (h *LogsHandler) readLogFile(filePath, startDate, endDate, levelFilter, sea
 			continue
 		}
 
-		// Parse JSON - slog outputs time, level, msg, and additional fields
-		var rawEntry map[string]interface{}
-		if err := json.Unmarshal([]byte(line), &rawEntry); err != nil {
-			// Skip invalid JSON lines
-			continue
-		}
-
-		// Convert slog format to LogEntry format
-		entry := LogEntry{
-			Fields: make(map[string]interface{}),
-		}
-
-		// Extract time (slog uses "time" field)
-		if timeVal, ok := rawEntry["time"].(string); ok {
-			entry.Time = timeVal
-		}
-
-		// Extract level (slog JSON handler outputs level as string like "INFO", "ERROR", etc.)
-		if levelVal, ok := rawEntry["level"].(string); ok {
-			// Normalize to uppercase
-			entry.Level = strings.ToUpper(strings.TrimSpace(levelVal))
-		} else {
-			// If level is missing, skip this entry (invalid format)
-			continue
-		}
-
-		// Extract message (slog uses "msg" field)
-		if msgVal, ok := rawEntry["msg"].(string); ok {
-			entry.Msg = msgVal
-		} else {
-			// If msg is missing, skip this entry (invalid format)
+		entry, ok := parseLogLine([]byte(line))
+		if !ok {
 			continue
 		}
 
-		// Ensure we have time field
-		if entry.Time == "" {
-			// If time is missing, skip this entry (invalid format)
-			continue
-		}
-
-		// All other fields go into Fields map
-		for k, v := range rawEntry {
-			if k != "time" && k != "level" && k != "msg" {
-				entry.Fields[k] = v
-			}
-		}
-
 		// Apply filters
-		if !h.matchesFilters(entry, startDate, endDate, levelFilter, searchTerm) {
+		if !h.matchesFilters(entry, startDate, endDate, levelFilter, searchTerm, fieldFilters) {
 			continue
 		}
 
@@ -240,8 +206,60 @@ func (h *LogsHandler) readLogFile(filePath, startDate, endDate, levelFilter, sea
 	return entries, nil
 }
 
+// parseLogLine converts one slog JSON line to a LogEntry; false for invalid lines
+func parseLogLine(line []byte) (LogEntry, bool) {
+	// Parse JSON - slog outputs time, level, msg, and additional fields
+	var rawEntry map[string]interface{}
+	if err := json.Unmarshal(line, &rawEntry); err != nil {
+		// Skip invalid JSON lines
+		return LogEntry{}, false
+	}
+
+	// Convert slog format to LogEntry format
+	entry := LogEntry{
+		Fields: make(map[string]interface{}),
+	}
+
+	// Extract time (slog uses "time" field)
+	if timeVal, ok := rawEntry["time"].(string); ok {
+		entry.Time = timeVal
+	}
+
+	// Extract level (slog JSON handler outputs level as string like "INFO", "ERROR", etc.)
+	if levelVal, ok := rawEntry["level"].(string); ok {
+		// Normalize to uppercase
+		entry.Level = strings.ToUpper(strings.TrimSpace(levelVal))
+	} else {
+		// If level is missing, skip this entry (invalid format)
+		return LogEntry{}, false
+	}
+
+	// Extract message (slog uses "msg" field)
+	if msgVal, ok := rawEntry["msg"].(string); ok {
+		entry.Msg = msgVal
+	} else {
+		// If msg is missing, skip this entry (invalid format)
+		return LogEntry{}, false
+	}
+
+	// Ensure we have time field
+	if entry.Time == "" {
+		// If time is missing, skip this entry (invalid format)
+		return LogEntry{}, false
+	}
+
+	// All other fields go into Fields map
+	for k, v := range rawEntry {
+		if k != "time" && k != "level" && k != "msg" {
+			entry.Fields[k] = v
+		}
+	}
+
+	return entry, true
+}
+
 // matchesFilters checks if an entry matches all filters
-func (h *LogsHandler) matchesFilters(entry LogEntry, startDate, endDate, levelFilter, searchTerm string) bool {
+func (h *LogsHandler) matchesFilters(entry LogEntry, startDate, endDate, levelFilter, searchTerm string, fieldFilters map[string]string) bool {
 	// Filter by level (normalize to uppercase for comparison)
 	entryLevelUpper := strings.ToUpper(strings.TrimSpace(entry.Level))
 	if levelFilter != "" && levelFilter != "all" && levelFilter != "none" {
@@ -289,6 +307,14 @@ func (h *LogsHandler) matchesFilters(entry LogEntry, startDate, endDate, levelFi
 		}
 	}
 
+	// Filter by exact structured field values (all must match)
+	for key, want := range fieldFilters {
+		value, ok := entry.Fields[key]
+		if !ok || fmt.Sprintf("%v", value) != want {
+			return false
+		}
+	}
+
 	// Filter by search term
 	if searchTerm != "" {
 		searchLower := strings.ToLower(searchTerm)
@@ -312,6 +338,66 @@ func (h *LogsHandler) matchesFilters(entry LogEntry, startDate, endDate, levelFi
 	return true
 }
 
+// StartLogTail passes every new log entry matching levelFilter to emit, from a background goroutine
+// levelFilter uses the syntax of GetLogsFiltered ("", "all", "none" or e.g. "INFO,ERROR"). Starting again
+// replaces the running tail. Entries are dropped while emit falls behind, so logging never blocks.
+func (h *LogsHandler) StartLogTail(levelFilter string, emit func(LogEntry)) {
+	h.tailMu.Lock()
+	defer h.tailMu.Unlock()
+
+	if h.stopTail != nil {
+		h.stopTail()
+	}
+
+	lines, unsubscribe := logger.Subscribe(logTailBuffer)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for line := range lines {
+			entry, ok := parseLogLine(line)
+			if ok && h.matchesFilters(entry, "", "", levelFilter, "", nil) {
+				emit(entry)
+			}
+		}
+	}()
+
+	h.stopTail = func() {
+		unsubscribe()
+		<-done
+	}
+}
+
+// StopLogTail stops the running log tail; no entries are emitted once it returns
+func (h *LogsHandler) StopLogTail() {
+	h.tailMu.Lock()
+	defer h.tailMu.Unlock()
+
+	if h.stopTail != nil {
+		h.stopTail()
+		h.stopTail = nil
+	}
+}
+
+// ClearLogs deletes the daily log files dated before beforeDate (YYYY-MM-DD) and returns how many were removed
+// Only log files directly inside the logs directory are deleted; the file currently written to is kept.
+func (h *LogsHandler) ClearLogs(beforeDate string) (int, error) {
+	before, err := time.Parse("2006-01-02", beforeDate)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date format: %w", err)
+	}
+	return logger.RemoveLogFilesBefore(h.logsDir, before)
+}
+
+// ClearAllLogs deletes every daily log file and empties the file currently written to
+// Returns how many files were deleted.
+func (h *LogsHandler) ClearAllLogs() (int, error) {
+	removed, err := logger.RemoveLogFilesBefore(h.logsDir, time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return removed, err
+	}
+	return removed, logger.TruncateCurrentLog()
+}
+
 // getLogFilesInRange returns all log file paths in the date range
 func (h *LogsHandler) getLogFilesInRange(start, end time.Time) ([]string, error) {
 	var files []string
@@ -0,0 +1,138 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"pubsub-gui/internal/logger"
+)
+
+// newTestLogsHandler returns a logs handler reading a temporary directory with the given files
+func newTestLogsHandler(t *testing.T, files ...string) (*LogsHandler, string) {
+	t.Helper()
+	dir := t.TempDir()
+	for _, name := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}\n"), 0600); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", name, err)
+		}
+	}
+	return &LogsHandler{logsDir: dir}, dir
+}
+
+// remainingFiles lists the names left in dir
+func remainingFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestLogsHandler_ClearLogs(t *testing.T) {
+	h, dir := newTestLogsHandler(t,
+		"logs-2026-01-01.json",
+		"logs-2026-01-02.json",
+		"logs-2026-01-03.json",
+		"logs-notadate.json",
+		"notes.txt",
+	)
+	if err := os.Mkdir(filepath.Join(dir, "logs-2025-12-31.json"), 0700); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+
+	removed, err := h.ClearLogs("2026-01-03")
+	if err != nil {
+		t.Fatalf("ClearLogs() error = %v", err)
+	}
+	if removed != 2 {
+		t.Errorf("ClearLogs() removed = %d, want 2", removed)
+	}
+	want := []string{"logs-2025-12-31.json", "logs-2026-01-03.json", "logs-notadate.json", "notes.txt"}
+	if got := remainingFiles(t, dir); len(got) != len(want) {
+		t.Errorf("remaining files = %v, want %v", got, want)
+	} else {
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("remaining files = %v, want %v", got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestLogsHandler_ClearLogs_InvalidInput(t *testing.T) {
+	h, _ := newTestLogsHandler(t)
+	for _, date := range []string{"", "2026-13-01", "01/02/2026", "../2026-01-01"} {
+		if _, err := h.ClearLogs(date); err == nil {
+			t.Errorf("ClearLogs(%q) error = nil, want error", date)
+		}
+	}
+
+	uninitialized := &LogsHandler{}
+	if _, err := uninitialized.ClearLogs("2026-01-01"); err == nil {
+		t.Error("ClearLogs() without logs directory error = nil, want error")
+	}
+}
+
+func TestLogsHandler_ClearAllLogs(t *testing.T) {
+	h, dir := newTestLogsHandler(t, "logs-2026-01-01.json", "logs-2026-10-16.json", "notes.txt")
+
+	removed, err := h.ClearAllLogs()
+	if err != nil {
+		t.Fatalf("ClearAllLogs() error = %v", err)
+	}
+	if removed != 2 {
+		t.Errorf("ClearAllLogs() removed = %d, want 2", removed)
+	}
+	if got := remainingFiles(t, dir); len(got) != 1 || got[0] != "notes.txt" {
+		t.Errorf("remaining files = %v, want [notes.txt]", got)
+	}
+}
+
+func TestLogsHandler_LogTail(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := logger.InitLogger(); err != nil {
+		t.Fatalf("InitLogger() error = %v", err)
+	}
+
+	h := &LogsHandler{}
+	entries := make(chan LogEntry, 10)
+	// Restarting replaces the running tail
+	for i := 0; i < 3; i++ {
+		h.StartLogTail("ERROR", func(entry LogEntry) { entries <- entry })
+	}
+	defer h.StopLogTail()
+
+	logger.Info("tail test info")
+	logger.Error("tail test error", "topic", "orders")
+
+	select {
+	case entry := <-entries:
+		if entry.Level != "ERROR" || entry.Msg != "tail test error" {
+			t.Errorf("entry = %s %q, want ERROR %q", entry.Level, entry.Msg, "tail test error")
+		}
+		if entry.Fields["topic"] != "orders" {
+			t.Errorf("entry.Fields[topic] = %v, want orders", entry.Fields["topic"])
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no log entry received")
+	}
+
+	h.StopLogTail()
+	h.StopLogTail()
+	logger.Error("after stop")
+	select {
+	case entry := <-entries:
+		t.Errorf("received %q after StopLogTail", entry.Msg)
+	default:
+	}
+}
@@ -3,20 +3,57 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
+	monitoringapi "google.golang.org/api/monitoring/v3"
 
 	"pubsub-gui/internal/auth"
 	"pubsub-gui/internal/logger"
 	"pubsub-gui/internal/models"
 	"pubsub-gui/internal/pubsub/admin"
+	"pubsub-gui/internal/pubsub/metrics"
+	"pubsub-gui/internal/pubsub/publisher"
 	"pubsub-gui/internal/pubsub/subscriber"
 )
 
+// drainRateWindow is the window used to average the acknowledgement rate from Cloud Monitoring
+const drainRateWindow = 5 * time.Minute
+
+// DrainEstimate describes how long a subscription backlog is expected to take to clear
+type DrainEstimate struct {
+	SubscriptionID   string  `json:"subscriptionId"`
+	Backlog          int64   `json:"backlog"`          // num_undelivered_messages from Cloud Monitoring
+	ConsumptionRate  float64 `json:"consumptionRate"`  // Messages per second
+	RateSource       string  `json:"rateSource"`       // "monitoring" | "monitor"
+	RateWindow       string  `json:"rateWindow"`       // Period the rate was averaged over
+	Drains           bool    `json:"drains"`           // False when nothing is being consumed
+	EstimatedSeconds float64 `json:"estimatedSeconds"` // Only meaningful when Drains is true
+	Estimate         string  `json:"estimate"`         // Human-readable duration (e.g. "2m30s")
+	Note             string  `json:"note,omitempty"`   // Explanation when the estimate is degraded
+}
+
+// summaryPreviewLength is the maximum number of payload bytes included in a MessageSummary
+const summaryPreviewLength = 200
+
+// MessageSummary is a compact view of a buffered message for scanning busy streams
+type MessageSummary struct {
+	ID             string            `json:"id"`
+	PublishTime    string            `json:"publishTime"`
+	ReceiveTime    string            `json:"receiveTime"`
+	Size           int               `json:"size"`    // Payload size in bytes
+	Preview        string            `json:"preview"` // Truncated payload
+	Attributes     map[string]string `json:"attributes"`
+	OrderingKey    string            `json:"orderingKey,omitempty"`
+	HighlightColor string            `json:"highlightColor,omitempty"` // Color of the first matching highlight rule
+}
+
 // MonitoringHandler handles message monitoring operations
 type MonitoringHandler struct {
 	ctx            context.Context
@@ -25,10 +62,55 @@ type MonitoringHandler struct {
 	activeMonitors map[string]*subscriber.MessageStreamer
 	topicMonitors  map[string]string
 	monitorsMu     *sync.RWMutex
-	resourceMu     *sync.RWMutex
-	subscriptions  *[]admin.SubscriptionInfo
+	resourceStore  *ResourceStore
+	monitorTTLs    map[string]time.Duration         // TTL of auto-created monitor subscriptions (guarded by monitorsMu)
+	leaseHolds     map[string]int                   // Lease hold seconds per subscription set via SetMessageLease (guarded by monitorsMu)
+	monitorOpts    map[string]models.MonitorOptions // Flow control each monitor was started with, for restarts (guarded by monitorsMu)
+	redeliverySims map[string]bool                  // Subscriptions SimulateRedelivery is nacking messages on (guarded by monitorsMu)
+
+	isEmulatorEnabled func() bool
+	subscriptionLinks *SubscriptionLinkCache
+	publishHistory    *PublishHistory
+}
+
+// Limits for SimulateRedelivery
+const (
+	maxSimulatedNacks      = 100
+	redeliveryTimeout      = 2 * time.Minute
+	deadLetterCheckTimeout = 15 * time.Second
+)
+
+// RedeliveryResult reports the outcome of SimulateRedelivery
+type RedeliveryResult struct {
+	subscriber.RedeliveryTrace
+	SubscriptionID      string   `json:"subscriptionId"`
+	DeadLetterTopic     string   `json:"deadLetterTopic,omitempty"`
+	MaxDeliveryAttempts int      `json:"maxDeliveryAttempts,omitempty"`
+	LandedInDeadLetter  bool     `json:"landedInDeadLetter"`
+	Notes               []string `json:"notes,omitempty"`
+}
+
+// minProductionSubscriptionTTL is the smallest expiration TTL accepted by production Pub/Sub
+const minProductionSubscriptionTTL = 24 * time.Hour
+
+// ActiveMonitorInfo describes a running monitor
+type ActiveMonitorInfo struct {
+	SubscriptionID  string              `json:"subscriptionId"`
+	TopicID         string              `json:"topicId,omitempty"`         // Set for topic monitors
+	AutoAck         bool                `json:"autoAck"`                   // Current auto-ack setting
+	ExactlyOnce     bool                `json:"exactlyOnce"`               // Acks/nacks are confirmed (exactly-once subscription)
+	Paused          bool                `json:"paused"`                    // Receiving is paused (buffer kept)
+	BufferedCount   int                 `json:"bufferedCount"`             // Messages currently in the buffer
+	ReceivedCount   int64               `json:"receivedCount"`             // Messages received since start
+	StartedAt       string              `json:"startedAt"`                 // RFC3339
+	SubscriptionTTL string              `json:"subscriptionTtl,omitempty"` // Effective TTL if the subscription was auto-created
+	LeaseHold       string              `json:"leaseHold,omitempty"`       // How long unacked messages stay leased (empty for the library default)
+	AckStats        subscriber.AckStats `json:"ackStats"`                  // Ack/nack/expire/redelivery counts
 }
 
+// maxLeaseHoldSeconds bounds SetMessageLease to the client library's maximum lease extension
+const maxLeaseHoldSeconds = 3600
+
 // NewMonitoringHandler creates a new monitoring handler
 func NewMonitoringHandler(
 	ctx context.Context,
@@ -37,8 +119,7 @@ func NewMonitoringHandler(
 	activeMonitors map[string]*subscriber.MessageStreamer,
 	topicMonitors map[string]string,
 	monitorsMu *sync.RWMutex,
-	resourceMu *sync.RWMutex,
-	subscriptions *[]admin.SubscriptionInfo,
+	resourceStore *ResourceStore,
 ) *MonitoringHandler {
 	return &MonitoringHandler{
 		ctx:            ctx,
@@ -47,19 +128,61 @@ func NewMonitoringHandler(
 		activeMonitors: activeMonitors,
 		topicMonitors:  topicMonitors,
 		monitorsMu:     monitorsMu,
-		resourceMu:     resourceMu,
-		subscriptions:  subscriptions,
+		resourceStore:  resourceStore,
+		monitorTTLs:    make(map[string]time.Duration),
+		leaseHolds:     make(map[string]int),
+		monitorOpts:    make(map[string]models.MonitorOptions),
+		redeliverySims: make(map[string]bool),
 	}
 }
 
+// SetEmulatorCheckFunc sets the function to check if emulator is enabled
+func (h *MonitoringHandler) SetEmulatorCheckFunc(fn func() bool) {
+	h.isEmulatorEnabled = fn
+}
+
+// SetPublishHistory sets the history that republished messages are recorded in
+func (h *MonitoringHandler) SetPublishHistory(history *PublishHistory) {
+	h.publishHistory = history
+}
+
+// SetSubscriptionLinkCache sets the cache used to verify subscription→topic links without an API call
+func (h *MonitoringHandler) SetSubscriptionLinkCache(cache *SubscriptionLinkCache) {
+	h.subscriptionLinks = cache
+}
+
+// monitorSubscriptionTTL returns the effective TTL for auto-created monitor subscriptions
+// Production Pub/Sub rejects expiration TTLs below one day, so shorter values only apply to the emulator
+func (h *MonitoringHandler) monitorSubscriptionTTL() time.Duration {
+	ttl := time.Duration(models.DefaultMonitorSubscriptionTTLHours) * time.Hour
+	if h.config != nil {
+		ttl = h.config.GetMonitorSubscriptionTTL()
+	}
+
+	if ttl < minProductionSubscriptionTTL && (h.isEmulatorEnabled == nil || !h.isEmulatorEnabled()) {
+		logger.Warn("Monitor subscription TTL below production minimum, using 24h", "configuredTTL", ttl.String())
+		ttl = minProductionSubscriptionTTL
+	}
+
+	return ttl
+}
+
 // StartMonitor starts streaming pull for a subscription
-func (h *MonitoringHandler) StartMonitor(subscriptionID string) error {
+// Zero fields of options fall back to AppConfig.MonitorOptions, then to the client library defaults.
+func (h *MonitoringHandler) StartMonitor(subscriptionID string, options models.MonitorOptions) error {
 	// Check connection status
 	client := h.clientManager.GetClient()
 	if client == nil {
 		return models.ErrNotConnected
 	}
 
+	if err := options.Validate(); err != nil {
+		return fmt.Errorf("invalid monitor options: %w", err)
+	}
+	if h.config != nil {
+		options = options.WithDefaults(h.config.MonitorOptions)
+	}
+
 	// Check subscription type - only pull subscriptions can be monitored
 	projectID := h.clientManager.GetProjectID()
 	subInfo, err := admin.GetSubscriptionMetadataAdmin(h.ctx, client, projectID, subscriptionID)
@@ -67,8 +190,17 @@ func (h *MonitoringHandler) StartMonitor(subscriptionID string) error {
 		return fmt.Errorf("failed to get subscription metadata: %w", err)
 	}
 
-	if subInfo.SubscriptionType == "push" {
-		return fmt.Errorf("monitoring is not supported for push subscriptions. Push subscriptions deliver messages via HTTP POST to an endpoint")
+	if subInfo.Detached {
+		return fmt.Errorf("subscription %s is detached from its topic and no longer receives messages: monitoring is not possible", subscriptionID)
+	}
+	if subInfo.SubscriptionType == "bigquery" {
+		return fmt.Errorf("monitoring is not supported for BigQuery subscriptions: messages are written directly to table %s. Only pull subscriptions can be monitored", subInfo.BigQueryTable)
+	}
+	if subInfo.SubscriptionType == "cloudstorage" {
+		return fmt.Errorf("monitoring is not supported for Cloud Storage subscriptions: messages are written directly to bucket %s. Only pull subscriptions can be monitored", subInfo.CloudStorageBucket)
+	}
+	if subInfo.SubscriptionType != "pull" {
+		return fmt.Errorf("monitoring is not supported for %s subscriptions. Only pull subscriptions can be monitored", subInfo.SubscriptionType)
 	}
 
 	// Check if already monitoring this subscription
@@ -77,10 +209,15 @@ func (h *MonitoringHandler) StartMonitor(subscriptionID string) error {
 		h.monitorsMu.Unlock()
 		return fmt.Errorf("already monitoring subscription: %s", subscriptionID)
 	}
+	if h.redeliverySims[subscriptionID] {
+		h.monitorsMu.Unlock()
+		return fmt.Errorf("a redelivery simulation is running on %s: wait for it to finish before monitoring (the monitor would consume the message)", subscriptionID)
+	}
 	h.monitorsMu.Unlock()
 
 	// Get subscriber for the subscription
 	sub := client.Subscriber(subscriptionID)
+	subscriber.ApplyMonitorOptions(sub, options)
 
 	// Get buffer size from config
 	bufferSize := 500 // default
@@ -99,15 +236,30 @@ func (h *MonitoringHandler) StartMonitor(subscriptionID string) error {
 
 	// Create message streamer
 	streamer := subscriber.NewMessageStreamer(h.ctx, sub, subscriptionID, buffer, autoAck)
+	streamer.SetExactlyOnce(subInfo.EnableExactlyOnce)
+
+	// Apply a lease hold chosen before the monitor (re)started
+	h.monitorsMu.RLock()
+	holdSeconds, hasHold := h.leaseHolds[subscriptionID]
+	h.monitorsMu.RUnlock()
+	if hasHold {
+		streamer.SetLeaseHold(leaseHoldDuration(holdSeconds, subInfo.AckDeadline))
+	}
 
 	// Start streaming
 	if err := streamer.Start(); err != nil {
 		return fmt.Errorf("failed to start monitor: %w", err)
 	}
 
-	// Store active monitor
+	// Store active monitor; a simulation started meanwhile keeps the subscription
 	h.monitorsMu.Lock()
+	if h.redeliverySims[subscriptionID] {
+		h.monitorsMu.Unlock()
+		streamer.Stop()
+		return fmt.Errorf("a redelivery simulation is running on %s: wait for it to finish before monitoring (the monitor would consume the message)", subscriptionID)
+	}
 	h.activeMonitors[subscriptionID] = streamer
+	h.monitorOpts[subscriptionID] = options
 	h.monitorsMu.Unlock()
 
 	// Emit monitor started event
@@ -127,6 +279,7 @@ func (h *MonitoringHandler) StopMonitor(subscriptionID string) error {
 		return fmt.Errorf("not monitoring subscription: %s", subscriptionID)
 	}
 	delete(h.activeMonitors, subscriptionID)
+	delete(h.monitorOpts, subscriptionID)
 	h.monitorsMu.Unlock()
 
 	// Stop the streamer
@@ -142,24 +295,55 @@ func (h *MonitoringHandler) StopMonitor(subscriptionID string) error {
 	return nil
 }
 
+// PauseMonitor stops receiving messages for a monitored subscription while keeping its buffer
+func (h *MonitoringHandler) PauseMonitor(subscriptionID string) error {
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+	if err := streamer.Pause(); err != nil {
+		return fmt.Errorf("failed to pause monitor: %w", err)
+	}
+
+	runtime.EventsEmit(h.ctx, "monitor:paused", map[string]interface{}{
+		"subscriptionID": subscriptionID,
+		"status":         "paused",
+	})
+	return nil
+}
+
+// ResumeMonitor restarts receiving messages for a paused monitor
+func (h *MonitoringHandler) ResumeMonitor(subscriptionID string) error {
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+	if err := streamer.Resume(); err != nil {
+		return fmt.Errorf("failed to resume monitor: %w", err)
+	}
+
+	runtime.EventsEmit(h.ctx, "monitor:resumed", map[string]interface{}{
+		"subscriptionID": subscriptionID,
+		"status":         "active",
+	})
+	return nil
+}
+
 // findExistingMonitoringSubscription searches for an existing subscription
 // that matches the monitoring pattern for the given topic
 func (h *MonitoringHandler) findExistingMonitoringSubscription(topicID string) (string, error) {
 	// Get subscriptions from cached store
-	h.resourceMu.RLock()
-	subscriptions := *h.subscriptions
-	h.resourceMu.RUnlock()
-
-	// If subscriptions is nil (shouldn't happen, but defensive check), treat as empty
-	if subscriptions == nil {
-		subscriptions = []admin.SubscriptionInfo{}
-	}
+	subscriptions := h.resourceStore.Subscriptions()
 
-	// Extract short topic name
-	topicName := topicID
-	if parts := strings.Split(topicID, "/"); len(parts) > 0 {
-		topicName = parts[len(parts)-1]
-	}
+	// Normalize topic ID: short name for the pattern, full name for comparison
+	projectID := h.clientManager.GetProjectID()
+	topicName, normalizedTopicID := admin.NormalizeName(projectID, "topic", topicID)
 	shortTopic := topicName
 	if len(shortTopic) > 20 {
 		shortTopic = shortTopic[:20]
@@ -168,23 +352,10 @@ func (h *MonitoringHandler) findExistingMonitoringSubscription(topicID string) (
 	// Build pattern prefix
 	patternPrefix := fmt.Sprintf("ps-gui-mon-%s-", shortTopic)
 
-	// Normalize topic ID for comparison
-	projectID := h.clientManager.GetProjectID()
-	normalizedTopicID := topicID
-	if !strings.HasPrefix(topicID, "projects/") {
-		normalizedTopicID = fmt.Sprintf("projects/%s/topics/%s", projectID, topicID)
-	}
-
 	// Search for matching subscription
 	for _, sub := range subscriptions {
 		// Extract subscription ID from full name
-		subID := sub.DisplayName
-		if strings.HasPrefix(sub.Name, "projects/") {
-			parts := strings.Split(sub.Name, "/")
-			if len(parts) >= 4 && parts[2] == "subscriptions" {
-				subID = parts[3]
-			}
-		}
+		subID, _ := admin.NormalizeName(projectID, "subscription", sub.Name)
 
 		// Check if it matches the pattern and is linked to the target topic
 		if strings.HasPrefix(subID, patternPrefix) && sub.Topic == normalizedTopicID {
@@ -198,9 +369,74 @@ func (h *MonitoringHandler) findExistingMonitoringSubscription(topicID string) (
 	return "", nil // No existing subscription found
 }
 
+// RestartMonitors restarts every active monitor on the current client, e.g. after it was rebuilt
+// Buffered messages, auto-ack, pause state and lease holds are kept. Returns how many monitors run again.
+func (h *MonitoringHandler) RestartMonitors() int {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return 0
+	}
+
+	h.monitorsMu.RLock()
+	monitors := make(map[string]*subscriber.MessageStreamer, len(h.activeMonitors))
+	for subID, streamer := range h.activeMonitors {
+		monitors[subID] = streamer
+	}
+	h.monitorsMu.RUnlock()
+
+	restarted := 0
+	for subID, old := range monitors {
+		done := make(chan error, 1)
+		go func() { done <- old.Stop() }()
+		select {
+		case <-done:
+		case <-time.After(2 * time.Second):
+			logger.Warn("Timeout stopping monitor before restart", "subscriptionID", subID)
+		}
+
+		h.monitorsMu.RLock()
+		options := h.monitorOpts[subID]
+		h.monitorsMu.RUnlock()
+		sub := client.Subscriber(subID)
+		subscriber.ApplyMonitorOptions(sub, options)
+		streamer := subscriber.NewMessageStreamer(h.ctx, sub, subID, old.GetBuffer(), old.GetAutoAck())
+		if hold := old.GetLeaseHold(); hold > 0 {
+			streamer.SetLeaseHold(hold)
+		}
+
+		err := streamer.Start()
+		if err == nil && old.IsPaused() {
+			err = streamer.Pause()
+		}
+
+		h.monitorsMu.Lock()
+		current, stillActive := h.activeMonitors[subID]
+		if err != nil || !stillActive || current != old {
+			if current == old {
+				delete(h.activeMonitors, subID)
+				delete(h.monitorOpts, subID)
+			}
+			h.monitorsMu.Unlock()
+			streamer.Stop()
+			if err != nil {
+				logger.Error("Failed to restart monitor after reconnect", "subscriptionID", subID, "error", err)
+				runtime.EventsEmit(h.ctx, "monitor:stopped", map[string]interface{}{
+					"subscriptionID": subID,
+				})
+			}
+			continue
+		}
+		h.activeMonitors[subID] = streamer
+		h.monitorsMu.Unlock()
+		restarted++
+	}
+	return restarted
+}
+
 // StartTopicMonitor creates a temporary subscription and starts monitoring a topic
 // If subscriptionID is provided and not empty, it uses that existing subscription instead of creating a new one
-func (h *MonitoringHandler) StartTopicMonitor(topicID string, subscriptionID string) error {
+// options is passed on to StartMonitor.
+func (h *MonitoringHandler) StartTopicMonitor(topicID string, subscriptionID string, options models.MonitorOptions) error {
 	// Check connection status
 	client := h.clientManager.GetClient()
 	if client == nil {
@@ -219,38 +455,37 @@ func (h *MonitoringHandler) StartTopicMonitor(topicID string, subscriptionID str
 
 	var subID string
 	var isNewSubscription bool
+	var ttl time.Duration
 
 	// If subscriptionID is provided, validate and use it
 	if subscriptionID != "" {
 		// Normalize subscription ID (extract short name if full path provided)
-		shortSubID := subscriptionID
-		if strings.HasPrefix(subscriptionID, "projects/") {
-			// Extract subscription ID from full path: projects/{project}/subscriptions/{sub-id}
-			parts := strings.Split(subscriptionID, "/")
-			if len(parts) >= 4 && parts[0] == "projects" && parts[2] == "subscriptions" {
-				shortSubID = parts[3]
-			}
-		}
+		shortSubID, _ := admin.NormalizeName(projectID, "subscription", subscriptionID)
 
-		// Validate subscription exists and is a pull subscription
-		subInfo, err := admin.GetSubscriptionMetadataAdmin(h.ctx, client, projectID, shortSubID)
-		if err != nil {
-			return fmt.Errorf("failed to get subscription metadata: %w", err)
+		// Resolve the subscription's topic from the synced link cache, falling back to the API
+		// StartMonitor re-validates existence and the push/pull type, so only the link is needed here
+		subTopic, cached := "", false
+		if h.subscriptionLinks != nil {
+			subTopic, cached = h.subscriptionLinks.Lookup(shortSubID)
 		}
+		if !cached {
+			subInfo, err := admin.GetSubscriptionMetadataAdmin(h.ctx, client, projectID, shortSubID)
+			if err != nil {
+				return fmt.Errorf("failed to get subscription metadata: %w", err)
+			}
 
-		// Check subscription type - only pull subscriptions can be monitored
-		if subInfo.SubscriptionType == "push" {
-			return fmt.Errorf("monitoring is not supported for push subscriptions. Push subscriptions deliver messages via HTTP POST to an endpoint")
+			// Check subscription type - only pull subscriptions can be monitored
+			if subInfo.SubscriptionType != "pull" {
+				return fmt.Errorf("monitoring is not supported for %s subscriptions. Only pull subscriptions can be monitored", subInfo.SubscriptionType)
+			}
+			subTopic = subInfo.Topic
 		}
 
 		// Normalize topic ID for comparison
-		normalizedTopicID := topicID
-		if !strings.HasPrefix(topicID, "projects/") {
-			normalizedTopicID = fmt.Sprintf("projects/%s/topics/%s", projectID, topicID)
-		}
+		_, normalizedTopicID := admin.NormalizeName(projectID, "topic", topicID)
 
 		// Verify subscription is subscribed to the target topic
-		if subInfo.Topic != normalizedTopicID {
+		if subTopic != normalizedTopicID {
 			return fmt.Errorf("subscription %s is not subscribed to topic %s", shortSubID, topicID)
 		}
 
@@ -288,19 +523,15 @@ func (h *MonitoringHandler) StartTopicMonitor(topicID string, subscriptionID str
 			// Generate a unique subscription ID for monitoring
 			// Format: ps-gui-mon-{short-topic}-{random}
 			// Extract the actual topic name from the full resource path if necessary
-			topicName := topicID
-			if parts := strings.Split(topicID, "/"); len(parts) > 0 {
-				topicName = parts[len(parts)-1]
-			}
-
-			shortTopic := topicName
+			shortTopic, _ := admin.NormalizeName(projectID, "topic", topicID)
 			if len(shortTopic) > 20 {
 				shortTopic = shortTopic[:20]
 			}
 			subID = fmt.Sprintf("ps-gui-mon-%s-%d", shortTopic, time.Now().UnixNano()%1000000)
 
-			// Create temporary subscription with 24h TTL
-			if err := admin.CreateSubscriptionAdmin(h.ctx, client, projectID, topicID, subID, 24*time.Hour); err != nil {
+			// Create temporary subscription with the configured TTL
+			ttl = h.monitorSubscriptionTTL()
+			if err := admin.CreateSubscriptionAdmin(h.ctx, client, projectID, topicID, subID, ttl); err != nil {
 				return fmt.Errorf("failed to create temporary subscription: %w", err)
 			}
 			isNewSubscription = true
@@ -308,7 +539,7 @@ func (h *MonitoringHandler) StartTopicMonitor(topicID string, subscriptionID str
 	}
 
 	// Start monitoring the subscription
-	if err := h.StartMonitor(subID); err != nil {
+	if err := h.StartMonitor(subID, options); err != nil {
 		// Cleanup subscription if it was newly created and monitoring fails to start
 		if isNewSubscription {
 			_ = admin.DeleteSubscriptionAdmin(h.ctx, client, projectID, subID)
@@ -319,6 +550,9 @@ func (h *MonitoringHandler) StartTopicMonitor(topicID string, subscriptionID str
 	// Store mapping
 	h.monitorsMu.Lock()
 	h.topicMonitors[topicID] = subID
+	if isNewSubscription {
+		h.monitorTTLs[subID] = ttl
+	}
 	h.monitorsMu.Unlock()
 
 	return nil
@@ -335,6 +569,7 @@ func (h *MonitoringHandler) StopTopicMonitor(topicID string) error {
 		return nil
 	}
 	delete(h.topicMonitors, topicID)
+	delete(h.monitorTTLs, subID)
 	h.monitorsMu.Unlock()
 
 	// Stop the monitor first
@@ -366,6 +601,243 @@ func (h *MonitoringHandler) StopTopicMonitor(topicID string) error {
 	return nil
 }
 
+// IsMonitoring reports whether a monitor is running for the subscription
+func (h *MonitoringHandler) IsMonitoring(subscriptionID string) bool {
+	h.monitorsMu.RLock()
+	defer h.monitorsMu.RUnlock()
+	_, exists := h.activeMonitors[subscriptionID]
+	return exists
+}
+
+// GetActiveMonitors returns a report of all running monitors
+func (h *MonitoringHandler) GetActiveMonitors() []ActiveMonitorInfo {
+	h.monitorsMu.RLock()
+	defer h.monitorsMu.RUnlock()
+
+	topicsBySub := make(map[string]string, len(h.topicMonitors))
+	for topicID, subID := range h.topicMonitors {
+		topicsBySub[subID] = topicID
+	}
+
+	monitors := make([]ActiveMonitorInfo, 0, len(h.activeMonitors))
+	for subID, streamer := range h.activeMonitors {
+		received, startedAt := streamer.GetReceiveStats()
+		info := ActiveMonitorInfo{
+			SubscriptionID: subID,
+			TopicID:        topicsBySub[subID],
+			AutoAck:        streamer.GetAutoAck(),
+			ExactlyOnce:    streamer.IsExactlyOnce(),
+			Paused:         streamer.IsPaused(),
+			BufferedCount:  streamer.GetBuffer().Size(),
+			ReceivedCount:  received,
+			StartedAt:      startedAt.Format(time.RFC3339),
+			AckStats:       streamer.GetAckStats(),
+		}
+		if hold := streamer.GetLeaseHold(); hold > 0 {
+			info.LeaseHold = hold.String()
+		}
+		if ttl, ok := h.monitorTTLs[subID]; ok {
+			info.SubscriptionTTL = ttl.String()
+		}
+		monitors = append(monitors, info)
+	}
+
+	sort.Slice(monitors, func(i, j int) bool { return monitors[i].SubscriptionID < monitors[j].SubscriptionID })
+	return monitors
+}
+
+// SetMessageLease sets how long unacked buffered messages of a subscription stay leased before release
+// The client library keeps extending their ack deadline (ModifyAckDeadline) until the hold elapses,
+// then the messages are nacked so Pub/Sub redelivers them. holdSeconds=0 disables extension: messages
+// are released once the subscription's ack deadline has passed. The setting is kept for later monitor restarts.
+func (h *MonitoringHandler) SetMessageLease(subscriptionID string, holdSeconds int) error {
+	if holdSeconds < 0 || holdSeconds > maxLeaseHoldSeconds {
+		return fmt.Errorf("hold must be between 0 and %d seconds", maxLeaseHoldSeconds)
+	}
+
+	h.monitorsMu.Lock()
+	h.leaseHolds[subscriptionID] = holdSeconds
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.Unlock()
+
+	if exists {
+		streamer.SetLeaseHold(leaseHoldDuration(holdSeconds, h.cachedAckDeadline(subscriptionID)))
+	}
+
+	return nil
+}
+
+// leaseHoldDuration converts a hold setting into a duration, using the ack deadline when extension is disabled
+func leaseHoldDuration(holdSeconds, ackDeadlineSeconds int) time.Duration {
+	if holdSeconds > 0 {
+		return time.Duration(holdSeconds) * time.Second
+	}
+	if ackDeadlineSeconds <= 0 {
+		ackDeadlineSeconds = 10 // Pub/Sub default ack deadline
+	}
+	return time.Duration(ackDeadlineSeconds) * time.Second
+}
+
+// cachedAckDeadline returns a subscription's ack deadline from the resource cache (0 if not cached)
+func (h *MonitoringHandler) cachedAckDeadline(subscriptionID string) int {
+	if sub, ok := h.resourceStore.FindSubscription(subscriptionID); ok {
+		return sub.AckDeadline
+	}
+	return 0
+}
+
+// AckMessage manually acknowledges a buffered message the monitor still holds unacked
+func (h *MonitoringHandler) AckMessage(subscriptionID, messageID string) error {
+	return h.settleMessage(subscriptionID, messageID, true)
+}
+
+// NackMessage manually nacks a buffered message the monitor still holds unacked
+func (h *MonitoringHandler) NackMessage(subscriptionID, messageID string) error {
+	return h.settleMessage(subscriptionID, messageID, false)
+}
+
+// settleMessage acks or nacks a message and emits "message:acked" or "message:nacked"
+func (h *MonitoringHandler) settleMessage(subscriptionID, messageID string, ack bool) error {
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	event := "message:acked"
+	settle := streamer.AckMessage
+	if !ack {
+		event = "message:nacked"
+		settle = streamer.NackMessage
+	}
+	if err := settle(messageID); err != nil {
+		return err
+	}
+
+	runtime.EventsEmit(h.ctx, event, map[string]interface{}{
+		"subscriptionID": subscriptionID,
+		"messageID":      messageID,
+	})
+	return nil
+}
+
+// ForwardMessage republishes a buffered message to another topic
+// With preserveAttributes the original attributes and ordering key are kept; otherwise only the payload is sent
+func (h *MonitoringHandler) ForwardMessage(subscriptionID, messageID, targetTopicID string, preserveAttributes bool) (publisher.PublishResult, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return publisher.PublishResult{}, models.ErrNotConnected
+	}
+
+	if targetTopicID == "" {
+		return publisher.PublishResult{}, fmt.Errorf("target topic ID cannot be empty")
+	}
+
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+	if !exists {
+		return publisher.PublishResult{}, fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	msg, found := streamer.GetBuffer().GetMessage(messageID)
+	if !found {
+		return publisher.PublishResult{}, fmt.Errorf("message %s not found in buffer", messageID)
+	}
+
+	var attributes map[string]string
+	orderingKey := ""
+	if preserveAttributes {
+		attributes = msg.Attributes
+		orderingKey = msg.OrderingKey
+	}
+
+	result, err := publisher.PublishOrderedMessageWithResult(h.ctx, client, targetTopicID, msg.Data, attributes, orderingKey)
+	if err != nil {
+		return publisher.PublishResult{}, fmt.Errorf("failed to forward message: %w", err)
+	}
+
+	logger.Info("Forwarded message", "subscriptionID", subscriptionID, "messageID", messageID, "targetTopic", targetTopicID, "newMessageID", result.MessageID)
+	return result, nil
+}
+
+// RepublishWithEdits publishes an edited copy of a buffered message and records it in the publish history
+// newAttributes == nil keeps the original attributes; the ordering key is always kept.
+// targetTopicID defaults to the topic of the monitored subscription. When the original payload was JSON,
+// the edited payload must be valid JSON too.
+func (h *MonitoringHandler) RepublishWithEdits(subscriptionID, messageID, newPayload string, newAttributes map[string]string, targetTopicID string) (publisher.PublishResult, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return publisher.PublishResult{}, models.ErrNotConnected
+	}
+
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+	if !exists {
+		return publisher.PublishResult{}, fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	msg, found := streamer.GetBuffer().GetMessage(messageID)
+	if !found {
+		return publisher.PublishResult{}, fmt.Errorf("message %s not found in buffer", messageID)
+	}
+
+	if json.Valid([]byte(msg.Data)) && !json.Valid([]byte(newPayload)) {
+		return publisher.PublishResult{}, fmt.Errorf("edited payload is not valid JSON (the original message was JSON)")
+	}
+
+	attributes := newAttributes
+	if attributes == nil {
+		attributes = msg.Attributes
+	}
+
+	if targetTopicID == "" {
+		topic, err := h.subscriptionTopic(subscriptionID)
+		if err != nil {
+			return publisher.PublishResult{}, err
+		}
+		targetTopicID = topic
+	}
+
+	result, err := publisher.PublishOrderedMessageWithResult(h.ctx, client, targetTopicID, newPayload, attributes, msg.OrderingKey)
+	if h.publishHistory != nil {
+		h.publishHistory.RecordOrderedPublish("republish", targetTopicID, newPayload, attributes, msg.OrderingKey, result.MessageID, err)
+	}
+	if err != nil {
+		return publisher.PublishResult{}, fmt.Errorf("failed to republish message: %w", err)
+	}
+
+	logger.Info("Republished edited message", "subscriptionID", subscriptionID, "messageID", messageID, "targetTopic", targetTopicID, "newMessageID", result.MessageID)
+	return result, nil
+}
+
+// subscriptionTopic returns the short ID of the topic a subscription is attached to
+// Uses the synced link cache when fresh, otherwise fetches the subscription
+func (h *MonitoringHandler) subscriptionTopic(subscriptionID string) (string, error) {
+	projectID := h.clientManager.GetProjectID()
+	topic, cached := "", false
+	if h.subscriptionLinks != nil {
+		topic, cached = h.subscriptionLinks.Lookup(subscriptionID)
+	}
+	if !cached {
+		client := h.clientManager.GetClient()
+		if client == nil {
+			return "", models.ErrNotConnected
+		}
+		subInfo, err := admin.GetSubscriptionMetadataAdmin(h.ctx, client, projectID, subscriptionID)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve the subscription's topic: %w", err)
+		}
+		topic = subInfo.Topic
+	}
+
+	short, _ := admin.NormalizeName(projectID, "topic", topic)
+	return short, nil
+}
+
 // GetBufferedMessages returns all messages in the buffer for a subscription
 func (h *MonitoringHandler) GetBufferedMessages(subscriptionID string) ([]subscriber.PubSubMessage, error) {
 	h.monitorsMu.RLock()
@@ -381,19 +853,329 @@ func (h *MonitoringHandler) GetBufferedMessages(subscriptionID string) ([]subscr
 	return buffer.GetMessages(), nil
 }
 
-// ClearMessageBuffer clears the message buffer for a subscription
-func (h *MonitoringHandler) ClearMessageBuffer(subscriptionID string) error {
+// GetBufferedMessagesDeduped returns the buffered messages of a subscription with redeliveries collapsed
+func (h *MonitoringHandler) GetBufferedMessagesDeduped(subscriptionID string) ([]subscriber.PubSubMessage, error) {
 	h.monitorsMu.RLock()
 	streamer, exists := h.activeMonitors[subscriptionID]
 	h.monitorsMu.RUnlock()
 
 	if !exists {
-		return fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+		return []subscriber.PubSubMessage{}, fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	return streamer.GetBuffer().Deduplicated(), nil
+}
+
+// GetBufferedMessagesAs returns the buffered messages of a subscription with payloads rendered in mode
+func (h *MonitoringHandler) GetBufferedMessagesAs(subscriptionID, mode string) ([]subscriber.PubSubMessage, error) {
+	if err := subscriber.ValidateRenderMode(mode); err != nil {
+		return []subscriber.PubSubMessage{}, err
+	}
+
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return []subscriber.PubSubMessage{}, fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	return subscriber.RenderMessages(streamer.GetBuffer().GetMessages(), mode)
+}
+
+// SearchBufferedMessages returns the buffered messages of a subscription matching query
+func (h *MonitoringHandler) SearchBufferedMessages(subscriptionID, query string, searchAttributes bool) ([]subscriber.PubSubMessage, error) {
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return []subscriber.PubSubMessage{}, fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	return streamer.GetBuffer().Search(query, searchAttributes), nil
+}
+
+// ExportBufferedMessages serializes the buffered messages of a subscription as NDJSON or CSV
+func (h *MonitoringHandler) ExportBufferedMessages(subscriptionID, format string, base64Data bool) (string, error) {
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	return subscriber.ExportMessages(streamer.GetBuffer().GetMessages(), format, base64Data)
+}
+
+// GetMessageSummaries returns a compact view of buffered messages with highlight colors applied
+// Colors come from AppConfig.MonitorHighlightRules so every view colors messages the same way
+func (h *MonitoringHandler) GetMessageSummaries(subscriptionID string) ([]MessageSummary, error) {
+	messages, err := h.GetBufferedMessages(subscriptionID)
+	if err != nil {
+		return []MessageSummary{}, err
+	}
+
+	var rules []models.HighlightRule
+	if h.config != nil {
+		rules = h.config.MonitorHighlightRules
+	}
+
+	summaries := make([]MessageSummary, 0, len(messages))
+	for _, msg := range messages {
+		preview := msg.Data
+		if len(preview) > summaryPreviewLength {
+			preview = strings.ToValidUTF8(preview[:summaryPreviewLength], "") + "…"
+		}
+		summaries = append(summaries, MessageSummary{
+			ID:             msg.ID,
+			PublishTime:    msg.PublishTime,
+			ReceiveTime:    msg.ReceiveTime,
+			Size:           len(msg.Data),
+			Preview:        preview,
+			Attributes:     msg.Attributes,
+			OrderingKey:    msg.OrderingKey,
+			HighlightColor: models.MatchHighlightColor(rules, msg.Attributes),
+		})
+	}
+
+	return summaries, nil
+}
+
+// GetAckStats returns ack/nack/expire/redelivery counts for a monitored subscription
+func (h *MonitoringHandler) GetAckStats(subscriptionID string) (subscriber.AckStats, error) {
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return subscriber.AckStats{}, fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	return streamer.GetAckStats(), nil
+}
+
+// GetPayloadSizeHistogram returns the payload size distribution of a monitored subscription's buffer
+func (h *MonitoringHandler) GetPayloadSizeHistogram(subscriptionID string) (subscriber.SizeHistogram, error) {
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return subscriber.SizeHistogram{}, fmt.Errorf("not monitoring subscription: %s", subscriptionID)
+	}
+
+	return streamer.GetBuffer().PayloadSizeHistogram(), nil
+}
+
+// ClearMessageBuffer clears the message buffer for a subscription and returns the number of messages cleared
+func (h *MonitoringHandler) ClearMessageBuffer(subscriptionID string) (int, error) {
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+
+	if !exists {
+		return 0, fmt.Errorf("not monitoring subscription: %s", subscriptionID)
 	}
 
 	// Clear buffer
-	buffer := streamer.GetBuffer()
-	buffer.Clear()
+	cleared := streamer.GetBuffer().Clear()
+	streamer.ResetAckStats()
 
-	return nil
+	runtime.EventsEmit(h.ctx, "monitor:buffer-cleared", map[string]interface{}{
+		"subscriptionID": subscriptionID,
+		"count":          cleared,
+	})
+
+	return cleared, nil
+}
+
+// ClearAllBuffers clears the message buffers of every active monitor and returns the total cleared
+func (h *MonitoringHandler) ClearAllBuffers() int {
+	h.monitorsMu.RLock()
+	streamers := make(map[string]*subscriber.MessageStreamer, len(h.activeMonitors))
+	for subID, streamer := range h.activeMonitors {
+		streamers[subID] = streamer
+	}
+	h.monitorsMu.RUnlock()
+
+	total := 0
+	for subID, streamer := range streamers {
+		cleared := streamer.GetBuffer().Clear()
+		streamer.ResetAckStats()
+		total += cleared
+
+		runtime.EventsEmit(h.ctx, "monitor:buffer-cleared", map[string]interface{}{
+			"subscriptionID": subID,
+			"count":          cleared,
+		})
+	}
+
+	return total
+}
+
+// EstimateDrainTime estimates how long the backlog of a subscription will take to clear
+// Backlog comes from Cloud Monitoring; the consumption rate comes from the subscription's
+// acknowledgement rate, falling back to the live monitor's receive rate when no metric data exists
+func (h *MonitoringHandler) EstimateDrainTime(subscriptionID string) (*DrainEstimate, error) {
+	if !h.clientManager.IsConnected() {
+		return nil, models.ErrNotConnected
+	}
+
+	svc, projectID, err := h.metricsService()
+	if err != nil {
+		return nil, err
+	}
+
+	backlog, err := metrics.GetSubscriptionBacklog(h.ctx, svc, projectID, subscriptionID)
+	if err != nil {
+		if errors.Is(err, metrics.ErrNoData) {
+			return nil, fmt.Errorf("no backlog data for subscription %s yet (metrics can take a few minutes to appear)", subscriptionID)
+		}
+		return nil, err
+	}
+	return h.drainEstimate(svc, projectID, subscriptionID, backlog)
+}
+
+// drainEstimate computes how long a known backlog takes to clear at the subscription's consumption rate
+func (h *MonitoringHandler) drainEstimate(svc *monitoringapi.Service, projectID, subscriptionID string, backlog int64) (*DrainEstimate, error) {
+	estimate := &DrainEstimate{
+		SubscriptionID: subscriptionID,
+		Backlog:        backlog,
+		RateSource:     "monitoring",
+		RateWindow:     drainRateWindow.String(),
+	}
+
+	rate, err := metrics.GetSubscriptionAckRate(h.ctx, svc, projectID, subscriptionID, drainRateWindow)
+	if err != nil {
+		logger.Warn("Falling back to live monitor rate", "subscriptionID", subscriptionID, "error", err)
+
+		h.monitorsMu.RLock()
+		streamer, exists := h.activeMonitors[subscriptionID]
+		h.monitorsMu.RUnlock()
+		if !exists {
+			if errors.Is(err, metrics.ErrNoData) {
+				// No acknowledgements recorded and nothing observed locally
+				rate = 0
+			} else {
+				return nil, err
+			}
+		} else {
+			received, since := streamer.GetReceiveStats()
+			elapsed := time.Since(since)
+			if elapsed > 0 {
+				rate = float64(received) / elapsed.Seconds()
+			}
+			estimate.RateSource = "monitor"
+			estimate.RateWindow = elapsed.Round(time.Second).String()
+		}
+	}
+	estimate.ConsumptionRate = rate
+
+	seconds, drains := metrics.EstimateDrainSeconds(backlog, rate)
+	estimate.Drains = drains
+	estimate.EstimatedSeconds = seconds
+	if drains {
+		estimate.Estimate = (time.Duration(seconds) * time.Second).Round(time.Second).String()
+	} else {
+		estimate.Estimate = "never"
+		estimate.Note = "no messages are being consumed, so the backlog will not clear at the current rate"
+	}
+
+	return estimate, nil
+}
+
+// SimulateRedelivery nacks the next message on a subscription `times` times to drive up its
+// delivery attempt count, then reports the resulting attempt and whether it reached the dead letter topic
+// Intended for exercising poison-message handling against the emulator; the subscription must not be monitored
+func (h *MonitoringHandler) SimulateRedelivery(subscriptionID string, times int) (*RedeliveryResult, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return nil, models.ErrNotConnected
+	}
+
+	if times < 1 || times > maxSimulatedNacks {
+		return nil, fmt.Errorf("times must be between 1 and %d", maxSimulatedNacks)
+	}
+
+	// The subscription stays reserved until the simulation ends, so no monitor consumes the message meanwhile
+	h.monitorsMu.Lock()
+	_, monitored := h.activeMonitors[subscriptionID]
+	simulating := h.redeliverySims[subscriptionID]
+	if !monitored && !simulating {
+		h.redeliverySims[subscriptionID] = true
+	}
+	h.monitorsMu.Unlock()
+	if monitored {
+		return nil, fmt.Errorf("stop monitoring %s before simulating redelivery (the monitor would consume the message)", subscriptionID)
+	}
+	if simulating {
+		return nil, fmt.Errorf("a redelivery simulation is already running on %s", subscriptionID)
+	}
+	defer func() {
+		h.monitorsMu.Lock()
+		delete(h.redeliverySims, subscriptionID)
+		h.monitorsMu.Unlock()
+	}()
+
+	projectID := h.clientManager.GetProjectID()
+	subInfo, err := admin.GetSubscriptionMetadataAdmin(h.ctx, client, projectID, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription metadata: %w", err)
+	}
+	if subInfo.SubscriptionType != "pull" {
+		return nil, fmt.Errorf("redelivery simulation is only supported for pull subscriptions")
+	}
+
+	result := &RedeliveryResult{SubscriptionID: subscriptionID}
+
+	// Watch the dead letter topic through a temporary subscription created before nacking,
+	// so forwarded messages are captured without consuming from existing DLQ subscriptions
+	var dlqSubID string
+	if subInfo.DeadLetterPolicy != nil && subInfo.DeadLetterPolicy.DeadLetterTopic != "" {
+		result.DeadLetterTopic = subInfo.DeadLetterPolicy.DeadLetterTopic
+		result.MaxDeliveryAttempts = subInfo.DeadLetterPolicy.MaxDeliveryAttempts
+
+		dlqSubID = fmt.Sprintf("ps-gui-dlq-sim-%d", time.Now().UnixNano()%1000000)
+		if err := admin.CreateSubscriptionAdmin(h.ctx, client, projectID, result.DeadLetterTopic, dlqSubID, h.monitorSubscriptionTTL()); err != nil {
+			logger.Warn("Failed to create dead letter watch subscription", "topic", result.DeadLetterTopic, "error", err)
+			result.Notes = append(result.Notes, "could not watch the dead letter topic: "+err.Error())
+			dlqSubID = ""
+		} else {
+			defer func() {
+				if err := admin.DeleteSubscriptionAdmin(h.ctx, client, projectID, dlqSubID); err != nil {
+					logger.Warn("Failed to delete dead letter watch subscription (will be cleaned up by TTL)", "subscriptionID", dlqSubID, "error", err)
+				}
+			}()
+		}
+	} else {
+		result.Notes = append(result.Notes, "subscription has no dead letter policy; delivery attempts are only reported when one is configured")
+	}
+
+	trace, err := subscriber.NackRepeatedly(h.ctx, client.Subscriber(subscriptionID), times, result.MaxDeliveryAttempts, redeliveryTimeout)
+	if err != nil {
+		return nil, err
+	}
+	result.RedeliveryTrace = *trace
+
+	switch {
+	case trace.DeadLettered && trace.Nacks < times:
+		result.Notes = append(result.Notes, fmt.Sprintf("message reached the maximum of %d delivery attempts after %d of %d nacks", result.MaxDeliveryAttempts, trace.Nacks, times))
+	case trace.Nacks < times:
+		result.Notes = append(result.Notes, fmt.Sprintf("message was only redelivered %d of %d times before timing out", trace.Nacks, times))
+	}
+	if trace.DeliveryAttempt == 0 {
+		result.Notes = append(result.Notes, "Pub/Sub did not report a delivery attempt; deliveries were counted locally")
+	}
+
+	if dlqSubID != "" && !trace.Redelivered {
+		found, err := subscriber.FindMessage(h.ctx, client.Subscriber(dlqSubID), trace.Data, trace.Attributes, deadLetterCheckTimeout)
+		if err != nil {
+			result.Notes = append(result.Notes, "failed to check the dead letter topic: "+err.Error())
+		}
+		result.LandedInDeadLetter = found
+	}
+
+	return result, nil
 }
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2/pstest"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
+	"pubsub-gui/internal/pubsub/subscriber"
+)
+
+func TestMonitoringHandler_RestartMonitors(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := logger.InitLogger(); err != nil {
+		t.Fatalf("InitLogger() error = %v", err)
+	}
+	srv := pstest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	clientManager := auth.NewClientManager(ctx)
+	defer clientManager.Close()
+	monitors := make(map[string]*subscriber.MessageStreamer)
+	var monitorsMu sync.RWMutex
+	h := NewMonitoringHandler(ctx, models.NewDefaultConfig(), clientManager, monitors, make(map[string]string), &monitorsMu, nil)
+
+	if got := h.RestartMonitors(); got != 0 {
+		t.Errorf("RestartMonitors() while disconnected = %d, want 0", got)
+	}
+
+	// The lost client the monitors were started on, and the rebuilt one
+	lost, err := auth.ConnectWithADC(ctx, "p", srv.Addr)
+	if err != nil {
+		t.Fatalf("ConnectWithADC() error = %v", err)
+	}
+	defer lost.Close()
+	rebuilt, err := auth.ConnectWithADC(ctx, "p", srv.Addr)
+	if err != nil {
+		t.Fatalf("ConnectWithADC() error = %v", err)
+	}
+	if err := clientManager.SetClient(rebuilt, "p"); err != nil {
+		t.Fatalf("SetClient() error = %v", err)
+	}
+	if err := admin.CreateTopicAdmin(ctx, rebuilt, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	for _, subID := range []string{"live", "paused"} {
+		if err := admin.CreateSubscriptionWithConfig(ctx, rebuilt, "p", "orders", subID, admin.SubscriptionConfig{AckDeadline: 10}); err != nil {
+			t.Fatalf("CreateSubscriptionWithConfig(%s) error = %v", subID, err)
+		}
+	}
+
+	old := make(map[string]*subscriber.MessageStreamer)
+	for _, subID := range []string{"live", "paused"} {
+		streamer := subscriber.NewMessageStreamer(ctx, lost.Subscriber(subID), subID, subscriber.NewMessageBuffer(10), subID == "live")
+		if err := streamer.Start(); err != nil {
+			t.Fatalf("Start(%s) error = %v", subID, err)
+		}
+		old[subID] = streamer
+		monitors[subID] = streamer
+	}
+	old["live"].SetLeaseHold(time.Minute)
+	if err := old["paused"].Pause(); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+
+	if got := h.RestartMonitors(); got != 2 {
+		t.Fatalf("RestartMonitors() = %d, want 2", got)
+	}
+	defer func() {
+		for _, streamer := range monitors {
+			streamer.Stop()
+		}
+	}()
+
+	for subID, previous := range old {
+		current := monitors[subID]
+		if current == nil || current == previous {
+			t.Fatalf("monitor %s was not replaced", subID)
+		}
+		if current.GetBuffer() != previous.GetBuffer() {
+			t.Errorf("monitor %s lost its message buffer", subID)
+		}
+		if current.GetAutoAck() != previous.GetAutoAck() {
+			t.Errorf("monitor %s auto-ack = %v, want %v", subID, current.GetAutoAck(), previous.GetAutoAck())
+		}
+	}
+	if got := monitors["live"].GetLeaseHold(); got != time.Minute {
+		t.Errorf("live monitor lease hold = %v, want 1m", got)
+	}
+	if monitors["live"].IsPaused() || !monitors["paused"].IsPaused() {
+		t.Errorf("paused states = %v, %v, want only the paused monitor paused", monitors["live"].IsPaused(), monitors["paused"].IsPaused())
+	}
+}
+
+func TestMonitoringHandler_SimulateRedeliveryReservesSubscription(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := logger.InitLogger(); err != nil {
+		t.Fatalf("InitLogger() error = %v", err)
+	}
+	srv := pstest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client, err := auth.ConnectWithADC(ctx, "p", srv.Addr)
+	if err != nil {
+		t.Fatalf("ConnectWithADC() error = %v", err)
+	}
+	clientManager := auth.NewClientManager(ctx)
+	defer clientManager.Close()
+	if err := clientManager.SetClient(client, "p"); err != nil {
+		t.Fatalf("SetClient() error = %v", err)
+	}
+	if err := admin.CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	if err := admin.CreateSubscriptionWithConfig(ctx, client, "p", "orders", "orders-sub", admin.SubscriptionConfig{AckDeadline: 10}); err != nil {
+		t.Fatalf("CreateSubscriptionWithConfig() error = %v", err)
+	}
+
+	monitors := make(map[string]*subscriber.MessageStreamer)
+	var monitorsMu sync.RWMutex
+	h := NewMonitoringHandler(ctx, models.NewDefaultConfig(), clientManager, monitors, make(map[string]string), &monitorsMu, nil)
+
+	// A monitored subscription cannot be simulated on
+	monitors["orders-sub"] = nil
+	if _, err := h.SimulateRedelivery("orders-sub", 1); err == nil || !strings.Contains(err.Error(), "stop monitoring") {
+		t.Errorf("SimulateRedelivery(monitored) error = %v, want a stop monitoring error", err)
+	}
+	delete(monitors, "orders-sub")
+
+	// A running simulation keeps monitors and other simulations off the subscription
+	h.redeliverySims["orders-sub"] = true
+	if err := h.StartMonitor("orders-sub", models.MonitorOptions{}); err == nil || !strings.Contains(err.Error(), "redelivery simulation") {
+		t.Errorf("StartMonitor(during simulation) error = %v, want a redelivery simulation error", err)
+	}
+	if _, err := h.SimulateRedelivery("orders-sub", 1); err == nil || !strings.Contains(err.Error(), "already running") {
+		t.Errorf("SimulateRedelivery(during simulation) error = %v, want already running", err)
+	}
+	if len(monitors) != 0 {
+		t.Errorf("active monitors = %v, want none", monitors)
+	}
+}
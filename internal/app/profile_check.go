@@ -0,0 +1,94 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/models"
+)
+
+// profileTestTimeout bounds building the client and the reachability check of TestProfile
+const profileTestTimeout = 30 * time.Second
+
+// ProfileTestResult reports whether a profile can connect, without switching to it
+type ProfileTestResult struct {
+	ProfileID        string `json:"profileId"`
+	Success          bool   `json:"success"`
+	AuthMethod       string `json:"authMethod"`
+	EmulatorHost     string `json:"emulatorHost,omitempty"`     // Resolved emulator host; empty for real GCP
+	LatencyMs        int64  `json:"latencyMs"`                  // Duration of the reachability check
+	ContainerRuntime string `json:"containerRuntime,omitempty"` // Managed emulator profiles: runtime that would run the emulator
+	Message          string `json:"message,omitempty"`          // Informational note when reachability could not be checked
+	Error            string `json:"error,omitempty"`
+}
+
+// TestProfile builds a throwaway client for a profile and checks it can reach the project
+// The active connection is not touched. emulatorHost is the resolved host (test-mode override applied here).
+func (h *ConnectionHandler) TestProfile(profile models.ConnectionProfile, emulatorHost string) ProfileTestResult {
+	emulatorHost = h.resolveEmulatorHost(emulatorHost)
+	result := ProfileTestResult{
+		ProfileID:    profile.ID,
+		AuthMethod:   profile.AuthMethod,
+		EmulatorHost: emulatorHost,
+	}
+
+	ctx, cancel := context.WithTimeout(h.ctx, profileTestTimeout)
+	defer cancel()
+
+	client, err := h.newProfileClient(ctx, profile, emulatorHost)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer client.Close()
+
+	start := time.Now()
+	err = auth.ValidateConnection(ctx, client, profile.ProjectID)
+	result.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Success = true
+	return result
+}
+
+// newProfileClient creates a Pub/Sub client with a profile's auth settings
+// Emulators ignore credentials, and OAuth never opens the browser: a profile without a stored token fails.
+func (h *ConnectionHandler) newProfileClient(ctx context.Context, profile models.ConnectionProfile, emulatorHost string) (*pubsub.Client, error) {
+	if emulatorHost != "" {
+		return auth.ConnectWithADC(ctx, profile.ProjectID, emulatorHost)
+	}
+
+	switch profile.AuthMethod {
+	case "ADC":
+		return auth.ConnectWithADC(ctx, profile.ProjectID, "")
+	case "ServiceAccount":
+		if err := checkServiceAccountKey(profile.ServiceAccountPath); err != nil {
+			return nil, err
+		}
+		return auth.ConnectWithServiceAccount(ctx, profile.ProjectID, profile.ServiceAccountPath, "")
+	case "OAuth":
+		tokenStore, err := auth.NewTokenStore(filepath.Dir(h.configManager.GetConfigPath()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize token store: %w", err)
+		}
+		token, err := tokenStore.LoadAccountToken(profile.ID, profile.OAuthEmail)
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, fmt.Errorf("not signed in: connect with this profile once to authorize it")
+		}
+		client, _, err := auth.ConnectWithOAuth(ctx, profile.ProjectID, profile.OAuthClientPath, profile.ID, profile.OAuthEmail, tokenStore, "", false)
+		return client, err
+	default:
+		return nil, fmt.Errorf("unsupported auth method: %s", profile.AuthMethod)
+	}
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/pubsub/v2/pstest"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/models"
+)
+
+func TestConnectionHandler_TestProfile(t *testing.T) {
+	srv := pstest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	clientManager := auth.NewClientManager(ctx)
+	h := NewConnectionHandler(ctx, models.NewDefaultConfig(), nil, clientManager, nil)
+	profile := models.ConnectionProfile{ID: "p1", ProjectID: "test-project", AuthMethod: "ADC"}
+
+	result := h.TestProfile(profile, srv.Addr)
+	if !result.Success {
+		t.Fatalf("TestProfile() Success = false, error = %q", result.Error)
+	}
+	if result.EmulatorHost != srv.Addr || result.AuthMethod != "ADC" {
+		t.Errorf("TestProfile() = %+v, want emulator host %s and auth method ADC", result, srv.Addr)
+	}
+	if clientManager.IsConnected() {
+		t.Error("TestProfile() changed the active connection")
+	}
+
+	profile.AuthMethod = "Kerberos"
+	if result := h.TestProfile(profile, ""); result.Success || result.Error == "" {
+		t.Errorf("TestProfile(unsupported auth) = %+v, want failure", result)
+	}
+}
@@ -0,0 +1,285 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+)
+
+// Publish history limits
+const (
+	maxPublishHistoryEntries = 200
+	maxHistoryPayloadBytes   = 64 * 1024 // Larger payloads are stored truncated
+)
+
+// PublishHistoryEntry records a single publish attempt
+type PublishHistoryEntry struct {
+	ID          string            `json:"id"`
+	TopicID     string            `json:"topicId"`
+	Payload     string            `json:"payload"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	OrderingKey string            `json:"orderingKey,omitempty"`
+	MessageID   string            `json:"messageId,omitempty"` // Empty when the publish failed
+	Timestamp   string            `json:"timestamp"`           // RFC3339
+	Success     bool              `json:"success"`
+	Error       string            `json:"error,omitempty"`
+	Source      string            `json:"source,omitempty"`    // "publish" | "template" | "republish" | "resend" | "scheduled" | "batch"
+	Truncated   bool              `json:"truncated,omitempty"` // Payload was cut to at most maxHistoryPayloadBytes
+}
+
+// PublishHistoryFileName is the file a profile's publish history is persisted to, inside its data directory
+const PublishHistoryFileName = "publish-history.json"
+
+// PublishHistory keeps the most recent publishes in memory, newest last
+// When attached to a file, every change is also written to it outside mu, so slow disks never block readers.
+type PublishHistory struct {
+	mu        sync.RWMutex
+	entries   []PublishHistoryEntry
+	path      string // File the history is persisted to; empty keeps it in memory only
+	profileID string // Profile the entries belong to
+	version   uint64 // Bumped on every change to entries or path
+
+	saveMu       sync.Mutex // Serializes file writes
+	savedVersion uint64     // Last version written; guarded by saveMu
+}
+
+// NewPublishHistory creates an empty publish history
+func NewPublishHistory() *PublishHistory {
+	return &PublishHistory{}
+}
+
+// Record appends a publish attempt, dropping the oldest entries beyond the cap
+// ID and Timestamp are filled in when empty; the stored entry is returned
+func (h *PublishHistory) Record(entry PublishHistoryEntry) PublishHistoryEntry {
+	entry = prepareHistoryEntry(entry)
+
+	h.mu.Lock()
+	h.appendLocked(entry)
+	h.mu.Unlock()
+	h.save()
+	return entry
+}
+
+// RecordBatch appends many publish attempts at once, writing the history file a single time
+// Only the newest maxPublishHistoryEntries entries can be kept, so older ones are skipped.
+func (h *PublishHistory) RecordBatch(entries []PublishHistoryEntry) {
+	if overflow := len(entries) - maxPublishHistoryEntries; overflow > 0 {
+		entries = entries[overflow:]
+	}
+
+	h.mu.Lock()
+	for _, entry := range entries {
+		h.appendLocked(prepareHistoryEntry(entry))
+	}
+	h.mu.Unlock()
+	h.save()
+}
+
+// prepareHistoryEntry fills in the ID and timestamp and bounds the payload of a new entry
+func prepareHistoryEntry(entry PublishHistoryEntry) PublishHistoryEntry {
+	if entry.ID == "" {
+		entry.ID = uuid.NewString()
+	}
+	if entry.Timestamp == "" {
+		entry.Timestamp = time.Now().Format(time.RFC3339)
+	}
+	if len(entry.Payload) > maxHistoryPayloadBytes {
+		// Cut on a rune boundary so the stored payload stays valid UTF-8
+		n := maxHistoryPayloadBytes
+		for n > 0 && !utf8.RuneStart(entry.Payload[n]) {
+			n--
+		}
+		entry.Payload = entry.Payload[:n]
+		entry.Truncated = true
+	}
+	entry.Attributes = maps.Clone(entry.Attributes)
+	return entry
+}
+
+// appendLocked appends an entry, dropping the oldest entries beyond the cap; h.mu must be held
+func (h *PublishHistory) appendLocked(entry PublishHistoryEntry) {
+	h.version++
+	h.entries = append(h.entries, entry)
+	if overflow := len(h.entries) - maxPublishHistoryEntries; overflow > 0 {
+		h.entries = append([]PublishHistoryEntry(nil), h.entries[overflow:]...)
+	}
+}
+
+// RecordPublish records the outcome of publishing payload and attributes to topicID
+func (h *PublishHistory) RecordPublish(source, topicID, payload string, attributes map[string]string, messageID string, err error) PublishHistoryEntry {
+	return h.RecordOrderedPublish(source, topicID, payload, attributes, "", messageID, err)
+}
+
+// RecordOrderedPublish records the outcome of publishing payload and attributes with an ordering key to topicID
+func (h *PublishHistory) RecordOrderedPublish(source, topicID, payload string, attributes map[string]string, orderingKey, messageID string, err error) PublishHistoryEntry {
+	entry := PublishHistoryEntry{
+		TopicID:     topicID,
+		Payload:     payload,
+		Attributes:  attributes,
+		OrderingKey: orderingKey,
+		MessageID:   messageID,
+		Success:     err == nil,
+		Source:      source,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return h.Record(entry)
+}
+
+// List returns up to limit entries, newest first (limit <= 0 returns all)
+func (h *PublishHistory) List(limit int) []PublishHistoryEntry {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if limit <= 0 || limit > len(h.entries) {
+		limit = len(h.entries)
+	}
+	result := make([]PublishHistoryEntry, 0, limit)
+	for i := len(h.entries) - 1; i >= 0 && len(result) < limit; i-- {
+		result = append(result, h.entries[i])
+	}
+	return result
+}
+
+// Get returns the entry with the given ID
+func (h *PublishHistory) Get(id string) (PublishHistoryEntry, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, entry := range h.entries {
+		if entry.ID == id {
+			entry.Attributes = maps.Clone(entry.Attributes)
+			return entry, nil
+		}
+	}
+	return PublishHistoryEntry{}, models.ErrHistoryEntryNotFound
+}
+
+// Clear removes every entry (and empties the attached file)
+func (h *PublishHistory) Clear() {
+	h.mu.Lock()
+	h.entries = nil
+	h.version++
+	h.mu.Unlock()
+	h.save()
+}
+
+// Attach persists the history of a profile to path, replacing the entries in memory with the ones stored there
+// A missing file starts an empty history. Entries recorded before attaching are dropped, so
+// histories of different profiles never mix.
+func (h *PublishHistory) Attach(profileID, path string) error {
+	var entries []PublishHistoryEntry
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read publish history: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return fmt.Errorf("failed to parse publish history %s: %w", path, err)
+		}
+	}
+	if overflow := len(entries) - maxPublishHistoryEntries; overflow > 0 {
+		entries = entries[overflow:]
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries = entries
+	h.path = path
+	h.profileID = profileID
+	h.version++
+	return nil
+}
+
+// PersistTo starts persisting the current entries to path, overwriting what it holds
+func (h *PublishHistory) PersistTo(path string) {
+	h.mu.Lock()
+	h.path = path
+	h.version++
+	h.mu.Unlock()
+	h.save()
+}
+
+// Detach stops persisting the history; entries stay in memory
+func (h *PublishHistory) Detach() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.path = ""
+}
+
+// KeepInMemory stops persisting and keeps the history of a profile in memory only
+// Entries of another profile are dropped, so histories of different profiles never mix.
+func (h *PublishHistory) KeepInMemory(profileID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.path = ""
+	if h.profileID != profileID {
+		h.entries = nil
+		h.profileID = profileID
+		h.version++
+	}
+}
+
+// save writes the entries to the attached file, if any, unless a newer save already wrote them
+// The entries are copied under h.mu and written after releasing it. Failures are logged: the history
+// still works in memory.
+func (h *PublishHistory) save() {
+	h.saveMu.Lock()
+	defer h.saveMu.Unlock()
+
+	h.mu.RLock()
+	path, version := h.path, h.version
+	entries := slices.Clone(h.entries)
+	h.mu.RUnlock()
+
+	if path == "" || version == h.savedVersion {
+		return
+	}
+	if err := writeHistoryFile(path, entries); err != nil {
+		logger.Warn("Failed to save publish history", "path", path, "error", err)
+		return
+	}
+	h.savedVersion = version
+}
+
+// writeHistoryFile atomically replaces path with the JSON encoding of entries
+func writeHistoryFile(path string, entries []PublishHistoryEntry) error {
+	if entries == nil {
+		entries = []PublishHistoryEntry{}
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(path), "publish-history-*.tmp")
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+	defer os.Remove(tempPath) // Clean up temp file if rename fails
+
+	if _, err := tempFile.Write(data); err != nil {
+		tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, path)
+}
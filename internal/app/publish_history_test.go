@@ -0,0 +1,183 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"pubsub-gui/internal/models"
+)
+
+func TestPublishHistory_RecordAndClear(t *testing.T) {
+	h := NewPublishHistory()
+	first := h.RecordPublish("publish", "orders", "one", map[string]string{"k": "v"}, "m1", nil)
+	h.RecordPublish("publish", "orders", "two", nil, "", errors.New("denied"))
+
+	list := h.List(0)
+	if len(list) != 2 || list[0].Payload != "two" || list[1].Payload != "one" {
+		t.Fatalf("List() = %+v, want newest first", list)
+	}
+	if list[0].Success || list[0].Error != "denied" || !list[1].Success {
+		t.Errorf("List() outcomes = %+v", list)
+	}
+	if got, err := h.Get(first.ID); err != nil || got.Attributes["k"] != "v" {
+		t.Errorf("Get() = %+v, %v", got, err)
+	}
+
+	h.Clear()
+	if list := h.List(0); len(list) != 0 {
+		t.Errorf("List() after Clear() = %d entries, want 0", len(list))
+	}
+	if _, err := h.Get(first.ID); !errors.Is(err, models.ErrHistoryEntryNotFound) {
+		t.Errorf("Get() after Clear() error = %v, want ErrHistoryEntryNotFound", err)
+	}
+}
+
+func TestPublishHistory_RecordEntry(t *testing.T) {
+	h := NewPublishHistory()
+	entry := h.RecordOrderedPublish("republish", "orders", "body", nil, "customer-1", "m1", nil)
+	if entry.OrderingKey != "customer-1" {
+		t.Errorf("RecordOrderedPublish() ordering key = %q, want customer-1", entry.OrderingKey)
+	}
+
+	// A two-byte rune straddles the limit and must not be split
+	payload := strings.Repeat("a", maxHistoryPayloadBytes-1) + "é"
+	entry = h.RecordPublish("publish", "orders", payload, nil, "m2", nil)
+	if !entry.Truncated || len(entry.Payload) != maxHistoryPayloadBytes-1 || !utf8.ValidString(entry.Payload) {
+		t.Errorf("RecordPublish() truncated = %v, payload length %d, valid UTF-8 %v, want truncated to %d bytes of valid UTF-8",
+			entry.Truncated, len(entry.Payload), utf8.ValidString(entry.Payload), maxHistoryPayloadBytes-1)
+	}
+}
+
+func TestPublishHistory_RecordBatchKeepsNewest(t *testing.T) {
+	h := NewPublishHistory()
+	entries := make([]PublishHistoryEntry, maxPublishHistoryEntries+50)
+	for i := range entries {
+		entries[i] = PublishHistoryEntry{TopicID: "orders", Payload: fmt.Sprint(i), Source: "batch"}
+	}
+
+	h.RecordBatch(entries)
+	list := h.List(0)
+	if len(list) != maxPublishHistoryEntries {
+		t.Fatalf("List() = %d entries, want %d", len(list), maxPublishHistoryEntries)
+	}
+	if list[0].Payload != fmt.Sprint(len(entries)-1) || list[len(list)-1].Payload != "50" {
+		t.Errorf("kept payloads %s..%s, want %d..50", list[0].Payload, list[len(list)-1].Payload, len(entries)-1)
+	}
+	if list[0].ID == "" || list[0].Timestamp == "" {
+		t.Errorf("batch entry missing ID or timestamp: %+v", list[0])
+	}
+}
+
+func TestPublishHistory_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PublishHistoryFileName)
+
+	h := NewPublishHistory()
+	h.RecordPublish("publish", "orders", "before attach", nil, "m0", nil)
+	if err := h.Attach("p1", path); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+	if list := h.List(0); len(list) != 0 {
+		t.Errorf("List() after attaching a new file = %d entries, want 0", len(list))
+	}
+	h.RecordPublish("publish", "orders", "persisted", map[string]string{"k": "v"}, "m1", nil)
+
+	reloaded := NewPublishHistory()
+	if err := reloaded.Attach("p1", path); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+	list := reloaded.List(0)
+	if len(list) != 1 || list[0].Payload != "persisted" || list[0].Attributes["k"] != "v" {
+		t.Fatalf("reloaded history = %+v, want the persisted entry", list)
+	}
+
+	reloaded.Clear()
+	reloaded.Detach()
+	reloaded.RecordPublish("publish", "orders", "memory only", nil, "m2", nil)
+	if err := h.Attach("p1", path); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+	if list := h.List(0); len(list) != 0 {
+		t.Errorf("history file after Clear() and Detach() = %+v, want empty", list)
+	}
+}
+
+func TestPublishHistory_PersistTo(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PublishHistoryFileName)
+	h := NewPublishHistory()
+	h.RecordPublish("publish", "orders", "kept", nil, "m1", nil)
+
+	h.PersistTo(path)
+	reloaded := NewPublishHistory()
+	if err := reloaded.Attach("p1", path); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+	if list := reloaded.List(0); len(list) != 1 || list[0].Payload != "kept" {
+		t.Errorf("persisted history = %+v, want the in-memory entry", list)
+	}
+}
+
+func TestPublishHistory_AttachCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PublishHistoryFileName)
+	if err := os.WriteFile(path, []byte("not json"), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	h := NewPublishHistory()
+	h.RecordPublish("publish", "orders", "kept", nil, "m1", nil)
+	if err := h.Attach("p1", path); err == nil {
+		t.Fatal("Attach() error = nil, want parse error")
+	}
+	if list := h.List(0); len(list) != 1 {
+		t.Errorf("List() after failed Attach() = %d entries, want the previous entry", len(list))
+	}
+}
+
+func TestPublishHistory_ConcurrentRecordsArePersisted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PublishHistoryFileName)
+	h := NewPublishHistory()
+	if err := h.Attach("p1", path); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			h.RecordPublish("publish", "orders", fmt.Sprint(i), nil, "m", nil)
+		}(i)
+	}
+	wg.Wait()
+
+	// The last write must hold every entry, whatever order the saves ran in
+	reloaded := NewPublishHistory()
+	if err := reloaded.Attach("p1", path); err != nil {
+		t.Fatalf("Attach() error = %v", err)
+	}
+	if list := reloaded.List(0); len(list) != 20 {
+		t.Errorf("persisted history = %d entries, want 20", len(list))
+	}
+}
+
+func TestPublishHistory_KeepInMemory(t *testing.T) {
+	h := NewPublishHistory()
+	h.KeepInMemory("p1")
+	h.RecordPublish("publish", "orders", "p1 entry", nil, "m1", nil)
+
+	// Reconnecting the same profile keeps its history
+	h.KeepInMemory("p1")
+	if list := h.List(0); len(list) != 1 {
+		t.Fatalf("List() after reconnecting the same profile = %d entries, want 1", len(list))
+	}
+
+	h.KeepInMemory("p2")
+	if list := h.List(0); len(list) != 0 {
+		t.Errorf("List() after switching profile = %+v, want no entries of the previous profile", list)
+	}
+}
@@ -0,0 +1,82 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/publisher"
+	"pubsub-gui/internal/pubsub/subscriber"
+)
+
+// deadLetterAttributePrefix prefixes the attributes Pub/Sub adds to dead-lettered messages
+// (CloudPubSubDeadLetterSourceSubscription, CloudPubSubDeadLetterSourceDeliveryCount, ...)
+const deadLetterAttributePrefix = "CloudPubSubDeadLetterSource"
+
+// ReplayBufferedMessages republishes a snapshot of a monitored subscription's buffer to a topic
+// Messages are published with the default batch concurrency, so their order is not kept; the result's
+// sourceMessageIds maps every index back to the buffered message. onProgress is passed to the batch publisher.
+func (h *MonitoringHandler) ReplayBufferedMessages(subID, targetTopicID string, preserveAttributes bool, onProgress func(done, total int)) (publisher.BatchPublishResult, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return publisher.BatchPublishResult{}, models.ErrNotConnected
+	}
+	if targetTopicID == "" {
+		return publisher.BatchPublishResult{}, fmt.Errorf("target topic ID cannot be empty")
+	}
+
+	h.monitorsMu.RLock()
+	streamer, exists := h.activeMonitors[subID]
+	h.monitorsMu.RUnlock()
+	if !exists {
+		return publisher.BatchPublishResult{}, fmt.Errorf("not monitoring subscription: %s", subID)
+	}
+
+	buffered := streamer.GetBuffer().GetMessages()
+	if len(buffered) == 0 {
+		return publisher.BatchPublishResult{}, fmt.Errorf("no buffered messages to replay for subscription: %s", subID)
+	}
+
+	messages, sourceIDs := replayInputs(buffered, preserveAttributes)
+	result, err := publisher.PublishMessagesBatch(h.ctx, client, targetTopicID, messages, 0, onProgress)
+	if err != nil {
+		return publisher.BatchPublishResult{}, fmt.Errorf("failed to replay messages: %w", err)
+	}
+	result.SourceMessageIDs = sourceIDs
+
+	logger.Info("Replayed buffered messages", "subscriptionID", subID, "targetTopic", targetTopicID, "total", result.Total, "failed", result.Failed)
+	return result, nil
+}
+
+// replayInputs converts buffered messages into batch inputs and returns their IDs in the same order
+// Without preserveAttributes the dead letter attributes added by Pub/Sub are dropped; other attributes are kept.
+func replayInputs(messages []subscriber.PubSubMessage, preserveAttributes bool) ([]publisher.BatchMessageInput, []string) {
+	inputs := make([]publisher.BatchMessageInput, len(messages))
+	sourceIDs := make([]string, len(messages))
+	for i, msg := range messages {
+		inputs[i] = publisher.BatchMessageInput{Payload: msg.Data, Attributes: msg.Attributes}
+		if !preserveAttributes {
+			inputs[i].Attributes = withoutDeadLetterAttributes(msg.Attributes)
+		}
+		sourceIDs[i] = msg.ID
+	}
+	return inputs, sourceIDs
+}
+
+// withoutDeadLetterAttributes returns a copy of attributes without the CloudPubSubDeadLetterSource* keys
+// nil is returned when no attributes remain.
+func withoutDeadLetterAttributes(attributes map[string]string) map[string]string {
+	var kept map[string]string
+	for key, value := range attributes {
+		if strings.HasPrefix(key, deadLetterAttributePrefix) {
+			continue
+		}
+		if kept == nil {
+			kept = make(map[string]string, len(attributes))
+		}
+		kept[key] = value
+	}
+	return kept
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"pubsub-gui/internal/pubsub/subscriber"
+)
+
+func TestReplayInputs(t *testing.T) {
+	messages := []subscriber.PubSubMessage{
+		{ID: "1", Data: "first", Attributes: map[string]string{
+			"orderId": "42",
+			"CloudPubSubDeadLetterSourceSubscription":  "projects/p/subscriptions/orders-worker",
+			"CloudPubSubDeadLetterSourceDeliveryCount": "5",
+		}},
+		{ID: "2", Data: "second", Attributes: map[string]string{"CloudPubSubDeadLetterSourceTopicPublishTime": "2024-01-01T00:00:00Z"}},
+		{ID: "3", Data: "third"},
+	}
+
+	inputs, sourceIDs := replayInputs(messages, false)
+	if !reflect.DeepEqual(sourceIDs, []string{"1", "2", "3"}) {
+		t.Errorf("replayInputs() source IDs = %v, want [1 2 3]", sourceIDs)
+	}
+	if len(inputs) != 3 || inputs[0].Payload != "first" || inputs[2].Payload != "third" {
+		t.Fatalf("replayInputs() = %+v, want payloads in buffer order", inputs)
+	}
+	if want := map[string]string{"orderId": "42"}; !reflect.DeepEqual(inputs[0].Attributes, want) {
+		t.Errorf("replayInputs(strip) attributes = %v, want %v", inputs[0].Attributes, want)
+	}
+	if inputs[1].Attributes != nil || inputs[2].Attributes != nil {
+		t.Errorf("replayInputs(strip) = %+v, want nil attributes when none remain", inputs[1:])
+	}
+	if len(messages[0].Attributes) != 3 {
+		t.Errorf("replayInputs() modified the buffered attributes: %v", messages[0].Attributes)
+	}
+
+	preserved, _ := replayInputs(messages, true)
+	if !reflect.DeepEqual(preserved[0].Attributes, messages[0].Attributes) {
+		t.Errorf("replayInputs(preserve) attributes = %v, want %v", preserved[0].Attributes, messages[0].Attributes)
+	}
+}
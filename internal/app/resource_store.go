@@ -0,0 +1,116 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"sync"
+
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+// ResourceStore holds the topics, subscriptions and snapshots from the last resource sync
+// It is shared by the resource and monitoring handlers; all access goes through its methods
+// so callers never alias the underlying slices.
+type ResourceStore struct {
+	mu            sync.RWMutex
+	topics        []admin.TopicInfo
+	subscriptions []admin.SubscriptionInfo
+	snapshots     []admin.SnapshotInfo
+}
+
+// NewResourceStore creates an empty resource store
+func NewResourceStore() *ResourceStore {
+	return &ResourceStore{
+		topics:        []admin.TopicInfo{},
+		subscriptions: []admin.SubscriptionInfo{},
+		snapshots:     []admin.SnapshotInfo{},
+	}
+}
+
+// Topics returns a copy of the cached topics
+func (s *ResourceStore) Topics() []admin.TopicInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]admin.TopicInfo, len(s.topics))
+	copy(result, s.topics)
+	return result
+}
+
+// Subscriptions returns a copy of the cached subscriptions
+func (s *ResourceStore) Subscriptions() []admin.SubscriptionInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]admin.SubscriptionInfo, len(s.subscriptions))
+	copy(result, s.subscriptions)
+	return result
+}
+
+// Snapshots returns a copy of the cached snapshots
+func (s *ResourceStore) Snapshots() []admin.SnapshotInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]admin.SnapshotInfo, len(s.snapshots))
+	copy(result, s.snapshots)
+	return result
+}
+
+// SetTopics replaces the cached topics with a copy of topics
+func (s *ResourceStore) SetTopics(topics []admin.TopicInfo) {
+	stored := make([]admin.TopicInfo, len(topics))
+	copy(stored, topics)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.topics = stored
+}
+
+// SetSubscriptions replaces the cached subscriptions with a copy of subscriptions
+func (s *ResourceStore) SetSubscriptions(subscriptions []admin.SubscriptionInfo) {
+	stored := make([]admin.SubscriptionInfo, len(subscriptions))
+	copy(stored, subscriptions)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions = stored
+}
+
+// SetSnapshots replaces the cached snapshots with a copy of snapshots
+func (s *ResourceStore) SetSnapshots(snapshots []admin.SnapshotInfo) {
+	stored := make([]admin.SnapshotInfo, len(snapshots))
+	copy(stored, snapshots)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots = stored
+}
+
+// FindSubscription returns a cached subscription by short ID or full resource name
+func (s *ResourceStore) FindSubscription(subscriptionID string) (admin.SubscriptionInfo, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, sub := range s.subscriptions {
+		if sub.DisplayName == subscriptionID || sub.Name == subscriptionID {
+			return sub, true
+		}
+	}
+	return admin.SubscriptionInfo{}, false
+}
+
+// Counts returns topic and subscription counts of the cached resources
+func (s *ResourceStore) Counts() ResourceCounts {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return countResources(s.topics, s.subscriptions)
+}
+
+// Clear drops all cached resources
+func (s *ResourceStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.topics = []admin.TopicInfo{}
+	s.subscriptions = []admin.SubscriptionInfo{}
+	s.snapshots = []admin.SnapshotInfo{}
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
+	"pubsub-gui/internal/pubsub/subscriber"
+)
+
+func TestResourceStore_ReturnsCopies(t *testing.T) {
+	store := NewResourceStore()
+	subs := []admin.SubscriptionInfo{{Name: "projects/p/subscriptions/a", DisplayName: "a", AckDeadline: 10}}
+	store.SetSubscriptions(subs)
+
+	// Mutating the caller's slice or a returned slice must not change the store
+	subs[0].AckDeadline = 99
+	got := store.Subscriptions()
+	got[0].AckDeadline = 42
+
+	sub, ok := store.FindSubscription("a")
+	if !ok {
+		t.Fatal("FindSubscription(a) ok = false, want true")
+	}
+	if sub.AckDeadline != 10 {
+		t.Errorf("AckDeadline = %d, want 10", sub.AckDeadline)
+	}
+	if _, ok := store.FindSubscription("projects/p/subscriptions/a"); !ok {
+		t.Error("FindSubscription(full name) ok = false, want true")
+	}
+
+	store.SetSnapshots([]admin.SnapshotInfo{{Name: "projects/p/snapshots/s", DisplayName: "s"}})
+	if n := len(store.Snapshots()); n != 1 {
+		t.Errorf("len(Snapshots()) = %d, want 1", n)
+	}
+
+	store.Clear()
+	if n := len(store.Subscriptions()); n != 0 {
+		t.Errorf("len(Subscriptions()) after Clear = %d, want 0", n)
+	}
+	if n := len(store.Snapshots()); n != 0 {
+		t.Errorf("len(Snapshots()) after Clear = %d, want 0", n)
+	}
+}
+
+// TestResourceStore_ConcurrentSyncAndMonitor exercises sync writes racing with monitor lookups
+// Run with -race to detect unsynchronized access to the shared store
+func TestResourceStore_ConcurrentSyncAndMonitor(t *testing.T) {
+	ctx := context.Background()
+	clientManager := auth.NewClientManager(ctx)
+	store := NewResourceStore()
+
+	resources := NewResourceHandler(ctx, clientManager, store)
+	var monitorsMu sync.RWMutex
+	monitoring := NewMonitoringHandler(
+		ctx,
+		models.NewDefaultConfig(),
+		clientManager,
+		make(map[string]*subscriber.MessageStreamer),
+		make(map[string]string),
+		&monitorsMu,
+		store,
+	)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				subs := make([]admin.SubscriptionInfo, 0, i%5)
+				for j := 0; j < i%5; j++ {
+					subs = append(subs, admin.SubscriptionInfo{
+						Name:             fmt.Sprintf("projects/p/subscriptions/ps-gui-mon-orders-%d-%d", w, j),
+						DisplayName:      fmt.Sprintf("ps-gui-mon-orders-%d-%d", w, j),
+						Topic:            "projects/p/topics/orders",
+						SubscriptionType: "pull",
+						AckDeadline:      j,
+					})
+				}
+				store.SetSubscriptions(subs)
+				store.SetTopics([]admin.TopicInfo{{Name: "projects/p/topics/orders", DisplayName: "orders"}})
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				_, _ = monitoring.findExistingMonitoringSubscription("orders")
+				_ = monitoring.cachedAckDeadline("ps-gui-mon-orders-0-1")
+				_, _ = resources.ListSubscriptions()
+				_ = resources.GetResourceCounts()
+			}
+		}()
+	}
+	wg.Wait()
+}
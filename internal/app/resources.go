@@ -3,8 +3,11 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +17,7 @@ import (
 	"pubsub-gui/internal/logger"
 	"pubsub-gui/internal/models"
 	"pubsub-gui/internal/pubsub/admin"
+	"pubsub-gui/internal/pubsub/metrics"
 )
 
 // SubscriptionUpdateParams represents parameters for updating a subscription
@@ -23,35 +27,60 @@ type SubscriptionUpdateParams struct {
 	Filter            *string                     `json:"filter,omitempty"`
 	DeadLetterPolicy  *admin.DeadLetterPolicyInfo `json:"deadLetterPolicy,omitempty"`
 	PushEndpoint      *string                     `json:"pushEndpoint,omitempty"`
+	OIDCToken         *models.OIDCToken           `json:"oidcToken,omitempty"` // Push authentication; an empty service account removes it
 	SubscriptionType  *string                     `json:"subscriptionType,omitempty"`
+	RetainAcked       *bool                       `json:"retainAckedMessages,omitempty"`
+	RetryPolicy       *models.RetryPolicy         `json:"retryPolicy,omitempty"`   // Empty backoffs remove the policy
+	ExpirationTTL     *string                     `json:"expirationTtl,omitempty"` // Empty string means never expire
+}
+
+// ReplayResult describes the outcome of a ReplayLast operation
+type ReplayResult struct {
+	SubscriptionID      string   `json:"subscriptionId"`
+	SeekTime            string   `json:"seekTime"`            // RFC3339 timestamp the subscription was sought to
+	Window              string   `json:"window"`              // Effective replay window (may be clamped to retention)
+	EnabledRetainAcked  bool     `json:"enabledRetainAcked"`  // True if retain_acked_messages was enabled by this call
+	ExpectedRedelivered int64    `json:"expectedRedelivered"` // Estimated messages to be redelivered
+	EstimateAvailable   bool     `json:"estimateAvailable"`   // False when metrics are unavailable (e.g., emulator)
+	Notes               []string `json:"notes,omitempty"`
 }
 
 // ResourceHandler handles topic and subscription resource management
 type ResourceHandler struct {
 	ctx               context.Context
 	clientManager     *auth.ClientManager
-	resourceMu        *sync.RWMutex
-	topics            *[]admin.TopicInfo
-	subscriptions     *[]admin.SubscriptionInfo
-	syncMu            sync.Mutex // Prevents concurrent sync operations
-	syncing           bool       // Tracks if sync is in progress
+	store             *ResourceStore
+	syncMu            sync.Mutex         // Guards syncGeneration and syncCancel, and serializes store updates from syncs
+	syncGeneration    uint64             // Incremented by every sync; only the latest may update the store
+	syncCancel        context.CancelFunc // Cancels the in-flight sync when a newer one starts
 	isEmulatorEnabled func() bool
+	apiTimeout        func() time.Duration // Configured admin call timeout; 0 keeps the default sync timeout
+	subscriptionLinks *SubscriptionLinkCache
+	emitEvent         func(eventName string, data interface{}) // Emits the events of a sync (runtime.EventsEmit outside tests)
 }
 
+// syncEvent is an event of a committed sync, emitted once syncMu is released
+type syncEvent struct {
+	name string
+	data interface{}
+}
+
+// defaultSyncTimeout bounds a resource sync when no API timeout is configured
+const defaultSyncTimeout = 15 * time.Second
+
 // NewResourceHandler creates a new resource handler
 func NewResourceHandler(
 	ctx context.Context,
 	clientManager *auth.ClientManager,
-	resourceMu *sync.RWMutex,
-	topics *[]admin.TopicInfo,
-	subscriptions *[]admin.SubscriptionInfo,
+	store *ResourceStore,
 ) *ResourceHandler {
 	return &ResourceHandler{
 		ctx:           ctx,
 		clientManager: clientManager,
-		resourceMu:    resourceMu,
-		topics:        topics,
-		subscriptions: subscriptions,
+		store:         store,
+		emitEvent: func(eventName string, data interface{}) {
+			runtime.EventsEmit(ctx, eventName, data)
+		},
 	}
 }
 
@@ -60,6 +89,16 @@ func (h *ResourceHandler) SetEmulatorCheckFunc(fn func() bool) {
 	h.isEmulatorEnabled = fn
 }
 
+// SetAPITimeoutFunc sets the function returning the configured admin call timeout, which also bounds syncs
+func (h *ResourceHandler) SetAPITimeoutFunc(fn func() time.Duration) {
+	h.apiTimeout = fn
+}
+
+// SetSubscriptionLinkCache sets the cache refreshed with subscription→topic links on each sync
+func (h *ResourceHandler) SetSubscriptionLinkCache(cache *SubscriptionLinkCache) {
+	h.subscriptionLinks = cache
+}
+
 // SyncResources manually triggers a resource sync (exposed for frontend refresh button)
 func (h *ResourceHandler) SyncResources() error {
 	if !h.clientManager.IsConnected() {
@@ -69,25 +108,58 @@ func (h *ResourceHandler) SyncResources() error {
 	return nil
 }
 
-// syncResources fetches topics and subscriptions from GCP in parallel and updates the local store
-// Emits a resources:updated event to notify the frontend
-// Uses a background context with timeout to prevent cancellation issues
-func (h *ResourceHandler) syncResources() {
-	// Prevent concurrent sync operations
+// startSync cancels the in-flight sync, if any, and starts a new sync generation with its own context
+func (h *ResourceHandler) startSync(timeout time.Duration) (context.Context, context.CancelFunc, uint64) {
 	h.syncMu.Lock()
-	if h.syncing {
-		h.syncMu.Unlock()
-		return // Skip if sync is already in progress
+	defer h.syncMu.Unlock()
+
+	if h.syncCancel != nil {
+		h.syncCancel()
 	}
-	h.syncing = true
-	h.syncMu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	h.syncGeneration++
+	h.syncCancel = cancel
+	return ctx, cancel, h.syncGeneration
+}
 
-	defer func() {
-		h.syncMu.Lock()
-		h.syncing = false
-		h.syncMu.Unlock()
-	}()
+// CancelSync cancels the in-flight sync, if any, and keeps it from updating the store or emitting events
+// Called before the store is cleared (disconnect, project switch) so a late sync cannot repopulate it.
+func (h *ResourceHandler) CancelSync() {
+	h.syncMu.Lock()
+	defer h.syncMu.Unlock()
 
+	h.syncGeneration++
+	if h.syncCancel != nil {
+		h.syncCancel()
+		h.syncCancel = nil
+	}
+}
+
+// isLatestSync reports whether no newer sync has started since generation
+func (h *ResourceHandler) isLatestSync(generation uint64) bool {
+	h.syncMu.Lock()
+	defer h.syncMu.Unlock()
+	return generation == h.syncGeneration
+}
+
+// commitSync runs apply while holding syncMu if generation is still the latest sync
+// Returns false without calling apply when a newer sync has started, so a slow older sync never overwrites newer results.
+func (h *ResourceHandler) commitSync(generation uint64, apply func()) bool {
+	h.syncMu.Lock()
+	defer h.syncMu.Unlock()
+	if generation != h.syncGeneration {
+		return false
+	}
+	apply()
+	return true
+}
+
+// syncResources fetches topics, subscriptions and snapshots from GCP in parallel and updates the local store
+// Emits resources:updated and snapshots:updated events to notify the frontend
+// Uses a background context with timeout to prevent cancellation issues
+// A newer sync or CancelSync cancels this one; only the latest sync updates the store and emits events,
+// which are emitted after the store update so no lock is held while the frontend is notified.
+func (h *ResourceHandler) syncResources() {
 	client := h.clientManager.GetClient()
 	if client == nil {
 		return
@@ -100,17 +172,23 @@ func (h *ResourceHandler) syncResources() {
 
 	// Use a background context with timeout for sync operations
 	// This prevents cancellation from app lifecycle events (disconnect, shutdown)
-	// Use a shorter timeout (15 seconds) - if emulator is unresponsive, fail fast and don't block
-	syncCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	// Use a shorter timeout (15 seconds) unless an API timeout is configured - if emulator is unresponsive,
+	// fail fast and don't block
+	syncTimeout := defaultSyncTimeout
+	if h.apiTimeout != nil && h.apiTimeout() > 0 {
+		syncTimeout = h.apiTimeout()
+	}
+	syncCtx, cancel, generation := h.startSync(syncTimeout)
 	defer cancel()
 
-	// Fetch topics and subscriptions in parallel
+	// Fetch topics, subscriptions and snapshots in parallel
 	var topics []admin.TopicInfo
 	var subscriptions []admin.SubscriptionInfo
-	var topicsErr, subsErr error
+	var snapshots []admin.SnapshotInfo
+	var topicsErr, subsErr, snapshotsErr error
 
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
@@ -122,7 +200,19 @@ func (h *ResourceHandler) syncResources() {
 		subscriptions, subsErr = admin.ListSubscriptionsAdmin(syncCtx, client, projectID)
 	}()
 
+	go func() {
+		defer wg.Done()
+		snapshots, snapshotsErr = admin.ListSnapshotsAdmin(syncCtx, client, projectID)
+	}()
+
 	wg.Wait()
+	if !h.isLatestSync(generation) {
+		logger.Debug("Resource sync superseded by a newer sync", "generation", generation)
+		return
+	}
+	topicsErr = admin.TimeoutError(topicsErr, syncTimeout)
+	subsErr = admin.TimeoutError(subsErr, syncTimeout)
+	snapshotsErr = admin.TimeoutError(snapshotsErr, syncTimeout)
 
 	// Check if we're using emulator (for more lenient error handling)
 	// Uses the callback if set, falls back to env var check for backward compatibility
@@ -172,71 +262,219 @@ func (h *ResourceHandler) syncResources() {
 		}
 	}
 
-	// Update local store with successful fetches only
-	h.resourceMu.Lock()
-	if topicsErr == nil {
-		*h.topics = topics
-	}
-	if subsErr == nil {
-		*h.subscriptions = subscriptions
+	// Snapshots are secondary; a failure is logged but does not surface as a sync error
+	if snapshotsErr != nil {
+		logger.Warn("Error syncing snapshots", "error", snapshotsErr)
 	}
-	h.resourceMu.Unlock()
 
-	// Emit event to frontend with updated resources (only include successful fetches)
-	updatePayload := make(map[string]interface{})
-	if topicsErr == nil {
-		updatePayload["topics"] = topics
-	}
-	if subsErr == nil {
-		updatePayload["subscriptions"] = subscriptions
+	// A newer sync may have started while results were processed; it owns the store from then on
+	var events []syncEvent
+	committed := h.commitSync(generation, func() {
+		// Update local store with successful fetches only
+		if topicsErr == nil {
+			admin.RecordTopicsExist(client, projectID, topics)
+		}
+
+		if topicsErr == nil {
+			h.store.SetTopics(topics)
+		}
+		if subsErr == nil {
+			h.store.SetSubscriptions(subscriptions)
+		}
+		if snapshotsErr == nil {
+			h.store.SetSnapshots(snapshots)
+		}
+		counts := h.store.Counts()
+
+		if subsErr == nil && h.subscriptionLinks != nil {
+			h.subscriptionLinks.Update(subscriptions)
+		}
+
+		// Emit event to frontend with updated resources (only include successful fetches)
+		updatePayload := make(map[string]interface{})
+		if topicsErr == nil {
+			updatePayload["topics"] = topics
+		}
+		if subsErr == nil {
+			updatePayload["subscriptions"] = subscriptions
+		}
+		updatePayload["counts"] = counts
+
+		// Only emit update event if we have at least one successful fetch
+		if topicsErr == nil || subsErr == nil {
+			events = append(events, syncEvent{name: "resources:updated", data: updatePayload})
+		}
+
+		if snapshotsErr == nil {
+			if snapshots == nil {
+				snapshots = []admin.SnapshotInfo{}
+			}
+			events = append(events, syncEvent{name: "snapshots:updated", data: map[string]interface{}{
+				"snapshots": snapshots,
+			}})
+		}
+
+		// Emit error event if any failures occurred
+		if hasErrors {
+			events = append(events, syncEvent{name: "resources:sync-error", data: map[string]interface{}{
+				"errors": errorDetails,
+			}})
+		}
+	})
+	if !committed {
+		logger.Debug("Resource sync superseded by a newer sync", "generation", generation)
+		return
 	}
 
-	// Only emit update event if we have at least one successful fetch
-	// Use original context for event emission (Wails requires it)
-	if len(updatePayload) > 0 {
-		runtime.EventsEmit(h.ctx, "resources:updated", updatePayload)
+	for _, event := range events {
+		h.emitEvent(event.name, event.data)
 	}
+}
 
-	// Emit error event if any failures occurred
-	if hasErrors {
-		runtime.EventsEmit(h.ctx, "resources:sync-error", map[string]interface{}{
-			"errors": errorDetails,
-		})
+// ResourceCounts summarizes the cached store for sidebar badges
+type ResourceCounts struct {
+	Topics                    int `json:"topics"`
+	PullSubscriptions         int `json:"pullSubscriptions"`
+	PushSubscriptions         int `json:"pushSubscriptions"`
+	BigQuerySubscriptions     int `json:"bigQuerySubscriptions"`
+	CloudStorageSubscriptions int `json:"cloudStorageSubscriptions"`
+	DetachedSubscriptions     int `json:"detachedSubscriptions"` // Also counted under their delivery type
+}
+
+// countResources computes badge counts from topics and subscriptions
+func countResources(topics []admin.TopicInfo, subscriptions []admin.SubscriptionInfo) ResourceCounts {
+	counts := ResourceCounts{Topics: len(topics)}
+	for _, sub := range subscriptions {
+		switch sub.SubscriptionType {
+		case "push":
+			counts.PushSubscriptions++
+		case "bigquery":
+			counts.BigQuerySubscriptions++
+		case "cloudstorage":
+			counts.CloudStorageSubscriptions++
+		default:
+			counts.PullSubscriptions++
+		}
+		if sub.Detached {
+			counts.DetachedSubscriptions++
+		}
 	}
+	return counts
+}
+
+// GetResourceCounts returns topic and subscription counts from the cached store
+func (h *ResourceHandler) GetResourceCounts() ResourceCounts {
+	return h.store.Counts()
 }
 
 // ListTopics returns all topics in the connected project (from cached store)
 func (h *ResourceHandler) ListTopics() ([]admin.TopicInfo, error) {
-	h.resourceMu.RLock()
-	defer h.resourceMu.RUnlock()
+	return h.store.Topics(), nil
+}
+
+// ListSubscriptions returns all subscriptions in the connected project (from cached store)
+func (h *ResourceHandler) ListSubscriptions() ([]admin.SubscriptionInfo, error) {
+	return h.store.Subscriptions(), nil
+}
 
-	// Return cached topics if available
-	if *h.topics != nil {
-		// Return a copy to prevent external modification
-		result := make([]admin.TopicInfo, len(*h.topics))
-		copy(result, *h.topics)
-		return result, nil
+// ListTopicsPage lists one page of topics straight from the API, keeping those whose short ID starts with prefix
+// The prefix is applied per page, so a page may hold fewer items than pageSize (even none) while more pages follow.
+func (h *ResourceHandler) ListTopicsPage(pageSize int, pageToken, prefix string) (admin.TopicsPage, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return admin.TopicsPage{}, models.ErrNotConnected
 	}
 
-	// Fallback to empty array if not synced yet
-	return []admin.TopicInfo{}, nil
+	page, err := admin.ListTopicsPage(h.ctx, client, h.clientManager.GetProjectID(), pageSize, pageToken)
+	if err != nil {
+		return admin.TopicsPage{}, fmt.Errorf("failed to list topics: %w", err)
+	}
+	if prefix != "" {
+		filtered := make([]admin.TopicInfo, 0, len(page.Topics))
+		for _, topic := range page.Topics {
+			if strings.HasPrefix(topic.DisplayName, prefix) {
+				filtered = append(filtered, topic)
+			}
+		}
+		page.Topics = filtered
+	}
+	return page, nil
 }
 
-// ListSubscriptions returns all subscriptions in the connected project (from cached store)
-func (h *ResourceHandler) ListSubscriptions() ([]admin.SubscriptionInfo, error) {
-	h.resourceMu.RLock()
-	defer h.resourceMu.RUnlock()
+// ListSubscriptionsPage lists one page of subscriptions straight from the API, keeping those whose short ID
+// starts with prefix; like ListTopicsPage, the prefix is applied per page
+func (h *ResourceHandler) ListSubscriptionsPage(pageSize int, pageToken, prefix string) (admin.SubscriptionsPage, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return admin.SubscriptionsPage{}, models.ErrNotConnected
+	}
 
-	// Return cached subscriptions if available
-	if *h.subscriptions != nil {
-		// Return a copy to prevent external modification
-		result := make([]admin.SubscriptionInfo, len(*h.subscriptions))
-		copy(result, *h.subscriptions)
-		return result, nil
+	page, err := admin.ListSubscriptionsPage(h.ctx, client, h.clientManager.GetProjectID(), pageSize, pageToken)
+	if err != nil {
+		return admin.SubscriptionsPage{}, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+	if prefix != "" {
+		filtered := make([]admin.SubscriptionInfo, 0, len(page.Subscriptions))
+		for _, sub := range page.Subscriptions {
+			if strings.HasPrefix(sub.DisplayName, prefix) {
+				filtered = append(filtered, sub)
+			}
+		}
+		page.Subscriptions = filtered
+	}
+	return page, nil
+}
+
+// ExportCatalog writes a JSON catalog of all cached topics and subscriptions with their full configs to outPath
+func (h *ResourceHandler) ExportCatalog(outPath, appVersion string) error {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return models.ErrNotConnected
 	}
 
-	// Fallback to empty array if not synced yet
-	return []admin.SubscriptionInfo{}, nil
+	if outPath == "" {
+		return fmt.Errorf("output path cannot be empty")
+	}
+
+	topics, _ := h.ListTopics()
+	subscriptions, _ := h.ListSubscriptions()
+
+	catalog := admin.BuildCatalog(h.ctx, client, h.clientManager.GetProjectID(), topics, subscriptions)
+	catalog.Header.AppVersion = appVersion
+
+	data, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal catalog: %w", err)
+	}
+
+	if err := os.WriteFile(outPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write catalog: %w", err)
+	}
+
+	return nil
+}
+
+// ResourceName is the short ID and fully-qualified name of a resource
+type ResourceName struct {
+	Short string `json:"short"` // e.g. "orders"
+	Full  string `json:"full"`  // e.g. "projects/my-project/topics/orders"
+}
+
+// ResolveResourceName returns the canonical short and full names of a resource in the connected project
+// resourceType is "topic", "subscription", "snapshot" or "schema"; name may be short or fully-qualified
+func (h *ResourceHandler) ResolveResourceName(resourceType, name string) (ResourceName, error) {
+	if h.clientManager.GetClient() == nil {
+		return ResourceName{}, models.ErrNotConnected
+	}
+	if strings.TrimSpace(name) == "" {
+		return ResourceName{}, fmt.Errorf("resource name is required")
+	}
+
+	short, full := admin.NormalizeName(h.clientManager.GetProjectID(), resourceType, strings.TrimSpace(name))
+	if full == "" {
+		return ResourceName{}, fmt.Errorf("unknown resource type: %s", resourceType)
+	}
+	return ResourceName{Short: short, Full: full}, nil
 }
 
 // GetTopicMetadata retrieves metadata for a specific topic
@@ -261,15 +499,15 @@ func (h *ResourceHandler) GetSubscriptionMetadata(subID string) (admin.Subscript
 	return admin.GetSubscriptionMetadataAdmin(h.ctx, client, projectID, subID)
 }
 
-// CreateTopic creates a new topic with optional message retention duration
-func (h *ResourceHandler) CreateTopic(topicID string, messageRetentionDuration string, syncResources func()) error {
+// CreateTopic creates a new topic with optional message retention duration, labels and schema
+func (h *ResourceHandler) CreateTopic(topicID string, messageRetentionDuration string, labels map[string]string, schemaSettings *admin.SchemaSettings, syncResources func()) error {
 	client := h.clientManager.GetClient()
 	if client == nil {
 		return models.ErrNotConnected
 	}
 
 	projectID := h.clientManager.GetProjectID()
-	err := admin.CreateTopicAdmin(h.ctx, client, projectID, topicID, messageRetentionDuration)
+	err := admin.CreateTopicAdmin(h.ctx, client, projectID, topicID, messageRetentionDuration, labels, schemaSettings)
 	if err != nil {
 		return err
 	}
@@ -287,6 +525,32 @@ func (h *ResourceHandler) CreateTopic(topicID string, messageRetentionDuration s
 	return nil
 }
 
+// UpdateTopic updates a topic's labels and/or message retention duration
+func (h *ResourceHandler) UpdateTopic(topicID string, params admin.TopicUpdateParams, syncResources func()) error {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return models.ErrNotConnected
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	err := admin.UpdateTopicAdmin(h.ctx, client, projectID, topicID, params)
+	if err != nil {
+		return err
+	}
+
+	// Trigger background sync to update local store
+	if syncResources != nil {
+		go syncResources()
+	}
+
+	// Emit event for frontend to refresh
+	runtime.EventsEmit(h.ctx, "topic:updated", map[string]interface{}{
+		"topicID": topicID,
+	})
+
+	return nil
+}
+
 // DeleteTopic deletes a topic
 func (h *ResourceHandler) DeleteTopic(topicID string, syncResources func()) error {
 	client := h.clientManager.GetClient()
@@ -340,6 +604,63 @@ func (h *ResourceHandler) CreateSubscription(topicID string, subID string, ttlSe
 	return nil
 }
 
+// CloneSubscription creates newSubID on the same topic as sourceSubID with its configuration and overrides applied
+func (h *ResourceHandler) CloneSubscription(sourceSubID, newSubID string, overrides admin.SubscriptionUpdateParams, syncResources func()) error {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return models.ErrNotConnected
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	if err := admin.CloneSubscriptionAdmin(h.ctx, client, projectID, sourceSubID, newSubID, overrides); err != nil {
+		return err
+	}
+
+	// Trigger background sync to update local store
+	if syncResources != nil {
+		go syncResources()
+	}
+
+	runtime.EventsEmit(h.ctx, "subscription:created", map[string]interface{}{
+		"subscriptionID": newSubID,
+	})
+
+	return nil
+}
+
+// EnsureTopicAndSubscription creates a topic and subscription if missing and reports what was created
+// Existing subscriptions are not modified; config differences are returned as warnings
+func (h *ResourceHandler) EnsureTopicAndSubscription(topicID, subID string, config admin.SubscriptionConfig, syncResources func()) (*admin.EnsureResult, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return nil, models.ErrNotConnected
+	}
+
+	result, err := admin.EnsureTopicAndSubscriptionAdmin(h.ctx, client, h.clientManager.GetProjectID(), topicID, subID, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.TopicCreated {
+		runtime.EventsEmit(h.ctx, "topic:created", map[string]interface{}{
+			"topicID": result.TopicID,
+		})
+	}
+	if result.SubscriptionCreated {
+		runtime.EventsEmit(h.ctx, "subscription:created", map[string]interface{}{
+			"subscriptionID": result.SubscriptionID,
+		})
+	}
+	if (result.TopicCreated || result.SubscriptionCreated) && syncResources != nil {
+		go syncResources()
+	}
+	for _, warning := range result.Warnings {
+		logger.Warn("Existing subscription differs from requested config", "subscriptionID", result.SubscriptionID, "difference", warning)
+	}
+
+	return result, nil
+}
+
 // DeleteSubscription deletes a subscription
 func (h *ResourceHandler) DeleteSubscription(subID string, syncResources func()) error {
 	client := h.clientManager.GetClient()
@@ -366,6 +687,30 @@ func (h *ResourceHandler) DeleteSubscription(subID string, syncResources func())
 	return nil
 }
 
+// DetachSubscription detaches a subscription from its topic, keeping the subscription
+func (h *ResourceHandler) DetachSubscription(subID string, syncResources func()) error {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return models.ErrNotConnected
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	if err := admin.DetachSubscriptionAdmin(h.ctx, client, projectID, subID); err != nil {
+		return err
+	}
+
+	// Trigger background sync so the detached flag reaches the store
+	if syncResources != nil {
+		go syncResources()
+	}
+
+	runtime.EventsEmit(h.ctx, "subscription:detached", map[string]interface{}{
+		"subscriptionID": subID,
+	})
+
+	return nil
+}
+
 // UpdateSubscription updates a subscription's configuration
 func (h *ResourceHandler) UpdateSubscription(subID string, params SubscriptionUpdateParams, syncResources func()) error {
 	client := h.clientManager.GetClient()
@@ -381,7 +726,11 @@ func (h *ResourceHandler) UpdateSubscription(subID string, params SubscriptionUp
 		RetentionDuration: params.RetentionDuration,
 		Filter:            params.Filter,
 		PushEndpoint:      params.PushEndpoint,
+		OIDCToken:         params.OIDCToken,
 		SubscriptionType:  params.SubscriptionType,
+		RetainAcked:       params.RetainAcked,
+		RetryPolicy:       params.RetryPolicy,
+		ExpirationTTL:     params.ExpirationTTL,
 	}
 	if params.DeadLetterPolicy != nil {
 		adminParams.DeadLetterPolicy = params.DeadLetterPolicy
@@ -405,10 +754,42 @@ func (h *ResourceHandler) UpdateSubscription(subID string, params SubscriptionUp
 	return nil
 }
 
-// SeekToTimestamp seeks a subscription to a specific timestamp.
+// PurgeSubscription discards every message currently in a subscription's backlog by seeking it to now
+// Emits "subscription:purged" with the seek time.
+func (h *ResourceHandler) PurgeSubscription(subscriptionID string, syncResources func()) error {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return models.ErrNotConnected
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	purgedAt, err := admin.PurgeSubscriptionAdmin(h.ctx, client, projectID, subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	if syncResources != nil {
+		go syncResources()
+	}
+
+	runtime.EventsEmit(h.ctx, "subscription:purged", map[string]interface{}{
+		"subscriptionID": subscriptionID,
+		"purgedAt":       purgedAt.Format(time.RFC3339Nano),
+	})
+
+	return nil
+}
+
+// emitSeeked emits a seek event under its current name and the older "subscription:sought" name
+func (h *ResourceHandler) emitSeeked(data map[string]interface{}) {
+	runtime.EventsEmit(h.ctx, "subscription:seeked", data)
+	runtime.EventsEmit(h.ctx, "subscription:sought", data)
+}
+
+// SeekSubscription seeks a subscription to a specific timestamp.
 // Messages published after the timestamp will be redelivered.
 // The timestamp should be in RFC3339 format (e.g., "2024-01-15T10:30:00Z").
-func (h *ResourceHandler) SeekToTimestamp(subscriptionID, timestamp string, syncResources func()) error {
+func (h *ResourceHandler) SeekSubscription(subscriptionID, timestamp string, syncResources func()) error {
 	client := h.clientManager.GetClient()
 	if client == nil {
 		return models.ErrNotConnected
@@ -421,7 +802,7 @@ func (h *ResourceHandler) SeekToTimestamp(subscriptionID, timestamp string, sync
 	}
 
 	projectID := h.clientManager.GetProjectID()
-	err = admin.SeekToTimestampAdmin(h.ctx, client, projectID, subscriptionID, t)
+	err = admin.SeekSubscriptionToTime(h.ctx, client, projectID, subscriptionID, t)
 	if err != nil {
 		return err
 	}
@@ -432,7 +813,7 @@ func (h *ResourceHandler) SeekToTimestamp(subscriptionID, timestamp string, sync
 	}
 
 	// Emit event for frontend
-	runtime.EventsEmit(h.ctx, "subscription:sought", map[string]interface{}{
+	h.emitSeeked(map[string]interface{}{
 		"subscriptionID": subscriptionID,
 		"seekType":       "timestamp",
 		"timestamp":      timestamp,
@@ -441,6 +822,121 @@ func (h *ResourceHandler) SeekToTimestamp(subscriptionID, timestamp string, sync
 	return nil
 }
 
+// ReplayLast redelivers the messages of the last duration (e.g., "10m") on a subscription
+// Acked messages can only be replayed when retain_acked_messages is enabled; if it is not,
+// the user is asked to enable it before seeking. The window is clamped to the retention duration.
+func (h *ResourceHandler) ReplayLast(subscriptionID, duration string, syncResources func()) (*ReplayResult, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return nil, models.ErrNotConnected
+	}
+
+	window, err := time.ParseDuration(duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration format (e.g., '10m', '1h'): %w", err)
+	}
+	if window <= 0 {
+		return nil, fmt.Errorf("duration must be positive")
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	subInfo, err := admin.GetSubscriptionMetadataAdmin(h.ctx, client, projectID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if subInfo.SubscriptionType == "push" {
+		return nil, fmt.Errorf("cannot replay push subscription %s; seek is only supported for pull subscriptions", subscriptionID)
+	}
+
+	result := &ReplayResult{SubscriptionID: subscriptionID}
+
+	if !subInfo.RetainAcked {
+		answer, err := runtime.MessageDialog(h.ctx, runtime.MessageDialogOptions{
+			Type:          runtime.QuestionDialog,
+			Title:         "Enable retain acked messages?",
+			Message:       fmt.Sprintf("Replaying acknowledged messages requires retain_acked_messages on %s. Enable it now? Messages acknowledged before enabling cannot be replayed.", subscriptionID),
+			Buttons:       []string{"Yes", "No"},
+			DefaultButton: "Yes",
+			CancelButton:  "No",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to confirm enabling retain acked messages: %w", err)
+		}
+		if answer != "Yes" {
+			return nil, fmt.Errorf("replay cancelled: retain_acked_messages is disabled on %s", subscriptionID)
+		}
+
+		enabled := true
+		if err := admin.UpdateSubscriptionAdmin(h.ctx, client, projectID, subscriptionID, admin.SubscriptionUpdateParams{RetainAcked: &enabled}); err != nil {
+			return nil, fmt.Errorf("failed to enable retain acked messages: %w", err)
+		}
+		result.EnabledRetainAcked = true
+		result.Notes = append(result.Notes, "retain_acked_messages was just enabled; only unacknowledged messages in the window will be redelivered this time")
+	}
+
+	// Messages older than the retention duration are gone, so clamp the window
+	if retention, err := time.ParseDuration(subInfo.RetentionDuration); err == nil && retention > 0 && window > retention {
+		window = retention
+		result.Notes = append(result.Notes, fmt.Sprintf("window clamped to the subscription's retention duration (%s)", retention))
+	}
+	result.Window = window.String()
+
+	seekTime := time.Now().Add(-window)
+	if err := admin.SeekSubscriptionToTime(h.ctx, client, projectID, subscriptionID, seekTime); err != nil {
+		return nil, err
+	}
+	result.SeekTime = seekTime.Format(time.RFC3339)
+
+	h.estimateReplayCount(subscriptionID, window, result)
+
+	if syncResources != nil {
+		go syncResources()
+	}
+
+	h.emitSeeked(map[string]interface{}{
+		"subscriptionID": subscriptionID,
+		"seekType":       "timestamp",
+		"timestamp":      result.SeekTime,
+	})
+
+	return result, nil
+}
+
+// estimateReplayCount fills in the expected number of redelivered messages using Cloud Monitoring
+// The estimate is the current backlog plus the messages acknowledged within the window (when retained)
+func (h *ResourceHandler) estimateReplayCount(subscriptionID string, window time.Duration, result *ReplayResult) {
+	opts, ok := h.clientManager.GetCredentialOptions()
+	if !ok {
+		result.Notes = append(result.Notes, "redelivery estimate is not available for emulator connections")
+		return
+	}
+
+	svc, err := metrics.NewService(h.ctx, opts...)
+	if err != nil {
+		logger.Warn("Failed to create monitoring client for replay estimate", "error", err)
+		return
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	backlog, err := metrics.GetSubscriptionBacklog(h.ctx, svc, projectID, subscriptionID)
+	if err != nil && !errors.Is(err, metrics.ErrNoData) {
+		logger.Warn("Failed to get backlog for replay estimate", "subscriptionID", subscriptionID, "error", err)
+		return
+	}
+
+	var acked int64
+	if !result.EnabledRetainAcked {
+		acked, err = metrics.GetSubscriptionAckCount(h.ctx, svc, projectID, subscriptionID, window)
+		if err != nil && !errors.Is(err, metrics.ErrNoData) {
+			logger.Warn("Failed to get ack count for replay estimate", "subscriptionID", subscriptionID, "error", err)
+			return
+		}
+	}
+
+	result.ExpectedRedelivered = backlog + acked
+	result.EstimateAvailable = true
+}
+
 // SeekToSnapshot seeks a subscription to a snapshot.
 // Messages in the snapshot will be redelivered.
 func (h *ResourceHandler) SeekToSnapshot(subscriptionID, snapshotID string, syncResources func()) error {
@@ -450,13 +946,13 @@ func (h *ResourceHandler) SeekToSnapshot(subscriptionID, snapshotID string, sync
 	}
 
 	projectID := h.clientManager.GetProjectID()
-	err := admin.SeekToSnapshotAdmin(h.ctx, client, projectID, subscriptionID, snapshotID)
+	err := admin.SeekSubscriptionToSnapshot(h.ctx, client, projectID, subscriptionID, snapshotID)
 	if err != nil {
 		return err
 	}
 
 	// Emit event for frontend
-	runtime.EventsEmit(h.ctx, "subscription:sought", map[string]interface{}{
+	h.emitSeeked(map[string]interface{}{
 		"subscriptionID": subscriptionID,
 		"seekType":       "snapshot",
 		"snapshotID":     snapshotID,
@@ -0,0 +1,205 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/pubsub/admin"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestResourceHandler_SyncOutOfOrderCompletion(t *testing.T) {
+	h := &ResourceHandler{}
+
+	olderCtx, olderCancel, older := h.startSync(time.Minute)
+	defer olderCancel()
+	newerCtx, newerCancel, newer := h.startSync(time.Minute)
+	defer newerCancel()
+
+	if !errors.Is(olderCtx.Err(), context.Canceled) {
+		t.Errorf("older sync context error = %v, want context.Canceled", olderCtx.Err())
+	}
+	if newerCtx.Err() != nil {
+		t.Errorf("newer sync context error = %v, want nil", newerCtx.Err())
+	}
+	if h.isLatestSync(older) || !h.isLatestSync(newer) {
+		t.Fatalf("isLatestSync(older, newer) = %v, %v, want false, true", h.isLatestSync(older), h.isLatestSync(newer))
+	}
+
+	// The newer sync completes first, then the slower older one
+	var applied []string
+	if !h.commitSync(newer, func() { applied = append(applied, "newer") }) {
+		t.Error("commitSync(newer) = false, want true")
+	}
+	if h.commitSync(older, func() { applied = append(applied, "older") }) {
+		t.Error("commitSync(older) = true, want false")
+	}
+	if len(applied) != 1 || applied[0] != "newer" {
+		t.Errorf("applied results = %v, want only the newer sync", applied)
+	}
+}
+
+// recordedEvents collects the events a ResourceHandler emits
+type recordedEvents struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (r *recordedEvents) emit(eventName string, data interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names = append(r.names, eventName)
+}
+
+func (r *recordedEvents) list() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.names...)
+}
+
+// newSyncTestHandler returns a resource handler connected to a pstest server with topic orders and subscription orders-sub
+// The client's ListTopics calls run through intercept.
+func newSyncTestHandler(t *testing.T, intercept grpc.UnaryClientInterceptor) (*ResourceHandler, *recordedEvents) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	if err := logger.InitLogger(); err != nil {
+		t.Fatalf("InitLogger() error = %v", err)
+	}
+	client, _ := pubsubtest.NewClient(t, grpc.WithUnaryInterceptor(intercept))
+
+	ctx := context.Background()
+	if err := admin.CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	if err := admin.CreateSubscriptionWithConfig(ctx, client, "p", "orders", "orders-sub", admin.SubscriptionConfig{AckDeadline: 10}); err != nil {
+		t.Fatalf("CreateSubscriptionWithConfig() error = %v", err)
+	}
+
+	clientManager := auth.NewClientManager(ctx)
+	t.Cleanup(func() { clientManager.Close() })
+	if err := clientManager.SetClient(client, "p"); err != nil {
+		t.Fatalf("SetClient() error = %v", err)
+	}
+
+	events := &recordedEvents{}
+	h := NewResourceHandler(ctx, clientManager, NewResourceStore())
+	h.SetEmulatorCheckFunc(func() bool { return true })
+	h.emitEvent = events.emit
+	return h, events
+}
+
+func TestResourceHandler_SyncResources(t *testing.T) {
+	passThrough := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	h, events := newSyncTestHandler(t, passThrough)
+
+	h.syncResources()
+
+	if topics := h.store.Topics(); len(topics) != 1 || topics[0].DisplayName != "orders" {
+		t.Errorf("store topics = %+v, want orders", topics)
+	}
+	if subs := h.store.Subscriptions(); len(subs) != 1 || subs[0].DisplayName != "orders-sub" {
+		t.Errorf("store subscriptions = %+v, want orders-sub", subs)
+	}
+	// pstest does not implement snapshots, which only log a warning
+	if got := strings.Join(events.list(), ","); got != "resources:updated" {
+		t.Errorf("emitted events = %s, want resources:updated", got)
+	}
+}
+
+func TestResourceHandler_CancelSync(t *testing.T) {
+	listing := make(chan struct{})
+	var once sync.Once
+	blockListTopics := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if strings.HasSuffix(method, "/ListTopics") {
+			once.Do(func() { close(listing) })
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	h, events := newSyncTestHandler(t, blockListTopics)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.syncResources()
+	}()
+	select {
+	case <-listing:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sync did not list topics")
+	}
+
+	// The store is cleared while the sync is in flight
+	h.CancelSync()
+	h.store.Clear()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("CancelSync() did not end the sync")
+	}
+
+	if subs := h.store.Subscriptions(); len(subs) != 0 {
+		t.Errorf("store subscriptions after CancelSync() = %+v, want none", subs)
+	}
+	if got := events.list(); len(got) != 0 {
+		t.Errorf("emitted events after CancelSync() = %v, want none", got)
+	}
+}
+
+func TestCountResources(t *testing.T) {
+	tests := []struct {
+		name          string
+		topics        []admin.TopicInfo
+		subscriptions []admin.SubscriptionInfo
+		want          ResourceCounts
+	}{
+		{name: "empty", want: ResourceCounts{}},
+		{
+			name:   "topics only",
+			topics: []admin.TopicInfo{{DisplayName: "a"}, {DisplayName: "b"}},
+			want:   ResourceCounts{Topics: 2},
+		},
+		{
+			name: "one of each type",
+			subscriptions: []admin.SubscriptionInfo{
+				{SubscriptionType: "pull"},
+				{SubscriptionType: "push"},
+				{SubscriptionType: "bigquery"},
+				{SubscriptionType: "cloudstorage"},
+			},
+			want: ResourceCounts{PullSubscriptions: 1, PushSubscriptions: 1, BigQuerySubscriptions: 1, CloudStorageSubscriptions: 1},
+		},
+		{
+			name:          "unknown type counts as pull",
+			subscriptions: []admin.SubscriptionInfo{{SubscriptionType: ""}, {SubscriptionType: "export"}},
+			want:          ResourceCounts{PullSubscriptions: 2},
+		},
+		{
+			name: "detached counted under their type too",
+			subscriptions: []admin.SubscriptionInfo{
+				{SubscriptionType: "pull", Detached: true},
+				{SubscriptionType: "push", Detached: true},
+				{SubscriptionType: "pull"},
+			},
+			want: ResourceCounts{PullSubscriptions: 2, PushSubscriptions: 1, DetachedSubscriptions: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countResources(tt.topics, tt.subscriptions); got != tt.want {
+				t.Errorf("countResources() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
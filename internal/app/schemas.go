@@ -0,0 +1,64 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"context"
+	"fmt"
+
+	vkit "cloud.google.com/go/pubsub/v2/apiv1"
+
+	"pubsub-gui/internal/auth"
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/schema"
+)
+
+// SchemaHandler handles schema operations
+type SchemaHandler struct {
+	ctx           context.Context
+	clientManager *auth.ClientManager
+}
+
+// NewSchemaHandler creates a new schema handler
+func NewSchemaHandler(
+	ctx context.Context,
+	clientManager *auth.ClientManager,
+) *SchemaHandler {
+	return &SchemaHandler{
+		ctx:           ctx,
+		clientManager: clientManager,
+	}
+}
+
+// schemaClient returns the schema client of the current connection
+func (h *SchemaHandler) schemaClient() (*vkit.SchemaClient, error) {
+	if !h.clientManager.IsConnected() {
+		return nil, models.ErrNotConnected
+	}
+	sc := h.clientManager.GetSchemaClient()
+	if sc == nil {
+		return nil, fmt.Errorf("schema operations are not available on this connection")
+	}
+	return sc, nil
+}
+
+// ListSchemas returns all schemas in the project
+func (h *SchemaHandler) ListSchemas() ([]schema.SchemaInfo, error) {
+	sc, err := h.schemaClient()
+	if err != nil {
+		return nil, err
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	return schema.ListSchemas(h.ctx, sc, projectID)
+}
+
+// ValidateMessageAgainstSchema checks a JSON-encoded payload against a schema
+func (h *SchemaHandler) ValidateMessageAgainstSchema(schemaName, payload string) error {
+	sc, err := h.schemaClient()
+	if err != nil {
+		return err
+	}
+
+	projectID := h.clientManager.GetProjectID()
+	return schema.ValidateMessage(h.ctx, sc, projectID, schemaName, payload, "JSON")
+}
@@ -0,0 +1,59 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"sync"
+	"time"
+
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+// subscriptionLinkTTL is how long links from the last resource sync are trusted
+const subscriptionLinkTTL = 5 * time.Minute
+
+// SubscriptionLinkCache maps subscription IDs to their topics for the current connection
+// It is refreshed on every resource sync and cleared on disconnect
+type SubscriptionLinkCache struct {
+	mu       sync.RWMutex
+	links    map[string]string // Short subscription ID -> full topic name
+	syncedAt time.Time
+}
+
+// NewSubscriptionLinkCache creates an empty link cache
+func NewSubscriptionLinkCache() *SubscriptionLinkCache {
+	return &SubscriptionLinkCache{links: make(map[string]string)}
+}
+
+// Update replaces the cached links with those of the given subscriptions
+func (c *SubscriptionLinkCache) Update(subscriptions []admin.SubscriptionInfo) {
+	links := make(map[string]string, len(subscriptions))
+	for _, sub := range subscriptions {
+		links[sub.DisplayName] = sub.Topic
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.links = links
+	c.syncedAt = time.Now()
+}
+
+// Lookup returns the topic of a subscription (short ID)
+// Returns false when the subscription is unknown or the cache is stale
+func (c *SubscriptionLinkCache) Lookup(subscriptionID string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.syncedAt.IsZero() || time.Since(c.syncedAt) > subscriptionLinkTTL {
+		return "", false
+	}
+	topic, ok := c.links[subscriptionID]
+	return topic, ok
+}
+
+// Clear drops all cached links
+func (c *SubscriptionLinkCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.links = make(map[string]string)
+	c.syncedAt = time.Time{}
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+func TestSubscriptionLinkCache(t *testing.T) {
+	subscriptions := []admin.SubscriptionInfo{
+		{DisplayName: "orders-sub", Topic: "projects/p/topics/orders"},
+		{DisplayName: "audit-sub", Topic: "projects/p/topics/audit"},
+	}
+
+	tests := []struct {
+		name           string
+		setup          func(c *SubscriptionLinkCache)
+		subscriptionID string
+		wantTopic      string
+		wantOK         bool
+	}{
+		{
+			name:           "never synced",
+			setup:          func(c *SubscriptionLinkCache) {},
+			subscriptionID: "orders-sub",
+		},
+		{
+			name:           "known subscription",
+			setup:          func(c *SubscriptionLinkCache) { c.Update(subscriptions) },
+			subscriptionID: "orders-sub",
+			wantTopic:      "projects/p/topics/orders",
+			wantOK:         true,
+		},
+		{
+			name:           "unknown subscription",
+			setup:          func(c *SubscriptionLinkCache) { c.Update(subscriptions) },
+			subscriptionID: "missing-sub",
+		},
+		{
+			name: "replaced by a later sync",
+			setup: func(c *SubscriptionLinkCache) {
+				c.Update(subscriptions)
+				c.Update(subscriptions[1:])
+			},
+			subscriptionID: "orders-sub",
+		},
+		{
+			name: "stale",
+			setup: func(c *SubscriptionLinkCache) {
+				c.Update(subscriptions)
+				c.syncedAt = time.Now().Add(-subscriptionLinkTTL - time.Second)
+			},
+			subscriptionID: "orders-sub",
+		},
+		{
+			name: "cleared",
+			setup: func(c *SubscriptionLinkCache) {
+				c.Update(subscriptions)
+				c.Clear()
+			},
+			subscriptionID: "orders-sub",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewSubscriptionLinkCache()
+			tt.setup(c)
+			topic, ok := c.Lookup(tt.subscriptionID)
+			if topic != tt.wantTopic || ok != tt.wantOK {
+				t.Errorf("Lookup(%q) = %q, %v, want %q, %v", tt.subscriptionID, topic, ok, tt.wantTopic, tt.wantOK)
+			}
+		})
+	}
+}
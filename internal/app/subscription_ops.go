@@ -0,0 +1,80 @@
+// Package app provides handler structs for organizing App methods by domain
+package app
+
+import (
+	"time"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/subscriber"
+)
+
+// SubscriptionOps combines backlog, throughput, ack and drain data for a subscription's ops view
+// Pieces that cannot be obtained are left unset and explained in Notes.
+type SubscriptionOps struct {
+	SubscriptionID          string               `json:"subscriptionId"`
+	Backlog                 *int64               `json:"backlog,omitempty"`                 // num_undelivered_messages
+	OldestUnackedAgeSeconds *int64               `json:"oldestUnackedAgeSeconds,omitempty"` // oldest_unacked_message_age
+	Monitored               bool                 `json:"monitored"`                         // True when a live monitor is running
+	ReceivedCount           int64                `json:"receivedCount,omitempty"`           // Messages received by the monitor
+	ThroughputPerSecond     float64              `json:"throughputPerSecond,omitempty"`     // Monitor receive rate since it started
+	ThroughputWindow        string               `json:"throughputWindow,omitempty"`        // Period the throughput was averaged over
+	AckStats                *subscriber.AckStats `json:"ackStats,omitempty"`                // Monitor ack/nack/expire/redelivery counts
+	Drain                   *DrainEstimate       `json:"drain,omitempty"`
+	Notes                   []string             `json:"notes,omitempty"`
+}
+
+// GetSubscriptionOps assembles backlog metrics, live monitor throughput, ack stats and a drain estimate
+// Missing pieces (no live monitor, emulator connection, no metric data yet) do not fail the call
+func (h *MonitoringHandler) GetSubscriptionOps(subscriptionID string) (*SubscriptionOps, error) {
+	if !h.clientManager.IsConnected() {
+		return nil, models.ErrNotConnected
+	}
+
+	ops := &SubscriptionOps{SubscriptionID: subscriptionID}
+
+	h.monitorsMu.RLock()
+	streamer, monitored := h.activeMonitors[subscriptionID]
+	h.monitorsMu.RUnlock()
+	if monitored {
+		ops.Monitored = true
+		received, since := streamer.GetReceiveStats()
+		ops.ReceivedCount = received
+		if elapsed := time.Since(since); elapsed > 0 {
+			ops.ThroughputPerSecond = float64(received) / elapsed.Seconds()
+			ops.ThroughputWindow = elapsed.Round(time.Second).String()
+		}
+		stats := streamer.GetAckStats()
+		ops.AckStats = &stats
+	} else {
+		ops.Notes = append(ops.Notes, "subscription is not monitored: live throughput and ack stats are unavailable")
+	}
+
+	svc, projectID, err := h.metricsService()
+	if err != nil {
+		ops.Notes = append(ops.Notes, err.Error())
+		return ops, nil
+	}
+
+	backlog := BacklogInfo{SubscriptionID: subscriptionID}
+	h.readBacklogMetrics(svc, projectID, &backlog)
+	ops.Backlog = backlog.Undelivered
+	ops.OldestUnackedAgeSeconds = backlog.OldestUnackedAgeSeconds
+	if backlog.Reason != "" {
+		ops.Notes = append(ops.Notes, backlog.Reason)
+	}
+	if backlog.Undelivered == nil {
+		if backlog.MetricsAvailable {
+			ops.Notes = append(ops.Notes, "no backlog data yet: the drain time cannot be estimated")
+		}
+		return ops, nil
+	}
+
+	drain, err := h.drainEstimate(svc, projectID, subscriptionID, *backlog.Undelivered)
+	if err != nil {
+		ops.Notes = append(ops.Notes, err.Error())
+		return ops, nil
+	}
+	ops.Drain = drain
+
+	return ops, nil
+}
@@ -2,10 +2,16 @@
 package app
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"pubsub-gui/internal/config"
 	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
 )
 
 // TemplateHandler handles message template operations
@@ -46,6 +52,18 @@ func (h *TemplateHandler) GetTemplates(topicID string) ([]models.MessageTemplate
 	return filtered, nil
 }
 
+// GetTemplate returns a single template by ID
+func (h *TemplateHandler) GetTemplate(templateID string) (models.MessageTemplate, error) {
+	if h.config != nil {
+		for _, t := range h.config.Templates {
+			if t.ID == templateID {
+				return t, nil
+			}
+		}
+	}
+	return models.MessageTemplate{}, models.ErrTemplateNotFound
+}
+
 // SaveTemplate saves a message template to the configuration
 func (h *TemplateHandler) SaveTemplate(template models.MessageTemplate) error {
 	// Generate ID if not provided
@@ -158,3 +176,111 @@ func (h *TemplateHandler) DeleteTemplate(templateID string) error {
 	// Save configuration
 	return h.configManager.SaveConfig(h.config)
 }
+
+// ExportTemplatesReport writes a Markdown report of all message templates grouped by linked topic
+// Linked topics are resolved against the given topics; global (unlinked) templates get their own section
+// The report is meant for reviewing and sharing, not for re-importing
+func (h *TemplateHandler) ExportTemplatesReport(outPath string, topics []admin.TopicInfo) error {
+	if outPath == "" {
+		return fmt.Errorf("output path cannot be empty")
+	}
+
+	var templates []models.MessageTemplate
+	if h.config != nil {
+		templates = h.config.Templates
+	}
+
+	report := renderTemplatesReport(templates, topics, time.Now())
+	if err := os.WriteFile(outPath, []byte(report), 0644); err != nil {
+		return fmt.Errorf("failed to write templates report: %w", err)
+	}
+
+	return nil
+}
+
+// renderTemplatesReport renders message templates as Markdown, grouped by linked topic
+func renderTemplatesReport(templates []models.MessageTemplate, topics []admin.TopicInfo, generatedAt time.Time) string {
+	// Resolve topic references (full name or short ID) to cached topics
+	known := make(map[string]admin.TopicInfo, len(topics)*2)
+	for _, topic := range topics {
+		known[topic.Name] = topic
+		known[topic.DisplayName] = topic
+	}
+
+	groups := make(map[string][]models.MessageTemplate)
+	var global []models.MessageTemplate
+	for _, t := range templates {
+		if t.TopicID == "" {
+			global = append(global, t)
+			continue
+		}
+		groups[t.TopicID] = append(groups[t.TopicID], t)
+	}
+
+	topicIDs := make([]string, 0, len(groups))
+	for topicID := range groups {
+		topicIDs = append(topicIDs, topicID)
+	}
+	sort.Strings(topicIDs)
+
+	var b strings.Builder
+	b.WriteString("# Message Templates\n\n")
+	fmt.Fprintf(&b, "Generated %s — %d templates, %d linked topics, %d global.\n", generatedAt.Format(time.RFC3339), len(templates), len(topicIDs), len(global))
+
+	for _, topicID := range topicIDs {
+		heading := topicID
+		if topic, ok := known[topicID]; ok {
+			heading = fmt.Sprintf("%s (`%s`)", topic.DisplayName, topic.Name)
+		} else if len(topics) > 0 {
+			heading = fmt.Sprintf("`%s` (not found in connected project)", topicID)
+		}
+		fmt.Fprintf(&b, "\n## Topic: %s\n", heading)
+		writeTemplateEntries(&b, groups[topicID])
+	}
+
+	if len(global) > 0 {
+		b.WriteString("\n## Global templates (no linked topic)\n")
+		writeTemplateEntries(&b, global)
+	}
+
+	return b.String()
+}
+
+// writeTemplateEntries writes templates sorted by name, each with attributes and a fenced payload
+func writeTemplateEntries(b *strings.Builder, templates []models.MessageTemplate) {
+	sorted := make([]models.MessageTemplate, len(templates))
+	copy(sorted, templates)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	for _, t := range sorted {
+		fmt.Fprintf(b, "\n### %s\n\n", t.Name)
+		if t.UpdatedAt != "" {
+			fmt.Fprintf(b, "Last updated: %s\n\n", t.UpdatedAt)
+		}
+
+		if len(t.Attributes) > 0 {
+			keys := make([]string, 0, len(t.Attributes))
+			for key := range t.Attributes {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			b.WriteString("| Attribute | Value |\n|---|---|\n")
+			for _, key := range keys {
+				fmt.Fprintf(b, "| `%s` | `%s` |\n", key, strings.ReplaceAll(t.Attributes[key], "|", "\\|"))
+			}
+			b.WriteString("\n")
+		}
+
+		// Use a fence longer than any backtick run in the payload so it can't close early
+		fence := "```"
+		for strings.Contains(t.Payload, fence) {
+			fence += "`"
+		}
+		lang := ""
+		if json.Valid([]byte(t.Payload)) {
+			lang = "json"
+		}
+		fmt.Fprintf(b, "%s%s\n%s\n%s\n", fence, lang, t.Payload, fence)
+	}
+}
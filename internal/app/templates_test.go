@@ -0,0 +1,102 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+func TestRenderTemplatesReport(t *testing.T) {
+	generatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	orders := admin.TopicInfo{Name: "projects/p/topics/orders", DisplayName: "orders"}
+
+	tests := []struct {
+		name      string
+		templates []models.MessageTemplate
+		topics    []admin.TopicInfo
+		contains  []string
+		excludes  []string
+	}{
+		{
+			name:      "empty",
+			templates: nil,
+			contains:  []string{"# Message Templates\n", "Generated 2024-05-01T12:00:00Z — 0 templates, 0 linked topics, 0 global."},
+			excludes:  []string{"## "},
+		},
+		{
+			name: "linked by short ID and full name",
+			templates: []models.MessageTemplate{
+				{Name: "b", TopicID: "orders", Payload: "x"},
+				{Name: "a", TopicID: "projects/p/topics/orders", Payload: "y"},
+			},
+			topics:   []admin.TopicInfo{orders},
+			contains: []string{"## Topic: orders (`projects/p/topics/orders`)", "2 templates, 2 linked topics, 0 global."},
+			excludes: []string{"not found"},
+		},
+		{
+			name:      "topic missing from the connected project",
+			templates: []models.MessageTemplate{{Name: "a", TopicID: "gone", Payload: "x"}},
+			topics:    []admin.TopicInfo{orders},
+			contains:  []string{"## Topic: `gone` (not found in connected project)"},
+		},
+		{
+			name:      "not connected",
+			templates: []models.MessageTemplate{{Name: "a", TopicID: "orders", Payload: "x"}},
+			contains:  []string{"## Topic: orders\n"},
+			excludes:  []string{"not found"},
+		},
+		{
+			name:      "global",
+			templates: []models.MessageTemplate{{Name: "a", Payload: "x"}},
+			contains:  []string{"## Global templates (no linked topic)\n\n### a\n", "1 templates, 0 linked topics, 1 global."},
+			excludes:  []string{"## Topic:"},
+		},
+		{
+			name:      "JSON payload and attributes",
+			templates: []models.MessageTemplate{{Name: "a", Payload: `{"id":1}`, Attributes: map[string]string{"z": "1", "k": "a|b"}, UpdatedAt: "2024-04-30"}},
+			contains:  []string{"Last updated: 2024-04-30\n", "| `k` | `a\\|b` |\n| `z` | `1` |\n", "```json\n{\"id\":1}\n```\n"},
+		},
+		{
+			name:      "payload with backtick fence",
+			templates: []models.MessageTemplate{{Name: "a", Payload: "see ```code```"}},
+			contains:  []string{"````\nsee ```code```\n````\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := renderTemplatesReport(tt.templates, tt.topics, generatedAt)
+			for _, want := range tt.contains {
+				if !strings.Contains(report, want) {
+					t.Errorf("renderTemplatesReport() does not contain %q:\n%s", want, report)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(report, unwanted) {
+					t.Errorf("renderTemplatesReport() contains %q:\n%s", unwanted, report)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderTemplatesReport_SortsTopicsAndTemplates(t *testing.T) {
+	templates := []models.MessageTemplate{
+		{Name: "second", TopicID: "b", Payload: "x"},
+		{Name: "first", TopicID: "b", Payload: "x"},
+		{Name: "only", TopicID: "a", Payload: "x"},
+	}
+	report := renderTemplatesReport(templates, nil, time.Now())
+
+	order := []string{"## Topic: a", "### only", "## Topic: b", "### first", "### second"}
+	last := -1
+	for _, heading := range order {
+		i := strings.Index(report, heading)
+		if i <= last {
+			t.Fatalf("renderTemplatesReport() headings out of order, want %v:\n%s", order, report)
+		}
+		last = i
+	}
+}
@@ -4,10 +4,12 @@ package app
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"pubsub-gui/internal/auth"
 	"pubsub-gui/internal/config"
 	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
 	"pubsub-gui/internal/templates"
 )
 
@@ -45,9 +47,25 @@ func NewTopicSubscriptionTemplateHandler(ctx context.Context, clientManager *aut
 	return handler
 }
 
-// GetTemplates returns all templates (built-in and custom)
+// ReloadCustomTemplates reloads the registry's custom templates from the config
+func (h *TopicSubscriptionTemplateHandler) ReloadCustomTemplates() error {
+	customTemplates := make([]*models.TopicSubscriptionTemplate, 0, len(h.config.TopicSubscriptionTemplates))
+	for i := range h.config.TopicSubscriptionTemplates {
+		customTemplates = append(customTemplates, &h.config.TopicSubscriptionTemplates[i])
+	}
+	return h.registry.ReplaceCustomTemplates(customTemplates)
+}
+
+// GetTemplates returns all templates: built-ins first, then user templates, each sorted by name
 func (h *TopicSubscriptionTemplateHandler) GetTemplates() ([]*models.TopicSubscriptionTemplate, error) {
-	return h.registry.ListTemplates(), nil
+	list := h.registry.ListTemplates()
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].IsBuiltIn != list[j].IsBuiltIn {
+			return list[i].IsBuiltIn
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
 }
 
 // GetTemplatesByCategory returns templates filtered by category
@@ -84,26 +102,76 @@ func (h *TopicSubscriptionTemplateHandler) CreateFromTemplate(request *models.Te
 	return creator.CreateFromTemplate(request)
 }
 
-// SaveCustomTemplate saves a custom template to the configuration
-func (h *TopicSubscriptionTemplateHandler) SaveCustomTemplate(template *models.TopicSubscriptionTemplate) error {
-	// Validate template
-	if err := template.Validate(); err != nil {
-		return err
+// CreateFromTemplateMultiEnv creates resources from a template in several environments
+func (h *TopicSubscriptionTemplateHandler) CreateFromTemplateMultiEnv(templateID, baseName string, environments []string) ([]models.TemplateEnvironmentResult, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return nil, models.ErrNotConnected
 	}
 
-	// Ensure it's marked as custom
-	template.IsBuiltIn = false
+	projectID := h.clientManager.GetProjectID()
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID not available")
+	}
 
-	// Add to registry
-	if err := h.registry.AddCustomTemplate(template); err != nil {
-		return err
+	if len(environments) == 0 {
+		return nil, fmt.Errorf("at least one environment is required")
+	}
+
+	creator := templates.NewCreator(h.ctx, client, projectID, h.registry)
+	return creator.CreateFromTemplateMultiEnv(templateID, baseName, environments), nil
+}
+
+// CheckSubscriptionDrift compares a live subscription's config with the subscription at subIndex in a template
+func (h *TopicSubscriptionTemplateHandler) CheckSubscriptionDrift(subID, templateID string, subIndex int) ([]models.DriftField, error) {
+	client := h.clientManager.GetClient()
+	if client == nil {
+		return nil, models.ErrNotConnected
+	}
+
+	template, err := h.registry.GetTemplate(templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	subInfo, err := admin.GetSubscriptionMetadataAdmin(h.ctx, client, h.clientManager.GetProjectID(), subID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update config: find and update existing template, or add new one
+	return templates.DetectSubscriptionDrift(template, subIndex, subInfo)
+}
+
+// SaveCustomTemplate creates or updates a user template in the configuration
+// Built-in templates cannot be saved over, and names must be unique among user templates.
+func (h *TopicSubscriptionTemplateHandler) SaveCustomTemplate(template *models.TopicSubscriptionTemplate) error {
 	if h.config == nil {
 		return fmt.Errorf("config is nil")
 	}
+	if template.IsBuiltIn {
+		return fmt.Errorf("cannot save built-in template %q: save a copy under a new ID instead", template.ID)
+	}
+	if template.ID == "" {
+		template.ID = models.GenerateID()
+	}
+
+	if err := template.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", models.ErrInvalidTemplate, err)
+	}
+
+	// Check for duplicate names (excluding the template itself if updating)
+	for _, t := range h.config.TopicSubscriptionTemplates {
+		if t.Name == template.Name && t.ID != template.ID {
+			return models.ErrDuplicateTemplate
+		}
+	}
 
+	// Add to registry (rejects IDs of built-in templates)
+	if err := h.registry.AddCustomTemplate(template); err != nil {
+		return err
+	}
+
+	// Find and update existing template, or add new one
 	found := false
 	for i, t := range h.config.TopicSubscriptionTemplates {
 		if t.ID == template.ID {
@@ -121,18 +189,18 @@ func (h *TopicSubscriptionTemplateHandler) SaveCustomTemplate(template *models.T
 	return h.configManager.SaveConfig(h.config)
 }
 
-// DeleteCustomTemplate removes a custom template
+// DeleteCustomTemplate removes a user template
+// Deleting a built-in or unknown template returns an error.
 func (h *TopicSubscriptionTemplateHandler) DeleteCustomTemplate(id string) error {
+	if h.config == nil {
+		return fmt.Errorf("config is nil")
+	}
+
 	// Delete from registry
 	if err := h.registry.DeleteCustomTemplate(id); err != nil {
 		return err
 	}
 
-	// Remove from config
-	if h.config == nil {
-		return fmt.Errorf("config is nil")
-	}
-
 	newTemplates := make([]models.TopicSubscriptionTemplate, 0)
 	for _, t := range h.config.TopicSubscriptionTemplates {
 		if t.ID != id {
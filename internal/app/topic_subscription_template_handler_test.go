@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pubsub-gui/internal/config"
+	"pubsub-gui/internal/models"
+)
+
+func newTestTemplate(id, name string) *models.TopicSubscriptionTemplate {
+	return &models.TopicSubscriptionTemplate{
+		ID:            id,
+		Name:          name,
+		Category:      "development",
+		Subscriptions: []models.SubscriptionTemplateConfig{{Name: "sub", AckDeadline: 30}},
+	}
+}
+
+func TestTopicSubscriptionTemplateHandler_CustomTemplates(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	configManager, err := config.NewManager()
+	if err != nil {
+		t.Fatalf("NewManager() error = %v", err)
+	}
+	cfg := models.NewDefaultConfig()
+	h := NewTopicSubscriptionTemplateHandler(context.Background(), nil, cfg, configManager)
+
+	created := newTestTemplate("", "Mine")
+	if err := h.SaveCustomTemplate(created); err != nil {
+		t.Fatalf("SaveCustomTemplate() error = %v", err)
+	}
+	if created.ID == "" || len(cfg.TopicSubscriptionTemplates) != 1 {
+		t.Fatalf("SaveCustomTemplate() did not persist with a generated ID: %+v", cfg.TopicSubscriptionTemplates)
+	}
+
+	list, err := h.GetTemplates()
+	if err != nil {
+		t.Fatalf("GetTemplates() error = %v", err)
+	}
+	if last := list[len(list)-1]; last.ID != created.ID || !list[0].IsBuiltIn {
+		t.Errorf("GetTemplates() = built-ins then custom expected, got first %q last %q", list[0].ID, last.ID)
+	}
+
+	if err := h.SaveCustomTemplate(newTestTemplate("other", "Mine")); !errors.Is(err, models.ErrDuplicateTemplate) {
+		t.Errorf("SaveCustomTemplate(duplicate name) error = %v, want ErrDuplicateTemplate", err)
+	}
+	builtIn := newTestTemplate("development", "My Development")
+	if err := h.SaveCustomTemplate(builtIn); err == nil {
+		t.Error("SaveCustomTemplate(built-in ID) succeeded")
+	}
+	builtIn.ID, builtIn.IsBuiltIn = "new", true
+	if err := h.SaveCustomTemplate(builtIn); err == nil {
+		t.Error("SaveCustomTemplate(IsBuiltIn) succeeded")
+	}
+	if err := h.SaveCustomTemplate(newTestTemplate("bad", "")); !errors.Is(err, models.ErrInvalidTemplate) {
+		t.Errorf("SaveCustomTemplate(invalid) error = %v, want ErrInvalidTemplate", err)
+	}
+
+	if err := h.DeleteCustomTemplate("development"); err == nil {
+		t.Error("DeleteCustomTemplate(built-in) succeeded")
+	}
+	if err := h.DeleteCustomTemplate("missing"); !errors.Is(err, models.ErrTemplateNotFound) {
+		t.Errorf("DeleteCustomTemplate(missing) error = %v, want ErrTemplateNotFound", err)
+	}
+	if err := h.DeleteCustomTemplate(created.ID); err != nil {
+		t.Fatalf("DeleteCustomTemplate() error = %v", err)
+	}
+	if len(cfg.TopicSubscriptionTemplates) != 0 {
+		t.Errorf("template still in config after delete: %+v", cfg.TopicSubscriptionTemplates)
+	}
+
+	saved, err := configManager.LoadConfig()
+	if err != nil || len(saved.TopicSubscriptionTemplates) != 0 {
+		t.Errorf("saved config = %+v, %v", saved.TopicSubscriptionTemplates, err)
+	}
+}
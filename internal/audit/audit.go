@@ -0,0 +1,125 @@
+// Package audit provides an append-only trail of operations that change Pub/Sub resources
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Outcome values recorded for each audited operation
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Entry represents a single audited operation
+type Entry struct {
+	Time         string `json:"time"`                  // RFC3339 timestamp
+	Operation    string `json:"operation"`             // "create" | "update" | "delete" | "seek" | "detach" | "set-iam-policy"
+	ResourceType string `json:"resourceType"`          // "topic" | "subscription" | "snapshot" | "template"
+	Resource     string `json:"resource"`              // Resource ID as passed by the caller
+	ProfileID    string `json:"profileId,omitempty"`   // Active profile at the time of the operation
+	ProfileName  string `json:"profileName,omitempty"` // Active profile name (for display)
+	ProjectID    string `json:"projectId,omitempty"`   // Connected GCP project
+	Identity     string `json:"identity,omitempty"`    // Connected identity (OAuth email or service account)
+	Outcome      string `json:"outcome"`               // "success" | "failure"
+	Error        string `json:"error,omitempty"`       // Error message when outcome is failure
+}
+
+// Logger appends audit entries to daily NDJSON files (audit-YYYY-MM-DD.ndjson)
+// It is intentionally separate from the debug logger so entries are never rotated away or filtered
+type Logger struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewLogger creates an audit logger writing into dir, creating it if needed
+func NewLogger(dir string) (*Logger, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create audit directory: %w", err)
+	}
+	return &Logger{dir: dir}, nil
+}
+
+// fileName returns the audit file name for a given date (YYYY-MM-DD)
+func fileName(date string) string {
+	return "audit-" + date + ".ndjson"
+}
+
+// Record appends an entry to the audit file for the entry's date
+// Time defaults to now when empty
+func (l *Logger) Record(entry Entry) error {
+	now := time.Now()
+	if entry.Time == "" {
+		entry.Time = now.Format(time.RFC3339)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	filePath := filepath.Join(l.dir, fileName(now.Format("2006-01-02")))
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// Read returns all entries recorded on a specific date (YYYY-MM-DD) in the order they were written
+// Returns an empty slice if no audit file exists for that date
+func (l *Logger) Read(date string) ([]Entry, error) {
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return nil, fmt.Errorf("invalid date format: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	file, err := os.Open(filepath.Join(l.dir, fileName(date)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Entry{}, nil
+		}
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer file.Close()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var entry Entry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			// Skip partially written lines rather than failing the whole read
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit file: %w", err)
+	}
+
+	return entries, nil
+}
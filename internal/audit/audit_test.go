@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLogger_RecordAndRead(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewLogger(dir)
+	if err != nil {
+		t.Fatalf("NewLogger() error = %v", err)
+	}
+
+	entries := []Entry{
+		{Operation: "create", ResourceType: "topic", Resource: "orders", ProjectID: "my-project", Outcome: OutcomeSuccess},
+		{Operation: "delete", ResourceType: "subscription", Resource: "orders-sub", ProjectID: "my-project", Outcome: OutcomeFailure, Error: "permission denied"},
+	}
+	for _, e := range entries {
+		if err := l.Record(e); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	got, err := l.Read(time.Now().Format("2006-01-02"))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(got) != len(entries) {
+		t.Fatalf("Read() returned %d entries, want %d", len(got), len(entries))
+	}
+	for i, e := range got {
+		if e.Time == "" {
+			t.Errorf("entry %d: Time is empty, want default timestamp", i)
+		}
+		if e.Operation != entries[i].Operation || e.Resource != entries[i].Resource || e.Outcome != entries[i].Outcome {
+			t.Errorf("entry %d = %+v, want %+v", i, e, entries[i])
+		}
+	}
+	if got[1].Error != "permission denied" {
+		t.Errorf("entry 1 Error = %q, want %q", got[1].Error, "permission denied")
+	}
+}
+
+func TestLogger_Read_NoFile(t *testing.T) {
+	l, err := NewLogger(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLogger() error = %v", err)
+	}
+
+	got, err := l.Read("2020-01-01")
+	if err != nil {
+		t.Fatalf("Read() error = %v, want nil", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Errorf("Read() = %v, want empty slice", got)
+	}
+}
+
+func TestLogger_Read_InvalidDate(t *testing.T) {
+	l, err := NewLogger(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLogger() error = %v", err)
+	}
+
+	if _, err := l.Read("01/02/2020"); err == nil {
+		t.Error("Read() error = nil, want error for invalid date")
+	}
+}
+
+func TestLogger_Read_SkipsInvalidLines(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewLogger(dir)
+	if err != nil {
+		t.Fatalf("NewLogger() error = %v", err)
+	}
+
+	content := `{"time":"2020-01-01T00:00:00Z","operation":"create","resourceType":"topic","resource":"a","outcome":"success"}
+not-json
+
+{"time":"2020-01-01T00:00:01Z","operation":"delete","resourceType":"topic","resource":"a","outcome":"success"}
+`
+	if err := os.WriteFile(filepath.Join(dir, fileName("2020-01-01")), []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write audit file: %v", err)
+	}
+
+	got, err := l.Read("2020-01-01")
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("Read() returned %d entries, want 2", len(got))
+	}
+}
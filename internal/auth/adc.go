@@ -7,8 +7,6 @@ import (
 
 	"cloud.google.com/go/pubsub/v2"
 	"google.golang.org/api/option"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // ConnectWithADC creates a Pub/Sub client using Application Default Credentials
@@ -19,15 +17,11 @@ func ConnectWithADC(ctx context.Context, projectID string, emulatorHost string)
 	// If emulator host is provided, use it directly (don't check env var)
 	if emulatorHost != "" {
 		// Use emulator endpoint with insecure connection (no TLS)
-		opts = append(opts, option.WithEndpoint(emulatorHost))
-		opts = append(opts, option.WithoutAuthentication())
-		opts = append(opts, option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())))
+		opts = emulatorOptions(emulatorHost)
 	} else {
 		// Fall back to env var for external tooling compatibility
 		if envHost := os.Getenv("PUBSUB_EMULATOR_HOST"); envHost != "" {
-			opts = append(opts, option.WithEndpoint(envHost))
-			opts = append(opts, option.WithoutAuthentication())
-			opts = append(opts, option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())))
+			opts = emulatorOptions(envHost)
 		}
 	}
 
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub/v2"
+	vkit "cloud.google.com/go/pubsub/v2/apiv1"
+	"google.golang.org/api/option"
 
 	"pubsub-gui/internal/logger"
 )
@@ -18,6 +20,13 @@ type ClientManager struct {
 	client    *pubsub.Client
 	projectID string
 	ctx       context.Context
+
+	// Credentials used by the current connection, reused for other Google APIs (e.g. Cloud Monitoring)
+	// Only set for production connections; emulator connections have no usable credentials
+	credentialOpts []option.ClientOption
+	hasCredentials bool
+
+	schemaClient *vkit.SchemaClient // Schema client of the current connection; nil until set
 }
 
 // NewClientManager creates a new ClientManager
@@ -76,12 +85,59 @@ func (cm *ClientManager) SetClient(client *pubsub.Client, projectID string) erro
 		}
 	}
 
+	cm.closeSchemaClientLocked()
 	cm.client = client
 	cm.projectID = projectID
+	cm.credentialOpts = nil
+	cm.hasCredentials = false
 
 	return nil
 }
 
+// SetSchemaClient records the schema client of the current connection, which is closed with it
+// Must be called after SetClient, which closes the previous one
+func (cm *ClientManager) SetSchemaClient(sc *vkit.SchemaClient) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.closeSchemaClientLocked()
+	cm.schemaClient = sc
+}
+
+// GetSchemaClient returns the schema client of the current connection (nil if not connected)
+func (cm *ClientManager) GetSchemaClient() *vkit.SchemaClient {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.schemaClient
+}
+
+// closeSchemaClientLocked closes and forgets the schema client; cm.mu must be held
+func (cm *ClientManager) closeSchemaClientLocked() {
+	if cm.schemaClient == nil {
+		return
+	}
+	if err := cm.schemaClient.Close(); err != nil {
+		logger.Warn("Error closing schema client", "error", err)
+	}
+	cm.schemaClient = nil
+}
+
+// SetCredentialOptions records the credentials used by the current production connection
+// Must be called after SetClient, which resets them
+func (cm *ClientManager) SetCredentialOptions(opts ...option.ClientOption) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.credentialOpts = opts
+	cm.hasCredentials = true
+}
+
+// GetCredentialOptions returns the credentials of the current connection
+// The boolean is false when not connected or connected to the emulator
+func (cm *ClientManager) GetCredentialOptions() ([]option.ClientOption, bool) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.credentialOpts, cm.hasCredentials
+}
+
 // Close closes the active Pub/Sub client connection
 // Uses a timeout to prevent blocking if gRPC connections are stuck
 func (cm *ClientManager) Close() error {
@@ -92,9 +148,12 @@ func (cm *ClientManager) Close() error {
 		return nil
 	}
 
+	cm.closeSchemaClientLocked()
 	client := cm.client
 	cm.client = nil
 	cm.projectID = ""
+	cm.credentialOpts = nil
+	cm.hasCredentials = false
 
 	// Close client in a goroutine with timeout to prevent blocking
 	// if gRPC connections are stuck in IO wait
@@ -0,0 +1,146 @@
+// Package auth handles Google Cloud Pub/Sub authentication and client management
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+)
+
+// DefaultKeepaliveInterval is the time between connection probes
+const DefaultKeepaliveInterval = 30 * time.Second
+
+// Reconnect backoff (variables so tests can shorten them)
+var (
+	keepaliveInitialBackoff = 2 * time.Second
+	keepaliveMaxBackoff     = 2 * time.Minute
+)
+
+// KeepaliveOptions configures the background connection probe of a ClientManager
+type KeepaliveOptions struct {
+	Interval   time.Duration      // Time between probes (default DefaultKeepaliveInterval)
+	Reconnect  func() error       // Rebuilds the client (e.g. from the active profile) without user interaction; nil only re-probes
+	OnLost     func(err error)    // Called when a probe of a working connection fails
+	OnRestored func(rebuilt bool) // Called when the connection works again; rebuilt is true if Reconnect replaced the client
+}
+
+// StartKeepalive probes the active client every interval until the manager's context is done
+// When a probe cannot reach Pub/Sub or the credentials stopped working, the connection is reported lost,
+// then re-probed and rebuilt with exponential backoff until it works again or the client is closed or
+// replaced (e.g. by a manual reconnect). Other probe errors (e.g. a revoked permission) are only logged.
+func (cm *ClientManager) StartKeepalive(opts KeepaliveOptions) {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultKeepaliveInterval
+	}
+	go cm.runKeepalive(opts)
+}
+
+// runKeepalive probes the client on a ticker and recovers lost connections
+func (cm *ClientManager) runKeepalive(opts KeepaliveOptions) {
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cm.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		client, projectID := cm.snapshot()
+		if client == nil {
+			continue
+		}
+		err := ValidateConnection(cm.ctx, client, projectID)
+		if err == nil || cm.ctx.Err() != nil {
+			continue
+		}
+		if !isConnectionLost(err) {
+			logger.Debug("Keepalive probe failed on a reachable connection", "projectId", projectID, "error", err)
+			continue
+		}
+		if current, _ := cm.snapshot(); current != client {
+			continue // Disconnected or reconnected while the probe ran
+		}
+
+		logger.Warn("Pub/Sub connection lost", "projectId", projectID, "error", err)
+		if opts.OnLost != nil {
+			opts.OnLost(err)
+		}
+		cm.recoverConnection(client, opts)
+	}
+}
+
+// recoverConnection retries a lost connection with exponential backoff
+// Each attempt first re-probes the lost client, then rebuilds it with opts.Reconnect.
+func (cm *ClientManager) recoverConnection(lost *pubsub.Client, opts KeepaliveOptions) {
+	backoff := keepaliveInitialBackoff
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-cm.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		current, projectID := cm.snapshot()
+		if current != lost {
+			// A manual disconnect ends the recovery; a manual reconnect restored the connection
+			if current != nil && opts.OnRestored != nil {
+				opts.OnRestored(false)
+			}
+			return
+		}
+
+		if err := ValidateConnection(cm.ctx, current, projectID); err == nil || !isConnectionLost(err) {
+			logger.Info("Pub/Sub connection restored", "projectId", projectID, "attempt", attempt)
+			if opts.OnRestored != nil {
+				opts.OnRestored(false)
+			}
+			return
+		}
+
+		if opts.Reconnect != nil {
+			err := opts.Reconnect()
+			if err == nil {
+				logger.Info("Pub/Sub client rebuilt after connection loss", "projectId", projectID, "attempt", attempt)
+				if opts.OnRestored != nil {
+					opts.OnRestored(true)
+				}
+				return
+			}
+			logger.Warn("Reconnect attempt failed", "projectId", projectID, "attempt", attempt, "error", err)
+		}
+
+		backoff *= 2
+		if backoff > keepaliveMaxBackoff {
+			backoff = keepaliveMaxBackoff
+		}
+	}
+}
+
+// isConnectionLost reports whether a probe error means Pub/Sub could not be reached or the credentials stopped working
+func isConnectionLost(err error) bool {
+	if errors.Is(err, models.ErrInvalidAuth) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Unauthenticated:
+		return true
+	default:
+		return false
+	}
+}
+
+// snapshot returns the current client and project ID together
+func (cm *ClientManager) snapshot() (*pubsub.Client, string) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.client, cm.projectID
+}
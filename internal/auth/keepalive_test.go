@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	"cloud.google.com/go/pubsub/v2/pstest"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+)
+
+// initTestLogger points the logger at a temporary home directory
+func initTestLogger(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	if err := logger.InitLogger(); err != nil {
+		t.Fatalf("InitLogger() error = %v", err)
+	}
+}
+
+// shortenKeepaliveBackoff makes reconnect attempts follow each other quickly
+func shortenKeepaliveBackoff(t *testing.T) {
+	t.Helper()
+	initial, maxBackoff := keepaliveInitialBackoff, keepaliveMaxBackoff
+	keepaliveInitialBackoff, keepaliveMaxBackoff = time.Millisecond, 4*time.Millisecond
+	t.Cleanup(func() { keepaliveInitialBackoff, keepaliveMaxBackoff = initial, maxBackoff })
+}
+
+// newFailingClient returns a client of a pstest server whose calls fail with the code stored in failCode (codes.OK passes)
+func newFailingClient(t *testing.T, srv *pstest.Server, failCode *atomic.Uint32) *pubsub.Client {
+	t.Helper()
+	inject := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if code := codes.Code(failCode.Load()); code != codes.OK {
+			return status.Error(code, "injected failure")
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	conn, err := grpc.NewClient(srv.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(inject))
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	client, err := pubsub.NewClient(context.Background(), "p", option.WithGRPCConn(conn))
+	if err != nil {
+		t.Fatalf("pubsub.NewClient() error = %v", err)
+	}
+	return client
+}
+
+func TestIsConnectionLost(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "unavailable", err: fmt.Errorf("could not reach Pub/Sub: %w", status.Error(codes.Unavailable, "down")), want: true},
+		{name: "deadline exceeded", err: status.Error(codes.DeadlineExceeded, "slow"), want: true},
+		{name: "context deadline", err: fmt.Errorf("probe: %w", context.DeadlineExceeded), want: true},
+		{name: "unauthenticated", err: fmt.Errorf("%w: token expired", models.ErrInvalidAuth), want: true},
+		{name: "permission denied", err: status.Error(codes.PermissionDenied, "denied"), want: false},
+		{name: "not found", err: errors.New("project not found"), want: false},
+		{name: "internal", err: status.Error(codes.Internal, "oops"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isConnectionLost(tt.err); got != tt.want {
+				t.Errorf("isConnectionLost(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientManager_RunKeepalive(t *testing.T) {
+	initTestLogger(t)
+	shortenKeepaliveBackoff(t)
+	srv := pstest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cm := NewClientManager(ctx)
+	defer cm.Close()
+
+	var failCode, healthy atomic.Uint32
+	if err := cm.SetClient(newFailingClient(t, srv, &failCode), "p"); err != nil {
+		t.Fatalf("SetClient() error = %v", err)
+	}
+
+	var reconnects atomic.Int32
+	lost := make(chan error, 1)
+	restored := make(chan bool, 1)
+	cm.StartKeepalive(KeepaliveOptions{
+		Interval: 5 * time.Millisecond,
+		Reconnect: func() error {
+			reconnects.Add(1)
+			return cm.SetClient(newFailingClient(t, srv, &healthy), "p")
+		},
+		OnLost:     func(err error) { lost <- err },
+		OnRestored: func(rebuilt bool) { restored <- rebuilt },
+	})
+
+	// A reachable connection that lacks a permission is not lost
+	failCode.Store(uint32(codes.PermissionDenied))
+	select {
+	case err := <-lost:
+		t.Fatalf("OnLost(%v) called for a permission error", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	failCode.Store(uint32(codes.Unauthenticated))
+	select {
+	case err := <-lost:
+		if !errors.Is(err, models.ErrInvalidAuth) {
+			t.Errorf("OnLost() error = %v, want ErrInvalidAuth", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnLost() not called after the credentials stopped working")
+	}
+	select {
+	case rebuilt := <-restored:
+		if !rebuilt {
+			t.Error("OnRestored(false), want the client rebuilt")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnRestored() not called")
+	}
+	if got := reconnects.Load(); got != 1 {
+		t.Errorf("Reconnect() called %d times, want 1", got)
+	}
+}
+
+func TestClientManager_RecoverConnection(t *testing.T) {
+	tests := []struct {
+		name           string
+		prepare        func(cm *ClientManager, failCode *atomic.Uint32, other *pubsub.Client)
+		failReconnects int32 // Reconnect attempts that fail before one succeeds
+		wantReconnects int32
+		wantRestored   []bool
+	}{
+		{
+			name: "lost client works again",
+			prepare: func(cm *ClientManager, failCode *atomic.Uint32, other *pubsub.Client) {
+				failCode.Store(uint32(codes.OK))
+			},
+			wantRestored: []bool{false},
+		},
+		{
+			name: "probe error on a reachable connection",
+			prepare: func(cm *ClientManager, failCode *atomic.Uint32, other *pubsub.Client) {
+				failCode.Store(uint32(codes.PermissionDenied))
+			},
+			wantRestored: []bool{false},
+		},
+		{
+			name:           "rebuilt after failed attempts",
+			prepare:        func(cm *ClientManager, failCode *atomic.Uint32, other *pubsub.Client) {},
+			failReconnects: 2,
+			wantReconnects: 3,
+			wantRestored:   []bool{true},
+		},
+		{
+			name: "manual disconnect",
+			prepare: func(cm *ClientManager, failCode *atomic.Uint32, other *pubsub.Client) {
+				cm.Close()
+			},
+		},
+		{
+			name: "manual reconnect",
+			prepare: func(cm *ClientManager, failCode *atomic.Uint32, other *pubsub.Client) {
+				cm.SetClient(other, "p")
+			},
+			wantRestored: []bool{false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initTestLogger(t)
+			shortenKeepaliveBackoff(t)
+			srv := pstest.NewServer()
+			defer srv.Close()
+
+			cm := NewClientManager(context.Background())
+			defer cm.Close()
+			var failCode, healthy atomic.Uint32
+			failCode.Store(uint32(codes.Unauthenticated))
+			lostClient := newFailingClient(t, srv, &failCode)
+			if err := cm.SetClient(lostClient, "p"); err != nil {
+				t.Fatalf("SetClient() error = %v", err)
+			}
+			other := newFailingClient(t, srv, &healthy)
+			tt.prepare(cm, &failCode, other)
+
+			var reconnects atomic.Int32
+			var restored []bool
+			cm.recoverConnection(lostClient, KeepaliveOptions{
+				Reconnect: func() error {
+					if reconnects.Add(1) <= tt.failReconnects {
+						return errors.New("still offline")
+					}
+					return cm.SetClient(other, "p")
+				},
+				OnRestored: func(rebuilt bool) { restored = append(restored, rebuilt) },
+			})
+
+			if got := reconnects.Load(); got != tt.wantReconnects {
+				t.Errorf("Reconnect() called %d times, want %d", got, tt.wantReconnects)
+			}
+			if fmt.Sprint(restored) != fmt.Sprint(tt.wantRestored) {
+				t.Errorf("OnRestored() calls = %v, want %v", restored, tt.wantRestored)
+			}
+		})
+	}
+}
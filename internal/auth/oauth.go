@@ -8,6 +8,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
@@ -71,7 +75,8 @@ type AuthenticateResult struct {
 }
 
 // Authenticate starts the OAuth2 flow and waits for completion
-func (oa *OAuthAuthenticator) Authenticate(ctx context.Context) (*AuthenticateResult, error) {
+// A non-empty loginHint preselects that Google account on the consent screen.
+func (oa *OAuthAuthenticator) Authenticate(ctx context.Context, loginHint string) (*AuthenticateResult, error) {
 	// Generate PKCE challenge
 	pkce, err := generatePKCE()
 	if err != nil {
@@ -98,12 +103,16 @@ func (oa *OAuthAuthenticator) Authenticate(ctx context.Context) (*AuthenticateRe
 	}()
 
 	// Build authorization URL with PKCE
-	authURL := oa.config.AuthCodeURL(state,
+	authOpts := []oauth2.AuthCodeOption{
 		oauth2.AccessTypeOffline, // Request refresh token
 		oauth2.ApprovalForce,     // Force consent screen
 		oauth2.SetAuthURLParam("code_challenge", pkce.Challenge),
 		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
-	)
+	}
+	if loginHint != "" {
+		authOpts = append(authOpts, oauth2.SetAuthURLParam("login_hint", loginHint))
+	}
+	authURL := oa.config.AuthCodeURL(state, authOpts...)
 
 	// Open browser for user to authenticate
 	if err := OpenURL(authURL); err != nil {
@@ -163,6 +172,44 @@ func (oa *OAuthAuthenticator) RefreshToken(ctx context.Context, oldToken *models
 	return newToken, nil
 }
 
+// revokeURL is Google's OAuth token revocation endpoint
+const revokeURL = "https://oauth2.googleapis.com/revoke"
+
+// RevokeToken revokes a token's grant with Google
+// The refresh token is preferred since revoking it ends the whole grant. A token Google no longer
+// accepts (already revoked or expired) counts as revoked.
+func RevokeToken(ctx context.Context, token *models.OAuthToken) error {
+	value := token.RefreshToken
+	if value == "" {
+		value = token.AccessToken
+	}
+	if value == "" {
+		return nil
+	}
+
+	form := url.Values{"token": {value}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, revokeURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create revoke request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode == http.StatusBadRequest && strings.Contains(string(body), "invalid_token") {
+		return nil
+	}
+	return fmt.Errorf("failed to revoke token: %s", resp.Status)
+}
+
 // getUserEmail retrieves the user's email from the OAuth token
 func getUserEmail(ctx context.Context, token *oauth2.Token) (string, error) {
 	// Get user info from Google's userinfo endpoint
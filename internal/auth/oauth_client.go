@@ -4,19 +4,22 @@ package auth
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"cloud.google.com/go/pubsub/v2"
 	"golang.org/x/oauth2"
 	"google.golang.org/api/option"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"pubsub-gui/internal/logger"
 	"pubsub-gui/internal/models"
 )
 
 // ConnectWithOAuth creates a Pub/Sub client using OAuth2 credentials
-// If emulatorHost is provided, connects to the emulator instead of production
-func ConnectWithOAuth(ctx context.Context, projectID, oauthClientPath, profileID string, tokenStore *TokenStore, emulatorHost string) (*pubsub.Client, string, error) {
+// Tokens are stored per profile and Google account: with emailHint set the profile's token for that account is
+// used, and signing in preselects it. New tokens are saved under the signed-in account's key (from userinfo), so
+// another account never reuses them. If emulatorHost is provided, connects to the emulator instead of production.
+// Without interactive, a missing or unusable token returns models.ErrOAuthSignInRequired instead of opening the browser.
+func ConnectWithOAuth(ctx context.Context, projectID, oauthClientPath, profileID, emailHint string, tokenStore *TokenStore, emulatorHost string, interactive bool) (*pubsub.Client, string, error) {
 	// Load OAuth config from file
 	oauthConfig, err := models.LoadOAuthConfigFromFile(oauthClientPath)
 	if err != nil {
@@ -27,11 +30,12 @@ func ConnectWithOAuth(ctx context.Context, projectID, oauthClientPath, profileID
 	authenticator := NewOAuthAuthenticator(oauthConfig)
 
 	// Try to load existing token
-	storedToken, err := tokenStore.LoadToken(profileID)
+	storedToken, storedKey, err := tokenStore.loadAccountToken(profileID, emailHint)
 	var token *oauth2.Token
 	var userEmail string
 
 	if err == nil && storedToken != nil {
+		refreshed := false
 		// Check if token is expired
 		if storedToken.IsExpired() {
 			// Refresh the token
@@ -40,18 +44,7 @@ func ConnectWithOAuth(ctx context.Context, projectID, oauthClientPath, profileID
 				// Refresh failed, need to re-authenticate
 				return nil, "", fmt.Errorf("token refresh failed, please re-authenticate: %w", err)
 			}
-
-			// Save refreshed token
-			newStoredToken := &models.OAuthToken{
-				AccessToken:  token.AccessToken,
-				RefreshToken: token.RefreshToken,
-				TokenType:    token.TokenType,
-				Expiry:       token.Expiry,
-			}
-			if err := tokenStore.SaveToken(profileID, newStoredToken); err != nil {
-				// Non-fatal error, log but continue
-				logger.Warn("Failed to save refreshed token", "error", err)
-			}
+			refreshed = true
 		} else {
 			// Token is still valid
 			token = &oauth2.Token{
@@ -62,11 +55,36 @@ func ConnectWithOAuth(ctx context.Context, projectID, oauthClientPath, profileID
 			}
 		}
 
-		// Get user email (might be cached in profile)
+		// Get user email (falls back to the account recorded with the token)
 		userEmail, _ = getUserEmail(ctx, token)
-	} else {
+		if userEmail == "" {
+			userEmail = storedToken.Email
+		}
+
+		switch {
+		case emailHint != "" && userEmail != "" && !strings.EqualFold(userEmail, emailHint):
+			// A token of another account (saved before accounts were tracked): sign in with the right one
+			logger.Info("Stored OAuth token belongs to another account", "profileId", profileID, "account", userEmail, "expected", emailHint)
+			token = nil
+		case refreshed || storedToken.Email != userEmail || accountTokenKey(profileID, userEmail) != storedKey:
+			// Save the refreshed token or record its account; a token stored without its account moves to the account's key
+			key := accountTokenKey(profileID, userEmail)
+			saveOAuthToken(tokenStore, key, token, userEmail)
+			if key != storedKey {
+				if err := tokenStore.DeleteToken(storedKey); err != nil {
+					logger.Warn("Failed to remove token after moving it to its account", "error", err)
+				}
+			}
+		}
+	}
+
+	if token == nil {
+		if !interactive {
+			return nil, "", models.ErrOAuthSignInRequired
+		}
+
 		// No token exists, need to authenticate
-		result, err := authenticator.Authenticate(ctx)
+		result, err := authenticator.Authenticate(ctx, emailHint)
 		if err != nil {
 			return nil, "", fmt.Errorf("authentication failed: %w", err)
 		}
@@ -77,18 +95,11 @@ func ConnectWithOAuth(ctx context.Context, projectID, oauthClientPath, profileID
 
 		token = result.Token
 		userEmail = result.UserEmail
-
-		// Save token
-		storedToken := &models.OAuthToken{
-			AccessToken:  token.AccessToken,
-			RefreshToken: token.RefreshToken,
-			TokenType:    token.TokenType,
-			Expiry:       token.Expiry,
-		}
-		if err := tokenStore.SaveToken(profileID, storedToken); err != nil {
-			// Non-fatal error, log but continue
-			logger.Warn("Failed to save token", "error", err)
+		if emailHint != "" && !strings.EqualFold(userEmail, emailHint) {
+			return nil, "", fmt.Errorf("signed in as %s, but the profile uses the account %s", userEmail, emailHint)
 		}
+
+		saveOAuthToken(tokenStore, accountTokenKey(profileID, userEmail), token, userEmail)
 	}
 
 	// Create Pub/Sub client with OAuth token
@@ -96,12 +107,11 @@ func ConnectWithOAuth(ctx context.Context, projectID, oauthClientPath, profileID
 
 	// If emulator host is provided, use it instead of production
 	if emulatorHost != "" {
-		opts = append(opts, option.WithEndpoint(emulatorHost))
-		opts = append(opts, option.WithoutAuthentication())
-		opts = append(opts, option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())))
+		opts = emulatorOptions(emulatorHost)
 	} else {
-		// Use OAuth token for production
-		opts = append(opts, option.WithTokenSource(oauth2.StaticTokenSource(token)))
+		// Use OAuth token for production; it is refreshed and saved again when it expires
+		tokenSource := authenticator.persistingTokenSource(ctx, token, tokenStore, accountTokenKey(profileID, userEmail), userEmail)
+		opts = append(opts, option.WithTokenSource(tokenSource))
 	}
 
 	client, err := pubsub.NewClient(ctx, projectID, opts...)
@@ -111,3 +121,94 @@ func ConnectWithOAuth(ctx context.Context, projectID, oauthClientPath, profileID
 
 	return client, userEmail, nil
 }
+
+// accountTokenKey returns the key a token of the signed-in account is stored under
+// The profile's default key is only used when the account could not be determined.
+func accountTokenKey(profileID, userEmail string) string {
+	if userEmail == "unknown" {
+		userEmail = ""
+	}
+	return TokenKey(profileID, userEmail)
+}
+
+// saveOAuthToken stores a token with its account under key; failures are logged since the connection still works
+func saveOAuthToken(tokenStore *TokenStore, key string, token *oauth2.Token, email string) {
+	if email == "unknown" {
+		email = ""
+	}
+	storedToken := &models.OAuthToken{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		Expiry:       token.Expiry,
+		Email:        email,
+	}
+	if err := tokenStore.SaveToken(key, storedToken); err != nil {
+		logger.Warn("Failed to save token", "error", err)
+	}
+}
+
+// OAuthCredentialOptions returns client options that authenticate other Google APIs
+// with the stored OAuth token for a profile and account (empty email for the profile's default token)
+// The token is refreshed with the OAuth client when it expires, and the refreshed token is saved.
+func OAuthCredentialOptions(ctx context.Context, oauthClientPath, profileID, email string, tokenStore *TokenStore) ([]option.ClientOption, error) {
+	oauthConfig, err := models.LoadOAuthConfigFromFile(oauthClientPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OAuth config: %w", err)
+	}
+
+	storedToken, storedKey, err := tokenStore.loadAccountToken(profileID, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OAuth token: %w", err)
+	}
+	if storedToken == nil {
+		return nil, fmt.Errorf("no stored OAuth token for profile %s", profileID)
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  storedToken.AccessToken,
+		RefreshToken: storedToken.RefreshToken,
+		TokenType:    storedToken.TokenType,
+		Expiry:       storedToken.Expiry,
+	}
+	tokenSource := NewOAuthAuthenticator(oauthConfig).persistingTokenSource(ctx, token, tokenStore, storedKey, storedToken.Email)
+	return []option.ClientOption{option.WithTokenSource(tokenSource)}, nil
+}
+
+// persistingTokenSource returns a token source that refreshes token when it expires and saves each new token under key
+func (oa *OAuthAuthenticator) persistingTokenSource(ctx context.Context, token *oauth2.Token, tokenStore *TokenStore, key, email string) oauth2.TokenSource {
+	return &savingTokenSource{
+		source:     oa.config.TokenSource(ctx, token),
+		tokenStore: tokenStore,
+		key:        key,
+		email:      email,
+		last:       token.AccessToken,
+	}
+}
+
+// savingTokenSource saves the tokens of source that differ from the last one returned
+type savingTokenSource struct {
+	source     oauth2.TokenSource
+	tokenStore *TokenStore
+	key        string
+	email      string
+
+	mu   sync.Mutex
+	last string // Access token returned last
+}
+
+// Token returns a valid token, saving it when the wrapped source refreshed it
+func (s *savingTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if token.AccessToken != s.last {
+		s.last = token.AccessToken
+		saveOAuthToken(s.tokenStore, s.key, token, s.email)
+	}
+	return token, nil
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestPersistingTokenSource_SavesRefreshedToken(t *testing.T) {
+	var refreshes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := refreshes.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"fresh-%d","token_type":"Bearer","expires_in":3600}`, n)
+	}))
+	defer srv.Close()
+
+	store := newTestTokenStore(t)
+	authenticator := &OAuthAuthenticator{config: &oauth2.Config{ClientID: "id", Endpoint: oauth2.Endpoint{TokenURL: srv.URL}}}
+	expired := &oauth2.Token{AccessToken: "stale", RefreshToken: "refresh", Expiry: time.Now().Add(-time.Hour)}
+	key := TokenKey("p1", "me@example.com")
+	source := authenticator.persistingTokenSource(context.Background(), expired, store, key, "me@example.com")
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token()
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		if token.AccessToken != "fresh-1" {
+			t.Errorf("Token() access token = %q, want fresh-1", token.AccessToken)
+		}
+	}
+	if got := refreshes.Load(); got != 1 {
+		t.Errorf("token endpoint called %d times, want 1", got)
+	}
+
+	saved, err := store.LoadToken(key)
+	if err != nil || saved == nil {
+		t.Fatalf("LoadToken() = %v, %v, want the refreshed token", saved, err)
+	}
+	if saved.AccessToken != "fresh-1" || saved.RefreshToken != "refresh" || saved.Email != "me@example.com" {
+		t.Errorf("saved token = %+v, want fresh-1 with the refresh token and account kept", saved)
+	}
+}
+
+func TestPersistingTokenSource_ValidTokenNotSaved(t *testing.T) {
+	store := newTestTokenStore(t)
+	authenticator := &OAuthAuthenticator{config: &oauth2.Config{ClientID: "id"}}
+	valid := &oauth2.Token{AccessToken: "valid", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour)}
+	source := authenticator.persistingTokenSource(context.Background(), valid, store, "p1", "")
+
+	token, err := source.Token()
+	if err != nil || token.AccessToken != "valid" {
+		t.Fatalf("Token() = %v, %v, want the valid token", token, err)
+	}
+	if saved, err := store.LoadToken("p1"); err != nil || saved != nil {
+		t.Errorf("LoadToken() = %v, %v, want nothing saved for an unchanged token", saved, err)
+	}
+}
@@ -0,0 +1,40 @@
+// Package auth handles Google Cloud Pub/Sub authentication and client management
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	vkit "cloud.google.com/go/pubsub/v2/apiv1"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// emulatorOptions returns the client options for an emulator without authentication or TLS
+func emulatorOptions(emulatorHost string) []option.ClientOption {
+	return []option.ClientOption{
+		option.WithEndpoint(emulatorHost),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	}
+}
+
+// NewSchemaClient creates a schema client for the same endpoint as a Pub/Sub connection
+// The v2 Pub/Sub client has no schema client, so one is built from the connection's options: the emulator
+// when emulatorHost (or PUBSUB_EMULATOR_HOST) is set, otherwise production with credentialOpts.
+func NewSchemaClient(ctx context.Context, emulatorHost string, credentialOpts ...option.ClientOption) (*vkit.SchemaClient, error) {
+	if emulatorHost == "" {
+		emulatorHost = os.Getenv("PUBSUB_EMULATOR_HOST")
+	}
+	opts := credentialOpts
+	if emulatorHost != "" {
+		opts = emulatorOptions(emulatorHost)
+	}
+	sc, err := vkit.NewSchemaClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schema client: %w", err)
+	}
+	return sc, nil
+}
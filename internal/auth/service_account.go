@@ -3,6 +3,7 @@ package auth
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 
 	"cloud.google.com/go/pubsub/v2"
@@ -44,3 +45,21 @@ func ConnectWithServiceAccount(ctx context.Context, projectID, keyPath string, e
 
 	return client, nil
 }
+
+// ServiceAccountEmail returns the client_email from a service account JSON key file
+// Returns an empty string if the file cannot be read or parsed (identity is informational only)
+func ServiceAccountEmail(keyPath string) string {
+	data, err := os.ReadFile(keyPath)
+	if err != nil {
+		return ""
+	}
+
+	var key struct {
+		ClientEmail string `json:"client_email"`
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return ""
+	}
+
+	return key.ClientEmail
+}
@@ -11,10 +11,48 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
+	"pubsub-gui/internal/logger"
 	"pubsub-gui/internal/models"
 )
 
+// accountKeySeparator separates the profile ID from the account email in token keys
+const accountKeySeparator = "~"
+
+// OAuthAccount describes a stored OAuth token and the Google account it belongs to
+type OAuthAccount struct {
+	Email      string    `json:"email"`     // Empty for tokens saved before accounts were tracked
+	ProfileID  string    `json:"profileId"` // Profile the token was obtained for
+	Expiry     time.Time `json:"expiry"`    // Access token expiry
+	Expired    bool      `json:"expired"`
+	CanRefresh bool      `json:"canRefresh"` // A refresh token is stored, so an expired access token is renewed on connect
+}
+
+// TokenKey returns the store key of a profile's token for a Google account
+// An empty email gives the profile's default key, used when the profile names no account.
+func TokenKey(profileID, email string) string {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return profileID
+	}
+	return profileID + accountKeySeparator + sanitizeEmail(email)
+}
+
+// sanitizeEmail lowercases an email and replaces characters that are unsafe in file names
+func sanitizeEmail(email string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', strings.ContainsRune("@._+-", r):
+			return r
+		default:
+			return '_'
+		}
+	}, strings.ToLower(email))
+}
+
 // TokenStore manages secure storage of OAuth tokens
 type TokenStore struct {
 	baseDir string
@@ -42,8 +80,8 @@ func NewTokenStore(configDir string) (*TokenStore, error) {
 	}, nil
 }
 
-// SaveToken saves an OAuth token for a profile (encrypted)
-func (ts *TokenStore) SaveToken(profileID string, token *models.OAuthToken) error {
+// SaveToken saves an OAuth token under a key (see TokenKey), encrypted
+func (ts *TokenStore) SaveToken(key string, token *models.OAuthToken) error {
 	// Serialize token to JSON
 	data, err := json.Marshal(token)
 	if err != nil {
@@ -57,7 +95,7 @@ func (ts *TokenStore) SaveToken(profileID string, token *models.OAuthToken) erro
 	}
 
 	// Write encrypted data to file
-	tokenPath := filepath.Join(ts.baseDir, profileID+".json")
+	tokenPath := filepath.Join(ts.baseDir, key+".json")
 	if err := os.WriteFile(tokenPath, encrypted, 0600); err != nil {
 		return fmt.Errorf("failed to write token file: %w", err)
 	}
@@ -65,9 +103,9 @@ func (ts *TokenStore) SaveToken(profileID string, token *models.OAuthToken) erro
 	return nil
 }
 
-// LoadToken loads an OAuth token for a profile (decrypted)
-func (ts *TokenStore) LoadToken(profileID string) (*models.OAuthToken, error) {
-	tokenPath := filepath.Join(ts.baseDir, profileID+".json")
+// LoadToken loads the OAuth token stored under a key (decrypted); nil when there is none
+func (ts *TokenStore) LoadToken(key string) (*models.OAuthToken, error) {
+	tokenPath := filepath.Join(ts.baseDir, key+".json")
 
 	// Read encrypted data
 	encrypted, err := os.ReadFile(tokenPath)
@@ -93,15 +131,133 @@ func (ts *TokenStore) LoadToken(profileID string) (*models.OAuthToken, error) {
 	return &token, nil
 }
 
-// DeleteToken removes a token for a profile
-func (ts *TokenStore) DeleteToken(profileID string) error {
-	tokenPath := filepath.Join(ts.baseDir, profileID+".json")
+// DeleteToken removes the token stored under a key
+func (ts *TokenStore) DeleteToken(key string) error {
+	tokenPath := filepath.Join(ts.baseDir, key+".json")
 	if err := os.Remove(tokenPath); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to delete token file: %w", err)
 	}
 	return nil
 }
 
+// LoadAccountToken loads a profile's token for an account (empty email for the profile's default token)
+// Without a stored token for the account, the profile's default token is used if it belongs to that account
+// or predates account tracking (its account is checked once connected). Returns nil when there is none.
+func (ts *TokenStore) LoadAccountToken(profileID, email string) (*models.OAuthToken, error) {
+	token, _, err := ts.loadAccountToken(profileID, email)
+	return token, err
+}
+
+// loadAccountToken is LoadAccountToken that also returns the key the token is stored under
+func (ts *TokenStore) loadAccountToken(profileID, email string) (*models.OAuthToken, string, error) {
+	key := TokenKey(profileID, email)
+	token, err := ts.LoadToken(key)
+	if err != nil || token != nil || key == profileID {
+		return token, key, err
+	}
+
+	fallback, err := ts.LoadToken(profileID)
+	if err != nil || fallback == nil {
+		return nil, "", err
+	}
+	if fallback.Email == "" || strings.EqualFold(fallback.Email, email) {
+		return fallback, profileID, nil
+	}
+	return nil, "", nil
+}
+
+// ListAccounts returns every stored token with its account, sorted by email then profile
+// Tokens that cannot be read (e.g. encrypted with a lost key) are skipped.
+func (ts *TokenStore) ListAccounts() ([]OAuthAccount, error) {
+	keys, err := ts.keys()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]OAuthAccount, 0, len(keys))
+	for _, key := range keys {
+		token, err := ts.LoadToken(key)
+		if err != nil || token == nil {
+			logger.Warn("Skipping unreadable OAuth token", "key", key, "error", err)
+			continue
+		}
+		profileID, _, _ := strings.Cut(key, accountKeySeparator)
+		accounts = append(accounts, OAuthAccount{
+			Email:      token.Email,
+			ProfileID:  profileID,
+			Expiry:     token.Expiry,
+			Expired:    token.IsExpired(),
+			CanRefresh: token.RefreshToken != "",
+		})
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		if accounts[i].Email != accounts[j].Email {
+			return accounts[i].Email < accounts[j].Email
+		}
+		return accounts[i].ProfileID < accounts[j].ProfileID
+	})
+	return accounts, nil
+}
+
+// DeleteAccountTokens removes every token of a Google account (matched case-insensitively) and returns them
+// Returns models.ErrOAuthAccountNotFound when the account has no stored token.
+func (ts *TokenStore) DeleteAccountTokens(email string) ([]*models.OAuthToken, error) {
+	keys, err := ts.keys()
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []*models.OAuthToken
+	for _, key := range keys {
+		token, err := ts.LoadToken(key)
+		if err != nil || token == nil || !strings.EqualFold(token.Email, strings.TrimSpace(email)) {
+			continue
+		}
+		if err := ts.DeleteToken(key); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, token)
+	}
+
+	if len(deleted) == 0 {
+		return nil, fmt.Errorf("%w: %s", models.ErrOAuthAccountNotFound, email)
+	}
+	return deleted, nil
+}
+
+// DeleteProfileTokens removes a profile's tokens for every account
+func (ts *TokenStore) DeleteProfileTokens(profileID string) error {
+	keys, err := ts.keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key == profileID || strings.HasPrefix(key, profileID+accountKeySeparator) {
+			if err := ts.DeleteToken(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// keys returns the keys of all stored tokens
+func (ts *TokenStore) keys() ([]string, error) {
+	entries, err := os.ReadDir(ts.baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tokens directory: %w", err)
+	}
+
+	var keys []string
+	for _, entry := range entries {
+		if name := entry.Name(); !entry.IsDir() && strings.HasSuffix(name, ".json") {
+			keys = append(keys, strings.TrimSuffix(name, ".json"))
+		}
+	}
+	return keys, nil
+}
+
 // encrypt encrypts data using AES-256-GCM
 func (ts *TokenStore) encrypt(plaintext []byte) ([]byte, error) {
 	block, err := aes.NewCipher(ts.key)
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"pubsub-gui/internal/models"
+)
+
+// newTestTokenStore returns a token store in a temporary directory
+func newTestTokenStore(t *testing.T) *TokenStore {
+	t.Helper()
+	initTestLogger(t)
+	store, err := NewTokenStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewTokenStore() error = %v", err)
+	}
+	return store
+}
+
+// saveTestToken stores a token for an account under key
+func saveTestToken(t *testing.T, store *TokenStore, key, email string) {
+	t.Helper()
+	token := &models.OAuthToken{AccessToken: "access-" + key, RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour), Email: email}
+	if err := store.SaveToken(key, token); err != nil {
+		t.Fatalf("SaveToken(%q) error = %v", key, err)
+	}
+}
+
+func TestTokenKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		profileID string
+		email     string
+		want      string
+	}{
+		{name: "no account", profileID: "p1", email: "", want: "p1"},
+		{name: "blank account", profileID: "p1", email: "  ", want: "p1"},
+		{name: "account", profileID: "p1", email: "user@example.com", want: "p1~user@example.com"},
+		{name: "lowercased", profileID: "p1", email: "User@Example.COM", want: "p1~user@example.com"},
+		{name: "unsafe characters", profileID: "p1", email: "a/b\\c d@example.com", want: "p1~a_b_c_d@example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TokenKey(tt.profileID, tt.email); got != tt.want {
+				t.Errorf("TokenKey(%q, %q) = %q, want %q", tt.profileID, tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenStore_LoadAccountToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		defaultEmail string // Account of the profile's default token
+		accountToken bool   // A token is stored under the account key
+		email        string
+		want         string // AccessToken of the loaded token, empty for none
+	}{
+		{name: "account token", accountToken: true, email: "me@example.com", want: "access-p1~me@example.com"},
+		{name: "default token without account", email: "", want: "access-p1"},
+		{name: "legacy default token", defaultEmail: "", email: "me@example.com", want: "access-p1"},
+		{name: "default token of the same account", defaultEmail: "ME@example.com", email: "me@example.com", want: "access-p1"},
+		{name: "default token of another account", defaultEmail: "other@example.com", email: "me@example.com", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTestTokenStore(t)
+			saveTestToken(t, store, "p1", tt.defaultEmail)
+			if tt.accountToken {
+				saveTestToken(t, store, TokenKey("p1", tt.email), tt.email)
+			}
+
+			token, err := store.LoadAccountToken("p1", tt.email)
+			if err != nil {
+				t.Fatalf("LoadAccountToken() error = %v", err)
+			}
+			got := ""
+			if token != nil {
+				got = token.AccessToken
+			}
+			if got != tt.want {
+				t.Errorf("LoadAccountToken(%q) token = %q, want %q", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenStore_ListAccounts(t *testing.T) {
+	store := newTestTokenStore(t)
+	saveTestToken(t, store, TokenKey("p2", "b@example.com"), "b@example.com")
+	saveTestToken(t, store, TokenKey("p1", "b@example.com"), "b@example.com")
+	saveTestToken(t, store, TokenKey("p1", "a@example.com"), "a@example.com")
+	saveTestToken(t, store, "p3", "")
+
+	accounts, err := store.ListAccounts()
+	if err != nil {
+		t.Fatalf("ListAccounts() error = %v", err)
+	}
+	want := []struct{ email, profileID string }{
+		{"", "p3"},
+		{"a@example.com", "p1"},
+		{"b@example.com", "p1"},
+		{"b@example.com", "p2"},
+	}
+	if len(accounts) != len(want) {
+		t.Fatalf("ListAccounts() = %+v, want %d accounts", accounts, len(want))
+	}
+	for i, account := range accounts {
+		if account.Email != want[i].email || account.ProfileID != want[i].profileID {
+			t.Errorf("ListAccounts()[%d] = %s/%s, want %s/%s", i, account.Email, account.ProfileID, want[i].email, want[i].profileID)
+		}
+		if account.Expired || !account.CanRefresh {
+			t.Errorf("ListAccounts()[%d] expired = %v, canRefresh = %v, want false, true", i, account.Expired, account.CanRefresh)
+		}
+	}
+}
+
+func TestTokenStore_DeleteAccountTokens(t *testing.T) {
+	store := newTestTokenStore(t)
+	saveTestToken(t, store, TokenKey("p1", "me@example.com"), "me@example.com")
+	saveTestToken(t, store, "p2", "Me@Example.com")
+	saveTestToken(t, store, TokenKey("p1", "other@example.com"), "other@example.com")
+
+	deleted, err := store.DeleteAccountTokens("me@example.com")
+	if err != nil {
+		t.Fatalf("DeleteAccountTokens() error = %v", err)
+	}
+	if len(deleted) != 2 {
+		t.Errorf("DeleteAccountTokens() deleted %d tokens, want 2", len(deleted))
+	}
+	accounts, err := store.ListAccounts()
+	if err != nil {
+		t.Fatalf("ListAccounts() error = %v", err)
+	}
+	if len(accounts) != 1 || accounts[0].Email != "other@example.com" {
+		t.Errorf("ListAccounts() after delete = %+v, want only other@example.com", accounts)
+	}
+
+	if _, err := store.DeleteAccountTokens("me@example.com"); !errors.Is(err, models.ErrOAuthAccountNotFound) {
+		t.Errorf("DeleteAccountTokens(no tokens) error = %v, want ErrOAuthAccountNotFound", err)
+	}
+}
+
+func TestTokenStore_DeleteProfileTokens(t *testing.T) {
+	store := newTestTokenStore(t)
+	saveTestToken(t, store, "p1", "")
+	saveTestToken(t, store, TokenKey("p1", "me@example.com"), "me@example.com")
+	saveTestToken(t, store, "p10", "")
+	saveTestToken(t, store, TokenKey("p2", "me@example.com"), "me@example.com")
+
+	if err := store.DeleteProfileTokens("p1"); err != nil {
+		t.Fatalf("DeleteProfileTokens() error = %v", err)
+	}
+	keys, err := store.keys()
+	if err != nil {
+		t.Fatalf("keys() error = %v", err)
+	}
+	remaining := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		remaining[key] = true
+	}
+	if len(keys) != 2 || !remaining["p10"] || !remaining[TokenKey("p2", "me@example.com")] {
+		t.Errorf("keys after DeleteProfileTokens(p1) = %v, want p10 and p2's token", keys)
+	}
+}
@@ -0,0 +1,56 @@
+// Package auth handles Google Cloud Pub/Sub authentication and client management
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/models"
+)
+
+// validateTimeout bounds the probe call so an unreachable endpoint does not hang the connect
+const validateTimeout = 15 * time.Second
+
+// ValidateConnection checks that the client's credentials work and can access the project
+// by listing at most one topic, so a wrong project ID or missing permission fails the connect
+// instead of every later operation
+func ValidateConnection(ctx context.Context, client *pubsub.Client, projectID string) error {
+	ctx, cancel := context.WithTimeout(ctx, validateTimeout)
+	defer cancel()
+
+	it := client.TopicAdminClient.ListTopics(ctx, &pubsubpb.ListTopicsRequest{
+		Project:  "projects/" + projectID,
+		PageSize: 1,
+	})
+	_, err := it.Next()
+	if err == nil || err == iterator.Done {
+		return nil
+	}
+
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return fmt.Errorf("project %q not found or not accessible with these credentials: %w", projectID, err)
+	case codes.PermissionDenied:
+		if strings.Contains(st.Message(), "SERVICE_DISABLED") || strings.Contains(st.Message(), "has not been used") {
+			return fmt.Errorf("the Pub/Sub API is not enabled for project %q: enable pubsub.googleapis.com and try again: %w", projectID, err)
+		}
+		return fmt.Errorf("permission denied on project %q: the credentials need pubsub.topics.list (e.g. roles/pubsub.viewer): %w", projectID, err)
+	case codes.Unauthenticated:
+		return fmt.Errorf("%w: %s", models.ErrInvalidAuth, st.Message())
+	case codes.InvalidArgument:
+		return fmt.Errorf("invalid project ID %q: %w", projectID, err)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return fmt.Errorf("could not reach Pub/Sub: %w", err)
+	default:
+		return fmt.Errorf("failed to validate connection: %w", err)
+	}
+}
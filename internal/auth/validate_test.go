@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+// failListTopics returns an interceptor failing ListTopics calls with err
+func failListTopics(err error) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if strings.HasSuffix(method, "/ListTopics") {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func TestValidateConnection(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error // Returned by ListTopics; nil lets pstest answer
+		code     codes.Code
+		contains string // Empty when the connection is valid
+	}{
+		{name: "valid", code: codes.OK},
+		{name: "missing project", err: status.Error(codes.NotFound, "project not found"), code: codes.NotFound, contains: "not found"},
+		{name: "permission denied", err: status.Error(codes.PermissionDenied, "caller lacks permission"), code: codes.PermissionDenied, contains: "pubsub.topics.list"},
+		{name: "API disabled", err: status.Error(codes.PermissionDenied, "SERVICE_DISABLED"), code: codes.PermissionDenied, contains: "not enabled"},
+		{name: "invalid project", err: status.Error(codes.InvalidArgument, "bad name"), code: codes.InvalidArgument, contains: "invalid project ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []grpc.DialOption
+			if tt.err != nil {
+				opts = append(opts, grpc.WithUnaryInterceptor(failListTopics(tt.err)))
+			}
+			client, _ := pubsubtest.NewClient(t, opts...)
+
+			err := ValidateConnection(context.Background(), client, pubsubtest.ProjectID)
+			if tt.contains == "" {
+				if err != nil {
+					t.Fatalf("ValidateConnection() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.contains) {
+				t.Fatalf("ValidateConnection() error = %v, want it to contain %q", err, tt.contains)
+			}
+			// The gRPC status stays reachable through the friendly message
+			if got := status.Code(errors.Unwrap(err)); got != tt.code {
+				t.Errorf("ValidateConnection() wrapped status code = %v, want %v", got, tt.code)
+			}
+		})
+	}
+}
+
+func TestValidateConnection_Unauthenticated(t *testing.T) {
+	client, _ := pubsubtest.NewClient(t, grpc.WithUnaryInterceptor(failListTopics(status.Error(codes.Unauthenticated, "token expired"))))
+
+	err := ValidateConnection(context.Background(), client, pubsubtest.ProjectID)
+	if !errors.Is(err, models.ErrInvalidAuth) {
+		t.Errorf("ValidateConnection() error = %v, want ErrInvalidAuth", err)
+	}
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pubsub-gui/internal/models"
+)
+
+func TestManager_LoadConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    *string // nil when the file does not exist
+		wantErr    error
+		wantBuffer int
+	}{
+		{name: "missing file returns defaults", wantBuffer: models.NewDefaultConfig().MessageBufferSize},
+		{name: "saved config", content: ptr(`{"messageBufferSize": 42}`), wantBuffer: 42},
+		{name: "invalid JSON", content: ptr(`{"messageBufferSize":`), wantErr: models.ErrInvalidConfig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Manager{configPath: filepath.Join(t.TempDir(), ConfigFileName)}
+			if tt.content != nil {
+				if err := os.WriteFile(m.configPath, []byte(*tt.content), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			config, err := m.LoadConfig()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadConfig() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || config.MessageBufferSize != tt.wantBuffer {
+				t.Errorf("LoadConfig() = %+v, %v, want buffer size %d", config, err, tt.wantBuffer)
+			}
+		})
+	}
+}
+
+func TestManager_SaveConfig(t *testing.T) {
+	// The config directory is created on save if it was removed
+	m := &Manager{configPath: filepath.Join(t.TempDir(), ConfigDirName, ConfigFileName)}
+
+	config := models.NewDefaultConfig()
+	config.MessageBufferSize = 42
+	if err := m.SaveConfig(config); err != nil {
+		t.Fatalf("SaveConfig() error = %v", err)
+	}
+
+	info, err := os.Stat(m.configPath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("config file permissions = %o, want 600", perm)
+	}
+	if temps, _ := filepath.Glob(filepath.Join(filepath.Dir(m.configPath), "config-*.tmp")); len(temps) != 0 {
+		t.Errorf("temporary files left after SaveConfig(): %v", temps)
+	}
+
+	loaded, err := m.LoadConfig()
+	if err != nil || loaded.MessageBufferSize != 42 {
+		t.Errorf("LoadConfig() after SaveConfig() = %+v, %v, want buffer size 42", loaded, err)
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -2,6 +2,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 )
@@ -12,6 +13,9 @@ const (
 
 	// ConfigFileName is the name of the JSON config file
 	ConfigFileName = "config.json"
+
+	// ProfilesDirName is the directory (inside the config dir) holding per-profile data
+	ProfilesDirName = "profiles"
 )
 
 // GetConfigDir returns the full path to the configuration directory (~/.pubsub-gui)
@@ -31,3 +35,24 @@ func GetConfigPath() (string, error) {
 	}
 	return filepath.Join(configDir, ConfigFileName), nil
 }
+
+// ProfileDataDir returns the data directory of a profile (<configDir>/profiles/<profileID>)
+// Persisted captures are namespaced per profile so data from different environments never mixes
+func ProfileDataDir(configDir, profileID string) (string, error) {
+	if profileID == "" || profileID == "." || profileID == ".." || profileID != filepath.Base(profileID) {
+		return "", fmt.Errorf("invalid profile ID %q", profileID)
+	}
+	return filepath.Join(configDir, ProfilesDirName, profileID), nil
+}
+
+// EnsureProfileDataDir returns the data directory of a profile, creating it if needed
+func EnsureProfileDataDir(configDir, profileID string) (string, error) {
+	dir, err := ProfileDataDir(configDir, profileID)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create profile data directory: %w", err)
+	}
+	return dir, nil
+}
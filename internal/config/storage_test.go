@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfileDataDir(t *testing.T) {
+	configDir := filepath.Join("home", ".pubsub-gui")
+
+	tests := []struct {
+		name      string
+		profileID string
+		want      string // empty when the ID is rejected
+	}{
+		{"uuid", "3f2b8c1e-7a4d-4e8f-9b1a-2c3d4e5f6a7b", filepath.Join(configDir, "profiles", "3f2b8c1e-7a4d-4e8f-9b1a-2c3d4e5f6a7b")},
+		{"plain name", "dev", filepath.Join(configDir, "profiles", "dev")},
+		{"dots inside name", "dev..prod", filepath.Join(configDir, "profiles", "dev..prod")},
+		{"empty", "", ""},
+		{"current directory", ".", ""},
+		{"parent directory", "..", ""},
+		{"parent traversal", "../config.json", ""},
+		{"nested traversal", "dev/../../secrets", ""},
+		{"subdirectory", "dev/captures", ""},
+		{"absolute path", string(filepath.Separator) + "etc", ""},
+		{"trailing separator", "dev" + string(filepath.Separator), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProfileDataDir(configDir, tt.profileID)
+			if tt.want == "" {
+				if err == nil {
+					t.Errorf("ProfileDataDir(%q) = %q, want error", tt.profileID, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ProfileDataDir(%q) = %q, %v, want %q", tt.profileID, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnsureProfileDataDir(t *testing.T) {
+	configDir := t.TempDir()
+
+	dir, err := EnsureProfileDataDir(configDir, "dev")
+	if err != nil {
+		t.Fatalf("EnsureProfileDataDir() error = %v", err)
+	}
+	info, err := os.Stat(dir)
+	if err != nil || !info.IsDir() {
+		t.Fatalf("EnsureProfileDataDir() did not create %s: %v", dir, err)
+	}
+	if perm := info.Mode().Perm(); perm != 0700 {
+		t.Errorf("profile data directory permissions = %o, want 700", perm)
+	}
+
+	// Existing directories are reused
+	if again, err := EnsureProfileDataDir(configDir, "dev"); err != nil || again != dir {
+		t.Errorf("EnsureProfileDataDir() again = %q, %v, want %q", again, err, dir)
+	}
+
+	if _, err := EnsureProfileDataDir(configDir, ".."); err == nil {
+		t.Error("EnsureProfileDataDir(..) error = nil, want error")
+	}
+	entries, err := os.ReadDir(filepath.Join(configDir, ProfilesDirName))
+	if err != nil || len(entries) != 1 {
+		t.Errorf("profiles directory entries = %v, %v, want only dev", entries, err)
+	}
+}
@@ -0,0 +1,167 @@
+// Package emulator provides managed Docker emulator functionality
+package emulator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
+)
+
+// SetHealthHandler sets a callback invoked when the container of a running emulator disappears
+// (healthy is false and reason says why) and when an emulator reported unhealthy runs again
+func (m *Manager) SetHealthHandler(handler func(profileID string, healthy bool, reason string)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onHealthChange = handler
+}
+
+// StartHealthChecks runs CheckHealth every interval until StopHealthChecks or StopAll
+// Calling it again replaces the running checker, e.g. when the interval changes.
+func (m *Manager) StartHealthChecks(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(m.ctx)
+
+	m.mu.Lock()
+	if m.healthCancel != nil {
+		m.healthCancel()
+	}
+	m.healthCancel = cancel
+	m.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.CheckHealth()
+			}
+		}
+	}()
+}
+
+// StopHealthChecks stops the periodic health checks
+func (m *Manager) StopHealthChecks() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.healthCancel != nil {
+		m.healthCancel()
+		m.healthCancel = nil
+	}
+}
+
+// CheckHealth checks that the container of every running emulator still runs
+// Emulators whose container is gone (e.g. after a Docker daemon restart) are set to StatusError and
+// started again when their config has AutoStart.
+func (m *Manager) CheckHealth() {
+	m.checkHealth("")
+}
+
+// CheckProfileHealth checks that the container of a profile's emulator still runs, like CheckHealth
+func (m *Manager) CheckProfileHealth(profileID string) {
+	m.checkHealth(profileID)
+}
+
+// checkHealth inspects the containers of running emulators, only profileID's when it is set
+func (m *Manager) checkHealth(profileID string) {
+	m.mu.RLock()
+	containers := make(map[string]ContainerRuntime)
+	for id, info := range m.emulators {
+		if (profileID != "" && id != profileID) || info.Status != StatusRunning {
+			continue
+		}
+		if rt, ok := m.runtimes[id]; ok {
+			containers[info.ContainerName] = rt
+		}
+	}
+	m.mu.RUnlock()
+
+	for name, rt := range containers {
+		running, err := m.isContainerRunning(rt, name)
+		if err == nil && running {
+			continue
+		}
+		reason := "emulator container is no longer running"
+		if err != nil {
+			reason = fmt.Sprintf("failed to check emulator container: %v", err)
+		}
+		m.markUnhealthy(name, reason)
+	}
+	m.reportRecovered()
+}
+
+// markUnhealthy sets every running emulator using a container to StatusError, reports it and
+// restarts the ones whose config has AutoStart
+func (m *Manager) markUnhealthy(name, reason string) {
+	m.mu.Lock()
+	var flagged, restart []string
+	for id, info := range m.emulators {
+		if info.ContainerName != name || info.Status != StatusRunning {
+			continue
+		}
+		info.Status = StatusError
+		info.Error = reason
+		m.unhealthy[id] = true
+		m.releaseLocked(id)
+		flagged = append(flagged, id)
+		if config, ok := m.profiles[id]; ok && config.AutoStart {
+			restart = append(restart, id)
+		}
+	}
+	if cancel, ok := m.cancels[name]; ok {
+		cancel()
+		delete(m.cancels, name)
+	}
+	configs := make(map[string]models.ManagedEmulatorConfig, len(restart))
+	for _, id := range restart {
+		configs[id] = m.profiles[id]
+	}
+	handler := m.onHealthChange
+	m.mu.Unlock()
+
+	for _, id := range flagged {
+		logger.Warn("Emulator container is gone", "profileId", id, "container", name, "reason", reason)
+		if handler != nil {
+			handler(id, false, reason)
+		}
+	}
+	for _, id := range restart {
+		logger.Info("Restarting unhealthy emulator", "profileId", id)
+		config := configs[id]
+		if err := m.restartFunc(id, &config); err != nil {
+			logger.Error("Failed to restart unhealthy emulator", "profileId", id, "error", err)
+		}
+	}
+}
+
+// reportRecovered reports emulators flagged unhealthy that are running again
+func (m *Manager) reportRecovered() {
+	m.mu.Lock()
+	var recovered []string
+	for id := range m.unhealthy {
+		info, ok := m.emulators[id]
+		switch {
+		case !ok || info.Status == StatusStopped:
+			delete(m.unhealthy, id)
+		case info.Status == StatusRunning:
+			delete(m.unhealthy, id)
+			recovered = append(recovered, id)
+		}
+	}
+	handler := m.onHealthChange
+	m.mu.Unlock()
+
+	for _, id := range recovered {
+		logger.Info("Emulator recovered", "profileId", id)
+		if handler != nil {
+			handler(id, true, "")
+		}
+	}
+}
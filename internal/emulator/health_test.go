@@ -0,0 +1,172 @@
+package emulator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"pubsub-gui/internal/models"
+)
+
+// healthEvent is a recorded health handler call
+type healthEvent struct {
+	profileID string
+	healthy   bool
+}
+
+// healthRecorder collects health handler calls and restart requests
+type healthRecorder struct {
+	mu       sync.Mutex
+	events   []healthEvent
+	restarts []string
+}
+
+func (r *healthRecorder) handle(profileID string, healthy bool, reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, healthEvent{profileID: profileID, healthy: healthy})
+}
+
+func (r *healthRecorder) restart(profileID string, config *models.ManagedEmulatorConfig) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.restarts = append(r.restarts, profileID)
+	return nil
+}
+
+func (r *healthRecorder) snapshot() ([]healthEvent, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]healthEvent(nil), r.events...), append([]string(nil), r.restarts...)
+}
+
+// newHealthTestManager returns a manager with a running emulator for profileID whose container is gone
+func newHealthTestManager(t *testing.T, profileID string, autoStart bool) (*Manager, *fakeRuntime, *healthRecorder) {
+	t.Helper()
+	initTestLogger(t)
+	manager := NewManager(context.Background())
+	recorder := &healthRecorder{}
+	manager.SetHealthHandler(recorder.handle)
+	manager.restartFunc = recorder.restart
+
+	config := sharedTestConfig()
+	config.AutoStart = autoStart
+	rt := newFakeRuntime()
+	seedRunningEmulator(manager, profileID, config, rt)
+	manager.mu.Lock()
+	manager.profiles[profileID] = *config
+	manager.mu.Unlock()
+	return manager, rt, recorder
+}
+
+func TestManager_CheckHealth_MarksGoneContainer(t *testing.T) {
+	manager, _, recorder := newHealthTestManager(t, "profile-a", false)
+
+	manager.CheckHealth()
+
+	status := manager.GetStatus("profile-a")
+	if status.Status != StatusError || status.Error == "" {
+		t.Errorf("GetStatus() after CheckHealth() = %v (%q), want error with a reason", status.Status, status.Error)
+	}
+	events, restarts := recorder.snapshot()
+	if len(events) != 1 || events[0] != (healthEvent{profileID: "profile-a", healthy: false}) {
+		t.Errorf("health events = %+v, want one unhealthy event for profile-a", events)
+	}
+	if len(restarts) != 0 {
+		t.Errorf("restarts = %v, want none without AutoStart", restarts)
+	}
+	if got := refCount(manager, containerName("profile-a")); got != 0 {
+		t.Errorf("refs after container loss = %d, want 0", got)
+	}
+
+	// An emulator that is already in the error state is not reported again
+	manager.CheckHealth()
+	if events, _ := recorder.snapshot(); len(events) != 1 {
+		t.Errorf("health events after second check = %+v, want 1", events)
+	}
+}
+
+func TestManager_CheckHealth_RunningContainer(t *testing.T) {
+	manager, rt, recorder := newHealthTestManager(t, "profile-a", true)
+	rt.running[containerName("profile-a")] = true
+
+	manager.CheckHealth()
+
+	if !manager.IsRunning("profile-a") {
+		t.Error("IsRunning() = false after a healthy check, want true")
+	}
+	if events, restarts := recorder.snapshot(); len(events) != 0 || len(restarts) != 0 {
+		t.Errorf("healthy check reported events %+v and restarts %v, want none", events, restarts)
+	}
+}
+
+func TestManager_CheckHealth_AutoRestartAndRecover(t *testing.T) {
+	manager, rt, recorder := newHealthTestManager(t, "profile-a", true)
+
+	manager.CheckHealth()
+	if _, restarts := recorder.snapshot(); len(restarts) != 1 || restarts[0] != "profile-a" {
+		t.Fatalf("restarts = %v, want [profile-a]", restarts)
+	}
+
+	// The restarted container comes up; the next check reports the recovery once
+	rt.mu.Lock()
+	rt.running[containerName("profile-a")] = true
+	rt.mu.Unlock()
+	manager.mu.Lock()
+	manager.emulators["profile-a"].Status = StatusRunning
+	manager.emulators["profile-a"].Error = ""
+	manager.mu.Unlock()
+
+	manager.CheckHealth()
+	manager.CheckHealth()
+
+	events, _ := recorder.snapshot()
+	want := []healthEvent{{profileID: "profile-a", healthy: false}, {profileID: "profile-a", healthy: true}}
+	if len(events) != len(want) || events[0] != want[0] || events[1] != want[1] {
+		t.Errorf("health events = %+v, want %+v", events, want)
+	}
+}
+
+func TestManager_CheckHealth_SharedContainer(t *testing.T) {
+	manager, rt, recorder := newHealthTestManager(t, "profile-a", false)
+	startWithRuntime(t, manager, "profile-b", sharedTestConfig(), rt)
+	manager.mu.Lock()
+	manager.profiles["profile-b"] = models.ManagedEmulatorConfig{}
+	manager.mu.Unlock()
+
+	manager.CheckProfileHealth("profile-b")
+
+	for _, profileID := range []string{"profile-a", "profile-b"} {
+		if status := manager.GetStatus(profileID); status.Status != StatusError {
+			t.Errorf("GetStatus(%s) = %v, want %v", profileID, status.Status, StatusError)
+		}
+	}
+	if events, _ := recorder.snapshot(); len(events) != 2 {
+		t.Errorf("health events = %+v, want one per sharing profile", events)
+	}
+}
+
+func TestManager_StartHealthChecks_StoppedByStopAll(t *testing.T) {
+	manager, _, recorder := newHealthTestManager(t, "profile-a", false)
+
+	manager.StartHealthChecks(10 * time.Millisecond)
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if events, _ := recorder.snapshot(); len(events) > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("health checker reported no event")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	manager.StopAll()
+	manager.mu.RLock()
+	running := manager.healthCancel != nil
+	manager.mu.RUnlock()
+	if running {
+		t.Error("StopAll() left the health checker running")
+	}
+}
@@ -7,7 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -29,12 +32,19 @@ const (
 
 // EmulatorInfo contains information about a running emulator instance
 type EmulatorInfo struct {
-	ProfileID     string `json:"profileId"`
-	ContainerName string `json:"containerName"`
-	Host          string `json:"host"`
-	Port          int    `json:"port"`
-	Status        Status `json:"status"`
-	Error         string `json:"error,omitempty"`
+	ProfileID         string `json:"profileId"`
+	ContainerName     string `json:"containerName"`
+	Host              string `json:"host"`
+	Port              int    `json:"port"`                     // Host port actually assigned to the container
+	ConfiguredPort    int    `json:"configuredPort,omitempty"` // Port from the profile config when a different port was assigned
+	Status            Status `json:"status"`
+	Error             string `json:"error,omitempty"`
+	ImageID           string `json:"imageId,omitempty"`      // Image ID (sha256 digest) the running container was created from
+	ImageWarning      string `json:"imageWarning,omitempty"` // Set when the local image tag resolves to a different image than the container
+	Persistent        bool   `json:"persistent,omitempty"`   // Container is kept (not removed) when stopped
+	Runtime           string `json:"runtime,omitempty"`      // Container runtime running the emulator ("docker" or "podman")
+	DataDirConfigured bool   `json:"dataDirConfigured"`      // Emulator state is persisted to a host directory
+	DataDir           string `json:"dataDir,omitempty"`      // Host directory mounted at /data
 }
 
 // Manager manages Docker-based Pub/Sub emulator instances
@@ -42,40 +52,84 @@ type Manager struct {
 	mu        sync.RWMutex
 	emulators map[string]*EmulatorInfo // profileID -> emulator info
 	cancels   map[string]context.CancelFunc
+	runtimes  map[string]ContainerRuntime             // profileID -> runtime its container was started with
+	refs      map[string]int                          // containerName -> number of profiles using the container
+	holders   map[string]string                       // profileID -> containerName it holds a reference to
+	configs   map[string]resolvedConfig               // containerName -> config it was started with, for sharing
+	profiles  map[string]models.ManagedEmulatorConfig // profileID -> config passed to Start, for auto-restart
+	unhealthy map[string]bool                         // profileIDs whose container disappeared while running
 	ctx       context.Context
+
+	healthCancel   context.CancelFunc                                                 // Stops the health checker; nil when not running
+	onHealthChange func(profileID string, healthy bool, reason string)                // Called when an emulator turns unhealthy or recovers
+	restartFunc    func(profileID string, config *models.ManagedEmulatorConfig) error // Overridable for tests
+
+	removeContainerFunc func(rt ContainerRuntime, name string)                   // Overridable for tests
+	lookPath            func(file string) (string, error)                        // Overridable for tests
+	onPortReassigned    func(profileID string, configuredPort, assignedPort int) // Called when Start picks a different port
+}
+
+// maxPortSearch bounds how many ports above the configured one Start tries when reassigning
+const maxPortSearch = 100
+
+// SetPortReassignedHandler sets a callback invoked when Start assigns a different port than configured
+// because the configured port is held by another managed emulator
+func (m *Manager) SetPortReassignedHandler(handler func(profileID string, configuredPort, assignedPort int)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onPortReassigned = handler
 }
 
 // NewManager creates a new emulator manager
 func NewManager(ctx context.Context) *Manager {
-	return &Manager{
+	m := &Manager{
 		emulators: make(map[string]*EmulatorInfo),
 		cancels:   make(map[string]context.CancelFunc),
+		runtimes:  make(map[string]ContainerRuntime),
+		refs:      make(map[string]int),
+		holders:   make(map[string]string),
+		configs:   make(map[string]resolvedConfig),
+		profiles:  make(map[string]models.ManagedEmulatorConfig),
+		unhealthy: make(map[string]bool),
 		ctx:       ctx,
+		lookPath:  exec.LookPath,
 	}
+	m.removeContainerFunc = m.removeContainer
+	m.restartFunc = m.Start
+	return m
 }
 
-// CheckDocker validates that Docker is installed and the daemon is running
-func (m *Manager) CheckDocker() error {
-	// Check if docker CLI is available
-	_, err := exec.LookPath("docker")
+// CheckRuntime picks the container runtime for a preference ("", "auto", "docker" or "podman")
+// and validates that its CLI is installed and its daemon is running
+func (m *Manager) CheckRuntime(preference string) (ContainerRuntime, error) {
+	rt, err := selectRuntime(preference, m.lookPath)
 	if err != nil {
-		return fmt.Errorf("docker CLI not found: please install Docker Desktop or Docker Engine")
+		return nil, err
 	}
 
-	// Check if docker daemon is running
 	ctx, cancel := context.WithTimeout(m.ctx, 5*time.Second)
 	defer cancel()
+	if err := rt.IsAvailable(ctx); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
 
-	cmd := exec.CommandContext(ctx, "docker", "info")
-	output, err := cmd.CombinedOutput()
+// runtimeFor returns the runtime a profile's container was started with
+// When this manager never started it (e.g. after an app restart), the runtime is picked from preference.
+func (m *Manager) runtimeFor(profileID, preference string) ContainerRuntime {
+	m.mu.RLock()
+	rt, ok := m.runtimes[profileID]
+	m.mu.RUnlock()
+	if ok {
+		return rt
+	}
+	rt, err := selectRuntime(preference, m.lookPath)
 	if err != nil {
-		if ctx.Err() == context.DeadlineExceeded {
-			return fmt.Errorf("docker daemon not responding (timeout)")
-		}
-		return fmt.Errorf("docker daemon not running: %s", strings.TrimSpace(string(output)))
+		logger.Warn("Failed to select container runtime, falling back to auto-detection", "profileId", profileID, "runtime", preference, "error", err)
+		rt, _ = selectRuntime(RuntimeAuto, m.lookPath)
 	}
-
-	return nil
+	return rt
 }
 
 // containerName generates a unique container name for a profile
@@ -89,6 +143,9 @@ type resolvedConfig struct {
 	Image       string
 	BindAddress string
 	DataDir     string
+	Pinned      bool   // Image is referenced by digest
+	Persistent  bool   // Run without --rm and restart the stopped container instead of recreating it
+	Runtime     string // Runtime preference; Start replaces it with the selected runtime's name
 }
 
 // resolveConfig applies defaults to the emulator configuration
@@ -110,13 +167,56 @@ func resolveConfig(config *models.ManagedEmulatorConfig) resolvedConfig {
 	if config.BindAddress != "" {
 		rc.BindAddress = config.BindAddress
 	}
+	if config.ImageDigest != "" {
+		rc.Image = pinnedImageRef(rc.Image, config.ImageDigest)
+		rc.Pinned = true
+	}
 	rc.DataDir = config.DataDir
+	rc.Persistent = config.Persistent
+	rc.Runtime = config.Runtime
 	return rc
 }
 
-// buildDockerArgs builds the docker run command arguments
+// pinnedImageRef replaces the tag or digest of an image reference with the given digest
+// e.g. ("google/cloud-sdk:emulators", "sha256:abc") -> "google/cloud-sdk@sha256:abc"
+func pinnedImageRef(image, digest string) string {
+	repo := image
+	if at := strings.Index(repo, "@"); at >= 0 {
+		repo = repo[:at]
+	}
+	// A colon after the last slash separates the tag (a colon before it belongs to a registry port)
+	if colon := strings.LastIndex(repo, ":"); colon > strings.LastIndex(repo, "/") {
+		repo = repo[:colon]
+	}
+	if !strings.Contains(digest, ":") {
+		digest = "sha256:" + digest
+	}
+	return repo + "@" + digest
+}
+
+// qualifyImage prefixes images without a registry with docker.io for Podman, which does not
+// resolve short names to Docker Hub by default
+func qualifyImage(runtimeName, image string) string {
+	if runtimeName != RuntimePodman {
+		return image
+	}
+	if slash := strings.Index(image, "/"); slash > 0 {
+		first := image[:slash]
+		if strings.ContainsAny(first, ".:") || first == "localhost" {
+			return image
+		}
+	}
+	return "docker.io/" + image
+}
+
+// buildDockerArgs builds the `run` command arguments for the selected container runtime
 func buildDockerArgs(containerName string, cfg resolvedConfig) []string {
-	args := []string{"run", "--rm", "--name", containerName}
+	args := []string{"run"}
+	if !cfg.Persistent {
+		// Persistent containers are kept after stopping so `docker start` can resume them
+		args = append(args, "--rm")
+	}
+	args = append(args, "--name", containerName)
 
 	// Port mapping: allow LAN access only if explicitly set to 0.0.0.0
 	if cfg.BindAddress == "0.0.0.0" {
@@ -127,7 +227,12 @@ func buildDockerArgs(containerName string, cfg resolvedConfig) []string {
 
 	// Data directory volume mount if specified
 	if cfg.DataDir != "" {
-		args = append(args, "-v", fmt.Sprintf("%s:/data", cfg.DataDir))
+		volume := fmt.Sprintf("%s:/data", cfg.DataDir)
+		if cfg.Runtime == RuntimePodman {
+			// Relabel the directory so SELinux allows the rootless container to write to it
+			volume += ":Z"
+		}
+		args = append(args, "-v", volume)
 	}
 
 	// Image and command
@@ -139,9 +244,9 @@ func buildDockerArgs(containerName string, cfg resolvedConfig) []string {
 	return args
 }
 
-// tryReuseContainer checks if an existing container can be reused, returns true if reused
-func (m *Manager) tryReuseContainer(info *EmulatorInfo, cfg resolvedConfig, profileID string) bool {
-	running, err := m.isContainerRunning(info.ContainerName)
+// tryReuseContainer checks if an existing running container can be reused, returns true if reused
+func (m *Manager) tryReuseContainer(rt ContainerRuntime, info *EmulatorInfo, cfg resolvedConfig, profileID string) bool {
+	running, err := m.isContainerRunning(rt, info.ContainerName)
 	if err != nil {
 		logger.Warn("Error checking existing container", "container", info.ContainerName, "error", err)
 		return false
@@ -150,17 +255,17 @@ func (m *Manager) tryReuseContainer(info *EmulatorInfo, cfg resolvedConfig, prof
 		return false
 	}
 
-	configMatches, err := m.validateContainerConfig(info.ContainerName, cfg.Image, cfg.Port, cfg.BindAddress)
+	configMatches, err := m.validateContainerConfig(rt, info.ContainerName, cfg.Image, cfg.Port, cfg.BindAddress, true)
 	if err != nil {
 		logger.Warn("Error validating container config, recreating", "container", info.ContainerName, "error", err)
-		m.stopContainer(info.ContainerName)
-		m.removeContainer(info.ContainerName)
+		m.stopContainer(rt, info.ContainerName)
+		m.removeContainer(rt, info.ContainerName)
 		return false
 	}
 	if !configMatches {
 		logger.Info("Container config mismatch, recreating", "container", info.ContainerName, "profileId", profileID)
-		m.stopContainer(info.ContainerName)
-		m.removeContainer(info.ContainerName)
+		m.stopContainer(rt, info.ContainerName)
+		m.removeContainer(rt, info.ContainerName)
 		return false
 	}
 
@@ -168,6 +273,35 @@ func (m *Manager) tryReuseContainer(info *EmulatorInfo, cfg resolvedConfig, prof
 	m.mu.Lock()
 	info.Status = StatusRunning
 	m.mu.Unlock()
+	m.recordImageID(rt, profileID, info.ContainerName, cfg)
+	return true
+}
+
+// canRestartContainer reports whether a persistent profile has a stopped container with matching
+// config that can be started again with `docker start` (keeping its state) instead of being recreated
+func (m *Manager) canRestartContainer(rt ContainerRuntime, info *EmulatorInfo, cfg resolvedConfig, profileID string) bool {
+	if !cfg.Persistent {
+		return false
+	}
+
+	exists, running, err := m.containerState(rt, info.ContainerName)
+	if err != nil {
+		logger.Warn("Error checking stopped container", "container", info.ContainerName, "error", err)
+		return false
+	}
+	if !exists || running {
+		return false
+	}
+
+	configMatches, err := m.validateContainerConfig(rt, info.ContainerName, cfg.Image, cfg.Port, cfg.BindAddress, false)
+	if err != nil {
+		logger.Warn("Error validating stopped container config, recreating", "container", info.ContainerName, "error", err)
+		return false
+	}
+	if !configMatches {
+		logger.Info("Stopped container config mismatch, recreating", "container", info.ContainerName, "profileId", profileID)
+		return false
+	}
 	return true
 }
 
@@ -177,6 +311,17 @@ func (m *Manager) Start(profileID string, config *models.ManagedEmulatorConfig)
 	if config == nil {
 		logger.Info("Using default emulator config", "profileId", profileID)
 	}
+	rt, err := selectRuntime(cfg.Runtime, m.lookPath)
+	if err != nil {
+		return err
+	}
+	cfg.Runtime = rt.Name()
+	cfg.Image = qualifyImage(cfg.Runtime, cfg.Image)
+	if cfg.DataDir != "" {
+		if err := validateDataDir(cfg.DataDir); err != nil {
+			return err
+		}
+	}
 
 	m.mu.Lock()
 	if info, exists := m.emulators[profileID]; exists {
@@ -185,23 +330,82 @@ func (m *Manager) Start(profileID string, config *models.ManagedEmulatorConfig)
 			return nil
 		}
 	}
+	if config != nil {
+		m.profiles[profileID] = *config
+	} else {
+		m.profiles[profileID] = models.DefaultManagedEmulatorConfig()
+	}
+
+	// A profile with the same emulator settings as an active one shares its container
+	if shared := m.findSharedLocked(profileID, cfg); shared != nil {
+		info := *shared
+		info.ProfileID = profileID
+		info.Error = ""
+		m.emulators[profileID] = &info
+		m.runtimes[profileID] = rt
+		m.acquireLocked(profileID, info.ContainerName)
+		references := m.refs[info.ContainerName]
+		m.mu.Unlock()
+		logger.Info("Sharing emulator container with another profile", "profileId", profileID, "sharedWith", shared.ProfileID, "container", info.ContainerName, "references", references)
+		return nil
+	}
+
+	// The profile's own container may still be shared by profiles that were started with other settings
+	name := containerName(profileID)
+	m.releaseLocked(profileID)
+	if m.refs[name] > 0 {
+		m.mu.Unlock()
+		return fmt.Errorf("emulator container %s is still used by another profile: disconnect it first or use the same emulator settings", name)
+	}
+	shareConfig := cfg
+
+	// Another managed emulator may already hold the configured port; pick the next one it does not use
+	configuredPort := cfg.Port
+	assignedPort, err := m.assignPortLocked(profileID, configuredPort)
+	if err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	cfg.Port = assignedPort
+	onPortReassigned := m.onPortReassigned
 
 	info := &EmulatorInfo{
-		ProfileID:     profileID,
-		ContainerName: containerName(profileID),
-		Status:        StatusStarting,
-		Port:          cfg.Port,
-		Host:          cfg.BindAddress,
+		ProfileID:         profileID,
+		ContainerName:     name,
+		Status:            StatusStarting,
+		Port:              cfg.Port,
+		Host:              cfg.BindAddress,
+		Persistent:        cfg.Persistent,
+		Runtime:           cfg.Runtime,
+		DataDirConfigured: cfg.DataDir != "",
+		DataDir:           cfg.DataDir,
+	}
+	if assignedPort != configuredPort {
+		info.ConfiguredPort = configuredPort
 	}
 	m.emulators[profileID] = info
+	m.runtimes[profileID] = rt
+	m.acquireLocked(profileID, name)
+	m.configs[name] = shareConfig
 	m.mu.Unlock()
 
+	if assignedPort != configuredPort {
+		logger.Warn("Emulator port in use by another managed emulator, reassigned", "profileId", profileID, "configuredPort", configuredPort, "port", assignedPort)
+		if onPortReassigned != nil {
+			onPortReassigned(profileID, configuredPort, assignedPort)
+		}
+	}
+
 	// Try to reuse existing container
-	if m.tryReuseContainer(info, cfg, profileID) {
+	if m.tryReuseContainer(rt, info, cfg, profileID) {
 		return nil
 	}
 
-	m.removeContainer(info.ContainerName)
+	// A stopped persistent container is started again so its state survives; anything else is recreated
+	restart := m.canRestartContainer(rt, info, cfg, profileID)
+	if !restart {
+		m.removeContainer(rt, info.ContainerName)
+	}
 
 	if err := m.checkPortAvailable(cfg.BindAddress, cfg.Port); err != nil {
 		m.setError(profileID, err)
@@ -210,22 +414,103 @@ func (m *Manager) Start(profileID string, config *models.ManagedEmulatorConfig)
 
 	ctx, cancel := context.WithCancel(m.ctx)
 	m.mu.Lock()
-	m.cancels[profileID] = cancel
+	m.cancels[name] = cancel
 	m.mu.Unlock()
 
-	args := buildDockerArgs(info.ContainerName, cfg)
-	logger.Info("Starting emulator container", "profileId", profileID, "container", info.ContainerName, "port", cfg.Port, "image", cfg.Image)
+	var args []string
+	if restart {
+		// -a attaches so logs stream and the process lifetime tracks the container like `docker run`
+		args = []string{"start", "-a", info.ContainerName}
+		logger.Info("Restarting stopped emulator container", "profileId", profileID, "container", info.ContainerName)
+	} else {
+		args = buildDockerArgs(info.ContainerName, cfg)
+		logger.Info("Starting emulator container", "profileId", profileID, "container", info.ContainerName, "port", cfg.Port, "image", cfg.Image, "persistent", cfg.Persistent)
+	}
 
-	go m.runContainer(ctx, profileID, args)
+	go m.runContainer(ctx, rt, profileID, args)
 	time.Sleep(500 * time.Millisecond)
-	go m.waitForEmulator(ctx, profileID, fmt.Sprintf("127.0.0.1:%d", cfg.Port))
+	go func() {
+		if m.waitForEmulator(ctx, profileID, fmt.Sprintf("127.0.0.1:%d", cfg.Port)) {
+			m.recordImageID(rt, profileID, info.ContainerName, cfg)
+		}
+	}()
 
 	return nil
 }
 
+// assignPortLocked returns port, or the next port above it, that no other active managed emulator uses
+// Ports held by unrelated processes are left to checkPortAvailable. Caller must hold m.mu.
+func (m *Manager) assignPortLocked(profileID string, port int) (int, error) {
+	used := make(map[int]bool)
+	for id, info := range m.emulators {
+		if id == profileID || info.Status == StatusStopped || info.Status == StatusError {
+			continue
+		}
+		used[info.Port] = true
+	}
+	for candidate := port; candidate <= port+maxPortSearch && candidate <= 65535; candidate++ {
+		if !used[candidate] {
+			return candidate, nil
+		}
+	}
+	return 0, fmt.Errorf("no free emulator port found in %d-%d", port, port+maxPortSearch)
+}
+
+// findSharedLocked returns an active emulator of another profile started with the same config, or nil
+// Caller must hold m.mu.
+func (m *Manager) findSharedLocked(profileID string, cfg resolvedConfig) *EmulatorInfo {
+	for name, started := range m.configs {
+		if started != cfg || m.refs[name] == 0 {
+			continue
+		}
+		for _, info := range m.containerInfosLocked(name) {
+			if info.ProfileID != profileID && (info.Status == StatusRunning || info.Status == StatusStarting) {
+				return info
+			}
+		}
+	}
+	return nil
+}
+
+// containerInfosLocked returns the emulators of the profiles holding a reference to a container
+// Caller must hold m.mu.
+func (m *Manager) containerInfosLocked(name string) []*EmulatorInfo {
+	var infos []*EmulatorInfo
+	for profileID, held := range m.holders {
+		if info, exists := m.emulators[profileID]; exists && held == name {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// acquireLocked records that a profile uses a container, dropping any reference it held before
+// Caller must hold m.mu.
+func (m *Manager) acquireLocked(profileID, name string) {
+	m.releaseLocked(profileID)
+	m.holders[profileID] = name
+	m.refs[name]++
+}
+
+// releaseLocked drops a profile's reference to its container and returns how many references remain
+// held is false when the profile held no reference. Caller must hold m.mu.
+func (m *Manager) releaseLocked(profileID string) (remaining int, held bool) {
+	name, ok := m.holders[profileID]
+	if !ok {
+		return 0, false
+	}
+	delete(m.holders, profileID)
+	m.refs[name]--
+	if m.refs[name] <= 0 {
+		delete(m.refs, name)
+		return 0, true
+	}
+	return m.refs[name], true
+}
+
 // runContainer runs the docker container and streams logs
-func (m *Manager) runContainer(ctx context.Context, profileID string, args []string) {
-	cmd := exec.CommandContext(ctx, "docker", args...)
+func (m *Manager) runContainer(ctx context.Context, rt ContainerRuntime, profileID string, args []string) {
+	cmd := rt.Run(ctx, args...)
 
 	// Get stdout pipe for log streaming
 	stdout, err := cmd.StdoutPipe()
@@ -275,34 +560,38 @@ func (m *Manager) runContainer(ctx context.Context, profileID string, args []str
 	// Wait for command to complete
 	err = cmd.Wait()
 
+	name := containerName(profileID)
 	m.mu.Lock()
-	info := m.emulators[profileID]
-	if info != nil {
-		if ctx.Err() == context.Canceled {
-			// Expected stop
-			info.Status = StatusStopped
-			logger.Info("Emulator stopped", "profileId", profileID)
-		} else if err != nil {
-			// Unexpected error
+	defer m.mu.Unlock()
+	if ctx.Err() == context.Canceled {
+		// Expected stop: Stop already released the references and updated the status
+		logger.Info("Emulator stopped", "profileId", profileID)
+		return
+	}
+	// The container is gone, so every profile sharing it loses its reference
+	for _, info := range m.containerInfosLocked(name) {
+		if err != nil {
 			info.Status = StatusError
 			info.Error = err.Error()
-			logger.Error("Emulator process exited with error", "profileId", profileID, "error", err)
 		} else {
-			// Clean exit
 			info.Status = StatusStopped
-			logger.Info("Emulator exited", "profileId", profileID)
 		}
+		m.releaseLocked(info.ProfileID)
+	}
+	if err != nil {
+		logger.Error("Emulator process exited with error", "profileId", profileID, "error", err)
+	} else {
+		logger.Info("Emulator exited", "profileId", profileID)
 	}
-	m.mu.Unlock()
 }
 
-// waitForEmulator waits for the emulator to be responsive
-func (m *Manager) waitForEmulator(ctx context.Context, profileID string, host string) {
+// waitForEmulator waits for the emulator to be responsive, returns true once it is
+func (m *Manager) waitForEmulator(ctx context.Context, profileID string, host string) bool {
 	const maxRetries = 30 // 30 seconds total
 	for range maxRetries {
 		select {
 		case <-ctx.Done():
-			return
+			return false
 		default:
 		}
 
@@ -310,12 +599,13 @@ func (m *Manager) waitForEmulator(ctx context.Context, profileID string, host st
 		if err == nil {
 			conn.Close()
 			m.mu.Lock()
-			if info, exists := m.emulators[profileID]; exists {
+			for _, info := range m.containerInfosLocked(containerName(profileID)) {
 				info.Status = StatusRunning
-				logger.Info("Emulator is ready", "profileId", profileID, "host", host)
 			}
 			m.mu.Unlock()
-			return
+			logger.Info("Emulator is ready", "profileId", profileID, "host", host)
+			m.reportRecovered()
+			return true
 		}
 
 		time.Sleep(time.Second)
@@ -323,33 +613,40 @@ func (m *Manager) waitForEmulator(ctx context.Context, profileID string, host st
 
 	// Timeout waiting for emulator
 	m.mu.Lock()
-	if info, exists := m.emulators[profileID]; exists {
+	for _, info := range m.containerInfosLocked(containerName(profileID)) {
 		if info.Status == StatusStarting {
 			info.Status = StatusError
 			info.Error = "timeout waiting for emulator to start"
-			logger.Error("Timeout waiting for emulator", "profileId", profileID)
 		}
 	}
 	m.mu.Unlock()
+	logger.Error("Timeout waiting for emulator", "profileId", profileID)
+	return false
 }
 
 // Stop stops the emulator for a profile
+// A container shared with other profiles keeps running until the last profile using it stops.
 func (m *Manager) Stop(profileID string) error {
 	m.mu.Lock()
 	info, exists := m.emulators[profileID]
-	cancel, hasCancel := m.cancels[profileID]
-	m.mu.Unlock()
-
+	remaining, held := m.releaseLocked(profileID)
 	if !exists || info.Status == StatusStopped {
+		m.mu.Unlock()
 		return nil // Already stopped
 	}
-
-	logger.Info("Stopping emulator", "profileId", profileID)
-
-	m.mu.Lock()
+	if held && remaining > 0 {
+		info.Status = StatusStopped
+		m.mu.Unlock()
+		logger.Info("Emulator container still used by other profiles, leaving it running", "profileId", profileID, "container", info.ContainerName, "references", remaining)
+		return nil
+	}
+	containerName := info.ContainerName
+	cancel, hasCancel := m.cancels[containerName]
 	info.Status = StatusStopping
 	m.mu.Unlock()
 
+	logger.Info("Stopping emulator", "profileId", profileID)
+
 	// Cancel context to signal graceful stop
 	if hasCancel {
 		cancel()
@@ -359,35 +656,111 @@ func (m *Manager) Stop(profileID string) error {
 	time.Sleep(500 * time.Millisecond)
 
 	// Check if container is still running
-	containerName := containerName(profileID)
-	running, err := m.isContainerRunning(containerName)
+	m.mu.RLock()
+	preference := m.profiles[profileID].Runtime
+	m.mu.RUnlock()
+	rt := m.runtimeFor(profileID, preference)
+	running, err := m.isContainerRunning(rt, containerName)
 	if err != nil {
 		logger.Error("Failed to check container status", "profileId", profileID, "container", containerName, "error", err)
 		return fmt.Errorf("failed to check container status: %w", err)
 	}
 
-	// Force stop if still running
+	// Force stop if still running; persistent containers are kept so they can be restarted later
 	if running {
-		logger.Info("Force stopping container", "container", containerName)
-		m.stopContainer(containerName)
+		logger.Info("Force stopping container", "container", containerName, "persistent", info.Persistent)
+		if info.Persistent {
+			m.haltContainer(rt, containerName)
+		} else {
+			m.stopContainer(rt, containerName)
+		}
 	}
 
 	m.mu.Lock()
 	info.Status = StatusStopped
-	delete(m.cancels, profileID)
+	delete(m.cancels, containerName)
 	m.mu.Unlock()
 
 	return nil
 }
 
-// StopAll stops all running emulators
-func (m *Manager) StopAll() {
+// Restart stops the emulator for a profile gracefully and starts it again
+// The emulator writes its state to the data directory when it shuts down, and reloads it on start.
+func (m *Manager) Restart(profileID string, config *models.ManagedEmulatorConfig) error {
+	if err := m.Stop(profileID); err != nil {
+		return err
+	}
+	return m.Start(profileID, config)
+}
+
+// Remove stops the emulator for a profile, removes its container and forgets the profile
+// The container is removed even if this manager never started it (e.g. left over from a previous run),
+// using the runtime configured in config.
+func (m *Manager) Remove(profileID string, config *models.ManagedEmulatorConfig) error {
+	if err := m.Stop(profileID); err != nil {
+		return err
+	}
+
+	name := containerName(profileID)
 	m.mu.RLock()
+	inUse := m.refs[name] > 0
+	m.mu.RUnlock()
+	if inUse {
+		logger.Info("Keeping emulator container still used by other profiles", "profileId", profileID, "container", name)
+	} else {
+		m.removeContainerFunc(m.runtimeFor(profileID, resolveConfig(config).Runtime), name)
+	}
+
+	m.mu.Lock()
+	delete(m.emulators, profileID)
+	delete(m.runtimes, profileID)
+	delete(m.profiles, profileID)
+	delete(m.unhealthy, profileID)
+	if !inUse {
+		delete(m.cancels, name)
+		delete(m.configs, name)
+	}
+	m.mu.Unlock()
+
+	return nil
+}
+
+// SharingProfiles returns the other profiles that hold a reference to profileID's container,
+// or to any container persisting to dataDir (when set), sorted by ID
+// Flushing or resetting the profile's emulator would affect them, because Stop leaves a shared container running.
+func (m *Manager) SharingProfiles(profileID, dataDir string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	name, ok := m.holders[profileID]
+	if !ok {
+		name = containerName(profileID)
+	}
+	var others []string
+	for holder, held := range m.holders {
+		if holder == profileID {
+			continue
+		}
+		if held == name || (dataDir != "" && m.configs[held].DataDir == dataDir) {
+			others = append(others, holder)
+		}
+	}
+	sort.Strings(others)
+	return others
+}
+
+// StopAll stops the health checker and all running emulators, including containers other profiles still reference
+func (m *Manager) StopAll() {
+	m.StopHealthChecks()
+
+	m.mu.Lock()
 	profileIDs := make([]string, 0, len(m.emulators))
 	for id := range m.emulators {
 		profileIDs = append(profileIDs, id)
 	}
-	m.mu.RUnlock()
+	m.refs = make(map[string]int)
+	m.holders = make(map[string]string)
+	m.mu.Unlock()
 
 	for _, id := range profileIDs {
 		m.Stop(id)
@@ -409,13 +782,38 @@ func (m *Manager) GetStatus(profileID string) *EmulatorInfo {
 
 	// Return a copy
 	return &EmulatorInfo{
-		ProfileID:     info.ProfileID,
-		ContainerName: info.ContainerName,
-		Host:          info.Host,
-		Port:          info.Port,
-		Status:        info.Status,
-		Error:         info.Error,
+		ProfileID:         info.ProfileID,
+		ContainerName:     info.ContainerName,
+		Host:              info.Host,
+		Port:              info.Port,
+		ConfiguredPort:    info.ConfiguredPort,
+		Status:            info.Status,
+		Error:             info.Error,
+		ImageID:           info.ImageID,
+		ImageWarning:      info.ImageWarning,
+		Persistent:        info.Persistent,
+		Runtime:           info.Runtime,
+		DataDirConfigured: info.DataDirConfigured,
+		DataDir:           info.DataDir,
+	}
+}
+
+// ConnectHost returns the host:port to connect to the emulator of a profile, using the port actually
+// assigned by Start. Returns false when the profile's emulator is not starting or running.
+func (m *Manager) ConnectHost(profileID string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	info, exists := m.emulators[profileID]
+	if !exists || info.Port == 0 || (info.Status != StatusRunning && info.Status != StatusStarting) {
+		return "", false
+	}
+	host := info.Host
+	if host == "" || host == "0.0.0.0" {
+		// Always connect to localhost, even if bound to 0.0.0.0
+		host = "127.0.0.1"
 	}
+	return fmt.Sprintf("%s:%d", host, info.Port), true
 }
 
 // IsRunning returns true if the emulator for a profile is running
@@ -439,35 +837,26 @@ func (m *Manager) checkPortAvailable(host string, port int) error {
 }
 
 // isContainerRunning checks if a container with the given name is running
-func (m *Manager) isContainerRunning(name string) (bool, error) {
+func (m *Manager) isContainerRunning(rt ContainerRuntime, name string) (bool, error) {
+	_, running, err := m.containerState(rt, name)
+	return running, err
+}
+
+// containerState reports whether a container with the given name exists and whether it is running
+func (m *Manager) containerState(rt ContainerRuntime, name string) (exists bool, running bool, err error) {
 	ctx, cancel := context.WithTimeout(m.ctx, 5*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "docker", "inspect", "-f", "{{.State.Running}}", name)
-	output, err := cmd.Output()
+	output, err := rt.Inspect(ctx, name, "{{.State.Running}}", false)
 	if err != nil {
-		// Check if it's a context deadline error
-		if errors.Is(err, context.DeadlineExceeded) {
-			return false, err
-		}
-
-		// Check if it's an ExitError (container not found case)
-		var exitErr *exec.ExitError
-		if errors.As(err, &exitErr) {
-			// Check stderr for "No such" or "No such object" (expected container not found case)
-			stderr := string(exitErr.Stderr)
-			if strings.Contains(stderr, "No such") || strings.Contains(stderr, "No such object") {
-				return false, nil // Container doesn't exist - expected case
-			}
-			// Other ExitError cases (permission denied, etc.) should be returned
-			return false, err
+		if errors.Is(err, errNoSuchObject) {
+			return false, false, nil // Container doesn't exist - expected case
 		}
-
-		// Any other error (non-ExitError) should be returned
-		return false, err
+		// Deadline, permission denied etc. are returned
+		return false, false, err
 	}
 
-	return strings.TrimSpace(string(output)) == "true", nil
+	return true, output == "true", nil
 }
 
 // parsePortMapping parses Docker port mapping output and extracts the bind address for the expected port.
@@ -507,19 +896,18 @@ func normalizeBindAddr(addr, defaultAddr string) string {
 	return addr
 }
 
-// validateContainerConfig checks if a running container's configuration matches the requested config.
+// validateContainerConfig checks if a container's configuration matches the requested config.
+// Stopped containers have no live port mappings, so their configured port bindings are compared instead.
 // Returns true if config matches, false if it doesn't, and error if inspection fails.
-func (m *Manager) validateContainerConfig(containerName, expectedImage string, expectedPort int, expectedBindAddr string) (bool, error) {
+func (m *Manager) validateContainerConfig(rt ContainerRuntime, containerName, expectedImage string, expectedPort int, expectedBindAddr string, running bool) (bool, error) {
 	ctx, cancel := context.WithTimeout(m.ctx, 5*time.Second)
 	defer cancel()
 
 	// Validate image
-	cmd := exec.CommandContext(ctx, "docker", "inspect", "-f", "{{.Config.Image}}", containerName)
-	imageOutput, err := cmd.Output()
+	actualImage, err := rt.Inspect(ctx, containerName, "{{.Config.Image}}", false)
 	if err != nil {
 		return false, fmt.Errorf("failed to inspect container image: %w", err)
 	}
-	actualImage := strings.TrimSpace(string(imageOutput))
 	normalizedExpectedImage := normalizeBindAddr(expectedImage, "google/cloud-sdk:emulators")
 
 	if actualImage != normalizedExpectedImage {
@@ -528,13 +916,15 @@ func (m *Manager) validateContainerConfig(containerName, expectedImage string, e
 	}
 
 	// Validate port mapping
-	cmd = exec.CommandContext(ctx, "docker", "inspect", "-f", "{{range $k, $v := .NetworkSettings.Ports}}{{$k}}={{range $v}}{{.HostIp}}:{{.HostPort}}{{end}} {{end}}", containerName)
-	portOutput, err := cmd.Output()
+	portsField := ".NetworkSettings.Ports"
+	if !running {
+		portsField = ".HostConfig.PortBindings"
+	}
+	portMapping, err := rt.Inspect(ctx, containerName, "{{range $k, $v := "+portsField+"}}{{$k}}={{range $v}}{{.HostIp}}:{{.HostPort}}{{end}} {{end}}", false)
 	if err != nil {
 		return false, fmt.Errorf("failed to inspect container ports: %w", err)
 	}
 
-	portMapping := strings.TrimSpace(string(portOutput))
 	actualBindAddr, found := parsePortMapping(portMapping, expectedPort)
 	if !found {
 		logger.Info("Container port mapping not found", "container", containerName, "expectedHostPort", expectedPort, "actualMapping", portMapping)
@@ -553,29 +943,86 @@ func (m *Manager) validateContainerConfig(containerName, expectedImage string, e
 	return true, nil
 }
 
+// recordImageID stores the image ID of a running container and warns when the configured
+// tag now resolves to a different (typically newer, pulled later) image locally
+// Pinned images are referenced by digest and cannot drift, so they are not compared
+func (m *Manager) recordImageID(rt ContainerRuntime, profileID, containerName string, cfg resolvedConfig) {
+	ctx, cancel := context.WithTimeout(m.ctx, 5*time.Second)
+	defer cancel()
+
+	imageID, err := rt.Inspect(ctx, containerName, "{{.Image}}", false)
+	if err != nil {
+		logger.Warn("Failed to inspect emulator container image", "container", containerName, "error", err)
+		return
+	}
+
+	var warning string
+	if !cfg.Pinned {
+		localImageID, err := rt.Inspect(ctx, cfg.Image, "{{.Id}}", true)
+		if err != nil {
+			logger.Warn("Failed to inspect local emulator image", "image", cfg.Image, "error", err)
+		} else {
+			warning = imageDriftWarning(cfg.Image, imageID, localImageID)
+		}
+	}
+	if warning != "" {
+		logger.Warn("Emulator container runs a stale image", "profileId", profileID, "container", containerName, "image", cfg.Image, "containerImageId", imageID)
+	}
+
+	m.mu.Lock()
+	for _, info := range m.containerInfosLocked(containerName) {
+		info.ImageID = imageID
+		info.ImageWarning = warning
+	}
+	m.mu.Unlock()
+}
+
+// imageDriftWarning returns a warning when the local tag resolves to a different image than the container uses
+func imageDriftWarning(image, containerImageID, localImageID string) string {
+	if containerImageID == "" || localImageID == "" || containerImageID == localImageID {
+		return ""
+	}
+	return fmt.Sprintf("local image %s now resolves to %s but the container runs %s; restart the emulator to use the newer image or pin a digest",
+		image, shortImageID(localImageID), shortImageID(containerImageID))
+}
+
+// shortImageID shortens "sha256:<hex>" to the first 12 hex characters like the docker CLI does
+func shortImageID(id string) string {
+	hex := strings.TrimPrefix(id, "sha256:")
+	if len(hex) > 12 {
+		hex = hex[:12]
+	}
+	return hex
+}
+
 // stopContainer stops a container
-func (m *Manager) stopContainer(name string) {
+func (m *Manager) stopContainer(rt ContainerRuntime, name string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "docker", "stop", name)
-	cmd.Run() // Ignore errors
+	rt.Stop(ctx, name) // Ignore errors
 
 	// Force remove if still exists
-	cmd = exec.CommandContext(ctx, "docker", "rm", "-f", name)
-	cmd.Run() // Ignore errors
+	rt.Remove(ctx, name) // Ignore errors
+}
+
+// haltContainer stops a container without removing it (used for persistent containers)
+func (m *Manager) haltContainer(rt ContainerRuntime, name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rt.Stop(ctx, name) // Ignore errors
 }
 
 // removeContainer removes a stopped container
-func (m *Manager) removeContainer(name string) {
+func (m *Manager) removeContainer(rt ContainerRuntime, name string) {
 	ctx, cancel := context.WithTimeout(m.ctx, 5*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "docker", "rm", "-f", name)
-	cmd.Run() // Ignore errors - container may not exist
+	rt.Remove(ctx, name) // Ignore errors - container may not exist
 }
 
-// setError sets the error status for an emulator
+// setError sets the error status for an emulator and the profiles sharing its container
 func (m *Manager) setError(profileID string, err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -584,6 +1031,64 @@ func (m *Manager) setError(profileID string, err error) {
 		info.Status = StatusError
 		info.Error = err.Error()
 	}
+	for _, info := range m.containerInfosLocked(containerName(profileID)) {
+		info.Status = StatusError
+		info.Error = err.Error()
+	}
 
 	logger.Error("Emulator error", "profileId", profileID, "error", err)
 }
+
+// validateDataDir checks that an emulator data directory is an existing, writable absolute path
+// so a bad path fails with an actionable error instead of an opaque container runtime error
+func validateDataDir(dir string) error {
+	if !filepath.IsAbs(dir) {
+		return fmt.Errorf("emulator data directory %q must be an absolute path", dir)
+	}
+	stat, err := os.Stat(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("emulator data directory %s does not exist: create it or clear the data directory setting", dir)
+		}
+		return fmt.Errorf("cannot access emulator data directory %s: %w", dir, err)
+	}
+	if !stat.IsDir() {
+		return fmt.Errorf("emulator data directory %s is not a directory", dir)
+	}
+
+	probe, err := os.CreateTemp(dir, ".pubsub-gui-write-check-*")
+	if err != nil {
+		return fmt.Errorf("emulator data directory %s is not writable: check its permissions", dir)
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+	return nil
+}
+
+// ClearDataDir removes everything inside an emulator data directory, keeping the directory itself
+// Refuses relative paths, the filesystem root and the user's home directory as a safety net
+func ClearDataDir(dir string) error {
+	clean := filepath.Clean(dir)
+	if !filepath.IsAbs(clean) || clean == filepath.Dir(clean) {
+		return fmt.Errorf("refusing to clear data directory %q", dir)
+	}
+	if home, err := os.UserHomeDir(); err == nil && clean == filepath.Clean(home) {
+		return fmt.Errorf("refusing to clear home directory %q", dir)
+	}
+
+	entries, err := os.ReadDir(clean)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read data directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(clean, entry.Name())); err != nil {
+			return fmt.Errorf("failed to clear data directory: %w", err)
+		}
+	}
+
+	return nil
+}
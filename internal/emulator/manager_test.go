@@ -2,9 +2,15 @@ package emulator
 
 import (
 	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
 
+	"pubsub-gui/internal/logger"
 	"pubsub-gui/internal/models"
 )
 
@@ -276,6 +282,56 @@ func TestManager_checkPortAvailable(t *testing.T) {
 	// Port was available - test passes
 }
 
+func TestManager_assignPortLocked(t *testing.T) {
+	manager := NewManager(context.Background())
+	manager.mu.Lock()
+	manager.emulators["a"] = &EmulatorInfo{ProfileID: "a", Port: 8085, Status: StatusRunning}
+	manager.emulators["b"] = &EmulatorInfo{ProfileID: "b", Port: 8086, Status: StatusStarting}
+	manager.emulators["c"] = &EmulatorInfo{ProfileID: "c", Port: 8087, Status: StatusStopped}
+	defer manager.mu.Unlock()
+
+	tests := []struct {
+		name      string
+		profileID string
+		port      int
+		want      int
+	}{
+		{"free port kept", "new", 9000, 9000},
+		{"skips ports of active emulators", "new", 8085, 8087},
+		{"own port is not a conflict", "a", 8085, 8085},
+		{"stopped emulator does not hold its port", "new", 8087, 8087},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := manager.assignPortLocked(tt.profileID, tt.port)
+			if err != nil {
+				t.Fatalf("assignPortLocked() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("assignPortLocked() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManager_ConnectHost(t *testing.T) {
+	manager := NewManager(context.Background())
+	manager.mu.Lock()
+	manager.emulators["lan"] = &EmulatorInfo{ProfileID: "lan", Host: "0.0.0.0", Port: 8086, Status: StatusRunning}
+	manager.emulators["stopped"] = &EmulatorInfo{ProfileID: "stopped", Host: "127.0.0.1", Port: 8087, Status: StatusStopped}
+	manager.mu.Unlock()
+
+	if host, ok := manager.ConnectHost("lan"); !ok || host != "127.0.0.1:8086" {
+		t.Errorf("ConnectHost(lan) = %q, %v, want 127.0.0.1:8086, true", host, ok)
+	}
+	if _, ok := manager.ConnectHost("stopped"); ok {
+		t.Error("ConnectHost(stopped) ok = true, want false")
+	}
+	if _, ok := manager.ConnectHost("unknown"); ok {
+		t.Error("ConnectHost(unknown) ok = true, want false")
+	}
+}
+
 // TestManagedEmulatorConfig tests config defaults and values
 func TestManagedEmulatorConfig_Defaults(t *testing.T) {
 	config := models.DefaultManagedEmulatorConfig()
@@ -297,6 +353,119 @@ func TestManagedEmulatorConfig_Defaults(t *testing.T) {
 	}
 }
 
+func TestManager_Remove_RemovesContainer(t *testing.T) {
+	manager := NewManager(context.Background())
+
+	var removed []string
+	manager.removeContainerFunc = func(_ ContainerRuntime, name string) {
+		removed = append(removed, name)
+	}
+
+	manager.mu.Lock()
+	manager.emulators["deleted-profile"] = &EmulatorInfo{
+		ProfileID: "deleted-profile",
+		Status:    StatusStopped,
+	}
+	manager.mu.Unlock()
+
+	if err := manager.Remove("deleted-profile", nil); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+
+	if len(removed) != 1 || removed[0] != containerName("deleted-profile") {
+		t.Errorf("Remove() removed containers %v, want [%s]", removed, containerName("deleted-profile"))
+	}
+	if status := manager.GetStatus("deleted-profile"); status.Status != StatusStopped {
+		t.Errorf("GetStatus() after Remove() = %v, want %v", status.Status, StatusStopped)
+	}
+	manager.mu.RLock()
+	_, tracked := manager.emulators["deleted-profile"]
+	manager.mu.RUnlock()
+	if tracked {
+		t.Error("Remove() did not forget the profile")
+	}
+}
+
+func TestManager_Remove_UnknownProfile(t *testing.T) {
+	manager := NewManager(context.Background())
+
+	var removed []string
+	manager.removeContainerFunc = func(_ ContainerRuntime, name string) {
+		removed = append(removed, name)
+	}
+
+	// A container may exist from a previous run even if this manager never started it
+	if err := manager.Remove("never-started", nil); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if len(removed) != 1 {
+		t.Errorf("Remove() removed %d containers, want 1", len(removed))
+	}
+}
+
+func TestManager_Remove_UsesConfiguredRuntime(t *testing.T) {
+	manager := NewManager(context.Background())
+	manager.lookPath = func(file string) (string, error) { return "/usr/bin/" + file, nil }
+
+	var runtimes []string
+	manager.removeContainerFunc = func(rt ContainerRuntime, _ string) {
+		runtimes = append(runtimes, rt.Name())
+	}
+
+	// After an app restart the manager has no record of the runtime the container was started with
+	if err := manager.Remove("podman-profile", &models.ManagedEmulatorConfig{Runtime: RuntimePodman}); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if err := manager.Remove("default-profile", nil); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if want := []string{RuntimePodman, RuntimeDocker}; !reflect.DeepEqual(runtimes, want) {
+		t.Errorf("Remove() used runtimes %v, want %v", runtimes, want)
+	}
+}
+
+func TestClearDataDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "topics.json"), []byte("{}"), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "nested", "deeper"), 0700); err != nil {
+		t.Fatalf("failed to create nested dir: %v", err)
+	}
+
+	if err := ClearDataDir(dir); err != nil {
+		t.Fatalf("ClearDataDir() error = %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("data directory was removed: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("ClearDataDir() left %d entries, want 0", len(entries))
+	}
+}
+
+func TestClearDataDir_Refuses(t *testing.T) {
+	for _, dir := range []string{"relative/data", "/", ""} {
+		if err := ClearDataDir(dir); err == nil {
+			t.Errorf("ClearDataDir(%q) error = nil, want error", dir)
+		}
+	}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		if err := ClearDataDir(home); err == nil {
+			t.Error("ClearDataDir(home) error = nil, want error")
+		}
+	}
+}
+
+func TestClearDataDir_Missing(t *testing.T) {
+	if err := ClearDataDir(filepath.Join(t.TempDir(), "missing")); err != nil {
+		t.Errorf("ClearDataDir() on missing dir error = %v, want nil", err)
+	}
+}
+
 // Integration-like tests that verify the manager handles multiple profiles
 func TestManager_MultipleProfiles(t *testing.T) {
 	ctx := context.Background()
@@ -326,10 +495,244 @@ func TestManager_MultipleProfiles(t *testing.T) {
 	}
 }
 
-// Note: TestManager_StopAll_WithMultipleProfiles and TestManager_Stop are skipped
-// because Stop() internally calls the logger which requires initialization that
-// conflicts with test execution. The stop functionality works correctly in the
-// actual application where the logger is properly initialized.
+// fakeRuntime records stop calls and reports containers as running until they are stopped
+type fakeRuntime struct {
+	mu      sync.Mutex
+	running map[string]bool
+	stopped []string
+}
+
+func newFakeRuntime(running ...string) *fakeRuntime {
+	rt := &fakeRuntime{running: make(map[string]bool)}
+	for _, name := range running {
+		rt.running[name] = true
+	}
+	return rt
+}
+
+func (f *fakeRuntime) Name() string                                  { return RuntimeDocker }
+func (f *fakeRuntime) IsAvailable(ctx context.Context) error         { return nil }
+func (f *fakeRuntime) Remove(ctx context.Context, name string) error { return nil }
+
+func (f *fakeRuntime) Run(ctx context.Context, args ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, "true")
+}
+
+func (f *fakeRuntime) Inspect(ctx context.Context, name, format string, image bool) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.running[name] {
+		return "true", nil
+	}
+	return "false", nil
+}
+
+func (f *fakeRuntime) Stop(ctx context.Context, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.running[name] = false
+	f.stopped = append(f.stopped, name)
+	return nil
+}
+
+func (f *fakeRuntime) stops() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.stopped...)
+}
+
+// initTestLogger points the global logger at a temporary home directory
+func initTestLogger(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	if err := logger.InitLogger(); err != nil {
+		t.Fatalf("InitLogger() error = %v", err)
+	}
+}
+
+// sharedTestConfig is the emulator config both profiles of the sharing tests use
+func sharedTestConfig() *models.ManagedEmulatorConfig {
+	config := models.DefaultManagedEmulatorConfig()
+	config.Port = 59185
+	config.Runtime = RuntimeDocker
+	return &config
+}
+
+// seedRunningEmulator registers a running container for a profile as Start would, without running docker
+func seedRunningEmulator(m *Manager, profileID string, config *models.ManagedEmulatorConfig, rt ContainerRuntime) {
+	cfg := resolveConfig(config)
+	cfg.Runtime = rt.Name()
+	cfg.Image = qualifyImage(cfg.Runtime, cfg.Image)
+	name := containerName(profileID)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.emulators[profileID] = &EmulatorInfo{
+		ProfileID:     profileID,
+		ContainerName: name,
+		Host:          cfg.BindAddress,
+		Port:          cfg.Port,
+		Status:        StatusRunning,
+		Runtime:       cfg.Runtime,
+	}
+	m.runtimes[profileID] = rt
+	m.acquireLocked(profileID, name)
+	m.configs[name] = cfg
+}
+
+// startWithRuntime starts a profile that shares a seeded container and swaps in the fake runtime
+func startWithRuntime(t *testing.T, m *Manager, profileID string, config *models.ManagedEmulatorConfig, rt ContainerRuntime) {
+	t.Helper()
+	if err := m.Start(profileID, config); err != nil {
+		t.Fatalf("Start(%s) error = %v", profileID, err)
+	}
+	m.mu.Lock()
+	m.runtimes[profileID] = rt
+	m.mu.Unlock()
+}
+
+// refCount returns the number of profiles referencing a container
+func refCount(m *Manager, name string) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.refs[name]
+}
+
+func TestManager_SharedContainer_ShareAndSwitch(t *testing.T) {
+	initTestLogger(t)
+	manager := NewManager(context.Background())
+	config := sharedTestConfig()
+	shared := containerName("profile-a")
+	rt := newFakeRuntime(shared)
+	seedRunningEmulator(manager, "profile-a", config, rt)
+
+	// profile-b has the same settings, so it attaches to profile-a's container
+	startWithRuntime(t, manager, "profile-b", config, rt)
+
+	status := manager.GetStatus("profile-b")
+	if status.ContainerName != shared || status.Status != StatusRunning || status.Port != config.Port {
+		t.Fatalf("GetStatus(profile-b) = %+v, want running on %s port %d", status, shared, config.Port)
+	}
+	if got := refCount(manager, shared); got != 2 {
+		t.Fatalf("refs after sharing = %d, want 2", got)
+	}
+
+	// Switching away from profile-a must not kill the container profile-b still uses
+	if err := manager.Stop("profile-a"); err != nil {
+		t.Fatalf("Stop(profile-a) error = %v", err)
+	}
+	if stops := rt.stops(); len(stops) != 0 {
+		t.Fatalf("Stop(profile-a) stopped containers %v, want none", stops)
+	}
+	if manager.IsRunning("profile-a") {
+		t.Error("IsRunning(profile-a) = true after Stop, want false")
+	}
+	if host, ok := manager.ConnectHost("profile-b"); !ok || host != "127.0.0.1:59185" {
+		t.Errorf("ConnectHost(profile-b) = %q, %v, want 127.0.0.1:59185, true", host, ok)
+	}
+
+	// Switching back attaches profile-a to the container profile-b kept alive
+	startWithRuntime(t, manager, "profile-a", config, rt)
+	if got := refCount(manager, shared); got != 2 {
+		t.Fatalf("refs after switching back = %d, want 2", got)
+	}
+
+	if err := manager.Stop("profile-b"); err != nil {
+		t.Fatalf("Stop(profile-b) error = %v", err)
+	}
+	if stops := rt.stops(); len(stops) != 0 {
+		t.Fatalf("Stop(profile-b) stopped containers %v, want none", stops)
+	}
+
+	// The last profile using the container tears it down
+	if err := manager.Stop("profile-a"); err != nil {
+		t.Fatalf("Stop(profile-a) error = %v", err)
+	}
+	if stops := rt.stops(); len(stops) != 1 || stops[0] != shared {
+		t.Errorf("last Stop() stopped containers %v, want [%s]", stops, shared)
+	}
+	if got := refCount(manager, shared); got != 0 {
+		t.Errorf("refs after last Stop() = %d, want 0", got)
+	}
+}
+
+func TestManager_SharedContainer_StopAllForcesStop(t *testing.T) {
+	initTestLogger(t)
+	manager := NewManager(context.Background())
+	config := sharedTestConfig()
+	shared := containerName("profile-a")
+	rt := newFakeRuntime(shared)
+	seedRunningEmulator(manager, "profile-a", config, rt)
+
+	startWithRuntime(t, manager, "profile-b", config, rt)
+
+	manager.StopAll()
+
+	if stops := rt.stops(); len(stops) != 1 || stops[0] != shared {
+		t.Errorf("StopAll() stopped containers %v, want [%s]", stops, shared)
+	}
+	for _, profileID := range []string{"profile-a", "profile-b"} {
+		if manager.IsRunning(profileID) {
+			t.Errorf("IsRunning(%s) = true after StopAll(), want false", profileID)
+		}
+	}
+	if got := refCount(manager, shared); got != 0 {
+		t.Errorf("refs after StopAll() = %d, want 0", got)
+	}
+}
+
+func TestManager_SharingProfiles(t *testing.T) {
+	initTestLogger(t)
+	manager := NewManager(context.Background())
+	config := sharedTestConfig()
+	config.DataDir = t.TempDir()
+	rt := newFakeRuntime(containerName("profile-a"))
+	seedRunningEmulator(manager, "profile-a", config, rt)
+	startWithRuntime(t, manager, "profile-b", config, rt)
+
+	if got := manager.SharingProfiles("profile-a", ""); len(got) != 1 || got[0] != "profile-b" {
+		t.Errorf("SharingProfiles(profile-a) = %v, want [profile-b]", got)
+	}
+	// A stopped profile with the same data directory would clear the running container's state
+	if got := manager.SharingProfiles("profile-c", config.DataDir); len(got) != 2 {
+		t.Errorf("SharingProfiles(profile-c, shared data dir) = %v, want both running profiles", got)
+	}
+	if got := manager.SharingProfiles("profile-c", t.TempDir()); len(got) != 0 {
+		t.Errorf("SharingProfiles(profile-c, other data dir) = %v, want none", got)
+	}
+
+	if err := manager.Stop("profile-b"); err != nil {
+		t.Fatalf("Stop(profile-b) error = %v", err)
+	}
+	if got := manager.SharingProfiles("profile-a", config.DataDir); len(got) != 0 {
+		t.Errorf("SharingProfiles(profile-a) after profile-b stopped = %v, want none", got)
+	}
+}
+
+func TestManager_findSharedLocked(t *testing.T) {
+	manager := NewManager(context.Background())
+	config := sharedTestConfig()
+	rt := newFakeRuntime()
+	seedRunningEmulator(manager, "profile-a", config, rt)
+
+	cfg := resolveConfig(config)
+	cfg.Runtime = RuntimeDocker
+	cfg.Image = qualifyImage(cfg.Runtime, cfg.Image)
+	other := cfg
+	other.DataDir = "/tmp/other"
+
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	if info := manager.findSharedLocked("profile-b", cfg); info == nil || info.ProfileID != "profile-a" {
+		t.Errorf("findSharedLocked(same config) = %+v, want profile-a", info)
+	}
+	if info := manager.findSharedLocked("profile-b", other); info != nil {
+		t.Errorf("findSharedLocked(different data dir) = %+v, want nil", info)
+	}
+	if info := manager.findSharedLocked("profile-a", cfg); info != nil {
+		t.Errorf("findSharedLocked(own container) = %+v, want nil", info)
+	}
+}
 
 // Benchmark tests
 func BenchmarkManager_GetStatus(b *testing.B) {
@@ -604,6 +1007,31 @@ func TestResolveConfig(t *testing.T) {
 				DataDir:     "/tmp/data",
 			},
 		},
+		{
+			name: "persistent",
+			config: &models.ManagedEmulatorConfig{
+				Persistent: true,
+			},
+			want: resolvedConfig{
+				Port:        8085,
+				Image:       "google/cloud-sdk:emulators",
+				BindAddress: "127.0.0.1",
+				Persistent:  true,
+			},
+		},
+		{
+			name: "image digest pins the image",
+			config: &models.ManagedEmulatorConfig{
+				ImageDigest: "sha256:abc123",
+			},
+			want: resolvedConfig{
+				Port:        8085,
+				Image:       "google/cloud-sdk@sha256:abc123",
+				BindAddress: "127.0.0.1",
+				DataDir:     "",
+				Pinned:      true,
+			},
+		},
 		{
 			name: "zero port uses default",
 			config: &models.ManagedEmulatorConfig{
@@ -628,6 +1056,40 @@ func TestResolveConfig(t *testing.T) {
 	}
 }
 
+func TestPinnedImageRef(t *testing.T) {
+	tests := []struct {
+		image, digest, want string
+	}{
+		{"google/cloud-sdk:emulators", "sha256:abc", "google/cloud-sdk@sha256:abc"},
+		{"google/cloud-sdk", "sha256:abc", "google/cloud-sdk@sha256:abc"},
+		{"registry.local:5000/cloud-sdk:emulators", "sha256:abc", "registry.local:5000/cloud-sdk@sha256:abc"},
+		{"registry.local:5000/cloud-sdk", "sha256:abc", "registry.local:5000/cloud-sdk@sha256:abc"},
+		{"google/cloud-sdk@sha256:old", "sha256:new", "google/cloud-sdk@sha256:new"},
+		{"google/cloud-sdk:emulators", "abc", "google/cloud-sdk@sha256:abc"},
+	}
+
+	for _, tt := range tests {
+		if got := pinnedImageRef(tt.image, tt.digest); got != tt.want {
+			t.Errorf("pinnedImageRef(%q, %q) = %q, want %q", tt.image, tt.digest, got, tt.want)
+		}
+	}
+}
+
+func TestImageDriftWarning(t *testing.T) {
+	const image = "google/cloud-sdk:emulators"
+	if got := imageDriftWarning(image, "sha256:aaa", "sha256:aaa"); got != "" {
+		t.Errorf("imageDriftWarning() same ID = %q, want empty", got)
+	}
+	if got := imageDriftWarning(image, "", "sha256:aaa"); got != "" {
+		t.Errorf("imageDriftWarning() unknown container ID = %q, want empty", got)
+	}
+
+	got := imageDriftWarning(image, "sha256:111111111111ffff", "sha256:222222222222ffff")
+	if !strings.Contains(got, "222222222222") || !strings.Contains(got, "111111111111") || strings.Contains(got, "ffff") {
+		t.Errorf("imageDriftWarning() = %q, want short IDs of both images", got)
+	}
+}
+
 // Tests for buildDockerArgs - builds docker run command arguments
 func TestBuildDockerArgs(t *testing.T) {
 	tests := []struct {
@@ -659,6 +1121,18 @@ func TestBuildDockerArgs(t *testing.T) {
 			wantContains:   []string{"-p", "9000:8085"},
 			wantNotContain: []string{"127.0.0.1:9000"},
 		},
+		{
+			name:          "podman data directory is relabeled",
+			containerName: "podman-container",
+			cfg: resolvedConfig{
+				Port:        8085,
+				Image:       "docker.io/google/cloud-sdk:emulators",
+				BindAddress: "127.0.0.1",
+				DataDir:     "/tmp/pubsub",
+				Runtime:     RuntimePodman,
+			},
+			wantContains: []string{"-v", "/tmp/pubsub:/data:Z", "--data-dir=/data"},
+		},
 		{
 			name:          "with data directory",
 			containerName: "data-container",
@@ -670,6 +1144,18 @@ func TestBuildDockerArgs(t *testing.T) {
 			},
 			wantContains: []string{"-v", "/tmp/emulator-data:/data", "--data-dir=/data"},
 		},
+		{
+			name:          "persistent container",
+			containerName: "persistent-container",
+			cfg: resolvedConfig{
+				Port:        8085,
+				Image:       "google/cloud-sdk:emulators",
+				BindAddress: "127.0.0.1",
+				Persistent:  true,
+			},
+			wantContains:   []string{"run", "--name", "persistent-container"},
+			wantNotContain: []string{"--rm"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -690,3 +1176,23 @@ func TestBuildDockerArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDataDir(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := validateDataDir(dir); err != nil {
+		t.Errorf("validateDataDir(existing dir) error = %v, want nil", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("validateDataDir left %d entries in the directory, want only the existing file", len(entries))
+	}
+	for _, bad := range []string{"relative/data", filepath.Join(dir, "missing"), file} {
+		if err := validateDataDir(bad); err == nil {
+			t.Errorf("validateDataDir(%q) error = nil, want error", bad)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Package emulator provides managed Docker emulator functionality
+package emulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Container runtime names accepted in ManagedEmulatorConfig.Runtime
+const (
+	RuntimeAuto   = "auto"
+	RuntimeDocker = "docker"
+	RuntimePodman = "podman"
+)
+
+// ContainerRuntime runs and inspects emulator containers
+// Docker and Podman share a compatible CLI, so both are driven through the same arguments.
+type ContainerRuntime interface {
+	Name() string
+	IsAvailable(ctx context.Context) error                                        // CLI installed and daemon/service responding
+	Run(ctx context.Context, args ...string) *exec.Cmd                            // Command for `run`/`start`; the caller owns its lifetime
+	Inspect(ctx context.Context, name, format string, image bool) (string, error) // Formatted `inspect` of a container (or image)
+	Stop(ctx context.Context, name string) error
+	Remove(ctx context.Context, name string) error // Force-removes a container; missing containers are not an error
+}
+
+// cliRuntime implements ContainerRuntime by shelling out to a Docker-compatible binary
+type cliRuntime struct {
+	binary      string
+	installHint string
+}
+
+// NewDockerRuntime returns the Docker container runtime
+func NewDockerRuntime() ContainerRuntime {
+	return cliRuntime{binary: "docker", installHint: "please install Docker Desktop or Docker Engine"}
+}
+
+// NewPodmanRuntime returns the Podman container runtime
+func NewPodmanRuntime() ContainerRuntime {
+	return cliRuntime{binary: "podman", installHint: "please install Podman"}
+}
+
+// Name returns the runtime's CLI binary name ("docker" or "podman")
+func (r cliRuntime) Name() string {
+	return r.binary
+}
+
+// IsAvailable checks that the CLI is installed and the daemon (or Podman service) responds
+func (r cliRuntime) IsAvailable(ctx context.Context) error {
+	if _, err := exec.LookPath(r.binary); err != nil {
+		return fmt.Errorf("%s CLI not found: %s", r.binary, r.installHint)
+	}
+
+	output, err := exec.CommandContext(ctx, r.binary, "info").CombinedOutput()
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("%s daemon not responding (timeout)", r.binary)
+		}
+		return fmt.Errorf("%s daemon not running: %s", r.binary, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// Run returns an unstarted command running the runtime CLI with args
+func (r cliRuntime) Run(ctx context.Context, args ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, r.binary, args...)
+}
+
+// Inspect returns the trimmed output of `inspect -f format name` (`image inspect` when image is true)
+// A missing container or image yields errNoSuchObject.
+func (r cliRuntime) Inspect(ctx context.Context, name, format string, image bool) (string, error) {
+	args := []string{"inspect", "-f", format, name}
+	if image {
+		args = append([]string{"image"}, args...)
+	}
+	output, err := exec.CommandContext(ctx, r.binary, args...).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		// Docker prints "No such object"/"No such container", Podman "no such container"
+		if errors.As(err, &exitErr) && strings.Contains(strings.ToLower(string(exitErr.Stderr)), "no such") {
+			return "", errNoSuchObject
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// Stop stops a running container
+func (r cliRuntime) Stop(ctx context.Context, name string) error {
+	return exec.CommandContext(ctx, r.binary, "stop", name).Run()
+}
+
+// Remove force-removes a container
+func (r cliRuntime) Remove(ctx context.Context, name string) error {
+	return exec.CommandContext(ctx, r.binary, "rm", "-f", name).Run()
+}
+
+// errNoSuchObject is returned by Inspect when the container or image does not exist
+var errNoSuchObject = errors.New("no such container or image")
+
+// selectRuntime picks the container runtime for a preference ("", "auto", "docker" or "podman")
+// Auto keeps Docker as the default and falls back to Podman only when the docker CLI is missing.
+func selectRuntime(preference string, lookPath func(string) (string, error)) (ContainerRuntime, error) {
+	switch preference {
+	case "", RuntimeAuto:
+		if _, err := lookPath(RuntimeDocker); err != nil {
+			if _, err := lookPath(RuntimePodman); err == nil {
+				return NewPodmanRuntime(), nil
+			}
+		}
+		return NewDockerRuntime(), nil
+	case RuntimeDocker:
+		return NewDockerRuntime(), nil
+	case RuntimePodman:
+		return NewPodmanRuntime(), nil
+	default:
+		return nil, fmt.Errorf("unsupported container runtime %q: must be auto, docker or podman", preference)
+	}
+}
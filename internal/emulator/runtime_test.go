@@ -0,0 +1,66 @@
+package emulator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSelectRuntime(t *testing.T) {
+	installed := func(binaries ...string) func(string) (string, error) {
+		return func(file string) (string, error) {
+			for _, b := range binaries {
+				if b == file {
+					return "/usr/bin/" + file, nil
+				}
+			}
+			return "", errors.New("not found")
+		}
+	}
+
+	tests := []struct {
+		name       string
+		preference string
+		lookPath   func(string) (string, error)
+		want       string
+		wantErr    bool
+	}{
+		{"auto prefers docker", RuntimeAuto, installed("docker", "podman"), RuntimeDocker, false},
+		{"empty means auto", "", installed("podman"), RuntimePodman, false},
+		{"auto falls back to podman", RuntimeAuto, installed("podman"), RuntimePodman, false},
+		{"auto defaults to docker when neither is installed", RuntimeAuto, installed(), RuntimeDocker, false},
+		{"explicit podman", RuntimePodman, installed("docker", "podman"), RuntimePodman, false},
+		{"explicit docker", RuntimeDocker, installed("podman"), RuntimeDocker, false},
+		{"unknown runtime", "containerd", installed("docker"), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := selectRuntime(tt.preference, tt.lookPath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectRuntime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && rt.Name() != tt.want {
+				t.Errorf("selectRuntime() = %s, want %s", rt.Name(), tt.want)
+			}
+		})
+	}
+}
+
+func TestQualifyImage(t *testing.T) {
+	tests := []struct {
+		runtime string
+		image   string
+		want    string
+	}{
+		{RuntimeDocker, "google/cloud-sdk:emulators", "google/cloud-sdk:emulators"},
+		{RuntimePodman, "google/cloud-sdk:emulators", "docker.io/google/cloud-sdk:emulators"},
+		{RuntimePodman, "ubuntu", "docker.io/ubuntu"},
+		{RuntimePodman, "gcr.io/google.com/cloudsdktool/cloud-sdk:emulators", "gcr.io/google.com/cloudsdktool/cloud-sdk:emulators"},
+		{RuntimePodman, "localhost/emulator:dev", "localhost/emulator:dev"},
+		{RuntimePodman, "registry:5000/emulator", "registry:5000/emulator"},
+	}
+	for _, tt := range tests {
+		if got := qualifyImage(tt.runtime, tt.image); got != tt.want {
+			t.Errorf("qualifyImage(%s, %q) = %q, want %q", tt.runtime, tt.image, got, tt.want)
+		}
+	}
+}
@@ -56,8 +56,8 @@ func InitLogger() error {
 		Level: slog.LevelDebug,
 	})
 
-	// Create multi-handler that writes to both
-	multiHandler := NewMultiHandler(textHandler, jsonHandler)
+	// Create multi-handler that writes to both, and to log tail subscribers
+	multiHandler := NewMultiHandler(textHandler, jsonHandler, newTailHandler())
 
 	// Create logger with multi-handler
 	globalLogger = slog.New(multiHandler)
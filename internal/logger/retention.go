@@ -0,0 +1,111 @@
+// Package logger provides structured logging with dual output (stdout + JSON file)
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Daily log files are named logs-YYYY-MM-DD.json
+const (
+	logFilePrefix = "logs-"
+	logFileSuffix = ".json"
+	logDateLayout = "2006-01-02"
+)
+
+// LogFileDate returns the date of a daily log file name, or false for any other file name
+func LogFileDate(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, logFilePrefix) || !strings.HasSuffix(name, logFileSuffix) {
+		return time.Time{}, false
+	}
+	date, err := time.Parse(logDateLayout, strings.TrimSuffix(strings.TrimPrefix(name, logFilePrefix), logFileSuffix))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// RemoveLogFilesBefore deletes the daily log files in dir dated before the given day and returns how many
+// were removed. Only regular files named like daily logs directly inside dir are touched, and the file
+// currently written to is kept.
+func RemoveLogFilesBefore(dir string, before time.Time) (int, error) {
+	if dir == "" || !filepath.IsAbs(dir) {
+		return 0, fmt.Errorf("logs directory is not initialized")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read logs directory: %w", err)
+	}
+
+	cutoff := time.Date(before.Year(), before.Month(), before.Day(), 0, 0, 0, 0, time.UTC)
+	current := currentLogPath()
+	removed := 0
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		date, ok := LogFileDate(entry.Name())
+		if !ok || !date.Before(cutoff) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		if path == current {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return removed, fmt.Errorf("failed to remove log file %s: %w", entry.Name(), err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// TruncateCurrentLog empties the log file currently written to
+func TruncateCurrentLog() error {
+	fileMu.Lock()
+	defer fileMu.Unlock()
+
+	if logFile == nil {
+		return nil
+	}
+	if err := logFile.Truncate(0); err != nil {
+		return fmt.Errorf("failed to truncate log file: %w", err)
+	}
+	return nil
+}
+
+// PruneLogs removes log files older than retentionDays in the background
+func PruneLogs(retentionDays int) {
+	if retentionDays <= 0 {
+		return
+	}
+	dir := GetLogsDir()
+	cutoff := time.Now().AddDate(0, 0, -retentionDays)
+	go func() {
+		removed, err := RemoveLogFilesBefore(dir, cutoff)
+		if err != nil {
+			Warn("Failed to prune old log files", "error", err)
+			return
+		}
+		if removed > 0 {
+			Info("Pruned old log files", "removed", removed, "retentionDays", retentionDays)
+		}
+	}()
+}
+
+// currentLogPath returns the path of the log file currently written to, or "" before InitLogger
+func currentLogPath() string {
+	fileMu.Lock()
+	defer fileMu.Unlock()
+
+	if logFile == nil {
+		return ""
+	}
+	return logFile.Name()
+}
@@ -0,0 +1,92 @@
+// Package logger provides structured logging with dual output (stdout + JSON file)
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// tail fans log records out to subscribers as JSON lines
+var tail = &broadcaster{subscribers: make(map[int]chan []byte)}
+
+// broadcaster is an io.Writer that copies every write to the subscriber channels
+type broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[int]chan []byte
+	nextID      int
+}
+
+// Write sends a copy of p to every subscriber whose channel has room; full channels drop the record
+func (b *broadcaster) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, ch := range b.subscribers {
+		line := append([]byte(nil), p...)
+		select {
+		case ch <- line:
+		default:
+		}
+	}
+	return len(p), nil
+}
+
+// active reports whether anyone is subscribed
+func (b *broadcaster) active() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers) > 0
+}
+
+// Subscribe returns a channel receiving every new log record as a JSON line (same format as the log file)
+// and a function that ends the subscription and closes the channel. Records are dropped while the channel
+// is full, so a slow subscriber never blocks logging.
+func Subscribe(buffer int) (<-chan []byte, func()) {
+	ch := make(chan []byte, buffer)
+
+	tail.mu.Lock()
+	id := tail.nextID
+	tail.nextID++
+	tail.subscribers[id] = ch
+	tail.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			tail.mu.Lock()
+			delete(tail.subscribers, id)
+			tail.mu.Unlock()
+			close(ch)
+		})
+	}
+	return ch, unsubscribe
+}
+
+// tailHandler encodes records for the broadcaster only while someone is subscribed
+type tailHandler struct {
+	slog.Handler
+}
+
+// newTailHandler creates the handler writing JSON lines to the broadcaster
+func newTailHandler() slog.Handler {
+	return tailHandler{Handler: slog.NewJSONHandler(tail, &slog.HandlerOptions{Level: slog.LevelDebug})}
+}
+
+// Handle encodes the record unless nobody is subscribed
+func (h tailHandler) Handle(ctx context.Context, record slog.Record) error {
+	if !tail.active() {
+		return nil
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs returns a tail handler with the given attributes
+func (h tailHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return tailHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup returns a tail handler with the given group
+func (h tailHandler) WithGroup(name string) slog.Handler {
+	return tailHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -16,14 +16,17 @@ const (
 	EmulatorModeManaged  EmulatorMode = "managed"
 )
 
-// ManagedEmulatorConfig contains settings for managed Docker emulator
+// ManagedEmulatorConfig contains settings for a managed emulator container (Docker or Podman)
 type ManagedEmulatorConfig struct {
 	Port        int    `json:"port"`                  // Host port to expose (default: 8085)
 	Image       string `json:"image,omitempty"`       // Docker image (default: google/cloud-sdk:emulators)
+	ImageDigest string `json:"imageDigest,omitempty"` // Optional digest (sha256:...) pinning the image for reproducible runs
 	DataDir     string `json:"dataDir,omitempty"`     // Optional data directory for persistence
 	AutoStart   bool   `json:"autoStart"`             // Start emulator automatically on connect (default: true)
 	AutoStop    bool   `json:"autoStop"`              // Stop emulator on disconnect (default: true)
 	BindAddress string `json:"bindAddress,omitempty"` // Bind address (default: 127.0.0.1, use 0.0.0.0 for LAN access)
+	Persistent  bool   `json:"persistent,omitempty"`  // Keep the container when stopped and restart it on next start (state survives app restarts)
+	Runtime     string `json:"runtime,omitempty"`     // Container runtime: "auto" (default, Docker if installed, else Podman), "docker" or "podman"
 }
 
 // DefaultManagedEmulatorConfig returns a ManagedEmulatorConfig with default values
@@ -37,6 +40,12 @@ func DefaultManagedEmulatorConfig() ManagedEmulatorConfig {
 	}
 }
 
+// ProfileColors lists the accent colors a profile can use in the UI
+var ProfileColors = []string{"gray", "red", "orange", "yellow", "green", "blue", "purple", "pink"}
+
+// ProfileIcons lists the icons a profile can use in the UI
+var ProfileIcons = []string{"cloud", "server", "database", "flask", "shield", "bolt", "globe", "home"}
+
 // ConnectionProfile represents a saved connection configuration
 type ConnectionProfile struct {
 	ID                 string                 `json:"id"`
@@ -49,24 +58,287 @@ type ConnectionProfile struct {
 	EmulatorHost       string                 `json:"emulatorHost,omitempty"`    // For external mode (backward compatible)
 	EmulatorMode       EmulatorMode           `json:"emulatorMode,omitempty"`    // "off" | "external" | "managed"
 	ManagedEmulator    *ManagedEmulatorConfig `json:"managedEmulator,omitempty"` // Settings for managed Docker emulator
+	Color              string                 `json:"color,omitempty"`           // Accent color for visual distinction (see ProfileColors)
+	Icon               string                 `json:"icon,omitempty"`            // Icon name for visual distinction (see ProfileIcons)
 	IsDefault          bool                   `json:"isDefault"`
 	CreatedAt          string                 `json:"createdAt"`
+	SeedConfig         *EmulatorSeedConfig    `json:"seedConfig,omitempty"` // Resources created after an emulator reset
+}
+
+// EmulatorSeedConfig lists the topics and subscriptions to create in a freshly reset emulator
+type EmulatorSeedConfig struct {
+	Topics []SeedTopic `json:"topics"`
+}
+
+// SeedTopic is a topic and its pull subscriptions to create when seeding an emulator
+type SeedTopic struct {
+	ID            string   `json:"id"`
+	Subscriptions []string `json:"subscriptions,omitempty"`
 }
 
 // AppConfig represents the application configuration stored in ~/.pubsub-gui/config.json
 type AppConfig struct {
-	Profiles                   []ConnectionProfile         `json:"profiles"`
-	ActiveProfileID            string                      `json:"activeProfileId,omitempty"`
-	MessageBufferSize          int                         `json:"messageBufferSize"`
-	AutoAck                    bool                        `json:"autoAck"`
-	Theme                      string                      `json:"theme"`                                // "light" | "dark" | "auto" | "dracula" | "monokai" | "nord" | "sienna"
-	FontSize                   string                      `json:"fontSize"`                             // "small" | "medium" | "large"
-	Templates                  []MessageTemplate           `json:"templates"`                            // Message templates
-	TopicSubscriptionTemplates []TopicSubscriptionTemplate `json:"topicSubscriptionTemplates,omitempty"` // Topic/subscription templates
-	AutoCheckUpgrades          bool                        `json:"autoCheckUpgrades"`
-	UpgradeCheckInterval       int                         `json:"upgradeCheckInterval"` // hours
-	LastUpgradeCheck           time.Time                   `json:"lastUpgradeCheck,omitempty"`
-	DismissedUpgradeVersion    string                      `json:"dismissedUpgradeVersion,omitempty"`
+	Profiles                    []ConnectionProfile         `json:"profiles"`
+	ActiveProfileID             string                      `json:"activeProfileId,omitempty"`
+	MessageBufferSize           int                         `json:"messageBufferSize"`
+	AutoAck                     bool                        `json:"autoAck"`
+	Theme                       string                      `json:"theme"`                                // "light" | "dark" | "auto" | "dracula" | "monokai" | "nord" | "sienna"
+	FontSize                    string                      `json:"fontSize"`                             // "small" | "medium" | "large"
+	Templates                   []MessageTemplate           `json:"templates"`                            // Message templates
+	TopicSubscriptionTemplates  []TopicSubscriptionTemplate `json:"topicSubscriptionTemplates,omitempty"` // Topic/subscription templates
+	AttributeTemplates          []AttributeTemplate         `json:"attributeTemplates,omitempty"`         // Reusable attribute sets
+	AutoCheckUpgrades           bool                        `json:"autoCheckUpgrades"`
+	UpgradeCheckInterval        int                         `json:"upgradeCheckInterval"` // hours
+	LastUpgradeCheck            time.Time                   `json:"lastUpgradeCheck,omitempty"`
+	DismissedUpgradeVersion     string                      `json:"dismissedUpgradeVersion,omitempty"`
+	UpgradeSnoozedUntil         time.Time                   `json:"upgradeSnoozedUntil,omitempty"`         // No upgrade prompts before this time
+	MonitorSubscriptionTTLHours int                         `json:"monitorSubscriptionTTLHours,omitempty"` // TTL of auto-created monitor subscriptions (default 24)
+	MonitorHighlightRules       []HighlightRule             `json:"monitorHighlightRules,omitempty"`       // Attribute-based message coloring in the monitor
+	BacklogAgeWarnSeconds       int                         `json:"backlogAgeWarnSeconds,omitempty"`       // Warn when a monitored subscription's oldest unacked message is older (0 disables)
+	MonitorOptions              MonitorOptions              `json:"monitorOptions"`                        // Default flow control of monitors
+	EmulatorHealthCheckSeconds  int                         `json:"emulatorHealthCheckSeconds,omitempty"`  // Interval of managed emulator health checks (default 30)
+	LogRetentionDays            int                         `json:"logRetentionDays,omitempty"`            // Days daily log files are kept; 0 (unset) keeps them all
+	PersistPublishHistory       bool                        `json:"persistPublishHistory,omitempty"`       // Keep each profile's publish history on disk
+	APITimeoutSeconds           int                         `json:"apiTimeoutSeconds,omitempty"`           // Per-call timeout of admin API calls (0 keeps the client library default)
+	APIRetry                    APIRetryPolicy              `json:"apiRetry"`                              // Retries of admin API calls on transient errors
+}
+
+// APIRetryPolicy sets how admin API calls are retried on transient errors (Unavailable, DeadlineExceeded)
+// A zero MaxAttempts keeps the client library defaults; the backoff fields are only used with MaxAttempts set.
+type APIRetryPolicy struct {
+	MaxAttempts      int `json:"maxAttempts,omitempty"`      // Attempts per call including the first; 1 disables retries
+	InitialBackoffMs int `json:"initialBackoffMs,omitempty"` // Delay before the first retry (default 100)
+	MaxBackoffMs     int `json:"maxBackoffMs,omitempty"`     // Upper bound of the growing delay between retries (default 10000)
+}
+
+// Bounds and defaults of APIRetryPolicy
+const (
+	MaxAPIRetryAttempts     = 10
+	DefaultAPIRetryBackoff  = 100 * time.Millisecond
+	DefaultAPIRetryMaxDelay = 10 * time.Second
+	MaxAPIRetryBackoffMs    = 60000
+)
+
+// Validate checks that the attempts and backoffs are within the allowed ranges
+func (p APIRetryPolicy) Validate() error {
+	if p.MaxAttempts < 0 || p.MaxAttempts > MaxAPIRetryAttempts {
+		return errors.New("max attempts must be between 0 and " + itoa(MaxAPIRetryAttempts))
+	}
+	if p.InitialBackoffMs < 0 || p.InitialBackoffMs > MaxAPIRetryBackoffMs {
+		return errors.New("initial backoff must be between 0 and " + itoa(MaxAPIRetryBackoffMs) + " ms")
+	}
+	if p.MaxBackoffMs < 0 || p.MaxBackoffMs > MaxAPIRetryBackoffMs {
+		return errors.New("max backoff must be between 0 and " + itoa(MaxAPIRetryBackoffMs) + " ms")
+	}
+	if p.MaxBackoffMs > 0 && p.MaxBackoffMs < p.InitialBackoffMs {
+		return errors.New("max backoff cannot be shorter than the initial backoff")
+	}
+	return nil
+}
+
+// InitialBackoff returns the delay before the first retry, falling back to the default when unset
+func (p APIRetryPolicy) InitialBackoff() time.Duration {
+	if p.InitialBackoffMs <= 0 {
+		return DefaultAPIRetryBackoff
+	}
+	return time.Duration(p.InitialBackoffMs) * time.Millisecond
+}
+
+// MaxBackoff returns the upper bound of the delay between retries, falling back to the default when unset
+// Never shorter than InitialBackoff.
+func (p APIRetryPolicy) MaxBackoff() time.Duration {
+	maxBackoff := DefaultAPIRetryMaxDelay
+	if p.MaxBackoffMs > 0 {
+		maxBackoff = time.Duration(p.MaxBackoffMs) * time.Millisecond
+	}
+	return max(maxBackoff, p.InitialBackoff())
+}
+
+// MonitorOptions sets the flow control of a monitor's streaming pull
+// Zero fields keep the client library defaults. Unacked messages count as outstanding until they are
+// acked, nacked or released, so low limits throttle how fast a monitor without auto-ack fills its buffer.
+type MonitorOptions struct {
+	MaxOutstandingMessages int `json:"maxOutstandingMessages,omitempty"`
+	MaxOutstandingBytes    int `json:"maxOutstandingBytes,omitempty"`
+	NumGoroutines          int `json:"numGoroutines,omitempty"` // Number of streaming pull streams
+}
+
+// Validate checks that no flow control limit is negative
+func (o MonitorOptions) Validate() error {
+	if o.MaxOutstandingMessages < 0 {
+		return errors.New("max outstanding messages cannot be negative")
+	}
+	if o.MaxOutstandingBytes < 0 {
+		return errors.New("max outstanding bytes cannot be negative")
+	}
+	if o.NumGoroutines < 0 {
+		return errors.New("number of goroutines cannot be negative")
+	}
+	return nil
+}
+
+// WithDefaults returns o with its zero fields taken from defaults
+func (o MonitorOptions) WithDefaults(defaults MonitorOptions) MonitorOptions {
+	if o.MaxOutstandingMessages == 0 {
+		o.MaxOutstandingMessages = defaults.MaxOutstandingMessages
+	}
+	if o.MaxOutstandingBytes == 0 {
+		o.MaxOutstandingBytes = defaults.MaxOutstandingBytes
+	}
+	if o.NumGoroutines == 0 {
+		o.NumGoroutines = defaults.NumGoroutines
+	}
+	return o
+}
+
+// HighlightRule colors monitored messages whose attribute matches a value
+type HighlightRule struct {
+	AttributeKey string `json:"attributeKey"` // Attribute to inspect (e.g., "eventType")
+	Equals       string `json:"equals"`       // Exact value the attribute must have
+	Color        string `json:"color"`        // CSS color applied to matching messages
+}
+
+// ValidateHighlightRules checks that every rule has an attribute key and a color
+func ValidateHighlightRules(rules []HighlightRule) error {
+	for i, rule := range rules {
+		if strings.TrimSpace(rule.AttributeKey) == "" {
+			return errors.New("highlight rule " + itoa(i) + ": attribute key cannot be empty")
+		}
+		if strings.TrimSpace(rule.Color) == "" {
+			return errors.New("highlight rule " + itoa(i) + ": color cannot be empty")
+		}
+	}
+	return nil
+}
+
+// MatchHighlightColor returns the color of the first rule matching the attributes, or "" if none match
+func MatchHighlightColor(rules []HighlightRule, attributes map[string]string) string {
+	for _, rule := range rules {
+		if value, ok := attributes[rule.AttributeKey]; ok && value == rule.Equals {
+			return rule.Color
+		}
+	}
+	return ""
+}
+
+// Bounds for AppConfig.MonitorSubscriptionTTLHours
+const (
+	DefaultMonitorSubscriptionTTLHours = 24
+	MinMonitorSubscriptionTTLHours     = 1
+	MaxMonitorSubscriptionTTLHours     = 31 * 24
+)
+
+// ValidateMonitorSubscriptionTTLHours checks that a monitor subscription TTL is within the allowed range
+func ValidateMonitorSubscriptionTTLHours(hours int) error {
+	if hours < MinMonitorSubscriptionTTLHours || hours > MaxMonitorSubscriptionTTLHours {
+		return errors.New("monitor subscription TTL must be between " + itoa(MinMonitorSubscriptionTTLHours) + " and " + itoa(MaxMonitorSubscriptionTTLHours) + " hours")
+	}
+	return nil
+}
+
+// Bounds for AppConfig.EmulatorHealthCheckSeconds
+const (
+	DefaultEmulatorHealthCheckSeconds = 30
+	MinEmulatorHealthCheckSeconds     = 5
+	MaxEmulatorHealthCheckSeconds     = 3600
+)
+
+// ValidateEmulatorHealthCheckSeconds checks that the emulator health check interval is within the allowed range
+func ValidateEmulatorHealthCheckSeconds(seconds int) error {
+	if seconds < MinEmulatorHealthCheckSeconds || seconds > MaxEmulatorHealthCheckSeconds {
+		return errors.New("emulator health check interval must be between " + itoa(MinEmulatorHealthCheckSeconds) + " and " + itoa(MaxEmulatorHealthCheckSeconds) + " seconds")
+	}
+	return nil
+}
+
+// Bounds for AppConfig.LogRetentionDays when set
+const (
+	MinLogRetentionDays = 1
+	MaxLogRetentionDays = 3650
+)
+
+// ValidateLogRetentionDays checks that the log retention is within the allowed range
+func ValidateLogRetentionDays(days int) error {
+	if days < MinLogRetentionDays || days > MaxLogRetentionDays {
+		return errors.New("log retention must be between " + itoa(MinLogRetentionDays) + " and " + itoa(MaxLogRetentionDays) + " days")
+	}
+	return nil
+}
+
+// MaxAPITimeoutSeconds bounds the per-call timeout of admin API calls (10 minutes)
+const MaxAPITimeoutSeconds = 600
+
+// ValidateAPITimeoutSeconds checks that the admin API call timeout is within the allowed range (0 keeps the default)
+func ValidateAPITimeoutSeconds(seconds int) error {
+	if seconds < 0 || seconds > MaxAPITimeoutSeconds {
+		return errors.New("API timeout must be between 0 and " + itoa(MaxAPITimeoutSeconds) + " seconds")
+	}
+	return nil
+}
+
+// MaxUpgradeSnoozeHours bounds how long upgrade prompts can be snoozed (30 days)
+const MaxUpgradeSnoozeHours = 720
+
+// ValidateUpgradeSnoozeHours checks that an upgrade snooze is within the allowed range (0 ends the snooze)
+func ValidateUpgradeSnoozeHours(hours int) error {
+	if hours < 0 || hours > MaxUpgradeSnoozeHours {
+		return errors.New("upgrade snooze must be between 0 and " + itoa(MaxUpgradeSnoozeHours) + " hours")
+	}
+	return nil
+}
+
+// ValidateBacklogAgeWarnSeconds checks that the backlog age warning threshold is not negative (0 disables it)
+func ValidateBacklogAgeWarnSeconds(seconds int) error {
+	if seconds < 0 {
+		return errors.New("backlog age warning threshold cannot be negative")
+	}
+	return nil
+}
+
+// GetMonitorSubscriptionTTL returns the TTL for auto-created monitor subscriptions
+// Falls back to the default when unset (configs written before the setting existed)
+func (c *AppConfig) GetMonitorSubscriptionTTL() time.Duration {
+	hours := c.MonitorSubscriptionTTLHours
+	if hours <= 0 {
+		hours = DefaultMonitorSubscriptionTTLHours
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// GetEmulatorHealthCheckInterval returns the interval of managed emulator health checks
+// Falls back to the default when unset
+func (c *AppConfig) GetEmulatorHealthCheckInterval() time.Duration {
+	seconds := c.EmulatorHealthCheckSeconds
+	if seconds <= 0 {
+		seconds = DefaultEmulatorHealthCheckSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// GetAPITimeout returns the per-call timeout of admin API calls, or 0 when the client library default applies
+func (c *AppConfig) GetAPITimeout() time.Duration {
+	if c.APITimeoutSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(c.APITimeoutSeconds) * time.Second
+}
+
+// GetLogRetentionDays returns how many days daily log files are kept
+// Returns 0, meaning no pruning, when unset: configs saved before retention existed keep all their logs
+func (c *AppConfig) GetLogRetentionDays() int {
+	if c.LogRetentionDays <= 0 {
+		return 0
+	}
+	return c.LogRetentionDays
+}
+
+// ShouldPromptUpgrade reports whether the user should be told about latestVersion at now
+// Dismissed versions are never prompted again, and no version is prompted while snoozed.
+func (c *AppConfig) ShouldPromptUpgrade(latestVersion string, now time.Time) bool {
+	if c.DismissedUpgradeVersion != "" && c.DismissedUpgradeVersion == latestVersion {
+		return false
+	}
+	return !now.Before(c.UpgradeSnoozedUntil)
 }
 
 // Validate checks if the ConnectionProfile has all required fields
@@ -125,11 +397,34 @@ func (cp *ConnectionProfile) Validate() error {
 			cp.ManagedEmulator.BindAddress != "0.0.0.0" {
 			return errors.New("managed emulator bind address must be '127.0.0.1' or '0.0.0.0'")
 		}
+		switch cp.ManagedEmulator.Runtime {
+		case "", "auto", "docker", "podman":
+		default:
+			return errors.New("managed emulator runtime must be 'auto', 'docker' or 'podman'")
+		}
+	}
+
+	// Validate visual distinction settings (empty means UI default)
+	if cp.Color != "" && !containsString(ProfileColors, cp.Color) {
+		return errors.New("profile color must be one of: " + strings.Join(ProfileColors, ", "))
+	}
+	if cp.Icon != "" && !containsString(ProfileIcons, cp.Icon) {
+		return errors.New("profile icon must be one of: " + strings.Join(ProfileIcons, ", "))
 	}
 
 	return nil
 }
 
+// containsString reports whether value is present in values
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // GetEffectiveEmulatorMode returns the emulator mode, applying migration logic for backward compatibility
 // If emulatorMode is not set, it infers from emulatorHost
 func (cp *ConnectionProfile) GetEffectiveEmulatorMode() EmulatorMode {
@@ -198,18 +493,19 @@ func (cp *ConnectionProfile) IsEmulatorEnabled() bool {
 // NewDefaultConfig creates a new AppConfig with default values
 func NewDefaultConfig() *AppConfig {
 	return &AppConfig{
-		Profiles:                   []ConnectionProfile{},
-		ActiveProfileID:            "",
-		MessageBufferSize:          500,
-		AutoAck:                    true,
-		Theme:                      "auto",
-		FontSize:                   "medium",
-		Templates:                  []MessageTemplate{},
-		TopicSubscriptionTemplates: []TopicSubscriptionTemplate{},
-		AutoCheckUpgrades:          true,
-		UpgradeCheckInterval:       24,
-		LastUpgradeCheck:           time.Time{},
-		DismissedUpgradeVersion:    "",
+		Profiles:                    []ConnectionProfile{},
+		ActiveProfileID:             "",
+		MessageBufferSize:           500,
+		AutoAck:                     true,
+		Theme:                       "auto",
+		FontSize:                    "medium",
+		Templates:                   []MessageTemplate{},
+		TopicSubscriptionTemplates:  []TopicSubscriptionTemplate{},
+		AutoCheckUpgrades:           true,
+		UpgradeCheckInterval:        24,
+		LastUpgradeCheck:            time.Time{},
+		DismissedUpgradeVersion:     "",
+		MonitorSubscriptionTTLHours: DefaultMonitorSubscriptionTTLHours,
 	}
 }
 
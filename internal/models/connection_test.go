@@ -3,6 +3,7 @@ package models
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestConnectionProfile_Validate(t *testing.T) {
@@ -249,6 +250,42 @@ func TestConnectionProfile_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid color and icon",
+			profile: ConnectionProfile{
+				ID:         "test-id",
+				Name:       "Test Profile",
+				ProjectID:  "my-project",
+				AuthMethod: "ADC",
+				Color:      "red",
+				Icon:       "shield",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid color",
+			profile: ConnectionProfile{
+				ID:         "test-id",
+				Name:       "Test Profile",
+				ProjectID:  "my-project",
+				AuthMethod: "ADC",
+				Color:      "#ff0000",
+			},
+			wantErr: true,
+			errMsg:  "profile color must be one of",
+		},
+		{
+			name: "invalid icon",
+			profile: ConnectionProfile{
+				ID:         "test-id",
+				Name:       "Test Profile",
+				ProjectID:  "my-project",
+				AuthMethod: "ADC",
+				Icon:       "rocket",
+			},
+			wantErr: true,
+			errMsg:  "profile icon must be one of",
+		},
 	}
 
 	for _, tt := range tests {
@@ -493,6 +530,202 @@ func TestDefaultManagedEmulatorConfig(t *testing.T) {
 	}
 }
 
+func TestValidateMonitorSubscriptionTTLHours(t *testing.T) {
+	tests := []struct {
+		hours   int
+		wantErr bool
+	}{
+		{0, true},
+		{1, false},
+		{24, false},
+		{744, false},
+		{745, true},
+		{-1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(itoa(tt.hours), func(t *testing.T) {
+			err := ValidateMonitorSubscriptionTTLHours(tt.hours)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMonitorSubscriptionTTLHours(%d) error = %v, wantErr %v", tt.hours, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateBacklogAgeWarnSeconds(t *testing.T) {
+	for _, seconds := range []int{0, 1, 3600} {
+		if err := ValidateBacklogAgeWarnSeconds(seconds); err != nil {
+			t.Errorf("ValidateBacklogAgeWarnSeconds(%d) error = %v, want nil", seconds, err)
+		}
+	}
+	if err := ValidateBacklogAgeWarnSeconds(-1); err == nil {
+		t.Error("ValidateBacklogAgeWarnSeconds(-1) error = nil, want error")
+	}
+}
+
+func TestValidateEmulatorHealthCheckSeconds(t *testing.T) {
+	for _, seconds := range []int{5, 30, 3600} {
+		if err := ValidateEmulatorHealthCheckSeconds(seconds); err != nil {
+			t.Errorf("ValidateEmulatorHealthCheckSeconds(%d) error = %v, want nil", seconds, err)
+		}
+	}
+	for _, seconds := range []int{-1, 0, 4, 3601} {
+		if err := ValidateEmulatorHealthCheckSeconds(seconds); err == nil {
+			t.Errorf("ValidateEmulatorHealthCheckSeconds(%d) error = nil, want error", seconds)
+		}
+	}
+}
+
+func TestAppConfig_GetEmulatorHealthCheckInterval(t *testing.T) {
+	config := &AppConfig{}
+	if got := config.GetEmulatorHealthCheckInterval(); got != 30*time.Second {
+		t.Errorf("GetEmulatorHealthCheckInterval() with unset value = %v, want 30s", got)
+	}
+
+	config.EmulatorHealthCheckSeconds = 10
+	if got := config.GetEmulatorHealthCheckInterval(); got != 10*time.Second {
+		t.Errorf("GetEmulatorHealthCheckInterval() = %v, want 10s", got)
+	}
+}
+
+func TestValidateLogRetentionDays(t *testing.T) {
+	for _, days := range []int{1, 30, 3650} {
+		if err := ValidateLogRetentionDays(days); err != nil {
+			t.Errorf("ValidateLogRetentionDays(%d) error = %v, want nil", days, err)
+		}
+	}
+	for _, days := range []int{-1, 0, 3651} {
+		if err := ValidateLogRetentionDays(days); err == nil {
+			t.Errorf("ValidateLogRetentionDays(%d) error = nil, want error", days)
+		}
+	}
+}
+
+func TestAppConfig_GetLogRetentionDays(t *testing.T) {
+	config := &AppConfig{}
+	if got := config.GetLogRetentionDays(); got != 0 {
+		t.Errorf("GetLogRetentionDays() with unset value = %d, want 0 (no pruning)", got)
+	}
+
+	config.LogRetentionDays = 7
+	if got := config.GetLogRetentionDays(); got != 7 {
+		t.Errorf("GetLogRetentionDays() = %d, want 7", got)
+	}
+}
+
+func TestValidateUpgradeSnoozeHours(t *testing.T) {
+	for _, hours := range []int{0, 1, 720} {
+		if err := ValidateUpgradeSnoozeHours(hours); err != nil {
+			t.Errorf("ValidateUpgradeSnoozeHours(%d) error = %v, want nil", hours, err)
+		}
+	}
+	for _, hours := range []int{-1, 721} {
+		if err := ValidateUpgradeSnoozeHours(hours); err == nil {
+			t.Errorf("ValidateUpgradeSnoozeHours(%d) error = nil, want error", hours)
+		}
+	}
+}
+
+func TestAppConfig_ShouldPromptUpgrade(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		config AppConfig
+		want   bool
+	}{
+		{name: "no preferences", config: AppConfig{}, want: true},
+		{name: "dismissed version", config: AppConfig{DismissedUpgradeVersion: "v1.3.0"}, want: false},
+		{name: "other version dismissed", config: AppConfig{DismissedUpgradeVersion: "v1.2.0"}, want: true},
+		{name: "snoozed", config: AppConfig{UpgradeSnoozedUntil: now.Add(time.Hour)}, want: false},
+		{name: "snooze over", config: AppConfig{UpgradeSnoozedUntil: now.Add(-time.Hour)}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.ShouldPromptUpgrade("v1.3.0", now); got != tt.want {
+				t.Errorf("ShouldPromptUpgrade() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppConfig_GetMonitorSubscriptionTTL(t *testing.T) {
+	config := &AppConfig{}
+	if got := config.GetMonitorSubscriptionTTL(); got != 24*time.Hour {
+		t.Errorf("GetMonitorSubscriptionTTL() with unset value = %v, want 24h", got)
+	}
+
+	config.MonitorSubscriptionTTLHours = 2
+	if got := config.GetMonitorSubscriptionTTL(); got != 2*time.Hour {
+		t.Errorf("GetMonitorSubscriptionTTL() = %v, want 2h", got)
+	}
+}
+
+func TestMonitorOptions_Validate(t *testing.T) {
+	if err := (MonitorOptions{}).Validate(); err != nil {
+		t.Errorf("Validate() zero options error = %v, want nil", err)
+	}
+	if err := (MonitorOptions{MaxOutstandingMessages: 10, MaxOutstandingBytes: 1 << 20, NumGoroutines: 1}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+	for _, options := range []MonitorOptions{{MaxOutstandingMessages: -1}, {MaxOutstandingBytes: -1}, {NumGoroutines: -1}} {
+		if err := options.Validate(); err == nil {
+			t.Errorf("Validate(%+v) error = nil, want error", options)
+		}
+	}
+}
+
+func TestMonitorOptions_WithDefaults(t *testing.T) {
+	defaults := MonitorOptions{MaxOutstandingMessages: 100, MaxOutstandingBytes: 1000, NumGoroutines: 2}
+	got := MonitorOptions{MaxOutstandingMessages: 5}.WithDefaults(defaults)
+	want := MonitorOptions{MaxOutstandingMessages: 5, MaxOutstandingBytes: 1000, NumGoroutines: 2}
+	if got != want {
+		t.Errorf("WithDefaults() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMatchHighlightColor(t *testing.T) {
+	rules := []HighlightRule{
+		{AttributeKey: "eventType", Equals: "order.created", Color: "green"},
+		{AttributeKey: "eventType", Equals: "order.failed", Color: "red"},
+		{AttributeKey: "priority", Equals: "", Color: "gray"},
+		{AttributeKey: "source", Equals: "billing", Color: "blue"},
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		want       string
+	}{
+		{"first match", map[string]string{"eventType": "order.created"}, "green"},
+		{"second rule", map[string]string{"eventType": "order.failed"}, "red"},
+		{"first matching rule wins", map[string]string{"eventType": "order.failed", "source": "billing"}, "red"},
+		{"empty value must be present", map[string]string{"priority": ""}, "gray"},
+		{"missing key does not match empty value", map[string]string{"other": "x"}, ""},
+		{"no attributes", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchHighlightColor(rules, tt.attributes); got != tt.want {
+				t.Errorf("MatchHighlightColor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateHighlightRules(t *testing.T) {
+	if err := ValidateHighlightRules([]HighlightRule{{AttributeKey: "eventType", Equals: "a", Color: "red"}}); err != nil {
+		t.Errorf("ValidateHighlightRules() valid rule error = %v", err)
+	}
+	if err := ValidateHighlightRules([]HighlightRule{{AttributeKey: " ", Color: "red"}}); err == nil {
+		t.Error("ValidateHighlightRules() empty key error = nil, want error")
+	}
+	if err := ValidateHighlightRules([]HighlightRule{{AttributeKey: "eventType"}}); err == nil {
+		t.Error("ValidateHighlightRules() empty color error = nil, want error")
+	}
+}
+
 func TestItoa(t *testing.T) {
 	tests := []struct {
 		input int
@@ -551,3 +784,53 @@ func BenchmarkConnectionProfile_GetEffectiveEmulatorHost(b *testing.B) {
 		_ = profile.GetEffectiveEmulatorHost()
 	}
 }
+
+func TestValidateAPITimeoutSeconds(t *testing.T) {
+	for _, seconds := range []int{0, 1, MaxAPITimeoutSeconds} {
+		if err := ValidateAPITimeoutSeconds(seconds); err != nil {
+			t.Errorf("ValidateAPITimeoutSeconds(%d) error = %v, want nil", seconds, err)
+		}
+	}
+	for _, seconds := range []int{-1, MaxAPITimeoutSeconds + 1} {
+		if err := ValidateAPITimeoutSeconds(seconds); err == nil {
+			t.Errorf("ValidateAPITimeoutSeconds(%d) error = nil, want error", seconds)
+		}
+	}
+
+	if got := (&AppConfig{}).GetAPITimeout(); got != 0 {
+		t.Errorf("GetAPITimeout() unset = %v, want 0", got)
+	}
+	if got := (&AppConfig{APITimeoutSeconds: 30}).GetAPITimeout(); got != 30*time.Second {
+		t.Errorf("GetAPITimeout() = %v, want 30s", got)
+	}
+}
+
+func TestAPIRetryPolicy(t *testing.T) {
+	valid := []APIRetryPolicy{{}, {MaxAttempts: 1}, {MaxAttempts: MaxAPIRetryAttempts, InitialBackoffMs: 200, MaxBackoffMs: 5000}}
+	for _, policy := range valid {
+		if err := policy.Validate(); err != nil {
+			t.Errorf("Validate(%+v) error = %v, want nil", policy, err)
+		}
+	}
+	invalid := []APIRetryPolicy{
+		{MaxAttempts: -1},
+		{MaxAttempts: MaxAPIRetryAttempts + 1},
+		{InitialBackoffMs: -1},
+		{MaxBackoffMs: MaxAPIRetryBackoffMs + 1},
+		{InitialBackoffMs: 500, MaxBackoffMs: 100},
+	}
+	for _, policy := range invalid {
+		if err := policy.Validate(); err == nil {
+			t.Errorf("Validate(%+v) error = nil, want error", policy)
+		}
+	}
+
+	var defaults APIRetryPolicy
+	if defaults.InitialBackoff() != DefaultAPIRetryBackoff || defaults.MaxBackoff() != DefaultAPIRetryMaxDelay {
+		t.Errorf("backoff defaults = %v/%v, want %v/%v", defaults.InitialBackoff(), defaults.MaxBackoff(), DefaultAPIRetryBackoff, DefaultAPIRetryMaxDelay)
+	}
+	// An initial backoff above the default maximum raises the maximum with it
+	if got := (APIRetryPolicy{InitialBackoffMs: 20000}).MaxBackoff(); got != 20*time.Second {
+		t.Errorf("MaxBackoff() = %v, want 20s", got)
+	}
+}
@@ -26,6 +26,9 @@ var (
 	// ErrServiceAccountNotFound is returned when the service account key file doesn't exist
 	ErrServiceAccountNotFound = errors.New("service account key file not found")
 
+	// ErrNoUpdateAsset is returned when a release has no download for the current OS and architecture
+	ErrNoUpdateAsset = errors.New("no release download for this platform")
+
 	// ErrDuplicateProfile is returned when trying to create a profile with a duplicate name
 	ErrDuplicateProfile = errors.New("profile with this name already exists")
 
@@ -37,4 +40,40 @@ var (
 
 	// ErrInvalidTemplate is returned when a template fails validation
 	ErrInvalidTemplate = errors.New("invalid template")
+
+	// ErrHistoryEntryNotFound is returned when a publish history entry with the given ID is not found
+	ErrHistoryEntryNotFound = errors.New("publish history entry not found")
+
+	// ErrMessageNotOutstanding is returned when manually acking or nacking a message that was already
+	// acked, nacked or released for redelivery
+	ErrMessageNotOutstanding = errors.New("message is no longer outstanding")
+
+	// ErrEmulatorShared is returned when flushing or resetting a managed emulator whose container or data
+	// directory is also used by other profiles
+	ErrEmulatorShared = errors.New("emulator is shared with other profiles: disconnect or stop them first")
+
+	// ErrSnapshotBacklogTooOld is returned when a snapshot cannot be created because the subscription's
+	// oldest unacked message would expire within an hour of the snapshot being taken
+	ErrSnapshotBacklogTooOld = errors.New("snapshot would expire too soon: the subscription's oldest unacked message is about to expire; ack or seek past old messages first")
+
+	// ErrIAMPolicyChanged is returned when setting an IAM policy whose etag no longer matches the current policy
+	ErrIAMPolicyChanged = errors.New("IAM policy changed since it was loaded: please refresh and try again")
+
+	// ErrIAMNotSupported is returned for IAM operations against an emulator, which does not implement IAM
+	ErrIAMNotSupported = errors.New("IAM policies are not supported by the Pub/Sub emulator")
+
+	// ErrSeekNotSupported is returned when the Pub/Sub emulator in use does not implement seek
+	ErrSeekNotSupported = errors.New("seek is not supported by this Pub/Sub emulator: update the emulator or use a GCP project")
+
+	// ErrAPITimeout is returned when a Pub/Sub API call does not complete within the configured timeout
+	ErrAPITimeout = errors.New("API call to Pub/Sub timed out")
+
+	// ErrOAuthAccountNotFound is returned when no stored OAuth token belongs to the given account
+	ErrOAuthAccountNotFound = errors.New("no stored OAuth token for this account")
+
+	// ErrOAuthSignInRequired is returned when an OAuth connection needs the browser sign-in but may not open it
+	ErrOAuthSignInRequired = errors.New("OAuth sign-in required: connect with the profile to sign in again")
+
+	// ErrInvalidResourceName is returned when a topic or subscription name breaks the Pub/Sub naming rules
+	ErrInvalidResourceName = errors.New("invalid resource name")
 )
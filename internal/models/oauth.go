@@ -14,6 +14,7 @@ type OAuthToken struct {
 	TokenType    string    `json:"token_type"`
 	Expiry       time.Time `json:"expiry"`
 	Scopes       []string  `json:"scopes,omitempty"`
+	Email        string    `json:"email,omitempty"` // Google account the token belongs to (empty for tokens saved by older versions)
 }
 
 // IsExpired checks if the access token has expired
@@ -0,0 +1,59 @@
+// Package models defines data structures for connection profiles and application configuration
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Pub/Sub resource name limits (https://cloud.google.com/pubsub/docs/pubsub-basics#resource_names)
+const (
+	MinResourceNameLength = 3
+	MaxResourceNameLength = 255
+	reservedNamePrefix    = "goog"
+)
+
+// ValidateResourceName checks a topic or subscription ID against the Pub/Sub naming rules
+// The error names the rule that was broken, so it can be shown before any API call is made.
+func ValidateResourceName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: name cannot be empty", ErrInvalidResourceName)
+	}
+	if len(name) < MinResourceNameLength {
+		return fmt.Errorf("%w %q: must be at least %s characters long", ErrInvalidResourceName, name, itoa(MinResourceNameLength))
+	}
+	if len(name) > MaxResourceNameLength {
+		return fmt.Errorf("%w: must be at most %s characters long (got %s)", ErrInvalidResourceName, itoa(MaxResourceNameLength), itoa(len(name)))
+	}
+	if !isASCIILetter(name[0]) {
+		return fmt.Errorf("%w %q: must start with a letter", ErrInvalidResourceName, name)
+	}
+	if strings.HasPrefix(name, reservedNamePrefix) {
+		return fmt.Errorf("%w %q: must not start with %q", ErrInvalidResourceName, name, reservedNamePrefix)
+	}
+	position := 0
+	for _, char := range name {
+		position++
+		if char > 0x7f || !isResourceNameChar(byte(char)) {
+			return fmt.Errorf("%w %q: character %q at position %s is not allowed (use letters, numbers, and - . _ ~ %% +)", ErrInvalidResourceName, name, char, itoa(position))
+		}
+	}
+	return nil
+}
+
+// isASCIILetter reports whether c is an ASCII letter
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isResourceNameChar reports whether c may appear in a resource name
+func isResourceNameChar(c byte) bool {
+	if isASCIILetter(c) || (c >= '0' && c <= '9') {
+		return true
+	}
+	switch c {
+	case '-', '.', '_', '~', '%', '+':
+		return true
+	}
+	return false
+}
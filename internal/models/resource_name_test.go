@@ -0,0 +1,57 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateResourceName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantRule string // Substring of the error; empty when the name is valid
+	}{
+		{name: "simple", input: "orders"},
+		{name: "minimum length", input: "abc"},
+		{name: "maximum length", input: "a" + strings.Repeat("b", 254)},
+		{name: "all allowed characters", input: "Orders-v1.2_test~50%+x"},
+		{name: "uppercase start", input: "Orders"},
+		{name: "goog inside the name", input: "my-goog-topic"},
+		{name: "reserved prefix in other case", input: "Google-events"},
+		{name: "empty", input: "", wantRule: "cannot be empty"},
+		{name: "one character", input: "a", wantRule: "at least 3 characters"},
+		{name: "two characters", input: "ab", wantRule: "at least 3 characters"},
+		{name: "too long", input: "a" + strings.Repeat("b", 255), wantRule: "at most 255 characters long (got 256)"},
+		{name: "leading digit", input: "1orders", wantRule: "must start with a letter"},
+		{name: "leading hyphen", input: "-orders", wantRule: "must start with a letter"},
+		{name: "leading underscore", input: "_orders", wantRule: "must start with a letter"},
+		{name: "reserved prefix", input: "goog-events", wantRule: `must not start with "goog"`},
+		{name: "reserved prefix only", input: "goog", wantRule: `must not start with "goog"`},
+		{name: "space", input: "my orders", wantRule: `character ' ' at position 3`},
+		{name: "slash", input: "team/orders", wantRule: `character '/' at position 5`},
+		{name: "non-ASCII letter", input: "ordés", wantRule: `character 'é' at position 4`},
+		{name: "trailing newline", input: "orders\n", wantRule: `character '\n' at position 7`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateResourceName(tt.input)
+			if tt.wantRule == "" {
+				if err != nil {
+					t.Errorf("ValidateResourceName(%q) error = %v, want nil", tt.input, err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateResourceName(%q) error = nil, want %q", tt.input, tt.wantRule)
+			}
+			if !errors.Is(err, ErrInvalidResourceName) {
+				t.Errorf("ValidateResourceName(%q) error = %v, want ErrInvalidResourceName", tt.input, err)
+			}
+			if !strings.Contains(err.Error(), tt.wantRule) {
+				t.Errorf("ValidateResourceName(%q) error = %q, want it to contain %q", tt.input, err.Error(), tt.wantRule)
+			}
+		})
+	}
+}
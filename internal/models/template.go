@@ -46,6 +46,34 @@ func NewMessageTemplate(name, payload string, attributes map[string]string) *Mes
 	}
 }
 
+// AttributeTemplate is a named set of attributes that can be merged into any published message
+type AttributeTemplate struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Attributes map[string]string `json:"attributes"`
+	CreatedAt  string            `json:"createdAt"` // ISO 8601 timestamp
+	UpdatedAt  string            `json:"updatedAt"` // ISO 8601 timestamp
+}
+
+// Validate ensures the attribute template has a name and at least one attribute, none with an empty key
+func (at *AttributeTemplate) Validate() error {
+	if strings.TrimSpace(at.ID) == "" {
+		return errors.New("template ID cannot be empty")
+	}
+	if strings.TrimSpace(at.Name) == "" {
+		return errors.New("template name cannot be empty")
+	}
+	if len(at.Attributes) == 0 {
+		return errors.New("attribute template must have at least one attribute")
+	}
+	for key := range at.Attributes {
+		if strings.TrimSpace(key) == "" {
+			return errors.New("attribute key cannot be empty")
+		}
+	}
+	return nil
+}
+
 // TopicSubscriptionTemplate represents a template for creating topics and subscriptions with best practices
 type TopicSubscriptionTemplate struct {
 	ID            string                       `json:"id"`                   // Template identifier
@@ -100,6 +128,46 @@ type RetryPolicy struct {
 type PushConfig struct {
 	Endpoint   string            `json:"endpoint"`             // Push endpoint URL
 	Attributes map[string]string `json:"attributes,omitempty"` // Push attributes
+	OIDCToken  *OIDCToken        `json:"oidcToken,omitempty"`  // Authenticate push requests with an OIDC token
+}
+
+// OIDCToken configures the OIDC token Pub/Sub attaches to push requests
+type OIDCToken struct {
+	ServiceAccountEmail string `json:"serviceAccountEmail"` // Service account the token is generated for
+	Audience            string `json:"audience,omitempty"`  // Token audience; Pub/Sub uses the push endpoint when empty
+}
+
+// Validate checks that the service account email is well-formed
+func (t *OIDCToken) Validate() error {
+	return ValidateServiceAccountEmail(t.ServiceAccountEmail)
+}
+
+// ValidateServiceAccountEmail checks that email looks like a service account address (name@domain.tld)
+func ValidateServiceAccountEmail(email string) error {
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" || strings.Contains(domain, "@") || strings.ContainsAny(email, " \t\r\n<>,;\"") ||
+		!strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") || strings.Contains(domain, "..") {
+		return fmt.Errorf("invalid service account email %q: expected name@project.iam.gserviceaccount.com", email)
+	}
+	return nil
+}
+
+// BigQueryConfig configures a BigQuery subscription, which writes messages directly to a table
+type BigQueryConfig struct {
+	Table             string `json:"table"`             // "{projectId}.{datasetId}.{tableId}"
+	UseTopicSchema    bool   `json:"useTopicSchema"`    // Map fields of the topic's schema to table columns
+	WriteMetadata     bool   `json:"writeMetadata"`     // Also write message ID, publish time, attributes etc.
+	DropUnknownFields bool   `json:"dropUnknownFields"` // Drop fields missing from the table instead of failing
+}
+
+// CloudStorageConfig configures a Cloud Storage subscription, which writes messages to files in a bucket
+type CloudStorageConfig struct {
+	Bucket         string `json:"bucket"`                   // Bucket name without the "gs://" prefix
+	FilenamePrefix string `json:"filenamePrefix,omitempty"` // Prefix for object names
+	FilenameSuffix string `json:"filenameSuffix,omitempty"` // Suffix for object names (e.g., ".json"), must not end in "/"
+	MaxDuration    string `json:"maxDuration,omitempty"`    // Max time before a new file is started, 1m-10m (e.g., "5m")
+	MaxBytes       int64  `json:"maxBytes,omitempty"`       // Max file size before a new file is started (0 = no limit)
+	Format         string `json:"format,omitempty"`         // "text" (default) or "avro"
 }
 
 // DeadLetterTemplateConfig represents dead letter queue configuration
@@ -134,6 +202,12 @@ type TemplateCreateResult struct {
 	Error             string   `json:"error,omitempty"`             // Error message if failed
 }
 
+// TemplateEnvironmentResult is the outcome of creating a template in a single environment
+type TemplateEnvironmentResult struct {
+	Environment string               `json:"environment"` // Environment suffix (e.g., "dev")
+	Result      TemplateCreateResult `json:"result"`      // Creation result for this environment
+}
+
 // Validate validates a TopicSubscriptionTemplate
 func (t *TopicSubscriptionTemplate) Validate() error {
 	if err := t.validateBasicFields(); err != nil {
@@ -205,6 +279,11 @@ func (t *TopicSubscriptionTemplate) validateSubscriptionConfig(index int, sub Su
 			return err
 		}
 	}
+	if sub.PushConfig != nil && sub.PushConfig.OIDCToken != nil {
+		if err := sub.PushConfig.OIDCToken.Validate(); err != nil {
+			return fmt.Errorf("subscription %d push authentication: %w", index, err)
+		}
+	}
 	return nil
 }
 
@@ -276,3 +355,10 @@ func (r *TemplateCreateRequest) validateBaseNameCharacters(baseName string) erro
 func (r *TemplateCreateRequest) isValidBaseNameChar(char rune) bool {
 	return (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-'
 }
+
+// DriftField describes a subscription setting that differs from its template
+type DriftField struct {
+	Field    string `json:"field"`    // Setting name (e.g., "ackDeadline", "retryPolicy.minimumBackoff")
+	Expected string `json:"expected"` // Value the template specifies
+	Actual   string `json:"actual"`   // Value currently set on the subscription
+}
@@ -737,3 +737,27 @@ func BenchmarkTemplateCreateRequest_Validate(b *testing.B) {
 		_ = request.Validate()
 	}
 }
+
+func TestValidateServiceAccountEmail(t *testing.T) {
+	tests := []struct {
+		email   string
+		wantErr bool
+	}{
+		{"pusher@my-project.iam.gserviceaccount.com", false},
+		{"123456789-compute@developer.gserviceaccount.com", false},
+		{"", true},
+		{"pusher", true},
+		{"@my-project.iam.gserviceaccount.com", true},
+		{"pusher@localhost", true},
+		{"pusher@@example.com", true},
+		{"pusher@example..com", true},
+		{"pusher@example.com.", true},
+		{"push er@example.com", true},
+		{"Pusher <pusher@example.com>", true},
+	}
+	for _, tt := range tests {
+		if err := ValidateServiceAccountEmail(tt.email); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateServiceAccountEmail(%q) error = %v, wantErr %v", tt.email, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/models"
+)
+
+// retryBackoffMultiplier is how much the delay between retries grows, as in the client library defaults
+const retryBackoffMultiplier = 1.3
+
+// transientCodes are the errors retried under a custom retry policy
+var transientCodes = []codes.Code{codes.Unavailable, codes.DeadlineExceeded}
+
+// ApplyCallPolicy sets the timeout and retries of a client's admin calls (topics, subscriptions, snapshots, IAM)
+// Publishing, pulling and acking keep the client library settings. A zero timeout or retry policy keeps the
+// library defaults. Call it on a new client before it is used: the options are appended to the current ones.
+func ApplyCallPolicy(client *pubsub.Client, timeout time.Duration, retry models.APIRetryPolicy) {
+	opts := callPolicyOptions(timeout, retry)
+	if client == nil || len(opts) == 0 {
+		return
+	}
+
+	topics := client.TopicAdminClient.CallOptions
+	subs := client.SubscriptionAdminClient.CallOptions
+	for _, callOpts := range []*[]gax.CallOption{
+		&topics.CreateTopic, &topics.UpdateTopic, &topics.GetTopic, &topics.ListTopics,
+		&topics.ListTopicSubscriptions, &topics.ListTopicSnapshots, &topics.DeleteTopic, &topics.DetachSubscription,
+		&topics.GetIamPolicy, &topics.SetIamPolicy, &topics.TestIamPermissions,
+		&subs.CreateSubscription, &subs.GetSubscription, &subs.UpdateSubscription, &subs.ListSubscriptions,
+		&subs.DeleteSubscription, &subs.ModifyPushConfig, &subs.GetSnapshot, &subs.ListSnapshots,
+		&subs.CreateSnapshot, &subs.UpdateSnapshot, &subs.DeleteSnapshot, &subs.Seek,
+		&subs.GetIamPolicy, &subs.SetIamPolicy, &subs.TestIamPermissions,
+	} {
+		*callOpts = append(*callOpts, opts...)
+	}
+}
+
+// callPolicyOptions returns the gax options for a timeout and retry policy; later options override the defaults
+func callPolicyOptions(timeout time.Duration, retry models.APIRetryPolicy) []gax.CallOption {
+	var opts []gax.CallOption
+	if timeout > 0 {
+		opts = append(opts, gax.WithTimeout(timeout))
+	}
+	if retry.MaxAttempts > 0 {
+		opts = append(opts, gax.WithRetry(func() gax.Retryer { return newRetryer(retry) }))
+	}
+	return opts
+}
+
+// newRetryer returns a retryer for one call: transient errors are retried with backoff up to MaxAttempts
+func newRetryer(retry models.APIRetryPolicy) gax.Retryer {
+	backoff := gax.Backoff{
+		Initial:    retry.InitialBackoff(),
+		Max:        retry.MaxBackoff(),
+		Multiplier: retryBackoffMultiplier,
+	}
+	return &boundedRetryer{retryer: gax.OnCodes(transientCodes, backoff), maxAttempts: retry.MaxAttempts}
+}
+
+// boundedRetryer stops retrying once a call has been attempted maxAttempts times
+type boundedRetryer struct {
+	retryer     gax.Retryer
+	attempts    int
+	maxAttempts int
+}
+
+// Retry reports whether to retry after err and how long to wait first
+func (r *boundedRetryer) Retry(err error) (time.Duration, bool) {
+	r.attempts++
+	if r.attempts >= r.maxAttempts {
+		return 0, false
+	}
+	return r.retryer.Retry(err)
+}
+
+// TimeoutError wraps a deadline error from an API call in models.ErrAPITimeout; other errors are returned as is
+func TimeoutError(err error, timeout time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded {
+		return fmt.Errorf("%w after %s: %w", models.ErrAPITimeout, timeout, err)
+	}
+	return err
+}
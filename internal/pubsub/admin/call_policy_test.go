@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestCallPolicyOptions_Defaults(t *testing.T) {
+	if opts := callPolicyOptions(0, models.APIRetryPolicy{}); len(opts) != 0 {
+		t.Errorf("callPolicyOptions() zero policy = %d options, want none", len(opts))
+	}
+	if opts := callPolicyOptions(time.Second, models.APIRetryPolicy{MaxAttempts: 3}); len(opts) != 2 {
+		t.Errorf("callPolicyOptions() = %d options, want 2 (timeout and retry)", len(opts))
+	}
+}
+
+func TestBoundedRetryer(t *testing.T) {
+	policy := models.APIRetryPolicy{MaxAttempts: 3, InitialBackoffMs: 1, MaxBackoffMs: 1}
+
+	unavailable := status.Error(codes.Unavailable, "connection reset")
+	r := newRetryer(policy)
+	for attempt := 1; attempt < policy.MaxAttempts; attempt++ {
+		if _, retry := r.Retry(unavailable); !retry {
+			t.Fatalf("Retry() after attempt %d = false, want true", attempt)
+		}
+	}
+	if _, retry := r.Retry(unavailable); retry {
+		t.Errorf("Retry() after %d attempts = true, want false", policy.MaxAttempts)
+	}
+
+	if _, retry := newRetryer(policy).Retry(status.Error(codes.DeadlineExceeded, "slow")); !retry {
+		t.Error("Retry(DeadlineExceeded) = false, want true")
+	}
+	if _, retry := newRetryer(policy).Retry(status.Error(codes.NotFound, "missing")); retry {
+		t.Error("Retry(NotFound) = true, want false")
+	}
+}
+
+func TestApplyCallPolicy(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	before := len(client.SubscriptionAdminClient.CallOptions.ListSubscriptions)
+	streamingBefore := len(client.SubscriptionAdminClient.CallOptions.StreamingPull)
+	publishBefore := len(client.TopicAdminClient.CallOptions.Publish)
+
+	ApplyCallPolicy(client, 5*time.Second, models.APIRetryPolicy{MaxAttempts: 2})
+
+	if got := len(client.SubscriptionAdminClient.CallOptions.ListSubscriptions); got != before+2 {
+		t.Errorf("ListSubscriptions options = %d, want %d", got, before+2)
+	}
+	if got := len(client.SubscriptionAdminClient.CallOptions.StreamingPull); got != streamingBefore {
+		t.Errorf("StreamingPull options = %d, want unchanged %d", got, streamingBefore)
+	}
+	if got := len(client.TopicAdminClient.CallOptions.Publish); got != publishBefore {
+		t.Errorf("Publish options = %d, want unchanged %d", got, publishBefore)
+	}
+
+	// Calls still work with the policy applied
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	topics, err := ListTopicsAdmin(ctx, client, "p")
+	if err != nil || len(topics) != 1 {
+		t.Errorf("ListTopicsAdmin() = %d topics, %v; want 1, nil", len(topics), err)
+	}
+}
+
+func TestTimeoutError(t *testing.T) {
+	if err := TimeoutError(nil, time.Second); err != nil {
+		t.Errorf("TimeoutError(nil) = %v, want nil", err)
+	}
+
+	other := errors.New("not found")
+	if err := TimeoutError(other, time.Second); err != other {
+		t.Errorf("TimeoutError(other) = %v, want the error unchanged", err)
+	}
+
+	for _, deadline := range []error{
+		fmt.Errorf("failed to list topics: %w", context.DeadlineExceeded),
+		fmt.Errorf("failed to list topics: %w", status.Error(codes.DeadlineExceeded, "deadline exceeded")),
+	} {
+		err := TimeoutError(deadline, 15*time.Second)
+		if !errors.Is(err, models.ErrAPITimeout) {
+			t.Errorf("TimeoutError(%v) = %v, want ErrAPITimeout", deadline, err)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/models"
+)
+
+// Catalog is a machine-readable inventory of a project's topics and subscriptions
+type Catalog struct {
+	Header        CatalogHeader         `json:"header"`
+	Topics        []CatalogTopic        `json:"topics"`
+	Subscriptions []CatalogSubscription `json:"subscriptions"`
+}
+
+// CatalogHeader identifies where and when a catalog was produced
+type CatalogHeader struct {
+	AppVersion string `json:"appVersion"`
+	ExportedAt string `json:"exportedAt"` // RFC3339
+	ProjectID  string `json:"projectId"`
+}
+
+// CatalogTopic is the full configuration of a topic plus its relationships
+type CatalogTopic struct {
+	Name                      string            `json:"name"`
+	DisplayName               string            `json:"displayName"`
+	Labels                    map[string]string `json:"labels,omitempty"`
+	MessageRetention          string            `json:"messageRetention,omitempty"`
+	KMSKeyName                string            `json:"kmsKeyName,omitempty"`
+	AllowedPersistenceRegions []string          `json:"allowedPersistenceRegions,omitempty"`
+	Schema                    *CatalogSchema    `json:"schema,omitempty"`
+	Subscriptions             []string          `json:"subscriptions"`           // Subscriptions attached to this topic
+	DeadLetterFor             []string          `json:"deadLetterFor,omitempty"` // Subscriptions using this topic as DLQ
+	FetchError                string            `json:"fetchError,omitempty"`    // Set when full config could not be fetched
+}
+
+// CatalogSchema describes the schema bound to a topic
+type CatalogSchema struct {
+	Name            string `json:"name"`
+	Encoding        string `json:"encoding,omitempty"`
+	FirstRevisionID string `json:"firstRevisionId,omitempty"`
+	LastRevisionID  string `json:"lastRevisionId,omitempty"`
+}
+
+// CatalogSubscription is the full configuration of a subscription plus its relationships
+type CatalogSubscription struct {
+	Name                  string                `json:"name"`
+	DisplayName           string                `json:"displayName"`
+	Topic                 string                `json:"topic"`
+	SubscriptionType      string                `json:"subscriptionType"`
+	PushEndpoint          string                `json:"pushEndpoint,omitempty"`
+	PushOIDCToken         *models.OIDCToken     `json:"pushOidcToken,omitempty"`
+	BigQueryTable         string                `json:"bigQueryTable,omitempty"`
+	CloudStorageBucket    string                `json:"cloudStorageBucket,omitempty"`
+	AckDeadline           int                   `json:"ackDeadline"`
+	RetentionDuration     string                `json:"retentionDuration,omitempty"`
+	RetainAckedMessages   bool                  `json:"retainAckedMessages"`
+	EnableMessageOrdering bool                  `json:"enableMessageOrdering"`
+	EnableExactlyOnce     bool                  `json:"enableExactlyOnce"`
+	Filter                string                `json:"filter,omitempty"`
+	Labels                map[string]string     `json:"labels,omitempty"`
+	ExpirationTTL         string                `json:"expirationTtl,omitempty"`
+	RetryMinimumBackoff   string                `json:"retryMinimumBackoff,omitempty"`
+	RetryMaximumBackoff   string                `json:"retryMaximumBackoff,omitempty"`
+	DeadLetterPolicy      *DeadLetterPolicyInfo `json:"deadLetterPolicy,omitempty"`
+	Detached              bool                  `json:"detached,omitempty"`
+	FetchError            string                `json:"fetchError,omitempty"` // Set when full config could not be fetched
+}
+
+// BuildCatalog builds a catalog from cached topics and subscriptions, fetching each resource's full config
+// Resources whose config cannot be fetched are still included using cached data, with FetchError set
+func BuildCatalog(ctx context.Context, client *pubsub.Client, projectID string, topics []TopicInfo, subscriptions []SubscriptionInfo) *Catalog {
+	catalog := &Catalog{
+		Header: CatalogHeader{
+			ExportedAt: time.Now().Format(time.RFC3339),
+			ProjectID:  projectID,
+		},
+		Topics:        make([]CatalogTopic, 0, len(topics)),
+		Subscriptions: make([]CatalogSubscription, 0, len(subscriptions)),
+	}
+
+	for _, sub := range subscriptions {
+		catalog.Subscriptions = append(catalog.Subscriptions, catalogSubscription(ctx, client, sub))
+	}
+
+	// Index relationships by topic name
+	attached := make(map[string][]string)
+	deadLetterFor := make(map[string][]string)
+	for _, sub := range catalog.Subscriptions {
+		attached[sub.Topic] = append(attached[sub.Topic], sub.Name)
+		if sub.DeadLetterPolicy != nil && sub.DeadLetterPolicy.DeadLetterTopic != "" {
+			deadLetterFor[sub.DeadLetterPolicy.DeadLetterTopic] = append(deadLetterFor[sub.DeadLetterPolicy.DeadLetterTopic], sub.Name)
+		}
+	}
+
+	for _, topic := range topics {
+		entry := catalogTopic(ctx, client, topic)
+		entry.Subscriptions = attached[topic.Name]
+		if entry.Subscriptions == nil {
+			entry.Subscriptions = []string{}
+		}
+		entry.DeadLetterFor = deadLetterFor[topic.Name]
+		catalog.Topics = append(catalog.Topics, entry)
+	}
+
+	// Stable ordering keeps exports diffable
+	sort.Slice(catalog.Topics, func(i, j int) bool { return catalog.Topics[i].Name < catalog.Topics[j].Name })
+	sort.Slice(catalog.Subscriptions, func(i, j int) bool { return catalog.Subscriptions[i].Name < catalog.Subscriptions[j].Name })
+
+	return catalog
+}
+
+// catalogTopic fetches the full config of a topic, falling back to cached info on error
+func catalogTopic(ctx context.Context, client *pubsub.Client, info TopicInfo) CatalogTopic {
+	entry := CatalogTopic{
+		Name:             info.Name,
+		DisplayName:      info.DisplayName,
+		MessageRetention: info.MessageRetention,
+	}
+
+	topic, err := client.TopicAdminClient.GetTopic(ctx, &pubsubpb.GetTopicRequest{Topic: info.Name})
+	if err != nil {
+		entry.FetchError = err.Error()
+		return entry
+	}
+
+	entry.Labels = topic.Labels
+	entry.KMSKeyName = topic.KmsKeyName
+	if topic.MessageRetentionDuration != nil {
+		entry.MessageRetention = topic.MessageRetentionDuration.AsDuration().String()
+	}
+	if topic.MessageStoragePolicy != nil {
+		entry.AllowedPersistenceRegions = topic.MessageStoragePolicy.AllowedPersistenceRegions
+	}
+	if topic.SchemaSettings != nil && topic.SchemaSettings.Schema != "" {
+		entry.Schema = &CatalogSchema{
+			Name:            topic.SchemaSettings.Schema,
+			Encoding:        topic.SchemaSettings.Encoding.String(),
+			FirstRevisionID: topic.SchemaSettings.FirstRevisionId,
+			LastRevisionID:  topic.SchemaSettings.LastRevisionId,
+		}
+	}
+
+	return entry
+}
+
+// catalogSubscription fetches the full config of a subscription, falling back to cached info on error
+func catalogSubscription(ctx context.Context, client *pubsub.Client, info SubscriptionInfo) CatalogSubscription {
+	entry := CatalogSubscription{
+		Name:                info.Name,
+		DisplayName:         info.DisplayName,
+		Topic:               info.Topic,
+		SubscriptionType:    info.SubscriptionType,
+		PushEndpoint:        info.PushEndpoint,
+		PushOIDCToken:       info.PushOIDCToken,
+		BigQueryTable:       info.BigQueryTable,
+		CloudStorageBucket:  info.CloudStorageBucket,
+		AckDeadline:         info.AckDeadline,
+		RetentionDuration:   info.RetentionDuration,
+		RetainAckedMessages: info.RetainAcked,
+		Filter:              info.Filter,
+		DeadLetterPolicy:    info.DeadLetterPolicy,
+	}
+
+	sub, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{Subscription: info.Name})
+	if err != nil {
+		entry.FetchError = err.Error()
+		return entry
+	}
+
+	entry.Topic = sub.Topic
+	entry.AckDeadline = int(sub.AckDeadlineSeconds)
+	entry.RetainAckedMessages = sub.RetainAckedMessages
+	entry.EnableMessageOrdering = sub.EnableMessageOrdering
+	entry.EnableExactlyOnce = sub.EnableExactlyOnceDelivery
+	entry.Filter = sub.Filter
+	entry.Labels = sub.Labels
+	entry.Detached = sub.Detached
+	if sub.MessageRetentionDuration != nil {
+		entry.RetentionDuration = sub.MessageRetentionDuration.AsDuration().String()
+	}
+	if sub.ExpirationPolicy != nil && sub.ExpirationPolicy.Ttl != nil {
+		entry.ExpirationTTL = sub.ExpirationPolicy.Ttl.AsDuration().String()
+	}
+	if sub.RetryPolicy != nil {
+		if sub.RetryPolicy.MinimumBackoff != nil {
+			entry.RetryMinimumBackoff = sub.RetryPolicy.MinimumBackoff.AsDuration().String()
+		}
+		if sub.RetryPolicy.MaximumBackoff != nil {
+			entry.RetryMaximumBackoff = sub.RetryPolicy.MaximumBackoff.AsDuration().String()
+		}
+	}
+	if sub.DeadLetterPolicy != nil {
+		entry.DeadLetterPolicy = &DeadLetterPolicyInfo{
+			DeadLetterTopic:     sub.DeadLetterPolicy.DeadLetterTopic,
+			MaxDeliveryAttempts: int(sub.DeadLetterPolicy.MaxDeliveryAttempts),
+		}
+	}
+
+	var delivery SubscriptionInfo
+	applySubscriptionType(&delivery, sub)
+	entry.SubscriptionType = delivery.SubscriptionType
+	entry.PushEndpoint = delivery.PushEndpoint
+	entry.PushOIDCToken = delivery.PushOIDCToken
+	entry.BigQueryTable = delivery.BigQueryTable
+	entry.CloudStorageBucket = delivery.CloudStorageBucket
+
+	return entry
+}
@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestBuildCatalog_SubscriptionTypes(t *testing.T) {
+	client, _ := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "orders")
+	pubsubtest.CreateSubscription(t, client, "orders-pull", "orders", nil)
+	pubsubtest.CreateSubscription(t, client, "orders-push", "orders", &pubsubpb.Subscription{
+		PushConfig: &pubsubpb.PushConfig{
+			PushEndpoint:         "https://example.com/push",
+			AuthenticationMethod: &pubsubpb.PushConfig_OidcToken_{OidcToken: &pubsubpb.PushConfig_OidcToken{ServiceAccountEmail: "push@p.iam.gserviceaccount.com"}},
+		},
+	})
+	pubsubtest.CreateSubscription(t, client, "orders-bq", "orders", &pubsubpb.Subscription{
+		BigqueryConfig: &pubsubpb.BigQueryConfig{Table: "p.d.orders"},
+	})
+	pubsubtest.CreateSubscription(t, client, "orders-gcs", "orders", &pubsubpb.Subscription{
+		CloudStorageConfig: &pubsubpb.CloudStorageConfig{Bucket: "orders-archive"},
+	})
+
+	ctx := context.Background()
+	subscriptions, err := ListSubscriptionsAdmin(ctx, client, "p")
+	if err != nil {
+		t.Fatalf("ListSubscriptionsAdmin() error = %v", err)
+	}
+	// Seed the catalog with stale cached types: the fetched config must win
+	for i := range subscriptions {
+		subscriptions[i].SubscriptionType = "pull"
+	}
+	catalog := BuildCatalog(ctx, client, "p", nil, subscriptions)
+
+	type delivery struct {
+		Type, PushEndpoint, BigQueryTable, CloudStorageBucket string
+		PushOIDCToken                                         *models.OIDCToken
+	}
+	got := make(map[string]delivery, len(catalog.Subscriptions))
+	for _, sub := range catalog.Subscriptions {
+		if sub.FetchError != "" {
+			t.Fatalf("catalog subscription %s FetchError = %s", sub.DisplayName, sub.FetchError)
+		}
+		got[sub.DisplayName] = delivery{sub.SubscriptionType, sub.PushEndpoint, sub.BigQueryTable, sub.CloudStorageBucket, sub.PushOIDCToken}
+	}
+
+	tests := []struct {
+		subscriptionID string
+		want           delivery
+	}{
+		{"orders-pull", delivery{Type: "pull"}},
+		{"orders-push", delivery{Type: "push", PushEndpoint: "https://example.com/push", PushOIDCToken: &models.OIDCToken{ServiceAccountEmail: "push@p.iam.gserviceaccount.com"}}},
+		{"orders-bq", delivery{Type: "bigquery", BigQueryTable: "p.d.orders"}},
+		{"orders-gcs", delivery{Type: "cloudstorage", CloudStorageBucket: "orders-archive"}},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(got[tt.subscriptionID], tt.want) {
+			t.Errorf("catalog subscription %s = %+v, want %+v", tt.subscriptionID, got[tt.subscriptionID], tt.want)
+		}
+	}
+}
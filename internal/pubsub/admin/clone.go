@@ -0,0 +1,202 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/models"
+)
+
+// deletedTopicName is the topic Pub/Sub reports for subscriptions whose topic was deleted
+const deletedTopicName = "_deleted-topic_"
+
+// CloneSubscriptionAdmin creates newSubID on the topic of sourceSubID with the source's configuration
+// overrides is applied on top of the copied configuration with the same meaning as in UpdateSubscriptionAdmin.
+// Fails if newSubID already exists.
+func CloneSubscriptionAdmin(ctx context.Context, client *pubsub.Client, projectID, sourceSubID, newSubID string, overrides SubscriptionUpdateParams) error {
+	_, sourceName := NormalizeName(projectID, "subscription", sourceSubID)
+	shortNewID, newName := NormalizeName(projectID, "subscription", newSubID)
+	if shortNewID == "" {
+		return fmt.Errorf("new subscription ID cannot be empty")
+	}
+	if newName == sourceName {
+		return fmt.Errorf("new subscription ID must differ from the source subscription")
+	}
+
+	source, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{Subscription: sourceName})
+	if err != nil {
+		return fmt.Errorf("failed to get source subscription: %w", err)
+	}
+	if source.Topic == deletedTopicName {
+		return fmt.Errorf("cannot clone subscription %s: its topic was deleted", sourceSubID)
+	}
+
+	_, err = client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{Subscription: newName})
+	if err == nil {
+		return fmt.Errorf("subscription %s already exists", shortNewID)
+	}
+	if status.Code(err) != codes.NotFound {
+		return fmt.Errorf("failed to check whether subscription %s exists: %w", shortNewID, err)
+	}
+
+	config := subscriptionConfigFromProto(source)
+	if err := applySubscriptionOverrides(&config, overrides); err != nil {
+		return err
+	}
+	return CreateSubscriptionWithConfig(ctx, client, projectID, source.Topic, newName, config)
+}
+
+// subscriptionConfigFromProto converts an existing subscription into a SubscriptionConfig that recreates it
+func subscriptionConfigFromProto(sub *pubsubpb.Subscription) SubscriptionConfig {
+	config := SubscriptionConfig{
+		AckDeadline:       int(sub.AckDeadlineSeconds),
+		EnableOrdering:    sub.EnableMessageOrdering,
+		EnableExactlyOnce: sub.EnableExactlyOnceDelivery,
+		Filter:            sub.Filter,
+		RetainAcked:       sub.RetainAckedMessages,
+	}
+	if sub.MessageRetentionDuration != nil {
+		config.RetentionDuration = sub.MessageRetentionDuration.AsDuration().String()
+	}
+	if sub.ExpirationPolicy != nil {
+		// A policy without a TTL never expires
+		config.ExpirationPolicy = &models.ExpirationPolicy{}
+		if sub.ExpirationPolicy.Ttl != nil {
+			config.ExpirationPolicy.TTL = sub.ExpirationPolicy.Ttl.AsDuration().String()
+		}
+	}
+	if sub.RetryPolicy != nil {
+		config.RetryPolicy = &models.RetryPolicy{
+			MinimumBackoff: sub.RetryPolicy.MinimumBackoff.AsDuration().String(),
+			MaximumBackoff: sub.RetryPolicy.MaximumBackoff.AsDuration().String(),
+		}
+	}
+	if sub.DeadLetterPolicy != nil {
+		config.DeadLetterPolicy = &DeadLetterPolicyInfo{
+			DeadLetterTopic:     sub.DeadLetterPolicy.DeadLetterTopic,
+			MaxDeliveryAttempts: int(sub.DeadLetterPolicy.MaxDeliveryAttempts),
+		}
+	}
+	if sub.PushConfig != nil && sub.PushConfig.PushEndpoint != "" {
+		config.PushConfig = &models.PushConfig{
+			Endpoint:   sub.PushConfig.PushEndpoint,
+			Attributes: sub.PushConfig.Attributes,
+			OIDCToken:  oidcTokenFromProto(sub.PushConfig),
+		}
+	}
+	if sub.BigqueryConfig != nil && sub.BigqueryConfig.Table != "" {
+		config.BigQueryConfig = &models.BigQueryConfig{
+			Table:             sub.BigqueryConfig.Table,
+			UseTopicSchema:    sub.BigqueryConfig.UseTopicSchema,
+			WriteMetadata:     sub.BigqueryConfig.WriteMetadata,
+			DropUnknownFields: sub.BigqueryConfig.DropUnknownFields,
+		}
+	}
+	if sub.CloudStorageConfig != nil && sub.CloudStorageConfig.Bucket != "" {
+		cloudStorage := &models.CloudStorageConfig{
+			Bucket:         sub.CloudStorageConfig.Bucket,
+			FilenamePrefix: sub.CloudStorageConfig.FilenamePrefix,
+			FilenameSuffix: sub.CloudStorageConfig.FilenameSuffix,
+			MaxBytes:       sub.CloudStorageConfig.MaxBytes,
+		}
+		if sub.CloudStorageConfig.MaxDuration != nil {
+			cloudStorage.MaxDuration = sub.CloudStorageConfig.MaxDuration.AsDuration().String()
+		}
+		if sub.CloudStorageConfig.GetAvroConfig() != nil {
+			cloudStorage.Format = "avro"
+		}
+		config.CloudStorageConfig = cloudStorage
+	}
+	if len(sub.Labels) > 0 {
+		config.Labels = sub.Labels
+	}
+	return config
+}
+
+// applySubscriptionOverrides applies update parameters to a subscription config
+// Subscription type "pull" drops push, BigQuery and Cloud Storage delivery.
+func applySubscriptionOverrides(config *SubscriptionConfig, overrides SubscriptionUpdateParams) error {
+	if overrides.AckDeadline != nil {
+		config.AckDeadline = *overrides.AckDeadline
+	}
+	if overrides.RetentionDuration != nil {
+		config.RetentionDuration = *overrides.RetentionDuration
+	}
+	if overrides.Filter != nil {
+		config.Filter = *overrides.Filter
+	}
+	if overrides.RetainAcked != nil {
+		config.RetainAcked = *overrides.RetainAcked
+	}
+	if overrides.ExpirationTTL != nil {
+		config.ExpirationPolicy = &models.ExpirationPolicy{TTL: *overrides.ExpirationTTL}
+	}
+	if overrides.RetryPolicy != nil {
+		// Empty backoffs remove the retry policy (immediate redelivery)
+		config.RetryPolicy = nil
+		if overrides.RetryPolicy.MinimumBackoff != "" || overrides.RetryPolicy.MaximumBackoff != "" {
+			policy := *overrides.RetryPolicy
+			config.RetryPolicy = &policy
+		}
+	}
+	if overrides.DeadLetterPolicy != nil {
+		if config.DeadLetterPolicy == nil {
+			config.DeadLetterPolicy = &DeadLetterPolicyInfo{}
+		}
+		if overrides.DeadLetterPolicy.DeadLetterTopic != "" {
+			config.DeadLetterPolicy.DeadLetterTopic = overrides.DeadLetterPolicy.DeadLetterTopic
+		}
+		if overrides.DeadLetterPolicy.MaxDeliveryAttempts > 0 {
+			config.DeadLetterPolicy.MaxDeliveryAttempts = overrides.DeadLetterPolicy.MaxDeliveryAttempts
+		}
+	}
+
+	subscriptionType := ""
+	if overrides.SubscriptionType != nil {
+		subscriptionType = *overrides.SubscriptionType
+	}
+	switch subscriptionType {
+	case "":
+		if overrides.PushEndpoint != nil {
+			if config.PushConfig == nil {
+				return fmt.Errorf("push endpoint override requires subscription type push")
+			}
+			config.PushConfig.Endpoint = *overrides.PushEndpoint
+		}
+	case "push":
+		if overrides.PushEndpoint == nil || *overrides.PushEndpoint == "" {
+			if config.PushConfig == nil {
+				return fmt.Errorf("push endpoint is required for a push subscription")
+			}
+		} else if config.PushConfig == nil {
+			config.PushConfig = &models.PushConfig{Endpoint: *overrides.PushEndpoint}
+		} else {
+			config.PushConfig.Endpoint = *overrides.PushEndpoint
+		}
+		config.BigQueryConfig = nil
+		config.CloudStorageConfig = nil
+	case "pull":
+		config.PushConfig = nil
+		config.BigQueryConfig = nil
+		config.CloudStorageConfig = nil
+	default:
+		return fmt.Errorf("invalid subscription type %q: must be pull or push", subscriptionType)
+	}
+
+	if overrides.OIDCToken != nil {
+		if config.PushConfig == nil {
+			return fmt.Errorf("push authentication override requires a push subscription")
+		}
+		config.PushConfig.OIDCToken = overrides.OIDCToken
+		if overrides.OIDCToken.ServiceAccountEmail == "" {
+			config.PushConfig.OIDCToken = nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestCloneSubscriptionAdmin(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	for _, topicID := range []string{"orders", "orders-dlq"} {
+		if err := CreateTopicAdmin(ctx, client, "p", topicID, "", nil, nil); err != nil {
+			t.Fatalf("CreateTopicAdmin(%s) error = %v", topicID, err)
+		}
+	}
+	source := SubscriptionConfig{
+		AckDeadline:       30,
+		RetentionDuration: "48h",
+		RetryPolicy:       &models.RetryPolicy{MinimumBackoff: "10s", MaximumBackoff: "5m0s"},
+		EnableOrdering:    true,
+		EnableExactlyOnce: true,
+		Filter:            `attributes.type = "order"`,
+		DeadLetterPolicy:  &DeadLetterPolicyInfo{DeadLetterTopic: "projects/p/topics/orders-dlq", MaxDeliveryAttempts: 5},
+		Labels:            map[string]string{"team": "billing"},
+		RetainAcked:       true,
+	}
+	if err := CreateSubscriptionWithConfig(ctx, client, "p", "orders", "orders-sub", source); err != nil {
+		t.Fatalf("CreateSubscriptionWithConfig() error = %v", err)
+	}
+
+	ackDeadline := 60
+	if err := CloneSubscriptionAdmin(ctx, client, "p", "orders-sub", "orders-sub-copy", SubscriptionUpdateParams{AckDeadline: &ackDeadline}); err != nil {
+		t.Fatalf("CloneSubscriptionAdmin() error = %v", err)
+	}
+
+	clone, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{Subscription: "projects/p/subscriptions/orders-sub-copy"})
+	if err != nil {
+		t.Fatalf("GetSubscription(clone) error = %v", err)
+	}
+	if clone.Topic != "projects/p/topics/orders" || clone.AckDeadlineSeconds != 60 || clone.Filter != source.Filter {
+		t.Errorf("clone topic/ack deadline/filter = %s/%d/%q, want orders/60/%q", clone.Topic, clone.AckDeadlineSeconds, clone.Filter, source.Filter)
+	}
+	if !clone.EnableMessageOrdering || !clone.EnableExactlyOnceDelivery || !clone.RetainAckedMessages {
+		t.Errorf("clone ordering/exactly-once/retain acked = %v/%v/%v, want all true", clone.EnableMessageOrdering, clone.EnableExactlyOnceDelivery, clone.RetainAckedMessages)
+	}
+	if clone.RetryPolicy == nil || clone.RetryPolicy.MinimumBackoff.AsDuration().String() != "10s" {
+		t.Errorf("clone retry policy = %v, want minimum backoff 10s", clone.RetryPolicy)
+	}
+	if clone.DeadLetterPolicy == nil || clone.DeadLetterPolicy.DeadLetterTopic != "projects/p/topics/orders-dlq" || clone.DeadLetterPolicy.MaxDeliveryAttempts != 5 {
+		t.Errorf("clone dead letter policy = %v, want orders-dlq after 5 attempts", clone.DeadLetterPolicy)
+	}
+	if clone.Labels["team"] != "billing" {
+		t.Errorf("clone labels = %v, want team=billing", clone.Labels)
+	}
+
+	err = CloneSubscriptionAdmin(ctx, client, "p", "orders-sub", "orders-sub-copy", SubscriptionUpdateParams{})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("CloneSubscriptionAdmin(existing ID) error = %v, want already exists", err)
+	}
+	if err := CloneSubscriptionAdmin(ctx, client, "p", "orders-sub", "projects/p/subscriptions/orders-sub", SubscriptionUpdateParams{}); err == nil {
+		t.Error("CloneSubscriptionAdmin(same ID) error = nil, want error")
+	}
+	if err := CloneSubscriptionAdmin(ctx, client, "p", "missing", "other", SubscriptionUpdateParams{}); err == nil {
+		t.Error("CloneSubscriptionAdmin(missing source) error = nil, want error")
+	}
+}
+
+func TestApplySubscriptionOverrides(t *testing.T) {
+	push := "push"
+	pull := "pull"
+	endpoint := "https://example.com/push"
+
+	config := SubscriptionConfig{PushConfig: &models.PushConfig{Endpoint: "https://old.example.com"}}
+	if err := applySubscriptionOverrides(&config, SubscriptionUpdateParams{SubscriptionType: &pull}); err != nil || config.PushConfig != nil {
+		t.Errorf("pull override: error = %v, push config = %v, want push config dropped", err, config.PushConfig)
+	}
+
+	if err := applySubscriptionOverrides(&config, SubscriptionUpdateParams{SubscriptionType: &push, PushEndpoint: &endpoint}); err != nil || config.PushConfig == nil || config.PushConfig.Endpoint != endpoint {
+		t.Errorf("push override: error = %v, push config = %v, want endpoint %s", err, config.PushConfig, endpoint)
+	}
+
+	pullConfig := SubscriptionConfig{}
+	if err := applySubscriptionOverrides(&pullConfig, SubscriptionUpdateParams{PushEndpoint: &endpoint}); err == nil {
+		t.Error("endpoint override on pull subscription error = nil, want error")
+	}
+
+	ttl := "48h"
+	never := ""
+	if err := applySubscriptionOverrides(&config, SubscriptionUpdateParams{ExpirationTTL: &ttl}); err != nil || config.ExpirationPolicy == nil || config.ExpirationPolicy.TTL != ttl {
+		t.Errorf("expiration override: error = %v, policy = %v, want TTL %s", err, config.ExpirationPolicy, ttl)
+	}
+	if err := applySubscriptionOverrides(&config, SubscriptionUpdateParams{ExpirationTTL: &never}); err != nil || config.ExpirationPolicy == nil || config.ExpirationPolicy.TTL != "" {
+		t.Errorf("never-expire override: error = %v, policy = %v, want an empty TTL", err, config.ExpirationPolicy)
+	}
+}
+
+func TestCloneSubscriptionAdmin_RetryPolicy(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	source := SubscriptionConfig{AckDeadline: 10, RetryPolicy: &models.RetryPolicy{MinimumBackoff: "10s", MaximumBackoff: "5m"}}
+	if err := CreateSubscriptionWithConfig(ctx, client, "p", "orders", "orders-sub", source); err != nil {
+		t.Fatalf("CreateSubscriptionWithConfig() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		cloneID  string
+		override *models.RetryPolicy
+		wantMin  string // empty when the clone has no retry policy
+		wantMax  string
+		wantErr  bool
+	}{
+		{name: "copies the source policy", cloneID: "copy", wantMin: "10s", wantMax: "5m0s"},
+		{name: "overrides the backoffs", cloneID: "copy-fast", override: &models.RetryPolicy{MinimumBackoff: "1s", MaximumBackoff: "30s"}, wantMin: "1s", wantMax: "30s"},
+		{name: "empty backoffs clear the policy", cloneID: "copy-immediate", override: &models.RetryPolicy{}},
+		{name: "invalid backoffs", cloneID: "copy-invalid", override: &models.RetryPolicy{MinimumBackoff: "1m", MaximumBackoff: "10s"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CloneSubscriptionAdmin(ctx, client, "p", "orders-sub", tt.cloneID, SubscriptionUpdateParams{RetryPolicy: tt.override})
+			if tt.wantErr {
+				if err == nil {
+					t.Error("CloneSubscriptionAdmin() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CloneSubscriptionAdmin() error = %v", err)
+			}
+			clone, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{Subscription: "projects/p/subscriptions/" + tt.cloneID})
+			if err != nil {
+				t.Fatalf("GetSubscription() error = %v", err)
+			}
+			if tt.wantMin == "" {
+				if clone.RetryPolicy != nil {
+					t.Errorf("clone retry policy = %v, want none", clone.RetryPolicy)
+				}
+				return
+			}
+			if clone.RetryPolicy == nil {
+				t.Fatal("clone retry policy = nil, want one")
+			}
+			if got := clone.RetryPolicy.MinimumBackoff.AsDuration().String(); got != tt.wantMin {
+				t.Errorf("clone minimum backoff = %s, want %s", got, tt.wantMin)
+			}
+			if got := clone.RetryPolicy.MaximumBackoff.AsDuration().String(); got != tt.wantMax {
+				t.Errorf("clone maximum backoff = %s, want %s", got, tt.wantMax)
+			}
+		})
+	}
+
+	// The override does not alias the caller's policy
+	override := &models.RetryPolicy{MinimumBackoff: "2s", MaximumBackoff: "20s"}
+	config := SubscriptionConfig{}
+	if err := applySubscriptionOverrides(&config, SubscriptionUpdateParams{RetryPolicy: override}); err != nil {
+		t.Fatalf("applySubscriptionOverrides() error = %v", err)
+	}
+	if config.RetryPolicy == override {
+		t.Error("applySubscriptionOverrides() kept the caller's retry policy pointer")
+	}
+}
+
+func TestCloneSubscriptionAdmin_ExpirationTTL(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	source := SubscriptionConfig{AckDeadline: 10, ExpirationPolicy: &models.ExpirationPolicy{TTL: "720h"}}
+	if err := CreateSubscriptionWithConfig(ctx, client, "p", "orders", "orders-sub", source); err != nil {
+		t.Fatalf("CreateSubscriptionWithConfig() error = %v", err)
+	}
+
+	ttl := "48h"
+	never := ""
+	invalid := "soon"
+	tests := []struct {
+		name     string
+		cloneID  string
+		override *string
+		wantTTL  string // empty for a policy that never expires
+		wantErr  bool
+	}{
+		{name: "copies the source TTL", cloneID: "copy", wantTTL: "720h0m0s"},
+		{name: "overrides the TTL", cloneID: "copy-48h", override: &ttl, wantTTL: "48h0m0s"},
+		{name: "never expires", cloneID: "copy-never", override: &never},
+		{name: "invalid TTL", cloneID: "copy-invalid", override: &invalid, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CloneSubscriptionAdmin(ctx, client, "p", "orders-sub", tt.cloneID, SubscriptionUpdateParams{ExpirationTTL: tt.override})
+			if tt.wantErr {
+				if err == nil {
+					t.Error("CloneSubscriptionAdmin() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CloneSubscriptionAdmin() error = %v", err)
+			}
+			clone, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{Subscription: "projects/p/subscriptions/" + tt.cloneID})
+			if err != nil {
+				t.Fatalf("GetSubscription() error = %v", err)
+			}
+			if clone.ExpirationPolicy == nil {
+				t.Fatal("clone expiration policy = nil, want one")
+			}
+			got := ""
+			if clone.ExpirationPolicy.Ttl != nil {
+				got = clone.ExpirationPolicy.Ttl.AsDuration().String()
+			}
+			if got != tt.wantTTL {
+				t.Errorf("clone expiration TTL = %q, want %q", got, tt.wantTTL)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"pubsub-gui/internal/models"
+)
+
+// Cloud Storage subscription limits enforced by Pub/Sub
+const (
+	cloudStorageMinDuration = time.Minute
+	cloudStorageMaxDuration = 10 * time.Minute
+	cloudStorageMinBytes    = 1000
+	cloudStorageMaxBytes    = 10 * 1024 * 1024 * 1024
+)
+
+// bucketNamePattern matches GCS bucket names: lowercase letters, digits, '-', '_' and '.',
+// starting and ending with a letter or digit
+var bucketNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,220}[a-z0-9]$`)
+
+// buildCloudStorageConfig validates config and converts it to its proto form
+func buildCloudStorageConfig(config *models.CloudStorageConfig) (*pubsubpb.CloudStorageConfig, error) {
+	bucket := config.Bucket
+	if strings.HasPrefix(bucket, "gs://") {
+		return nil, fmt.Errorf("invalid Cloud Storage bucket %q: use the bucket name without the gs:// prefix", bucket)
+	}
+	if !bucketNamePattern.MatchString(bucket) || strings.Contains(bucket, "..") {
+		return nil, fmt.Errorf("invalid Cloud Storage bucket %q: must be 3-222 lowercase letters, digits, '-', '_' or '.'", bucket)
+	}
+	if strings.HasSuffix(config.FilenameSuffix, "/") {
+		return nil, fmt.Errorf("invalid filename suffix %q: must not end in \"/\"", config.FilenameSuffix)
+	}
+
+	result := &pubsubpb.CloudStorageConfig{
+		Bucket:         bucket,
+		FilenamePrefix: config.FilenamePrefix,
+		FilenameSuffix: config.FilenameSuffix,
+	}
+
+	if config.MaxDuration != "" {
+		duration, err := time.ParseDuration(config.MaxDuration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max duration format: %w", err)
+		}
+		if duration < cloudStorageMinDuration || duration > cloudStorageMaxDuration {
+			return nil, fmt.Errorf("invalid max duration %s: must be between %s and %s", duration, cloudStorageMinDuration, cloudStorageMaxDuration)
+		}
+		result.MaxDuration = durationpb.New(duration)
+	}
+
+	if config.MaxBytes != 0 {
+		if config.MaxBytes < cloudStorageMinBytes || config.MaxBytes > cloudStorageMaxBytes {
+			return nil, fmt.Errorf("invalid max bytes %d: must be between %d and %d", config.MaxBytes, cloudStorageMinBytes, int64(cloudStorageMaxBytes))
+		}
+		result.MaxBytes = config.MaxBytes
+	}
+
+	switch config.Format {
+	case "", "text":
+		result.OutputFormat = &pubsubpb.CloudStorageConfig_TextConfig_{TextConfig: &pubsubpb.CloudStorageConfig_TextConfig{}}
+	case "avro":
+		result.OutputFormat = &pubsubpb.CloudStorageConfig_AvroConfig_{AvroConfig: &pubsubpb.CloudStorageConfig_AvroConfig{}}
+	default:
+		return nil, fmt.Errorf("invalid Cloud Storage format %q: must be text or avro", config.Format)
+	}
+
+	return result, nil
+}
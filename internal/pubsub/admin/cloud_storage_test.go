@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/models"
+)
+
+func TestBuildCloudStorageConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  models.CloudStorageConfig
+		wantErr bool
+	}{
+		{"bucket only", models.CloudStorageConfig{Bucket: "my-bucket"}, false},
+		{"all settings", models.CloudStorageConfig{Bucket: "my_bucket.example", FilenamePrefix: "logs/", FilenameSuffix: ".json", MaxDuration: "5m", MaxBytes: 1 << 20, Format: "avro"}, false},
+		{"gs prefix", models.CloudStorageConfig{Bucket: "gs://my-bucket"}, true},
+		{"uppercase bucket", models.CloudStorageConfig{Bucket: "MyBucket"}, true},
+		{"bucket too short", models.CloudStorageConfig{Bucket: "ab"}, true},
+		{"consecutive dots", models.CloudStorageConfig{Bucket: "my..bucket"}, true},
+		{"suffix ends in slash", models.CloudStorageConfig{Bucket: "my-bucket", FilenameSuffix: "out/"}, true},
+		{"duration too short", models.CloudStorageConfig{Bucket: "my-bucket", MaxDuration: "30s"}, true},
+		{"duration too long", models.CloudStorageConfig{Bucket: "my-bucket", MaxDuration: "11m"}, true},
+		{"invalid duration", models.CloudStorageConfig{Bucket: "my-bucket", MaxDuration: "five"}, true},
+		{"bytes too small", models.CloudStorageConfig{Bucket: "my-bucket", MaxBytes: 999}, true},
+		{"unknown format", models.CloudStorageConfig{Bucket: "my-bucket", Format: "parquet"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := buildCloudStorageConfig(&tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("buildCloudStorageConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildCloudStorageConfig_Fields(t *testing.T) {
+	got, err := buildCloudStorageConfig(&models.CloudStorageConfig{Bucket: "my-bucket", FilenameSuffix: ".txt", MaxDuration: "2m", MaxBytes: 5000})
+	if err != nil {
+		t.Fatalf("buildCloudStorageConfig() error = %v", err)
+	}
+	if got.MaxDuration.AsDuration() != 2*time.Minute {
+		t.Errorf("MaxDuration = %v, want 2m", got.MaxDuration.AsDuration())
+	}
+	if got.MaxBytes != 5000 {
+		t.Errorf("MaxBytes = %d, want 5000", got.MaxBytes)
+	}
+	if got.GetTextConfig() == nil {
+		t.Error("OutputFormat is not text, want text by default")
+	}
+}
+
+func TestApplySubscriptionType_CloudStorage(t *testing.T) {
+	var info SubscriptionInfo
+	applySubscriptionType(&info, &pubsubpb.Subscription{
+		CloudStorageConfig: &pubsubpb.CloudStorageConfig{Bucket: "my-bucket"},
+	})
+	if info.SubscriptionType != "cloudstorage" {
+		t.Errorf("SubscriptionType = %q, want cloudstorage", info.SubscriptionType)
+	}
+	if info.CloudStorageBucket != "my-bucket" {
+		t.Errorf("CloudStorageBucket = %q, want my-bucket", info.CloudStorageBucket)
+	}
+}
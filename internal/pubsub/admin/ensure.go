@@ -0,0 +1,140 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EnsureResult reports what EnsureTopicAndSubscriptionAdmin created and what already existed
+type EnsureResult struct {
+	TopicID             string   `json:"topicId"`
+	SubscriptionID      string   `json:"subscriptionId"`
+	TopicCreated        bool     `json:"topicCreated"`
+	SubscriptionCreated bool     `json:"subscriptionCreated"`
+	Warnings            []string `json:"warnings,omitempty"` // Differences between an existing subscription and the requested config
+}
+
+// EnsureTopicAndSubscriptionAdmin creates a topic and a subscription on it if they do not exist yet
+// An existing subscription is left untouched; differences from config are reported as warnings.
+func EnsureTopicAndSubscriptionAdmin(ctx context.Context, client *pubsub.Client, projectID, topicID, subID string, config SubscriptionConfig) (*EnsureResult, error) {
+	shortTopic, topicName := NormalizeName(projectID, "topic", topicID)
+	shortSub, subName := NormalizeName(projectID, "subscription", subID)
+	result := &EnsureResult{TopicID: shortTopic, SubscriptionID: shortSub}
+
+	topicCreated, err := ensureTopic(ctx, client, projectID, topicName)
+	if err != nil {
+		return nil, err
+	}
+	result.TopicCreated = topicCreated
+
+	existing, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{Subscription: subName})
+	switch {
+	case err == nil:
+		result.Warnings = subscriptionConfigMismatches(existing, projectID, topicName, config)
+		return result, nil
+	case status.Code(err) != codes.NotFound:
+		return nil, fmt.Errorf("failed to check subscription %s: %w", subName, err)
+	}
+
+	if err := CreateSubscriptionWithConfig(ctx, client, projectID, topicName, subName, config); err != nil {
+		// Created concurrently by someone else between the check and the create
+		if status.Code(err) == codes.AlreadyExists {
+			return result, nil
+		}
+		return nil, err
+	}
+	result.SubscriptionCreated = true
+
+	return result, nil
+}
+
+// ensureTopic creates a topic unless it exists, returning true when it was created
+func ensureTopic(ctx context.Context, client *pubsub.Client, projectID, topicName string) (bool, error) {
+	if topicExistence.exists(client, topicName) {
+		return false, nil
+	}
+
+	_, err := client.TopicAdminClient.GetTopic(ctx, &pubsubpb.GetTopicRequest{Topic: topicName})
+	if err == nil {
+		topicExistence.mark(client, topicName)
+		return false, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return false, fmt.Errorf("failed to check topic %s: %w", topicName, err)
+	}
+
+	if err := CreateTopicAdmin(ctx, client, projectID, topicName, "", nil, nil); err != nil {
+		if status.Code(err) != codes.AlreadyExists {
+			return false, err
+		}
+		topicExistence.mark(client, topicName)
+		return false, nil
+	}
+	topicExistence.mark(client, topicName)
+	return true, nil
+}
+
+// subscriptionConfigMismatches lists the requested settings an existing subscription does not have
+// Only settings that were explicitly requested are compared.
+func subscriptionConfigMismatches(sub *pubsubpb.Subscription, projectID, topicName string, config SubscriptionConfig) []string {
+	var warnings []string
+	if sub.Topic != topicName {
+		warnings = append(warnings, fmt.Sprintf("subscription is attached to %s, not %s", sub.Topic, topicName))
+	}
+	if config.AckDeadline > 0 && int(sub.AckDeadlineSeconds) != config.AckDeadline {
+		warnings = append(warnings, fmt.Sprintf("ack deadline is %ds, requested %ds", sub.AckDeadlineSeconds, config.AckDeadline))
+	}
+	if config.Filter != "" && sub.Filter != config.Filter {
+		warnings = append(warnings, fmt.Sprintf("filter is %q, requested %q", sub.Filter, config.Filter))
+	}
+	if config.EnableOrdering && !sub.EnableMessageOrdering {
+		warnings = append(warnings, "message ordering is disabled, requested enabled")
+	}
+	if config.EnableExactlyOnce && !sub.EnableExactlyOnceDelivery {
+		warnings = append(warnings, "exactly-once delivery is disabled, requested enabled")
+	}
+	if config.PushConfig != nil && config.PushConfig.Endpoint != "" {
+		endpoint := ""
+		if sub.PushConfig != nil {
+			endpoint = sub.PushConfig.PushEndpoint
+		}
+		if endpoint != config.PushConfig.Endpoint {
+			warnings = append(warnings, fmt.Sprintf("push endpoint is %q, requested %q", endpoint, config.PushConfig.Endpoint))
+		}
+	}
+	if config.BigQueryConfig != nil && config.BigQueryConfig.Table != "" {
+		table := ""
+		if sub.BigqueryConfig != nil {
+			table = sub.BigqueryConfig.Table
+		}
+		if table != config.BigQueryConfig.Table {
+			warnings = append(warnings, fmt.Sprintf("BigQuery table is %q, requested %q", table, config.BigQueryConfig.Table))
+		}
+	}
+	if config.CloudStorageConfig != nil && config.CloudStorageConfig.Bucket != "" {
+		bucket := ""
+		if sub.CloudStorageConfig != nil {
+			bucket = sub.CloudStorageConfig.Bucket
+		}
+		if bucket != config.CloudStorageConfig.Bucket {
+			warnings = append(warnings, fmt.Sprintf("Cloud Storage bucket is %q, requested %q", bucket, config.CloudStorageConfig.Bucket))
+		}
+	}
+	if config.DeadLetterPolicy != nil && config.DeadLetterPolicy.DeadLetterTopic != "" {
+		_, requested := NormalizeName(projectID, "topic", config.DeadLetterPolicy.DeadLetterTopic)
+		deadLetterTopic := ""
+		if sub.DeadLetterPolicy != nil {
+			deadLetterTopic = sub.DeadLetterPolicy.DeadLetterTopic
+		}
+		if deadLetterTopic != requested {
+			warnings = append(warnings, fmt.Sprintf("dead letter topic is %q, requested %q", deadLetterTopic, requested))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"testing"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/models"
+)
+
+func TestSubscriptionConfigMismatches(t *testing.T) {
+	const topic = "projects/my-project/topics/orders"
+	existing := &pubsubpb.Subscription{
+		Name:               "projects/my-project/subscriptions/orders-sub",
+		Topic:              topic,
+		AckDeadlineSeconds: 10,
+		DeadLetterPolicy:   &pubsubpb.DeadLetterPolicy{DeadLetterTopic: "projects/my-project/topics/orders-dlq"},
+	}
+
+	tests := []struct {
+		name      string
+		topicName string
+		config    SubscriptionConfig
+		want      int
+	}{
+		{"matching", topic, SubscriptionConfig{AckDeadline: 10}, 0},
+		{"unset settings are not compared", topic, SubscriptionConfig{}, 0},
+		{"short dead letter topic matches", topic, SubscriptionConfig{DeadLetterPolicy: &DeadLetterPolicyInfo{DeadLetterTopic: "orders-dlq"}}, 0},
+		{"different topic", "projects/my-project/topics/payments", SubscriptionConfig{}, 1},
+		{"different ack deadline", topic, SubscriptionConfig{AckDeadline: 30}, 1},
+		{"ordering and filter", topic, SubscriptionConfig{EnableOrdering: true, Filter: `attributes.type = "a"`}, 2},
+		{"push endpoint", topic, SubscriptionConfig{PushConfig: &models.PushConfig{Endpoint: "https://example.com/push"}}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := subscriptionConfigMismatches(existing, "my-project", tt.topicName, tt.config)
+			if len(got) != tt.want {
+				t.Errorf("subscriptionConfigMismatches() = %v, want %d warnings", got, tt.want)
+			}
+		})
+	}
+}
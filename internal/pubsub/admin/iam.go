@@ -0,0 +1,168 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"cloud.google.com/go/pubsub/v2"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/models"
+)
+
+// conditionalPolicyVersion is the IAM policy version required for bindings with conditions
+const conditionalPolicyVersion = 3
+
+// IAMPolicy is the IAM policy of a topic or subscription
+type IAMPolicy struct {
+	Version  int32        `json:"version"`
+	Bindings []IAMBinding `json:"bindings"`
+	Etag     string       `json:"etag"` // Base64 etag of the policy as read; must be sent back unchanged when setting it
+}
+
+// IAMBinding grants a role to a list of members
+type IAMBinding struct {
+	Role      string        `json:"role"`    // e.g. "roles/pubsub.publisher"
+	Members   []string      `json:"members"` // e.g. "user:ada@example.com", "serviceAccount:..."
+	Condition *IAMCondition `json:"condition,omitempty"`
+}
+
+// IAMCondition restricts a binding with a CEL expression
+type IAMCondition struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Expression  string `json:"expression"`
+}
+
+// GetTopicIAMPolicy returns the IAM policy of a topic
+func GetTopicIAMPolicy(ctx context.Context, client *pubsub.Client, projectID, topicID string) (IAMPolicy, error) {
+	policy, err := client.TopicAdminClient.GetIamPolicy(ctx, getIAMPolicyRequest(iamResource(projectID, "topic", topicID)))
+	if err != nil {
+		return IAMPolicy{}, iamError("get IAM policy of topic", topicID, err)
+	}
+	return toIAMPolicy(policy), nil
+}
+
+// SetTopicIAMPolicy replaces the IAM policy of a topic
+// The etag read with GetTopicIAMPolicy is required; if the policy changed since, models.ErrIAMPolicyChanged is returned.
+func SetTopicIAMPolicy(ctx context.Context, client *pubsub.Client, projectID, topicID string, policy IAMPolicy) (IAMPolicy, error) {
+	req, err := setIAMPolicyRequest(iamResource(projectID, "topic", topicID), policy)
+	if err != nil {
+		return IAMPolicy{}, err
+	}
+	updated, err := client.TopicAdminClient.SetIamPolicy(ctx, req)
+	if err != nil {
+		return IAMPolicy{}, iamError("set IAM policy of topic", topicID, err)
+	}
+	return toIAMPolicy(updated), nil
+}
+
+// GetSubscriptionIAMPolicy returns the IAM policy of a subscription
+func GetSubscriptionIAMPolicy(ctx context.Context, client *pubsub.Client, projectID, subID string) (IAMPolicy, error) {
+	policy, err := client.SubscriptionAdminClient.GetIamPolicy(ctx, getIAMPolicyRequest(iamResource(projectID, "subscription", subID)))
+	if err != nil {
+		return IAMPolicy{}, iamError("get IAM policy of subscription", subID, err)
+	}
+	return toIAMPolicy(policy), nil
+}
+
+// SetSubscriptionIAMPolicy replaces the IAM policy of a subscription
+// The etag read with GetSubscriptionIAMPolicy is required; if the policy changed since, models.ErrIAMPolicyChanged is returned.
+func SetSubscriptionIAMPolicy(ctx context.Context, client *pubsub.Client, projectID, subID string, policy IAMPolicy) (IAMPolicy, error) {
+	req, err := setIAMPolicyRequest(iamResource(projectID, "subscription", subID), policy)
+	if err != nil {
+		return IAMPolicy{}, err
+	}
+	updated, err := client.SubscriptionAdminClient.SetIamPolicy(ctx, req)
+	if err != nil {
+		return IAMPolicy{}, iamError("set IAM policy of subscription", subID, err)
+	}
+	return toIAMPolicy(updated), nil
+}
+
+// iamResource returns the full resource name of a topic or subscription
+func iamResource(projectID, resourceType, name string) string {
+	_, full := NormalizeName(projectID, resourceType, name)
+	return full
+}
+
+// getIAMPolicyRequest asks for version 3 so conditional bindings are returned rather than rejected
+func getIAMPolicyRequest(resource string) *iampb.GetIamPolicyRequest {
+	return &iampb.GetIamPolicyRequest{
+		Resource: resource,
+		Options:  &iampb.GetPolicyOptions{RequestedPolicyVersion: conditionalPolicyVersion},
+	}
+}
+
+// setIAMPolicyRequest validates policy and converts it to a SetIamPolicy request
+func setIAMPolicyRequest(resource string, policy IAMPolicy) (*iampb.SetIamPolicyRequest, error) {
+	if policy.Etag == "" {
+		return nil, fmt.Errorf("IAM policy etag is required: load the current policy before editing it")
+	}
+	etag, err := base64.StdEncoding.DecodeString(policy.Etag)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IAM policy etag: %w", err)
+	}
+
+	pb := &iampb.Policy{Version: policy.Version, Etag: etag}
+	for _, binding := range policy.Bindings {
+		if !strings.HasPrefix(binding.Role, "roles/") && !strings.HasPrefix(binding.Role, "projects/") && !strings.HasPrefix(binding.Role, "organizations/") {
+			return nil, fmt.Errorf("invalid role %q: must start with roles/ (or be a custom role name)", binding.Role)
+		}
+		if len(binding.Members) == 0 {
+			return nil, fmt.Errorf("binding for %s has no members", binding.Role)
+		}
+		pbBinding := &iampb.Binding{Role: binding.Role, Members: binding.Members}
+		if binding.Condition != nil {
+			if strings.TrimSpace(binding.Condition.Expression) == "" {
+				return nil, fmt.Errorf("condition of binding for %s has no expression", binding.Role)
+			}
+			pbBinding.Condition = &expr.Expr{
+				Title:       binding.Condition.Title,
+				Description: binding.Condition.Description,
+				Expression:  binding.Condition.Expression,
+			}
+			pb.Version = conditionalPolicyVersion
+		}
+		pb.Bindings = append(pb.Bindings, pbBinding)
+	}
+	return &iampb.SetIamPolicyRequest{Resource: resource, Policy: pb}, nil
+}
+
+// toIAMPolicy converts an IAM policy proto
+func toIAMPolicy(policy *iampb.Policy) IAMPolicy {
+	result := IAMPolicy{
+		Version:  policy.GetVersion(),
+		Bindings: []IAMBinding{},
+		Etag:     base64.StdEncoding.EncodeToString(policy.GetEtag()),
+	}
+	for _, binding := range policy.GetBindings() {
+		b := IAMBinding{Role: binding.Role, Members: binding.Members}
+		if c := binding.GetCondition(); c != nil {
+			b.Condition = &IAMCondition{Title: c.Title, Description: c.Description, Expression: c.Expression}
+		}
+		result.Bindings = append(result.Bindings, b)
+	}
+	return result
+}
+
+// iamError maps IAM RPC errors: emulators answer Unimplemented, and a stale etag fails with Aborted
+// (or FailedPrecondition mentioning the etag)
+func iamError(action, resourceID string, err error) error {
+	st, _ := status.FromError(err)
+	switch {
+	case st.Code() == codes.Unimplemented:
+		return models.ErrIAMNotSupported
+	case st.Code() == codes.Aborted,
+		st.Code() == codes.FailedPrecondition && strings.Contains(strings.ToLower(st.Message()), "etag"):
+		return fmt.Errorf("%w (%s)", models.ErrIAMPolicyChanged, resourceID)
+	default:
+		return fmt.Errorf("failed to %s %s: %w", action, resourceID, err)
+	}
+}
@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestIAMPolicyRoundTrip(t *testing.T) {
+	pb := &iampb.Policy{
+		Version: 3,
+		Etag:    []byte{0x01, 0x02},
+		Bindings: []*iampb.Binding{
+			{Role: "roles/pubsub.publisher", Members: []string{"user:ada@example.com"}},
+			{Role: "roles/pubsub.subscriber", Members: []string{"group:ops@example.com"}, Condition: &expr.Expr{Title: "t", Expression: "request.time < timestamp('2030-01-01T00:00:00Z')"}},
+		},
+	}
+
+	policy := toIAMPolicy(pb)
+	if policy.Etag != "AQI=" || len(policy.Bindings) != 2 || policy.Bindings[1].Condition == nil {
+		t.Fatalf("toIAMPolicy() = %+v", policy)
+	}
+
+	req, err := setIAMPolicyRequest("projects/p/topics/t", policy)
+	if err != nil {
+		t.Fatalf("setIAMPolicyRequest() error = %v", err)
+	}
+	if string(req.Policy.Etag) != string(pb.Etag) || req.Policy.Version != 3 || req.Policy.Bindings[1].Condition.Expression != pb.Bindings[1].Condition.Expression {
+		t.Errorf("setIAMPolicyRequest() policy = %v, want round trip of %v", req.Policy, pb)
+	}
+}
+
+func TestSetIAMPolicyRequest_Invalid(t *testing.T) {
+	valid := IAMBinding{Role: "roles/pubsub.viewer", Members: []string{"user:a@example.com"}}
+	tests := []struct {
+		name   string
+		policy IAMPolicy
+	}{
+		{"missing etag", IAMPolicy{Bindings: []IAMBinding{valid}}},
+		{"bad etag", IAMPolicy{Etag: "%%%", Bindings: []IAMBinding{valid}}},
+		{"bad role", IAMPolicy{Etag: "AQI=", Bindings: []IAMBinding{{Role: "pubsub.viewer", Members: valid.Members}}}},
+		{"no members", IAMPolicy{Etag: "AQI=", Bindings: []IAMBinding{{Role: valid.Role}}}},
+		{"empty condition", IAMPolicy{Etag: "AQI=", Bindings: []IAMBinding{{Role: valid.Role, Members: valid.Members, Condition: &IAMCondition{Title: "x"}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := setIAMPolicyRequest("projects/p/topics/t", tt.policy); err == nil {
+				t.Error("setIAMPolicyRequest() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestIAMError(t *testing.T) {
+	if err := iamError("set", "t", status.Error(codes.Aborted, "concurrent policy changes")); !errors.Is(err, models.ErrIAMPolicyChanged) {
+		t.Errorf("iamError(Aborted) = %v, want ErrIAMPolicyChanged", err)
+	}
+	if err := iamError("set", "t", status.Error(codes.FailedPrecondition, "etag mismatch")); !errors.Is(err, models.ErrIAMPolicyChanged) {
+		t.Errorf("iamError(FailedPrecondition etag) = %v, want ErrIAMPolicyChanged", err)
+	}
+	if err := iamError("set", "t", status.Error(codes.PermissionDenied, "denied")); errors.Is(err, models.ErrIAMPolicyChanged) {
+		t.Errorf("iamError(PermissionDenied) = %v, want a plain error", err)
+	}
+}
+
+func TestGetTopicIAMPolicy_Emulator(t *testing.T) {
+	client, _ := pubsubtest.NewClient(t)
+
+	if _, err := GetTopicIAMPolicy(context.Background(), client, "p", "t"); !errors.Is(err, models.ErrIAMNotSupported) {
+		t.Errorf("GetTopicIAMPolicy() error = %v, want ErrIAMNotSupported", err)
+	}
+}
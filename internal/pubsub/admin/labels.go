@@ -0,0 +1,61 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"fmt"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// GCP label limits (https://cloud.google.com/resource-manager/docs/labels-overview#requirements)
+const (
+	maxLabels      = 64
+	maxLabelLength = 63
+)
+
+// ValidateLabels checks label keys and values against GCP's constraints:
+// at most 64 labels; keys of 1-63 characters starting with a lowercase letter; values of 0-63 characters;
+// both limited to lowercase letters, digits, underscores and dashes
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > maxLabels {
+		return fmt.Errorf("too many labels: %d (maximum %d)", len(labels), maxLabels)
+	}
+
+	// Sorted so the reported error is deterministic
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if key == "" {
+			return fmt.Errorf("invalid label key: must not be empty")
+		}
+		if first, _ := utf8.DecodeRuneInString(key); !unicode.IsLower(first) {
+			return fmt.Errorf("invalid label key %q: must start with a lowercase letter", key)
+		}
+		if err := validateLabelPart(key); err != nil {
+			return fmt.Errorf("invalid label key %q: %w", key, err)
+		}
+		if err := validateLabelPart(labels[key]); err != nil {
+			return fmt.Errorf("invalid value for label %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// validateLabelPart checks the length and characters of a label key or value
+func validateLabelPart(s string) error {
+	if n := utf8.RuneCountInString(s); n > maxLabelLength {
+		return fmt.Errorf("%d characters exceeds the maximum of %d", n, maxLabelLength)
+	}
+	for _, r := range s {
+		if unicode.IsLower(r) || unicode.IsDigit(r) || r == '_' || r == '-' {
+			continue
+		}
+		return fmt.Errorf("character %q is not allowed (use lowercase letters, digits, '_' or '-')", r)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateLabels(t *testing.T) {
+	tooMany := make(map[string]string)
+	for i := 0; i <= maxLabels; i++ {
+		tooMany[fmt.Sprintf("k%d", i)] = "v"
+	}
+
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		wantErr bool
+	}{
+		{"nil", nil, false},
+		{"valid", map[string]string{"team": "payments", "cost-center": "cc_42", "env": ""}, false},
+		{"international lowercase", map[string]string{"équipe": "données"}, false},
+		{"uppercase key", map[string]string{"Team": "a"}, true},
+		{"key starts with digit", map[string]string{"1team": "a"}, true},
+		{"empty key", map[string]string{"": "a"}, true},
+		{"uppercase value", map[string]string{"team": "Payments"}, true},
+		{"invalid character", map[string]string{"team": "a.b"}, true},
+		{"key too long", map[string]string{"k" + strings.Repeat("a", maxLabelLength): "v"}, true},
+		{"value too long", map[string]string{"k": strings.Repeat("a", maxLabelLength+1)}, true},
+		{"too many labels", tooMany, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLabels(tt.labels)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLabels() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
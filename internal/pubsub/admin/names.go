@@ -0,0 +1,44 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"strings"
+
+	"pubsub-gui/internal/models"
+)
+
+// resourceCollections maps resource types to their collection segment in resource names
+var resourceCollections = map[string]string{
+	"topic":        "topics",
+	"subscription": "subscriptions",
+	"snapshot":     "snapshots",
+	"schema":       "schemas",
+}
+
+// NormalizeName returns the short ID and fully-qualified name of a resource
+// name may be a short ID ("orders") or a full path ("projects/p/topics/orders"); a full path keeps its project.
+// resourceType is "topic", "subscription", "snapshot" or "schema"; an unknown type returns empty strings.
+func NormalizeName(projectID, resourceType, name string) (short, full string) {
+	collection, ok := resourceCollections[resourceType]
+	if !ok {
+		return "", ""
+	}
+
+	if strings.HasPrefix(name, "projects/") {
+		// Full path: projects/{project}/{collection}/{id}
+		parts := strings.SplitN(name, "/", 4)
+		if len(parts) == 4 && parts[2] == collection && parts[3] != "" {
+			return parts[3], name
+		}
+		// Not a name of this resource type: keep it as-is so the API reports the problem
+		return extractDisplayName(name), name
+	}
+
+	return name, "projects/" + projectID + "/" + collection + "/" + name
+}
+
+// validateResourceID checks the ID of a resource about to be created against the Pub/Sub naming rules
+func validateResourceID(projectID, resourceType, name string) error {
+	short, _ := NormalizeName(projectID, resourceType, name)
+	return models.ValidateResourceName(short)
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		input        string
+		wantShort    string
+		wantFull     string
+	}{
+		{"short topic", "topic", "orders", "orders", "projects/my-project/topics/orders"},
+		{"full topic", "topic", "projects/my-project/topics/orders", "orders", "projects/my-project/topics/orders"},
+		{"full topic keeps its project", "topic", "projects/other/topics/orders", "orders", "projects/other/topics/orders"},
+		{"short subscription", "subscription", "orders-sub", "orders-sub", "projects/my-project/subscriptions/orders-sub"},
+		{"full subscription", "subscription", "projects/my-project/subscriptions/orders-sub", "orders-sub", "projects/my-project/subscriptions/orders-sub"},
+		{"short snapshot", "snapshot", "snap", "snap", "projects/my-project/snapshots/snap"},
+		{"short schema", "schema", "order-schema", "order-schema", "projects/my-project/schemas/order-schema"},
+		{"mismatched collection kept as-is", "subscription", "projects/my-project/topics/orders", "orders", "projects/my-project/topics/orders"},
+		{"unknown type", "queue", "orders", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			short, full := NormalizeName("my-project", tt.resourceType, tt.input)
+			if short != tt.wantShort || full != tt.wantFull {
+				t.Errorf("NormalizeName() = (%q, %q), want (%q, %q)", short, full, tt.wantShort, tt.wantFull)
+			}
+		})
+	}
+}
+
+func TestCreateWithConfig_InvalidNames(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "orders")
+
+	if err := CreateTopicWithConfig(ctx, client, "p", "goog-orders", models.TopicTemplateConfig{}); !errors.Is(err, models.ErrInvalidResourceName) {
+		t.Errorf("CreateTopicWithConfig(goog-orders) error = %v, want ErrInvalidResourceName", err)
+	}
+	if err := CreateSubscriptionWithConfig(ctx, client, "p", "orders", "1-sub", SubscriptionConfig{}); !errors.Is(err, models.ErrInvalidResourceName) {
+		t.Errorf("CreateSubscriptionWithConfig(1-sub) error = %v, want ErrInvalidResourceName", err)
+	}
+	// Full resource names are checked by their ID
+	if err := CreateSubscriptionWithConfig(ctx, client, "p", "orders", "projects/p/subscriptions/orders-sub", SubscriptionConfig{}); err != nil {
+		t.Errorf("CreateSubscriptionWithConfig(full name) error = %v", err)
+	}
+}
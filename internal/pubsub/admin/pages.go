@@ -0,0 +1,85 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"context"
+
+	"cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"google.golang.org/api/iterator"
+)
+
+// Page sizes accepted by the paginated list functions (the API returns at most 1000 items per page)
+const (
+	DefaultListPageSize = 100
+	MaxListPageSize     = 1000
+)
+
+// TopicsPage is one page of topics
+type TopicsPage struct {
+	Topics        []TopicInfo `json:"topics"`
+	NextPageToken string      `json:"nextPageToken,omitempty"` // Empty on the last page
+}
+
+// SubscriptionsPage is one page of subscriptions
+type SubscriptionsPage struct {
+	Subscriptions []SubscriptionInfo `json:"subscriptions"`
+	NextPageToken string             `json:"nextPageToken,omitempty"` // Empty on the last page
+}
+
+// ListTopicsPage lists one page of topics, starting at pageToken ("" for the first page)
+// pageSize <= 0 uses DefaultListPageSize; larger sizes are capped at MaxListPageSize.
+func ListTopicsPage(ctx context.Context, client *pubsub.Client, projectID string, pageSize int, pageToken string) (TopicsPage, error) {
+	if err := ctx.Err(); err != nil {
+		return TopicsPage{}, err
+	}
+
+	it := client.TopicAdminClient.ListTopics(ctx, &pubsubpb.ListTopicsRequest{
+		Project: "projects/" + projectID,
+	})
+	var topics []*pubsubpb.Topic
+	nextPageToken, err := iterator.NewPager(it, normalizePageSize(pageSize), pageToken).NextPage(&topics)
+	if err != nil {
+		return TopicsPage{}, err
+	}
+
+	page := TopicsPage{Topics: make([]TopicInfo, 0, len(topics)), NextPageToken: nextPageToken}
+	for _, topic := range topics {
+		page.Topics = append(page.Topics, topicInfoFromProto(topic, extractDisplayName(topic.Name)))
+	}
+	return page, nil
+}
+
+// ListSubscriptionsPage lists one page of subscriptions, starting at pageToken ("" for the first page)
+// pageSize <= 0 uses DefaultListPageSize; larger sizes are capped at MaxListPageSize.
+func ListSubscriptionsPage(ctx context.Context, client *pubsub.Client, projectID string, pageSize int, pageToken string) (SubscriptionsPage, error) {
+	if err := ctx.Err(); err != nil {
+		return SubscriptionsPage{}, err
+	}
+
+	it := client.SubscriptionAdminClient.ListSubscriptions(ctx, &pubsubpb.ListSubscriptionsRequest{
+		Project: "projects/" + projectID,
+	})
+	var subscriptions []*pubsubpb.Subscription
+	nextPageToken, err := iterator.NewPager(it, normalizePageSize(pageSize), pageToken).NextPage(&subscriptions)
+	if err != nil {
+		return SubscriptionsPage{}, err
+	}
+
+	page := SubscriptionsPage{Subscriptions: make([]SubscriptionInfo, 0, len(subscriptions)), NextPageToken: nextPageToken}
+	for _, sub := range subscriptions {
+		page.Subscriptions = append(page.Subscriptions, subscriptionInfoFromProto(sub, extractDisplayName(sub.Name)))
+	}
+	return page, nil
+}
+
+// normalizePageSize applies the default and maximum page size
+func normalizePageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultListPageSize
+	}
+	if pageSize > MaxListPageSize {
+		return MaxListPageSize
+	}
+	return pageSize
+}
@@ -0,0 +1,82 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestListTopicsPage(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	for i := range 5 {
+		if err := CreateTopicAdmin(ctx, client, "p", fmt.Sprintf("topic-%d", i), "", nil, nil); err != nil {
+			t.Fatalf("CreateTopicAdmin() error = %v", err)
+		}
+	}
+
+	seen := make(map[string]bool)
+	token, pages := "", 0
+	for {
+		page, err := ListTopicsPage(ctx, client, "p", 2, token)
+		if err != nil {
+			t.Fatalf("ListTopicsPage() error = %v", err)
+		}
+		pages++
+		if len(page.Topics) > 2 {
+			t.Errorf("page %d has %d topics, want at most 2", pages, len(page.Topics))
+		}
+		for _, topic := range page.Topics {
+			seen[topic.DisplayName] = true
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	if len(seen) != 5 || pages != 3 {
+		t.Errorf("listed %d topics over %d pages, want 5 over 3", len(seen), pages)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := ListTopicsPage(cancelled, client, "p", 2, ""); err == nil {
+		t.Error("ListTopicsPage(cancelled context) error = nil, want error")
+	}
+}
+
+func TestListSubscriptionsPage(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	for i := range 3 {
+		if err := CreateSubscriptionAdmin(ctx, client, "p", "orders", fmt.Sprintf("sub-%d", i), 0); err != nil {
+			t.Fatalf("CreateSubscriptionAdmin() error = %v", err)
+		}
+	}
+
+	page, err := ListSubscriptionsPage(ctx, client, "p", 0, "")
+	if err != nil {
+		t.Fatalf("ListSubscriptionsPage() error = %v", err)
+	}
+	if len(page.Subscriptions) != 3 || page.NextPageToken != "" {
+		t.Errorf("ListSubscriptionsPage() = %d subscriptions, next token %q; want 3 and no next page", len(page.Subscriptions), page.NextPageToken)
+	}
+	if page.Subscriptions[0].Topic != "projects/p/topics/orders" || page.Subscriptions[0].SubscriptionType != "pull" {
+		t.Errorf("ListSubscriptionsPage()[0] = %+v, want pull subscription on orders", page.Subscriptions[0])
+	}
+}
+
+func TestNormalizePageSize(t *testing.T) {
+	for _, tt := range []struct{ in, want int }{{0, DefaultListPageSize}, {-3, DefaultListPageSize}, {50, 50}, {5000, MaxListPageSize}} {
+		if got := normalizePageSize(tt.in); got != tt.want {
+			t.Errorf("normalizePageSize(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"fmt"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/models"
+)
+
+// validateOIDCToken checks a push authentication setting; nil or an empty service account means none
+func validateOIDCToken(token *models.OIDCToken) error {
+	if token == nil {
+		return nil
+	}
+	if token.ServiceAccountEmail == "" {
+		if token.Audience != "" {
+			return fmt.Errorf("an OIDC audience requires a service account email")
+		}
+		return nil
+	}
+	return token.Validate()
+}
+
+// setOIDCToken sets the authentication of a push config; nil or an empty service account removes it
+func setOIDCToken(push *pubsubpb.PushConfig, token *models.OIDCToken) {
+	if token == nil || token.ServiceAccountEmail == "" {
+		push.AuthenticationMethod = nil
+		return
+	}
+	push.AuthenticationMethod = &pubsubpb.PushConfig_OidcToken_{
+		OidcToken: &pubsubpb.PushConfig_OidcToken{
+			ServiceAccountEmail: token.ServiceAccountEmail,
+			Audience:            token.Audience,
+		},
+	}
+}
+
+// oidcTokenFromProto returns the OIDC authentication of a push config, or nil when it has none
+func oidcTokenFromProto(push *pubsubpb.PushConfig) *models.OIDCToken {
+	token := push.GetOidcToken()
+	if token == nil || token.ServiceAccountEmail == "" {
+		return nil
+	}
+	return &models.OIDCToken{ServiceAccountEmail: token.ServiceAccountEmail, Audience: token.Audience}
+}
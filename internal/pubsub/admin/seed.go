@@ -0,0 +1,36 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/pubsub/v2"
+
+	"pubsub-gui/internal/models"
+)
+
+// SeedResourcesAdmin creates the topics and subscriptions of a seed config
+// Stops at the first failure and returns how many resources were created before it
+func SeedResourcesAdmin(ctx context.Context, client *pubsub.Client, projectID string, seed *models.EmulatorSeedConfig) (int, error) {
+	if seed == nil {
+		return 0, nil
+	}
+
+	created := 0
+	for _, topic := range seed.Topics {
+		if err := CreateTopicAdmin(ctx, client, projectID, topic.ID, "", nil, nil); err != nil {
+			return created, fmt.Errorf("failed to seed topic %s: %w", topic.ID, err)
+		}
+		created++
+
+		for _, subID := range topic.Subscriptions {
+			if err := CreateSubscriptionWithConfig(ctx, client, projectID, topic.ID, subID, SubscriptionConfig{AckDeadline: 10}); err != nil {
+				return created, fmt.Errorf("failed to seed subscription %s: %w", subID, err)
+			}
+			created++
+		}
+	}
+
+	return created, nil
+}
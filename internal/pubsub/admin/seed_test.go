@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestSeedResourcesAdmin(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    []string // Topics created before seeding
+		seed        *models.EmulatorSeedConfig
+		wantCreated int
+		wantErr     string // empty when seeding succeeds
+		wantTopics  []string
+		wantSubs    []string
+	}{
+		{name: "nil seed", seed: nil},
+		{name: "empty seed", seed: &models.EmulatorSeedConfig{}},
+		{
+			name: "topics and subscriptions",
+			seed: &models.EmulatorSeedConfig{Topics: []models.SeedTopic{
+				{ID: "orders", Subscriptions: []string{"orders-audit", "orders-billing"}},
+				{ID: "events"},
+			}},
+			wantCreated: 4,
+			wantTopics:  []string{"events", "orders"},
+			wantSubs:    []string{"orders-audit", "orders-billing"},
+		},
+		{
+			name:     "stops at an existing topic",
+			existing: []string{"events"},
+			seed: &models.EmulatorSeedConfig{Topics: []models.SeedTopic{
+				{ID: "orders", Subscriptions: []string{"orders-audit"}},
+				{ID: "events", Subscriptions: []string{"events-audit"}},
+				{ID: "later"},
+			}},
+			wantCreated: 2,
+			wantErr:     "failed to seed topic events",
+			wantTopics:  []string{"events", "orders"},
+			wantSubs:    []string{"orders-audit"},
+		},
+		{
+			name: "stops at a duplicate subscription",
+			seed: &models.EmulatorSeedConfig{Topics: []models.SeedTopic{
+				{ID: "orders", Subscriptions: []string{"audit", "audit"}},
+			}},
+			wantCreated: 2,
+			wantErr:     "failed to seed subscription audit",
+			wantTopics:  []string{"orders"},
+			wantSubs:    []string{"audit"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client, _ := pubsubtest.NewClient(t)
+			for _, topicID := range tt.existing {
+				if err := CreateTopicAdmin(ctx, client, "p", topicID, "", nil, nil); err != nil {
+					t.Fatalf("CreateTopicAdmin(%s) error = %v", topicID, err)
+				}
+			}
+
+			created, err := SeedResourcesAdmin(ctx, client, "p", tt.seed)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("SeedResourcesAdmin() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("SeedResourcesAdmin() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if created != tt.wantCreated {
+				t.Errorf("SeedResourcesAdmin() created = %d, want %d", created, tt.wantCreated)
+			}
+
+			topics, err := ListTopicsAdmin(ctx, client, "p")
+			if err != nil {
+				t.Fatalf("ListTopicsAdmin() error = %v", err)
+			}
+			var topicIDs []string
+			for _, topic := range topics {
+				topicIDs = append(topicIDs, topic.DisplayName)
+			}
+			assertSortedIDs(t, "topics", topicIDs, tt.wantTopics)
+
+			subs, err := ListSubscriptionsAdmin(ctx, client, "p")
+			if err != nil {
+				t.Fatalf("ListSubscriptionsAdmin() error = %v", err)
+			}
+			var subIDs []string
+			for _, sub := range subs {
+				subIDs = append(subIDs, sub.DisplayName)
+			}
+			assertSortedIDs(t, "subscriptions", subIDs, tt.wantSubs)
+		})
+	}
+}
+
+// assertSortedIDs compares resource IDs regardless of listing order
+func assertSortedIDs(t *testing.T, kind string, got, want []string) {
+	t.Helper()
+	sort.Strings(got)
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("%s = %v, want %v", kind, got, want)
+	}
+}
@@ -9,6 +9,10 @@ import (
 	"cloud.google.com/go/pubsub/v2"
 	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/models"
 )
 
 // SnapshotInfo represents snapshot metadata
@@ -65,10 +69,7 @@ func ListSnapshotsAdmin(ctx context.Context, client *pubsub.Client, projectID st
 // and can only be used to seek subscriptions that share the same topic
 func ListSnapshotsForSubscriptionAdmin(ctx context.Context, client *pubsub.Client, projectID, subscriptionID string) ([]SnapshotInfo, error) {
 	// First, get the subscription to find its topic
-	subName := subscriptionID
-	if !strings.HasPrefix(subscriptionID, "projects/") {
-		subName = "projects/" + projectID + "/subscriptions/" + subscriptionID
-	}
+	_, subName := NormalizeName(projectID, "subscription", subscriptionID)
 
 	getReq := &pubsubpb.GetSubscriptionRequest{
 		Subscription: subName,
@@ -97,10 +98,7 @@ func ListSnapshotsForSubscriptionAdmin(ctx context.Context, client *pubsub.Clien
 
 // GetSnapshotAdmin retrieves metadata for a specific snapshot
 func GetSnapshotAdmin(ctx context.Context, client *pubsub.Client, projectID, snapshotID string) (SnapshotInfo, error) {
-	snapshotName := snapshotID
-	if !strings.HasPrefix(snapshotID, "projects/") {
-		snapshotName = "projects/" + projectID + "/snapshots/" + snapshotID
-	}
+	_, snapshotName := NormalizeName(projectID, "snapshot", snapshotID)
 
 	req := &pubsubpb.GetSnapshotRequest{
 		Snapshot: snapshotName,
@@ -131,16 +129,10 @@ func GetSnapshotAdmin(ctx context.Context, client *pubsub.Client, projectID, sna
 // CreateSnapshotAdmin creates a new snapshot from a subscription
 func CreateSnapshotAdmin(ctx context.Context, client *pubsub.Client, projectID, subscriptionID, snapshotID string, labels map[string]string) error {
 	// Normalize subscription ID
-	subName := subscriptionID
-	if !strings.HasPrefix(subscriptionID, "projects/") {
-		subName = "projects/" + projectID + "/subscriptions/" + subscriptionID
-	}
+	_, subName := NormalizeName(projectID, "subscription", subscriptionID)
 
 	// Normalize snapshot ID
-	snapshotName := snapshotID
-	if !strings.HasPrefix(snapshotID, "projects/") {
-		snapshotName = "projects/" + projectID + "/snapshots/" + snapshotID
-	}
+	_, snapshotName := NormalizeName(projectID, "snapshot", snapshotID)
 
 	req := &pubsubpb.CreateSnapshotRequest{
 		Name:         snapshotName,
@@ -153,18 +145,28 @@ func CreateSnapshotAdmin(ctx context.Context, client *pubsub.Client, projectID,
 
 	_, err := client.SubscriptionAdminClient.CreateSnapshot(ctx, req)
 	if err != nil {
+		if isSnapshotBacklogTooOld(err) {
+			return fmt.Errorf("failed to create snapshot %s from subscription %s: %w: %w", snapshotID, subscriptionID, models.ErrSnapshotBacklogTooOld, err)
+		}
 		return fmt.Errorf("failed to create snapshot %s from subscription %s: %w", snapshotID, subscriptionID, err)
 	}
 
 	return nil
 }
 
+// isSnapshotBacklogTooOld reports whether Pub/Sub refused a snapshot because its oldest message would expire within an hour
+// Other failed preconditions (e.g. a detached subscription) keep their own error.
+func isSnapshotBacklogTooOld(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return false
+	}
+	return strings.Contains(strings.ToLower(st.Message()), "backlog")
+}
+
 // DeleteSnapshotAdmin deletes a snapshot
 func DeleteSnapshotAdmin(ctx context.Context, client *pubsub.Client, projectID, snapshotID string) error {
-	snapshotName := snapshotID
-	if !strings.HasPrefix(snapshotID, "projects/") {
-		snapshotName = "projects/" + projectID + "/snapshots/" + snapshotID
-	}
+	_, snapshotName := NormalizeName(projectID, "snapshot", snapshotID)
 
 	req := &pubsubpb.DeleteSnapshotRequest{
 		Snapshot: snapshotName,
@@ -178,6 +180,49 @@ func DeleteSnapshotAdmin(ctx context.Context, client *pubsub.Client, projectID,
 	return nil
 }
 
+// SeekSubscriptionToSnapshot seeks a subscription to a snapshot.
+// All messages in the snapshot will be marked as unacknowledged and redelivered.
+func SeekSubscriptionToSnapshot(ctx context.Context, client *pubsub.Client, projectID, subID, snapshotID string) error {
+	// Normalize subscription ID
+	_, subName := NormalizeName(projectID, "subscription", subID)
+
+	// Normalize snapshot ID
+	_, snapshotName := NormalizeName(projectID, "snapshot", snapshotID)
+
+	// Verify subscription exists and is a pull subscription
+	getReq := &pubsubpb.GetSubscriptionRequest{
+		Subscription: subName,
+	}
+	sub, err := client.SubscriptionAdminClient.GetSubscription(ctx, getReq)
+	if err != nil {
+		return fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	// Check if it's a push subscription
+	if sub.PushConfig != nil && sub.PushConfig.PushEndpoint != "" {
+		return fmt.Errorf("cannot seek push subscription %s; seek is only supported for pull subscriptions", subID)
+	}
+
+	// Create seek request with snapshot
+	seekReq := &pubsubpb.SeekRequest{
+		Subscription: subName,
+		Target: &pubsubpb.SeekRequest_Snapshot{
+			Snapshot: snapshotName,
+		},
+	}
+
+	_, err = client.SubscriptionAdminClient.Seek(ctx, seekReq)
+	if err != nil {
+		// Snapshots are deleted automatically once they expire
+		if status.Code(err) == codes.NotFound {
+			return fmt.Errorf("snapshot %s not found; it may have expired: %w", snapshotID, err)
+		}
+		return fmt.Errorf("failed to seek subscription to snapshot: %w", err)
+	}
+
+	return nil
+}
+
 // extractSnapshotDisplayName extracts the short name from a full snapshot resource path
 func extractSnapshotDisplayName(fullName string) string {
 	// Full name format: projects/{project}/snapshots/{snapshot-id}
@@ -0,0 +1,31 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsSnapshotBacklogTooOld(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "backlog too old", err: status.Error(codes.FailedPrecondition, "The subscription's backlog is too old to create a snapshot"), want: true},
+		{name: "wrapped", err: fmt.Errorf("rpc: %w", status.Error(codes.FailedPrecondition, "Backlog would expire in less than 1 hour")), want: true},
+		{name: "other failed precondition", err: status.Error(codes.FailedPrecondition, "subscription is detached"), want: false},
+		{name: "other code", err: status.Error(codes.NotFound, "backlog of a missing subscription"), want: false},
+		{name: "not a status", err: errors.New("backlog"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSnapshotBacklogTooOld(tt.err); got != tt.want {
+				t.Errorf("isSnapshotBacklogTooOld(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"fmt"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/models"
+)
+
+// knownGCPRegions lists the Google Cloud regions accepted as message persistence regions
+// (https://cloud.google.com/about/locations). Multi-regions such as "us" or "europe" are not valid here.
+var knownGCPRegions = map[string]bool{
+	"africa-south1":           true,
+	"asia-east1":              true,
+	"asia-east2":              true,
+	"asia-northeast1":         true,
+	"asia-northeast2":         true,
+	"asia-northeast3":         true,
+	"asia-south1":             true,
+	"asia-south2":             true,
+	"asia-southeast1":         true,
+	"asia-southeast2":         true,
+	"australia-southeast1":    true,
+	"australia-southeast2":    true,
+	"europe-central2":         true,
+	"europe-north1":           true,
+	"europe-north2":           true,
+	"europe-southwest1":       true,
+	"europe-west1":            true,
+	"europe-west2":            true,
+	"europe-west3":            true,
+	"europe-west4":            true,
+	"europe-west6":            true,
+	"europe-west8":            true,
+	"europe-west9":            true,
+	"europe-west10":           true,
+	"europe-west12":           true,
+	"me-central1":             true,
+	"me-central2":             true,
+	"me-west1":                true,
+	"northamerica-northeast1": true,
+	"northamerica-northeast2": true,
+	"northamerica-south1":     true,
+	"southamerica-east1":      true,
+	"southamerica-west1":      true,
+	"us-central1":             true,
+	"us-east1":                true,
+	"us-east4":                true,
+	"us-east5":                true,
+	"us-south1":               true,
+	"us-west1":                true,
+	"us-west2":                true,
+	"us-west3":                true,
+	"us-west4":                true,
+}
+
+// ValidatePersistenceRegions checks that every region is a known GCP region and appears only once
+func ValidatePersistenceRegions(regions []string) error {
+	seen := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		if region == "" {
+			return fmt.Errorf("invalid persistence region: must not be empty")
+		}
+		if !knownGCPRegions[region] {
+			return fmt.Errorf("unknown persistence region %q: use a GCP region such as us-central1 or europe-west1", region)
+		}
+		if seen[region] {
+			return fmt.Errorf("duplicate persistence region %q", region)
+		}
+		seen[region] = true
+	}
+	return nil
+}
+
+// storagePolicyToProto validates a message storage policy and converts it for the API
+// nil is returned for a nil policy or one without regions, which allows storage in any region.
+func storagePolicyToProto(policy *models.MessageStoragePolicy) (*pubsubpb.MessageStoragePolicy, error) {
+	if policy == nil || len(policy.AllowedPersistenceRegions) == 0 {
+		return nil, nil
+	}
+	if err := ValidatePersistenceRegions(policy.AllowedPersistenceRegions); err != nil {
+		return nil, err
+	}
+	return &pubsubpb.MessageStoragePolicy{AllowedPersistenceRegions: policy.AllowedPersistenceRegions}, nil
+}
+
+// storagePolicyFromProto converts an API message storage policy; nil when no regions are restricted
+func storagePolicyFromProto(policy *pubsubpb.MessageStoragePolicy) *models.MessageStoragePolicy {
+	if policy == nil || len(policy.AllowedPersistenceRegions) == 0 {
+		return nil
+	}
+	return &models.MessageStoragePolicy{AllowedPersistenceRegions: policy.AllowedPersistenceRegions}
+}
@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestValidatePersistenceRegions(t *testing.T) {
+	tests := []struct {
+		name     string
+		regions  []string
+		contains string // empty when valid
+	}{
+		{"nil", nil, ""},
+		{"valid", []string{"us-central1", "europe-west1"}, ""},
+		{"unknown region", []string{"us-central1", "mars-north1"}, `"mars-north1"`},
+		{"multi-region", []string{"europe"}, "unknown persistence region"},
+		{"zone", []string{"us-central1-a"}, "unknown persistence region"},
+		{"uppercase", []string{"US-CENTRAL1"}, "unknown persistence region"},
+		{"empty region", []string{""}, "must not be empty"},
+		{"duplicate", []string{"us-east1", "us-east1"}, "duplicate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePersistenceRegions(tt.regions)
+			if tt.contains == "" {
+				if err != nil {
+					t.Errorf("ValidatePersistenceRegions(%v) error = %v, want nil", tt.regions, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("ValidatePersistenceRegions(%v) error = %v, want it to contain %q", tt.regions, err, tt.contains)
+			}
+		})
+	}
+}
+
+func TestUpdateTopicAdmin_MessageStoragePolicy(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+
+	regions := []string{"europe-west1", "europe-west4"}
+	params := TopicUpdateParams{MessageStoragePolicy: &models.MessageStoragePolicy{AllowedPersistenceRegions: regions}}
+	if err := UpdateTopicAdmin(ctx, client, "p", "orders", params); err != nil {
+		t.Fatalf("UpdateTopicAdmin() error = %v", err)
+	}
+	info, err := GetTopicMetadataAdmin(ctx, client, "p", "orders")
+	if err != nil {
+		t.Fatalf("GetTopicMetadataAdmin() error = %v", err)
+	}
+	if info.MessageStoragePolicy == nil || !reflect.DeepEqual(info.MessageStoragePolicy.AllowedPersistenceRegions, regions) {
+		t.Fatalf("MessageStoragePolicy = %+v, want regions %v", info.MessageStoragePolicy, regions)
+	}
+
+	invalid := TopicUpdateParams{MessageStoragePolicy: &models.MessageStoragePolicy{AllowedPersistenceRegions: []string{"eu-west1"}}}
+	if err := UpdateTopicAdmin(ctx, client, "p", "orders", invalid); err == nil || !strings.Contains(err.Error(), `"eu-west1"`) {
+		t.Errorf("UpdateTopicAdmin(unknown region) error = %v, want it to name the region", err)
+	}
+
+	cleared := TopicUpdateParams{MessageStoragePolicy: &models.MessageStoragePolicy{}}
+	if err := UpdateTopicAdmin(ctx, client, "p", "orders", cleared); err != nil {
+		t.Fatalf("UpdateTopicAdmin(clear) error = %v", err)
+	}
+	info, err = GetTopicMetadataAdmin(ctx, client, "p", "orders")
+	if err != nil {
+		t.Fatalf("GetTopicMetadataAdmin() error = %v", err)
+	}
+	if info.MessageStoragePolicy != nil {
+		t.Errorf("MessageStoragePolicy after clearing = %+v, want nil", info.MessageStoragePolicy)
+	}
+}
@@ -4,12 +4,15 @@ package admin
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/pubsub/v2"
 	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -20,15 +23,80 @@ import (
 
 // SubscriptionInfo represents subscription metadata
 type SubscriptionInfo struct {
-	Name              string                `json:"name"`
-	DisplayName       string                `json:"displayName"`
-	Topic             string                `json:"topic"`
-	AckDeadline       int                   `json:"ackDeadline"`
-	RetentionDuration string                `json:"retentionDuration"`
-	Filter            string                `json:"filter,omitempty"`
-	DeadLetterPolicy  *DeadLetterPolicyInfo `json:"deadLetterPolicy,omitempty"`
-	SubscriptionType  string                `json:"subscriptionType"`       // "pull" or "push"
-	PushEndpoint      string                `json:"pushEndpoint,omitempty"` // Only for push subscriptions
+	Name               string                `json:"name"`
+	DisplayName        string                `json:"displayName"`
+	Topic              string                `json:"topic"`
+	AckDeadline        int                   `json:"ackDeadline"`
+	RetentionDuration  string                `json:"retentionDuration"`
+	Filter             string                `json:"filter,omitempty"`
+	DeadLetterPolicy   *DeadLetterPolicyInfo `json:"deadLetterPolicy,omitempty"`
+	SubscriptionType   string                `json:"subscriptionType"`             // "pull", "push", "bigquery" or "cloudstorage"
+	PushEndpoint       string                `json:"pushEndpoint,omitempty"`       // Only for push subscriptions
+	PushOIDCToken      *models.OIDCToken     `json:"pushOidcToken,omitempty"`      // OIDC authentication of push requests, if configured
+	BigQueryTable      string                `json:"bigQueryTable,omitempty"`      // Only for BigQuery subscriptions
+	CloudStorageBucket string                `json:"cloudStorageBucket,omitempty"` // Only for Cloud Storage subscriptions
+	RetainAcked        bool                  `json:"retainAckedMessages"`          // Whether acked messages are kept for seek/replay
+	EnableOrdering     bool                  `json:"enableOrdering"`               // Message ordering enabled
+	EnableExactlyOnce  bool                  `json:"enableExactlyOnce"`            // Exactly-once delivery enabled
+	RetryPolicy        *models.RetryPolicy   `json:"retryPolicy,omitempty"`        // Retry backoff (nil means immediate redelivery)
+	ExpirationTTL      string                `json:"expirationTtl,omitempty"`      // Idle time before the subscription is deleted; empty means it never expires
+	ReadOnlyFields     []string              `json:"readOnlyFields"`               // Fields that cannot be changed after creation
+	Detached           bool                  `json:"detached,omitempty"`           // Detached from its topic (no longer receives messages)
+}
+
+// immutableSubscriptionFields lists subscription fields (by JSON name) that Pub/Sub rejects in updates
+var immutableSubscriptionFields = []string{"topic", "enableOrdering"}
+
+// ReadOnlySubscriptionFields returns the subscription fields that cannot be changed after creation
+func ReadOnlySubscriptionFields() []string {
+	return append([]string(nil), immutableSubscriptionFields...)
+}
+
+// applyDeliverySettings copies ordering, exactly-once, retry and expiration settings from a subscription proto
+// and marks the fields that are immutable after creation
+func applyDeliverySettings(info *SubscriptionInfo, sub *pubsubpb.Subscription) {
+	info.ReadOnlyFields = ReadOnlySubscriptionFields()
+	info.EnableOrdering = sub.EnableMessageOrdering
+	info.EnableExactlyOnce = sub.EnableExactlyOnceDelivery
+	if sub.RetryPolicy != nil {
+		info.RetryPolicy = &models.RetryPolicy{
+			MinimumBackoff: sub.RetryPolicy.MinimumBackoff.AsDuration().String(),
+			MaximumBackoff: sub.RetryPolicy.MaximumBackoff.AsDuration().String(),
+		}
+	}
+	if sub.ExpirationPolicy != nil && sub.ExpirationPolicy.Ttl != nil {
+		info.ExpirationTTL = sub.ExpirationPolicy.Ttl.AsDuration().String()
+	}
+}
+
+// applySubscriptionType sets the delivery type (and push endpoint, BigQuery table or bucket) and the detached flag from a subscription proto
+func applySubscriptionType(info *SubscriptionInfo, sub *pubsubpb.Subscription) {
+	info.Detached = sub.Detached
+	switch {
+	case sub.PushConfig != nil && sub.PushConfig.PushEndpoint != "":
+		info.SubscriptionType = "push"
+		info.PushEndpoint = sub.PushConfig.PushEndpoint
+		info.PushOIDCToken = oidcTokenFromProto(sub.PushConfig)
+	case sub.BigqueryConfig != nil && sub.BigqueryConfig.Table != "":
+		info.SubscriptionType = "bigquery"
+		info.BigQueryTable = sub.BigqueryConfig.Table
+	case sub.CloudStorageConfig != nil && sub.CloudStorageConfig.Bucket != "":
+		info.SubscriptionType = "cloudstorage"
+		info.CloudStorageBucket = sub.CloudStorageConfig.Bucket
+	default:
+		info.SubscriptionType = "pull"
+	}
+}
+
+// ValidateBigQueryTable checks that table has the form "{projectId}.{datasetId}.{tableId}"
+// (the legacy "{projectId}:{datasetId}.{tableId}" form is accepted too)
+func ValidateBigQueryTable(table string) error {
+	normalized := strings.Replace(table, ":", ".", 1)
+	parts := strings.Split(normalized, ".")
+	if len(parts) != 3 || slices.Contains(parts, "") {
+		return fmt.Errorf("invalid BigQuery table %q: expected projectId.datasetId.tableId", table)
+	}
+	return nil
 }
 
 // DeadLetterPolicyInfo represents dead letter queue configuration
@@ -64,34 +132,7 @@ func ListSubscriptionsAdmin(ctx context.Context, client *pubsub.Client, projectI
 			return nil, err
 		}
 
-		subInfo := SubscriptionInfo{
-			Name:              sub.Name,
-			DisplayName:       extractDisplayName(sub.Name),
-			Topic:             sub.Topic,
-			AckDeadline:       int(sub.AckDeadlineSeconds),
-			RetentionDuration: sub.MessageRetentionDuration.AsDuration().String(),
-		}
-
-		// Determine subscription type (pull or push)
-		if sub.PushConfig != nil && sub.PushConfig.PushEndpoint != "" {
-			subInfo.SubscriptionType = "push"
-			subInfo.PushEndpoint = sub.PushConfig.PushEndpoint
-		} else {
-			subInfo.SubscriptionType = "pull"
-		}
-
-		if sub.Filter != "" {
-			subInfo.Filter = sub.Filter
-		}
-
-		if sub.DeadLetterPolicy != nil {
-			subInfo.DeadLetterPolicy = &DeadLetterPolicyInfo{
-				DeadLetterTopic:     sub.DeadLetterPolicy.DeadLetterTopic,
-				MaxDeliveryAttempts: int(sub.DeadLetterPolicy.MaxDeliveryAttempts),
-			}
-		}
-
-		subscriptions = append(subscriptions, subInfo)
+		subscriptions = append(subscriptions, subscriptionInfoFromProto(sub, extractDisplayName(sub.Name)))
 	}
 
 	return subscriptions, nil
@@ -99,7 +140,7 @@ func ListSubscriptionsAdmin(ctx context.Context, client *pubsub.Client, projectI
 
 // GetSubscriptionMetadataAdmin retrieves metadata for a specific subscription
 func GetSubscriptionMetadataAdmin(ctx context.Context, client *pubsub.Client, projectID, subID string) (SubscriptionInfo, error) {
-	subName := "projects/" + projectID + "/subscriptions/" + subID
+	shortSubID, subName := NormalizeName(projectID, "subscription", subID)
 
 	req := &pubsubpb.GetSubscriptionRequest{
 		Subscription: subName,
@@ -110,21 +151,22 @@ func GetSubscriptionMetadataAdmin(ctx context.Context, client *pubsub.Client, pr
 		return SubscriptionInfo{}, fmt.Errorf("failed to get subscription: %w", err)
 	}
 
+	return subscriptionInfoFromProto(sub, shortSubID), nil
+}
+
+// subscriptionInfoFromProto converts an API subscription to SubscriptionInfo
+func subscriptionInfoFromProto(sub *pubsubpb.Subscription, displayName string) SubscriptionInfo {
 	subInfo := SubscriptionInfo{
 		Name:              sub.Name,
-		DisplayName:       subID,
+		DisplayName:       displayName,
 		Topic:             sub.Topic,
 		AckDeadline:       int(sub.AckDeadlineSeconds),
 		RetentionDuration: sub.MessageRetentionDuration.AsDuration().String(),
+		RetainAcked:       sub.RetainAckedMessages,
 	}
+	applyDeliverySettings(&subInfo, sub)
 
-	// Determine subscription type (pull or push)
-	if sub.PushConfig != nil && sub.PushConfig.PushEndpoint != "" {
-		subInfo.SubscriptionType = "push"
-		subInfo.PushEndpoint = sub.PushConfig.PushEndpoint
-	} else {
-		subInfo.SubscriptionType = "pull"
-	}
+	applySubscriptionType(&subInfo, sub)
 
 	if sub.Filter != "" {
 		subInfo.Filter = sub.Filter
@@ -137,42 +179,21 @@ func GetSubscriptionMetadataAdmin(ctx context.Context, client *pubsub.Client, pr
 		}
 	}
 
-	return subInfo, nil
+	return subInfo
 }
 
 // CreateSubscriptionAdmin creates a new subscription for a topic
 func CreateSubscriptionAdmin(ctx context.Context, client *pubsub.Client, projectID, topicID, subID string, ttl time.Duration) error {
-	// Normalize subscription ID (extract short name if full path provided)
-	shortSubID := subID
-	if strings.HasPrefix(subID, "projects/") {
-		// Extract subscription ID from full path: projects/{project}/subscriptions/{sub-id}
-		parts := strings.Split(subID, "/")
-		if len(parts) >= 4 && parts[0] == "projects" && parts[2] == "subscriptions" {
-			shortSubID = parts[3]
-		}
+	// Normalize names (short IDs or full paths)
+	_, subName := NormalizeName(projectID, "subscription", subID)
+	_, topicName := NormalizeName(projectID, "topic", topicID)
+	if err := validateResourceID(projectID, "subscription", subID); err != nil {
+		return err
 	}
 
-	// Normalize topic ID (extract short name if full path provided)
-	shortTopicID := topicID
-	if strings.HasPrefix(topicID, "projects/") {
-		// Extract topic ID from full path: projects/{project}/topics/{topic-id}
-		parts := strings.Split(topicID, "/")
-		if len(parts) >= 4 && parts[0] == "projects" && parts[2] == "topics" {
-			shortTopicID = parts[3]
-		}
-	}
-
-	// Build full resource names
-	subName := "projects/" + projectID + "/subscriptions/" + shortSubID
-	topicName := "projects/" + projectID + "/topics/" + shortTopicID
-
-	// Verify topic exists before creating subscription
-	topicReq := &pubsubpb.GetTopicRequest{
-		Topic: topicName,
-	}
-	_, err := client.TopicAdminClient.GetTopic(ctx, topicReq)
-	if err != nil {
-		return fmt.Errorf("topic %s does not exist or you don't have permission to access it: %w", topicName, err)
+	// Verify topic exists before creating subscription (cached listings skip the GetTopic call)
+	if err := ensureTopicExists(ctx, client, topicName); err != nil {
+		return err
 	}
 
 	// Create subscription using Subscription object directly (v2 API pattern)
@@ -185,7 +206,7 @@ func CreateSubscriptionAdmin(ctx context.Context, client *pubsub.Client, project
 		},
 	}
 
-	_, err = client.SubscriptionAdminClient.CreateSubscription(ctx, req)
+	_, err := client.SubscriptionAdminClient.CreateSubscription(ctx, req)
 	if err != nil {
 		// Provide more helpful error message
 		return fmt.Errorf("failed to create subscription %s for topic %s: %w. Ensure you have 'pubsub.subscriptions.create' permission", subName, topicName, err)
@@ -196,10 +217,7 @@ func CreateSubscriptionAdmin(ctx context.Context, client *pubsub.Client, project
 
 // DeleteSubscriptionAdmin deletes a subscription
 func DeleteSubscriptionAdmin(ctx context.Context, client *pubsub.Client, projectID, subID string) error {
-	subName := subID
-	if !strings.HasPrefix(subID, "projects/") {
-		subName = "projects/" + projectID + "/subscriptions/" + subID
-	}
+	_, subName := NormalizeName(projectID, "subscription", subID)
 
 	deleteReq := &pubsubpb.DeleteSubscriptionRequest{
 		Subscription: subName,
@@ -213,6 +231,21 @@ func DeleteSubscriptionAdmin(ctx context.Context, client *pubsub.Client, project
 	return nil
 }
 
+// DetachSubscriptionAdmin detaches a subscription from its topic
+// Pub/Sub stops delivering to it and drops its backlog; the subscription itself is kept, flagged as detached.
+func DetachSubscriptionAdmin(ctx context.Context, client *pubsub.Client, projectID, subID string) error {
+	_, subName := NormalizeName(projectID, "subscription", subID)
+
+	_, err := client.TopicAdminClient.DetachSubscription(ctx, &pubsubpb.DetachSubscriptionRequest{
+		Subscription: subName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to detach subscription: %w", err)
+	}
+
+	return nil
+}
+
 // SubscriptionUpdateParams represents parameters for updating a subscription
 type SubscriptionUpdateParams struct {
 	AckDeadline       *int                  `json:"ackDeadline,omitempty"`
@@ -220,29 +253,53 @@ type SubscriptionUpdateParams struct {
 	Filter            *string               `json:"filter,omitempty"`
 	DeadLetterPolicy  *DeadLetterPolicyInfo `json:"deadLetterPolicy,omitempty"`
 	PushEndpoint      *string               `json:"pushEndpoint,omitempty"`
+	OIDCToken         *models.OIDCToken     `json:"oidcToken,omitempty"`        // Push authentication; an empty service account removes it
 	SubscriptionType  *string               `json:"subscriptionType,omitempty"` // "pull" or "push"
+	RetainAcked       *bool                 `json:"retainAckedMessages,omitempty"`
+	RetryPolicy       *models.RetryPolicy   `json:"retryPolicy,omitempty"`   // Empty backoffs remove the policy (immediate redelivery)
+	ExpirationTTL     *string               `json:"expirationTtl,omitempty"` // e.g. "720h"; empty string means never expire
 }
 
 // SubscriptionConfig represents full subscription configuration for template-based creation
 type SubscriptionConfig struct {
-	AckDeadline       int                      `json:"ackDeadline"`                 // Ack deadline in seconds (10-600)
-	RetentionDuration string                   `json:"retentionDuration,omitempty"` // e.g., "7d"
-	ExpirationPolicy  *models.ExpirationPolicy `json:"expirationPolicy,omitempty"`  // Auto-delete after idle
-	RetryPolicy       *models.RetryPolicy      `json:"retryPolicy,omitempty"`       // Retry configuration
-	EnableOrdering    bool                     `json:"enableOrdering"`              // Enable message ordering
-	EnableExactlyOnce bool                     `json:"enableExactlyOnce"`           // Enable exactly-once delivery
-	Filter            string                   `json:"filter,omitempty"`            // Message filter expression
-	PushConfig        *models.PushConfig       `json:"pushConfig,omitempty"`        // Push subscription config
-	DeadLetterPolicy  *DeadLetterPolicyInfo    `json:"deadLetterPolicy,omitempty"`  // Dead letter policy
-	Labels            map[string]string        `json:"labels,omitempty"`            // Subscription labels
+	AckDeadline        int                        `json:"ackDeadline"`                   // Ack deadline in seconds (10-600)
+	RetentionDuration  string                     `json:"retentionDuration,omitempty"`   // e.g., "7d"
+	ExpirationPolicy   *models.ExpirationPolicy   `json:"expirationPolicy,omitempty"`    // Auto-delete after idle; nil keeps the 31-day default, an empty TTL never expires
+	RetryPolicy        *models.RetryPolicy        `json:"retryPolicy,omitempty"`         // Retry configuration
+	EnableOrdering     bool                       `json:"enableOrdering"`                // Enable message ordering
+	EnableExactlyOnce  bool                       `json:"enableExactlyOnce"`             // Enable exactly-once delivery
+	Filter             string                     `json:"filter,omitempty"`              // Message filter expression
+	PushConfig         *models.PushConfig         `json:"pushConfig,omitempty"`          // Push subscription config
+	BigQueryConfig     *models.BigQueryConfig     `json:"bigQueryConfig,omitempty"`      // BigQuery subscription config (exclusive with push)
+	CloudStorageConfig *models.CloudStorageConfig `json:"cloudStorageConfig,omitempty"`  // Cloud Storage subscription config (exclusive with push and BigQuery)
+	DeadLetterPolicy   *DeadLetterPolicyInfo      `json:"deadLetterPolicy,omitempty"`    // Dead letter policy
+	Labels             map[string]string          `json:"labels,omitempty"`              // Subscription labels
+	RetainAcked        bool                       `json:"retainAckedMessages,omitempty"` // Keep acked messages for seek/replay
 }
 
 // UpdateSubscriptionAdmin updates a subscription's configuration
 func UpdateSubscriptionAdmin(ctx context.Context, client *pubsub.Client, projectID, subID string, params SubscriptionUpdateParams) error {
 	// Normalize subscription ID
-	subName := subID
-	if !strings.HasPrefix(subID, "projects/") {
-		subName = "projects/" + projectID + "/subscriptions/" + subID
+	_, subName := NormalizeName(projectID, "subscription", subID)
+
+	if err := validateOIDCToken(params.OIDCToken); err != nil {
+		return err
+	}
+	var expirationPolicy *pubsubpb.ExpirationPolicy
+	if params.ExpirationTTL != nil {
+		policy, err := parseExpirationTTL(*params.ExpirationTTL)
+		if err != nil {
+			return err
+		}
+		expirationPolicy = policy
+	}
+	var retryPolicy *pubsubpb.RetryPolicy
+	if params.RetryPolicy != nil {
+		policy, err := retryPolicyToProto(params.RetryPolicy)
+		if err != nil {
+			return err
+		}
+		retryPolicy = policy
 	}
 
 	// Get current subscription to merge updates
@@ -280,6 +337,24 @@ func UpdateSubscriptionAdmin(ctx context.Context, client *pubsub.Client, project
 		updateMask = append(updateMask, "filter")
 	}
 
+	// Update retain acked messages if provided
+	if params.RetainAcked != nil {
+		updatedSub.RetainAckedMessages = *params.RetainAcked
+		updateMask = append(updateMask, "retain_acked_messages")
+	}
+
+	// Update expiration policy if provided
+	if params.ExpirationTTL != nil {
+		updatedSub.ExpirationPolicy = expirationPolicy
+		updateMask = append(updateMask, "expiration_policy")
+	}
+
+	// Update retry policy if provided
+	if params.RetryPolicy != nil {
+		updatedSub.RetryPolicy = retryPolicy
+		updateMask = append(updateMask, "retry_policy")
+	}
+
 	// Update dead letter policy if provided
 	if params.DeadLetterPolicy != nil {
 		if updatedSub.DeadLetterPolicy == nil {
@@ -294,21 +369,30 @@ func UpdateSubscriptionAdmin(ctx context.Context, client *pubsub.Client, project
 		updateMask = append(updateMask, "dead_letter_policy")
 	}
 
-	// Update push config if subscription type or endpoint changed
-	if params.SubscriptionType != nil || params.PushEndpoint != nil {
-		if *params.SubscriptionType == "push" {
-			if updatedSub.PushConfig == nil {
-				updatedSub.PushConfig = &pubsubpb.PushConfig{}
-			}
-			if params.PushEndpoint != nil {
-				updatedSub.PushConfig.PushEndpoint = *params.PushEndpoint
-			}
-			updateMask = append(updateMask, "push_config")
-		} else if *params.SubscriptionType == "pull" {
-			// Clear push config for pull subscriptions
-			updatedSub.PushConfig = nil
-			updateMask = append(updateMask, "push_config")
+	// Update push config if subscription type, endpoint or push authentication changed
+	subscriptionType := ""
+	if params.SubscriptionType != nil {
+		subscriptionType = *params.SubscriptionType
+	}
+	switch {
+	case subscriptionType == "pull":
+		// Clear push config for pull subscriptions
+		updatedSub.PushConfig = nil
+		updateMask = append(updateMask, "push_config")
+	case subscriptionType == "push" || params.PushEndpoint != nil || params.OIDCToken != nil:
+		if subscriptionType != "push" && updatedSub.PushConfig.GetPushEndpoint() == "" {
+			return fmt.Errorf("push endpoint and authentication can only be changed on push subscriptions")
+		}
+		if updatedSub.PushConfig == nil {
+			updatedSub.PushConfig = &pubsubpb.PushConfig{}
 		}
+		if params.PushEndpoint != nil {
+			updatedSub.PushConfig.PushEndpoint = *params.PushEndpoint
+		}
+		if params.OIDCToken != nil {
+			setOIDCToken(updatedSub.PushConfig, params.OIDCToken)
+		}
+		updateMask = append(updateMask, "push_config")
 	}
 
 	// If no fields to update, return early
@@ -332,37 +416,72 @@ func UpdateSubscriptionAdmin(ctx context.Context, client *pubsub.Client, project
 	return nil
 }
 
-// CreateSubscriptionWithConfig creates a new subscription with full configuration support
-func CreateSubscriptionWithConfig(ctx context.Context, client *pubsub.Client, projectID, topicID, subID string, config SubscriptionConfig) error {
-	// Normalize subscription ID (extract short name if full path provided)
-	shortSubID := subID
-	if strings.HasPrefix(subID, "projects/") {
-		parts := strings.Split(subID, "/")
-		if len(parts) >= 4 && parts[0] == "projects" && parts[2] == "subscriptions" {
-			shortSubID = parts[3]
-		}
+// parseExpirationTTL converts an expiration TTL such as "720h" into an expiration policy
+// An empty TTL returns a policy without TTL, which means the subscription never expires.
+func parseExpirationTTL(ttl string) (*pubsubpb.ExpirationPolicy, error) {
+	if ttl == "" {
+		return &pubsubpb.ExpirationPolicy{}, nil
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expiration policy TTL format: %w", err)
 	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("invalid expiration policy TTL %q: must be positive (use an empty value to never expire)", ttl)
+	}
+	return &pubsubpb.ExpirationPolicy{Ttl: durationpb.New(duration)}, nil
+}
 
-	// Normalize topic ID (extract short name if full path provided)
-	shortTopicID := topicID
-	if strings.HasPrefix(topicID, "projects/") {
-		parts := strings.Split(topicID, "/")
-		if len(parts) >= 4 && parts[0] == "projects" && parts[2] == "topics" {
-			shortTopicID = parts[3]
+// maxRetryBackoff is the largest minimum or maximum backoff Pub/Sub accepts
+const maxRetryBackoff = 600 * time.Second
+
+// retryPolicyToProto validates a retry policy and converts it for the API
+// Both backoffs must parse as durations between 0s and 600s, with the minimum below the maximum.
+// A policy with both backoffs empty returns nil, which removes the retry policy (immediate redelivery).
+func retryPolicyToProto(policy *models.RetryPolicy) (*pubsubpb.RetryPolicy, error) {
+	if policy.MinimumBackoff == "" && policy.MaximumBackoff == "" {
+		return nil, nil
+	}
+	minBackoff, err := time.ParseDuration(policy.MinimumBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("invalid minimum backoff format: %w", err)
+	}
+	maxBackoff, err := time.ParseDuration(policy.MaximumBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maximum backoff format: %w", err)
+	}
+	for _, backoff := range []time.Duration{minBackoff, maxBackoff} {
+		if backoff < 0 || backoff > maxRetryBackoff {
+			return nil, fmt.Errorf("invalid retry backoff %s: must be between 0s and %s", backoff, maxRetryBackoff)
 		}
 	}
+	if minBackoff >= maxBackoff {
+		return nil, fmt.Errorf("minimum backoff (%s) must be less than maximum backoff (%s)", minBackoff, maxBackoff)
+	}
+	return &pubsubpb.RetryPolicy{
+		MinimumBackoff: durationpb.New(minBackoff),
+		MaximumBackoff: durationpb.New(maxBackoff),
+	}, nil
+}
 
-	// Build full resource names
-	subName := "projects/" + projectID + "/subscriptions/" + shortSubID
-	topicName := "projects/" + projectID + "/topics/" + shortTopicID
+// CreateSubscriptionWithConfig creates a new subscription with full configuration support
+func CreateSubscriptionWithConfig(ctx context.Context, client *pubsub.Client, projectID, topicID, subID string, config SubscriptionConfig) error {
+	// Normalize names (short IDs or full paths)
+	_, subName := NormalizeName(projectID, "subscription", subID)
+	_, topicName := NormalizeName(projectID, "topic", topicID)
+	if err := validateResourceID(projectID, "subscription", subID); err != nil {
+		return err
+	}
 
-	// Verify topic exists before creating subscription
-	topicReq := &pubsubpb.GetTopicRequest{
-		Topic: topicName,
+	if config.PushConfig != nil {
+		if err := validateOIDCToken(config.PushConfig.OIDCToken); err != nil {
+			return err
+		}
 	}
-	_, err := client.TopicAdminClient.GetTopic(ctx, topicReq)
-	if err != nil {
-		return fmt.Errorf("topic %s does not exist or you don't have permission to access it: %w", topicName, err)
+
+	// Verify topic exists before creating subscription (cached listings skip the GetTopic call)
+	if err := ensureTopicExists(ctx, client, topicName); err != nil {
+		return err
 	}
 
 	// Create subscription using Subscription object directly (v2 API pattern)
@@ -384,30 +503,21 @@ func CreateSubscriptionWithConfig(ctx context.Context, client *pubsub.Client, pr
 	}
 
 	// Set expiration policy if provided
-	if config.ExpirationPolicy != nil && config.ExpirationPolicy.TTL != "" {
-		ttl, err := time.ParseDuration(config.ExpirationPolicy.TTL)
+	if config.ExpirationPolicy != nil {
+		expirationPolicy, err := parseExpirationTTL(config.ExpirationPolicy.TTL)
 		if err != nil {
-			return fmt.Errorf("invalid expiration policy TTL format: %w", err)
-		}
-		req.ExpirationPolicy = &pubsubpb.ExpirationPolicy{
-			Ttl: durationpb.New(ttl),
+			return err
 		}
+		req.ExpirationPolicy = expirationPolicy
 	}
 
 	// Set retry policy if provided
 	if config.RetryPolicy != nil {
-		minBackoff, err := time.ParseDuration(config.RetryPolicy.MinimumBackoff)
-		if err != nil {
-			return fmt.Errorf("invalid minimum backoff format: %w", err)
-		}
-		maxBackoff, err := time.ParseDuration(config.RetryPolicy.MaximumBackoff)
+		retryPolicy, err := retryPolicyToProto(config.RetryPolicy)
 		if err != nil {
-			return fmt.Errorf("invalid maximum backoff format: %w", err)
-		}
-		req.RetryPolicy = &pubsubpb.RetryPolicy{
-			MinimumBackoff: durationpb.New(minBackoff),
-			MaximumBackoff: durationpb.New(maxBackoff),
+			return err
 		}
+		req.RetryPolicy = retryPolicy
 	}
 
 	// Set enable ordering
@@ -416,6 +526,8 @@ func CreateSubscriptionWithConfig(ctx context.Context, client *pubsub.Client, pr
 	// Set exactly-once delivery
 	req.EnableExactlyOnceDelivery = config.EnableExactlyOnce
 
+	req.RetainAckedMessages = config.RetainAcked
+
 	// Set filter if provided
 	if config.Filter != "" {
 		req.Filter = config.Filter
@@ -429,6 +541,35 @@ func CreateSubscriptionWithConfig(ctx context.Context, client *pubsub.Client, pr
 		if len(config.PushConfig.Attributes) > 0 {
 			req.PushConfig.Attributes = config.PushConfig.Attributes
 		}
+		setOIDCToken(req.PushConfig, config.PushConfig.OIDCToken)
+	}
+
+	// Set BigQuery config if provided
+	if config.BigQueryConfig != nil && config.BigQueryConfig.Table != "" {
+		if req.PushConfig != nil {
+			return fmt.Errorf("a subscription cannot have both a push endpoint and a BigQuery table")
+		}
+		if err := ValidateBigQueryTable(config.BigQueryConfig.Table); err != nil {
+			return err
+		}
+		req.BigqueryConfig = &pubsubpb.BigQueryConfig{
+			Table:             config.BigQueryConfig.Table,
+			UseTopicSchema:    config.BigQueryConfig.UseTopicSchema,
+			WriteMetadata:     config.BigQueryConfig.WriteMetadata,
+			DropUnknownFields: config.BigQueryConfig.DropUnknownFields,
+		}
+	}
+
+	// Set Cloud Storage config if provided
+	if config.CloudStorageConfig != nil && config.CloudStorageConfig.Bucket != "" {
+		if req.PushConfig != nil || req.BigqueryConfig != nil {
+			return fmt.Errorf("a Cloud Storage subscription cannot also have a push endpoint or a BigQuery table")
+		}
+		cloudStorageConfig, err := buildCloudStorageConfig(config.CloudStorageConfig)
+		if err != nil {
+			return err
+		}
+		req.CloudStorageConfig = cloudStorageConfig
 	}
 
 	// Set dead letter policy if provided
@@ -446,7 +587,7 @@ func CreateSubscriptionWithConfig(ctx context.Context, client *pubsub.Client, pr
 		req.Labels = config.Labels
 	}
 
-	_, err = client.SubscriptionAdminClient.CreateSubscription(ctx, req)
+	_, err := client.SubscriptionAdminClient.CreateSubscription(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to create subscription %s for topic %s: %w. Ensure you have 'pubsub.subscriptions.create' permission", subName, topicName, err)
 	}
@@ -454,14 +595,16 @@ func CreateSubscriptionWithConfig(ctx context.Context, client *pubsub.Client, pr
 	return nil
 }
 
-// SeekToTimestampAdmin seeks a subscription to a specific timestamp.
+// defaultMessageRetention is how long Pub/Sub retains messages when a subscription does not set a retention duration
+const defaultMessageRetention = 7 * 24 * time.Hour
+
+// SeekSubscriptionToTime seeks a subscription to a specific timestamp.
 // All messages published after the timestamp will be marked as unacknowledged and redelivered.
-func SeekToTimestampAdmin(ctx context.Context, client *pubsub.Client, projectID, subID string, timestamp time.Time) error {
+// The timestamp must not be older than the subscription's message retention window. A future timestamp
+// is allowed and acknowledges every message published before it.
+func SeekSubscriptionToTime(ctx context.Context, client *pubsub.Client, projectID, subID string, t time.Time) error {
 	// Normalize subscription ID
-	subName := subID
-	if !strings.HasPrefix(subID, "projects/") {
-		subName = "projects/" + projectID + "/subscriptions/" + subID
-	}
+	_, subName := NormalizeName(projectID, "subscription", subID)
 
 	// Verify subscription exists and is a pull subscription
 	getReq := &pubsubpb.GetSubscriptionRequest{
@@ -477,11 +620,15 @@ func SeekToTimestampAdmin(ctx context.Context, client *pubsub.Client, projectID,
 		return fmt.Errorf("cannot seek push subscription %s; seek is only supported for pull subscriptions", subID)
 	}
 
+	if err := validateSeekTime(sub, t, time.Now()); err != nil {
+		return err
+	}
+
 	// Create seek request with timestamp
 	seekReq := &pubsubpb.SeekRequest{
 		Subscription: subName,
 		Target: &pubsubpb.SeekRequest_Time{
-			Time: timestamppb.New(timestamp),
+			Time: timestamppb.New(t),
 		},
 	}
 
@@ -493,47 +640,56 @@ func SeekToTimestampAdmin(ctx context.Context, client *pubsub.Client, projectID,
 	return nil
 }
 
-// SeekToSnapshotAdmin seeks a subscription to a snapshot.
-// All messages in the snapshot will be marked as unacknowledged and redelivered.
-func SeekToSnapshotAdmin(ctx context.Context, client *pubsub.Client, projectID, subID, snapshotID string) error {
-	// Normalize subscription ID
-	subName := subID
-	if !strings.HasPrefix(subID, "projects/") {
-		subName = "projects/" + projectID + "/subscriptions/" + subID
-	}
-
-	// Normalize snapshot ID
-	snapshotName := snapshotID
-	if !strings.HasPrefix(snapshotID, "projects/") {
-		snapshotName = "projects/" + projectID + "/snapshots/" + snapshotID
-	}
+// PurgeSubscriptionAdmin discards a subscription's backlog by seeking it to now and returns the seek time
+// Only attached pull and push subscriptions can be purged. An emulator without seek support fails with
+// models.ErrSeekNotSupported.
+func PurgeSubscriptionAdmin(ctx context.Context, client *pubsub.Client, projectID, subID string) (time.Time, error) {
+	_, subName := NormalizeName(projectID, "subscription", subID)
 
-	// Verify subscription exists and is a pull subscription
-	getReq := &pubsubpb.GetSubscriptionRequest{
-		Subscription: subName,
-	}
-	sub, err := client.SubscriptionAdminClient.GetSubscription(ctx, getReq)
+	sub, err := client.SubscriptionAdminClient.GetSubscription(ctx, &pubsubpb.GetSubscriptionRequest{Subscription: subName})
 	if err != nil {
-		return fmt.Errorf("failed to get subscription: %w", err)
+		return time.Time{}, fmt.Errorf("failed to get subscription: %w", err)
 	}
 
-	// Check if it's a push subscription
-	if sub.PushConfig != nil && sub.PushConfig.PushEndpoint != "" {
-		return fmt.Errorf("cannot seek push subscription %s; seek is only supported for pull subscriptions", subID)
+	var info SubscriptionInfo
+	applySubscriptionType(&info, sub)
+	if info.Detached {
+		return time.Time{}, fmt.Errorf("cannot purge detached subscription %s", subID)
+	}
+	if info.SubscriptionType != "pull" && info.SubscriptionType != "push" {
+		return time.Time{}, fmt.Errorf("cannot purge %s subscription %s; only pull and push subscriptions support seek", info.SubscriptionType, subID)
 	}
 
-	// Create seek request with snapshot
+	now := time.Now()
 	seekReq := &pubsubpb.SeekRequest{
 		Subscription: subName,
-		Target: &pubsubpb.SeekRequest_Snapshot{
-			Snapshot: snapshotName,
+		Target: &pubsubpb.SeekRequest_Time{
+			Time: timestamppb.New(now),
 		},
 	}
+	if _, err := client.SubscriptionAdminClient.Seek(ctx, seekReq); err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return time.Time{}, models.ErrSeekNotSupported
+		}
+		return time.Time{}, fmt.Errorf("failed to purge subscription: %w", err)
+	}
+	return now, nil
+}
 
-	_, err = client.SubscriptionAdminClient.Seek(ctx, seekReq)
-	if err != nil {
-		return fmt.Errorf("failed to seek subscription to snapshot: %w", err)
+// validateSeekTime checks that t is not before the start of the subscription's retention window
+// Topic message retention, when longer, extends the window. Future timestamps are valid seek targets.
+func validateSeekTime(sub *pubsubpb.Subscription, t, now time.Time) error {
+	retention := defaultMessageRetention
+	if sub.MessageRetentionDuration != nil {
+		retention = sub.MessageRetentionDuration.AsDuration()
+	}
+	if sub.TopicMessageRetentionDuration != nil && sub.TopicMessageRetentionDuration.AsDuration() > retention {
+		retention = sub.TopicMessageRetentionDuration.AsDuration()
 	}
 
+	if earliest := now.Add(-retention); t.Before(earliest) {
+		return fmt.Errorf("cannot seek to %s: outside the message retention window of %s (earliest seek time is %s)",
+			t.Format(time.RFC3339), retention, earliest.Format(time.RFC3339))
+	}
 	return nil
 }
@@ -0,0 +1,358 @@
+package admin
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestValidateSeekTime(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	oneDay := &pubsubpb.Subscription{MessageRetentionDuration: durationpb.New(24 * time.Hour)}
+	withTopicRetention := &pubsubpb.Subscription{
+		MessageRetentionDuration:      durationpb.New(24 * time.Hour),
+		TopicMessageRetentionDuration: durationpb.New(72 * time.Hour),
+	}
+
+	tests := []struct {
+		name    string
+		sub     *pubsubpb.Subscription
+		t       time.Time
+		wantErr bool
+	}{
+		{"within retention", oneDay, now.Add(-time.Hour), false},
+		{"now", oneDay, now, false},
+		{"older than retention", oneDay, now.Add(-25 * time.Hour), true},
+		{"future", oneDay, now.Add(time.Hour), false},
+		{"topic retention extends window", withTopicRetention, now.Add(-48 * time.Hour), false},
+		{"default retention", &pubsubpb.Subscription{}, now.Add(-6 * 24 * time.Hour), false},
+		{"older than default retention", &pubsubpb.Subscription{}, now.Add(-8 * 24 * time.Hour), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSeekTime(tt.sub, tt.t, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateSeekTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateBigQueryTable(t *testing.T) {
+	tests := []struct {
+		table   string
+		wantErr bool
+	}{
+		{"my-project.analytics.events", false},
+		{"my-project:analytics.events", false},
+		{"analytics.events", true},
+		{"my-project.analytics.", true},
+		{"a.b.c.d", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		if err := ValidateBigQueryTable(tt.table); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateBigQueryTable(%q) error = %v, wantErr %v", tt.table, err, tt.wantErr)
+		}
+	}
+}
+
+func TestReadOnlySubscriptionFields(t *testing.T) {
+	tests := []struct {
+		field    string
+		readOnly bool
+	}{
+		{"topic", true},
+		{"enableOrdering", true},
+		{"ackDeadline", false},
+		{"enableExactlyOnce", false},
+		{"filter", false},
+		{"retryPolicy", false},
+		{"deadLetterPolicy", false},
+		{"labels", false},
+	}
+	fields := ReadOnlySubscriptionFields()
+	for _, tt := range tests {
+		if got := slices.Contains(fields, tt.field); got != tt.readOnly {
+			t.Errorf("ReadOnlySubscriptionFields() contains %q = %v, want %v", tt.field, got, tt.readOnly)
+		}
+	}
+
+	// Callers get their own copy
+	fields[0] = "changed"
+	if got := ReadOnlySubscriptionFields(); got[0] != "topic" {
+		t.Errorf("ReadOnlySubscriptionFields()[0] after modifying a previous result = %q, want topic", got[0])
+	}
+}
+
+func TestApplySubscriptionType(t *testing.T) {
+	tests := []struct {
+		name string
+		sub  *pubsubpb.Subscription
+		want SubscriptionInfo
+	}{
+		{
+			name: "pull",
+			sub:  &pubsubpb.Subscription{},
+			want: SubscriptionInfo{SubscriptionType: "pull"},
+		},
+		{
+			name: "push",
+			sub:  &pubsubpb.Subscription{PushConfig: &pubsubpb.PushConfig{PushEndpoint: "https://example.com/push"}},
+			want: SubscriptionInfo{SubscriptionType: "push", PushEndpoint: "https://example.com/push"},
+		},
+		{
+			name: "push config without endpoint",
+			sub:  &pubsubpb.Subscription{PushConfig: &pubsubpb.PushConfig{}},
+			want: SubscriptionInfo{SubscriptionType: "pull"},
+		},
+		{
+			name: "bigquery",
+			sub:  &pubsubpb.Subscription{BigqueryConfig: &pubsubpb.BigQueryConfig{Table: "p.d.t"}},
+			want: SubscriptionInfo{SubscriptionType: "bigquery", BigQueryTable: "p.d.t"},
+		},
+		{
+			name: "cloud storage",
+			sub:  &pubsubpb.Subscription{CloudStorageConfig: &pubsubpb.CloudStorageConfig{Bucket: "archive"}},
+			want: SubscriptionInfo{SubscriptionType: "cloudstorage", CloudStorageBucket: "archive"},
+		},
+		{
+			name: "detached",
+			sub:  &pubsubpb.Subscription{Detached: true},
+			want: SubscriptionInfo{SubscriptionType: "pull", Detached: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info SubscriptionInfo
+			applySubscriptionType(&info, tt.sub)
+			if !reflect.DeepEqual(info, tt.want) {
+				t.Errorf("applySubscriptionType() = %+v, want %+v", info, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetachSubscriptionAdmin(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	if err := CreateSubscriptionAdmin(ctx, client, "p", "orders", "orders-sub", 0); err != nil {
+		t.Fatalf("CreateSubscriptionAdmin() error = %v", err)
+	}
+
+	if err := DetachSubscriptionAdmin(ctx, client, "p", "orders-sub"); err != nil {
+		t.Errorf("DetachSubscriptionAdmin() error = %v", err)
+	}
+	if err := DetachSubscriptionAdmin(ctx, client, "p", "missing"); err == nil {
+		t.Error("DetachSubscriptionAdmin(missing) error = nil, want error")
+	}
+}
+
+func TestPurgeSubscriptionAdmin(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	if err := CreateSubscriptionAdmin(ctx, client, "p", "orders", "orders-sub", 0); err != nil {
+		t.Fatalf("CreateSubscriptionAdmin() error = %v", err)
+	}
+
+	publisher := client.Publisher("orders")
+	defer publisher.Stop()
+	publish := func(data string) {
+		t.Helper()
+		if _, err := publisher.Publish(ctx, &pubsub.Message{Data: []byte(data)}).Get(ctx); err != nil {
+			t.Fatalf("Publish(%s) error = %v", data, err)
+		}
+	}
+	for _, data := range []string{"a", "b", "c"} {
+		publish(data)
+	}
+
+	before := time.Now()
+	purgedAt, err := PurgeSubscriptionAdmin(ctx, client, "p", "orders-sub")
+	if err != nil {
+		t.Fatalf("PurgeSubscriptionAdmin() error = %v", err)
+	}
+	if purgedAt.Before(before) || purgedAt.After(time.Now()) {
+		t.Errorf("PurgeSubscriptionAdmin() time = %v, want between %v and now", purgedAt, before)
+	}
+
+	// Only a message published after the purge is delivered
+	publish("after")
+	receiveCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	var received []string
+	var mu sync.Mutex
+	err = client.Subscriber("orders-sub").Receive(receiveCtx, func(_ context.Context, msg *pubsub.Message) {
+		msg.Ack()
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, string(msg.Data))
+	})
+	if err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 1 || received[0] != "after" {
+		t.Errorf("messages received after purge = %v, want only the one published after it", received)
+	}
+
+	if _, err := PurgeSubscriptionAdmin(ctx, client, "p", "missing"); err == nil {
+		t.Error("PurgeSubscriptionAdmin(missing) error = nil, want error")
+	}
+}
+
+func TestPushOIDCToken(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	token := &models.OIDCToken{ServiceAccountEmail: "pusher@p.iam.gserviceaccount.com", Audience: "https://example.com"}
+	config := SubscriptionConfig{AckDeadline: 10, PushConfig: &models.PushConfig{Endpoint: "https://example.com/push", OIDCToken: token}}
+	if err := CreateSubscriptionWithConfig(ctx, client, "p", "orders", "orders-push", config); err != nil {
+		t.Fatalf("CreateSubscriptionWithConfig() error = %v", err)
+	}
+
+	info, err := GetSubscriptionMetadataAdmin(ctx, client, "p", "orders-push")
+	if err != nil {
+		t.Fatalf("GetSubscriptionMetadataAdmin() error = %v", err)
+	}
+	if info.PushOIDCToken == nil || *info.PushOIDCToken != *token {
+		t.Errorf("PushOIDCToken = %+v, want %+v", info.PushOIDCToken, token)
+	}
+
+	badConfig := SubscriptionConfig{AckDeadline: 10, PushConfig: &models.PushConfig{Endpoint: "https://example.com/push",
+		OIDCToken: &models.OIDCToken{ServiceAccountEmail: "pusher"}}}
+	if err := CreateSubscriptionWithConfig(ctx, client, "p", "orders", "orders-bad", badConfig); err == nil {
+		t.Error("CreateSubscriptionWithConfig(malformed email) error = nil, want error")
+	}
+
+	if err := UpdateSubscriptionAdmin(ctx, client, "p", "orders-push", SubscriptionUpdateParams{OIDCToken: &models.OIDCToken{}}); err != nil {
+		t.Fatalf("UpdateSubscriptionAdmin(remove token) error = %v", err)
+	}
+	info, err = GetSubscriptionMetadataAdmin(ctx, client, "p", "orders-push")
+	if err != nil {
+		t.Fatalf("GetSubscriptionMetadataAdmin() error = %v", err)
+	}
+	if info.PushOIDCToken != nil || info.PushEndpoint != "https://example.com/push" {
+		t.Errorf("after removal: token = %+v, endpoint = %q, want no token and the endpoint kept", info.PushOIDCToken, info.PushEndpoint)
+	}
+
+	if err := CreateSubscriptionAdmin(ctx, client, "p", "orders", "orders-pull", 0); err != nil {
+		t.Fatalf("CreateSubscriptionAdmin() error = %v", err)
+	}
+	if err := UpdateSubscriptionAdmin(ctx, client, "p", "orders-pull", SubscriptionUpdateParams{OIDCToken: token}); err == nil {
+		t.Error("UpdateSubscriptionAdmin(token on pull subscription) error = nil, want error")
+	}
+}
+
+func TestUpdateSubscriptionAdmin_ExpirationTTL(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	if err := CreateSubscriptionAdmin(ctx, client, "p", "orders", "orders-sub", 0); err != nil {
+		t.Fatalf("CreateSubscriptionAdmin() error = %v", err)
+	}
+
+	ttl := "720h"
+	if err := UpdateSubscriptionAdmin(ctx, client, "p", "orders-sub", SubscriptionUpdateParams{ExpirationTTL: &ttl}); err != nil {
+		t.Fatalf("UpdateSubscriptionAdmin() error = %v", err)
+	}
+	info, err := GetSubscriptionMetadataAdmin(ctx, client, "p", "orders-sub")
+	if err != nil {
+		t.Fatalf("GetSubscriptionMetadataAdmin() error = %v", err)
+	}
+	if info.ExpirationTTL != "720h0m0s" {
+		t.Errorf("ExpirationTTL = %q, want 720h0m0s", info.ExpirationTTL)
+	}
+
+	for _, invalid := range []string{"30 days", "-1h", "0s"} {
+		if err := UpdateSubscriptionAdmin(ctx, client, "p", "orders-sub", SubscriptionUpdateParams{ExpirationTTL: &invalid}); err == nil {
+			t.Errorf("UpdateSubscriptionAdmin(ExpirationTTL %q) error = nil, want error", invalid)
+		}
+	}
+
+	never := ""
+	if err := UpdateSubscriptionAdmin(ctx, client, "p", "orders-sub", SubscriptionUpdateParams{ExpirationTTL: &never}); err != nil {
+		t.Fatalf("UpdateSubscriptionAdmin(never expire) error = %v", err)
+	}
+	info, err = GetSubscriptionMetadataAdmin(ctx, client, "p", "orders-sub")
+	if err != nil {
+		t.Fatalf("GetSubscriptionMetadataAdmin() error = %v", err)
+	}
+	if info.ExpirationTTL != "" {
+		t.Errorf("ExpirationTTL after clearing = %q, want empty", info.ExpirationTTL)
+	}
+}
+
+func TestUpdateSubscriptionAdmin_RetryPolicy(t *testing.T) {
+	ctx := context.Background()
+	client, _ := pubsubtest.NewClient(t)
+
+	if err := CreateTopicAdmin(ctx, client, "p", "orders", "", nil, nil); err != nil {
+		t.Fatalf("CreateTopicAdmin() error = %v", err)
+	}
+	if err := CreateSubscriptionAdmin(ctx, client, "p", "orders", "orders-sub", 0); err != nil {
+		t.Fatalf("CreateSubscriptionAdmin() error = %v", err)
+	}
+
+	policy := &models.RetryPolicy{MinimumBackoff: "10s", MaximumBackoff: "5m"}
+	if err := UpdateSubscriptionAdmin(ctx, client, "p", "orders-sub", SubscriptionUpdateParams{RetryPolicy: policy}); err != nil {
+		t.Fatalf("UpdateSubscriptionAdmin() error = %v", err)
+	}
+	info, err := GetSubscriptionMetadataAdmin(ctx, client, "p", "orders-sub")
+	if err != nil {
+		t.Fatalf("GetSubscriptionMetadataAdmin() error = %v", err)
+	}
+	want := models.RetryPolicy{MinimumBackoff: "10s", MaximumBackoff: "5m0s"}
+	if info.RetryPolicy == nil || *info.RetryPolicy != want {
+		t.Errorf("RetryPolicy = %+v, want %+v", info.RetryPolicy, want)
+	}
+
+	invalid := []models.RetryPolicy{
+		{MinimumBackoff: "1m", MaximumBackoff: "1m"},
+		{MinimumBackoff: "2m", MaximumBackoff: "1m"},
+		{MinimumBackoff: "ten seconds", MaximumBackoff: "1m"},
+		{MinimumBackoff: "10s", MaximumBackoff: ""},
+		{MinimumBackoff: "10s", MaximumBackoff: "11m"},
+	}
+	for _, p := range invalid {
+		if err := UpdateSubscriptionAdmin(ctx, client, "p", "orders-sub", SubscriptionUpdateParams{RetryPolicy: &p}); err == nil {
+			t.Errorf("UpdateSubscriptionAdmin(RetryPolicy %+v) error = nil, want error", p)
+		}
+	}
+
+	if err := UpdateSubscriptionAdmin(ctx, client, "p", "orders-sub", SubscriptionUpdateParams{RetryPolicy: &models.RetryPolicy{}}); err != nil {
+		t.Fatalf("UpdateSubscriptionAdmin(remove retry policy) error = %v", err)
+	}
+	info, err = GetSubscriptionMetadataAdmin(ctx, client, "p", "orders-sub")
+	if err != nil {
+		t.Fatalf("GetSubscriptionMetadataAdmin() error = %v", err)
+	}
+	if info.RetryPolicy != nil {
+		t.Errorf("RetryPolicy after removal = %+v, want nil", info.RetryPolicy)
+	}
+}
@@ -0,0 +1,116 @@
+// Package admin provides functions for listing and managing Pub/Sub topics and subscriptions
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+)
+
+// topicExistenceTTL bounds how long a listed topic is trusted to exist without a GetTopic call
+const topicExistenceTTL = 30 * time.Second
+
+// topicExistenceCache remembers recently seen topics per client (by full resource name) so subscription
+// creation can skip the GetTopic round-trip
+// Entries are keyed by client, so a topic seen on one connection (e.g. the emulator) is never trusted on another.
+type topicExistenceCache struct {
+	mu      sync.Mutex
+	expires map[*pubsub.Client]map[string]time.Time
+	now     func() time.Time
+}
+
+var topicExistence = &topicExistenceCache{
+	expires: make(map[*pubsub.Client]map[string]time.Time),
+	now:     time.Now,
+}
+
+// RecordTopicsExist marks topics from a fresh listing with client as existing, replacing previous entries
+// for the same client and project so topics missing from the listing are no longer trusted
+func RecordTopicsExist(client *pubsub.Client, projectID string, topics []TopicInfo) {
+	topicExistence.record(client, projectID, topics)
+}
+
+// ClearTopicExistenceCache drops all cached topics (used when the connection changes)
+func ClearTopicExistenceCache() {
+	topicExistence.clear()
+}
+
+func (c *topicExistenceCache) record(client *pubsub.Client, projectID string, topics []TopicInfo) {
+	prefix := "projects/" + projectID + "/topics/"
+	expiry := c.now().Add(topicExistenceTTL)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := c.expires[client]
+	if expires == nil {
+		expires = make(map[string]time.Time)
+		c.expires[client] = expires
+	}
+	for name := range expires {
+		if strings.HasPrefix(name, prefix) {
+			delete(expires, name)
+		}
+	}
+	for _, topic := range topics {
+		_, topicName := NormalizeName(projectID, "topic", topic.Name)
+		expires[topicName] = expiry
+	}
+}
+
+func (c *topicExistenceCache) exists(client *pubsub.Client, topicName string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiry, ok := c.expires[client][topicName]
+	if !ok {
+		return false
+	}
+	if c.now().After(expiry) {
+		delete(c.expires[client], topicName)
+		return false
+	}
+	return true
+}
+
+func (c *topicExistenceCache) mark(client *pubsub.Client, topicName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.expires[client] == nil {
+		c.expires[client] = make(map[string]time.Time)
+	}
+	c.expires[client][topicName] = c.now().Add(topicExistenceTTL)
+}
+
+func (c *topicExistenceCache) forget(client *pubsub.Client, topicName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.expires[client], topicName)
+}
+
+func (c *topicExistenceCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.expires = make(map[*pubsub.Client]map[string]time.Time)
+}
+
+// ensureTopicExists verifies a topic exists, consulting the existence cache before calling GetTopic
+func ensureTopicExists(ctx context.Context, client *pubsub.Client, topicName string) error {
+	if topicExistence.exists(client, topicName) {
+		return nil
+	}
+
+	_, err := client.TopicAdminClient.GetTopic(ctx, &pubsubpb.GetTopicRequest{Topic: topicName})
+	if err != nil {
+		return fmt.Errorf("topic %s does not exist or you don't have permission to access it: %w", topicName, err)
+	}
+
+	topicExistence.mark(client, topicName)
+	return nil
+}
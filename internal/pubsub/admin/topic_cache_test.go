@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+)
+
+func TestTopicExistenceCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &topicExistenceCache{
+		expires: make(map[*pubsub.Client]map[string]time.Time),
+		now:     func() time.Time { return now },
+	}
+	client, emulator := &pubsub.Client{}, &pubsub.Client{}
+
+	c.record(client, "my-project", []TopicInfo{
+		{Name: "projects/my-project/topics/orders"},
+		{Name: "payments"},
+	})
+	c.record(client, "other", []TopicInfo{{Name: "projects/other/topics/events"}})
+
+	for _, name := range []string{"projects/my-project/topics/orders", "projects/my-project/topics/payments", "projects/other/topics/events"} {
+		if !c.exists(client, name) {
+			t.Errorf("exists(%q) = false, want true", name)
+		}
+	}
+	if c.exists(emulator, "projects/my-project/topics/orders") {
+		t.Error("exists(orders) on another client = true, want false")
+	}
+
+	// A fresh listing replaces the project's entries but leaves other projects alone
+	c.record(client, "my-project", []TopicInfo{{Name: "orders"}})
+	if c.exists(client, "projects/my-project/topics/payments") {
+		t.Error("exists(payments) = true after it was missing from a listing, want false")
+	}
+	if !c.exists(client, "projects/other/topics/events") {
+		t.Error("exists(events) = false, want entries of other projects to be kept")
+	}
+
+	c.mark(emulator, "projects/my-project/topics/payments")
+	if !c.exists(emulator, "projects/my-project/topics/payments") || c.exists(client, "projects/my-project/topics/payments") {
+		t.Error("mark() on one client changed the other client's entries")
+	}
+
+	c.forget(client, "projects/my-project/topics/orders")
+	if c.exists(client, "projects/my-project/topics/orders") {
+		t.Error("exists(orders) = true after forget, want false")
+	}
+
+	c.mark(client, "projects/my-project/topics/orders")
+	now = now.Add(topicExistenceTTL + time.Second)
+	if c.exists(client, "projects/my-project/topics/orders") {
+		t.Error("exists(orders) = true after TTL, want false")
+	}
+
+	c.mark(client, "projects/my-project/topics/orders")
+	c.clear()
+	if c.exists(client, "projects/my-project/topics/orders") {
+		t.Error("exists(orders) = true after clear, want false")
+	}
+}
@@ -11,15 +11,34 @@ import (
 	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
 	"google.golang.org/api/iterator"
 	"google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"pubsub-gui/internal/models"
 )
 
 // TopicInfo represents topic metadata
 type TopicInfo struct {
-	Name             string `json:"name"`
-	DisplayName      string `json:"displayName"`
-	MessageRetention string `json:"messageRetention,omitempty"`
+	Name             string            `json:"name"`
+	DisplayName      string            `json:"displayName"`
+	MessageRetention string            `json:"messageRetention,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	SchemaSettings   *SchemaSettings   `json:"schemaSettings,omitempty"`
+
+	MessageStoragePolicy *models.MessageStoragePolicy `json:"messageStoragePolicy,omitempty"` // nil when messages may be stored in any region
+}
+
+// SchemaSettings binds a topic to a schema; published messages must match it
+type SchemaSettings struct {
+	Schema   string `json:"schema"`   // Schema ID or full name
+	Encoding string `json:"encoding"` // "JSON" or "BINARY"
+}
+
+// TopicUpdateParams represents the topic fields to update; nil fields are left unchanged
+type TopicUpdateParams struct {
+	Labels                   map[string]string `json:"labels,omitempty"`                   // Replaces all labels (an empty map removes them)
+	MessageRetentionDuration *string           `json:"messageRetentionDuration,omitempty"` // Empty string clears the retention
+
+	MessageStoragePolicy *models.MessageStoragePolicy `json:"messageStoragePolicy,omitempty"` // Replaces the allowed regions (no regions removes the restriction)
 }
 
 // ListTopicsAdmin lists all topics in the project using the v2 client
@@ -49,17 +68,7 @@ func ListTopicsAdmin(ctx context.Context, client *pubsub.Client, projectID strin
 			return nil, err
 		}
 
-		topicInfo := TopicInfo{
-			Name:        topic.Name,
-			DisplayName: extractDisplayName(topic.Name),
-		}
-
-		// Get message retention if available
-		if topic.MessageRetentionDuration != nil {
-			topicInfo.MessageRetention = topic.MessageRetentionDuration.AsDuration().String()
-		}
-
-		topics = append(topics, topicInfo)
+		topics = append(topics, topicInfoFromProto(topic, extractDisplayName(topic.Name)))
 	}
 
 	return topics, nil
@@ -67,7 +76,7 @@ func ListTopicsAdmin(ctx context.Context, client *pubsub.Client, projectID strin
 
 // GetTopicMetadataAdmin retrieves metadata for a specific topic
 func GetTopicMetadataAdmin(ctx context.Context, client *pubsub.Client, projectID, topicID string) (TopicInfo, error) {
-	topicName := "projects/" + projectID + "/topics/" + topicID
+	shortTopicID, topicName := NormalizeName(projectID, "topic", topicID)
 
 	req := &pubsubpb.GetTopicRequest{
 		Topic: topicName,
@@ -78,38 +87,69 @@ func GetTopicMetadataAdmin(ctx context.Context, client *pubsub.Client, projectID
 		return TopicInfo{}, err
 	}
 
+	return topicInfoFromProto(topic, shortTopicID), nil
+}
+
+// topicInfoFromProto converts an API topic to TopicInfo
+func topicInfoFromProto(topic *pubsubpb.Topic, displayName string) TopicInfo {
 	topicInfo := TopicInfo{
 		Name:        topic.Name,
-		DisplayName: topicID,
+		DisplayName: displayName,
 	}
 
+	// Get message retention if available
 	if topic.MessageRetentionDuration != nil {
 		topicInfo.MessageRetention = topic.MessageRetentionDuration.AsDuration().String()
 	}
 
-	return topicInfo, nil
-}
+	if len(topic.Labels) > 0 {
+		topicInfo.Labels = topic.Labels
+	}
 
-// CreateTopicAdmin creates a new topic with optional message retention duration
-func CreateTopicAdmin(ctx context.Context, client *pubsub.Client, projectID, topicID string, messageRetentionDuration string) error {
-	// Normalize topic ID (extract short name if full path provided)
-	shortTopicID := topicID
-	if strings.HasPrefix(topicID, "projects/") {
-		// Extract topic ID from full path: projects/{project}/topics/{topic-id}
-		parts := strings.Split(topicID, "/")
-		if len(parts) >= 4 && parts[0] == "projects" && parts[2] == "topics" {
-			shortTopicID = parts[3]
+	if topic.SchemaSettings != nil && topic.SchemaSettings.Schema != "" {
+		topicInfo.SchemaSettings = &SchemaSettings{
+			Schema:   topic.SchemaSettings.Schema,
+			Encoding: topic.SchemaSettings.Encoding.String(),
 		}
 	}
 
-	// Build full resource name
-	topicName := "projects/" + projectID + "/topics/" + shortTopicID
+	topicInfo.MessageStoragePolicy = storagePolicyFromProto(topic.MessageStoragePolicy)
+
+	return topicInfo
+}
+
+// CreateTopicAdmin creates a new topic with optional message retention duration, labels and schema
+func CreateTopicAdmin(ctx context.Context, client *pubsub.Client, projectID, topicID string, messageRetentionDuration string, labels map[string]string, schemaSettings *SchemaSettings) error {
+	_, topicName := NormalizeName(projectID, "topic", topicID)
+	if err := validateResourceID(projectID, "topic", topicID); err != nil {
+		return err
+	}
+
+	if err := ValidateLabels(labels); err != nil {
+		return err
+	}
 
 	// Create topic using Topic object directly (v2 API pattern)
 	req := &pubsubpb.Topic{
 		Name: topicName,
 	}
 
+	if len(labels) > 0 {
+		req.Labels = labels
+	}
+
+	if schemaSettings != nil && schemaSettings.Schema != "" {
+		encoding, err := ParseSchemaEncoding(schemaSettings.Encoding)
+		if err != nil {
+			return err
+		}
+		_, schemaName := NormalizeName(projectID, "schema", schemaSettings.Schema)
+		req.SchemaSettings = &pubsubpb.SchemaSettings{
+			Schema:   schemaName,
+			Encoding: encoding,
+		}
+	}
+
 	// Set message retention duration if provided
 	if messageRetentionDuration != "" {
 		duration, err := time.ParseDuration(messageRetentionDuration)
@@ -127,13 +167,73 @@ func CreateTopicAdmin(ctx context.Context, client *pubsub.Client, projectID, top
 	return nil
 }
 
+// ParseSchemaEncoding converts "JSON" or "BINARY" (case-insensitive) to the API encoding
+func ParseSchemaEncoding(encoding string) (pubsubpb.Encoding, error) {
+	switch strings.ToUpper(strings.TrimSpace(encoding)) {
+	case "JSON":
+		return pubsubpb.Encoding_JSON, nil
+	case "BINARY":
+		return pubsubpb.Encoding_BINARY, nil
+	default:
+		return pubsubpb.Encoding_ENCODING_UNSPECIFIED, fmt.Errorf("invalid schema encoding %q: must be JSON or BINARY", encoding)
+	}
+}
+
+// UpdateTopicAdmin updates a topic's labels, message retention duration and/or message storage policy using a field mask
+func UpdateTopicAdmin(ctx context.Context, client *pubsub.Client, projectID, topicID string, params TopicUpdateParams) error {
+	_, topicName := NormalizeName(projectID, "topic", topicID)
+
+	topic := &pubsubpb.Topic{Name: topicName}
+	var updateMask []string
+
+	if params.Labels != nil {
+		if err := ValidateLabels(params.Labels); err != nil {
+			return err
+		}
+		topic.Labels = params.Labels
+		updateMask = append(updateMask, "labels")
+	}
+
+	if params.MessageRetentionDuration != nil {
+		if *params.MessageRetentionDuration != "" {
+			duration, err := time.ParseDuration(*params.MessageRetentionDuration)
+			if err != nil {
+				return fmt.Errorf("invalid message retention duration format: %w", err)
+			}
+			topic.MessageRetentionDuration = durationpb.New(duration)
+		}
+		updateMask = append(updateMask, "message_retention_duration")
+	}
+
+	if params.MessageStoragePolicy != nil {
+		policy, err := storagePolicyToProto(params.MessageStoragePolicy)
+		if err != nil {
+			return err
+		}
+		topic.MessageStoragePolicy = policy
+		updateMask = append(updateMask, "message_storage_policy")
+	}
+
+	// If no fields to update, return early
+	if len(updateMask) == 0 {
+		return fmt.Errorf("no fields specified for update")
+	}
+
+	_, err := client.TopicAdminClient.UpdateTopic(ctx, &pubsubpb.UpdateTopicRequest{
+		Topic:      topic,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: updateMask},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update topic %s: %w", topicName, err)
+	}
+
+	return nil
+}
+
 // DeleteTopicAdmin deletes a topic
 func DeleteTopicAdmin(ctx context.Context, client *pubsub.Client, projectID, topicID string) error {
 	// Normalize topic ID
-	topicName := topicID
-	if !strings.HasPrefix(topicID, "projects/") {
-		topicName = "projects/" + projectID + "/topics/" + topicID
-	}
+	_, topicName := NormalizeName(projectID, "topic", topicID)
 
 	deleteReq := &pubsubpb.DeleteTopicRequest{
 		Topic: topicName,
@@ -143,24 +243,18 @@ func DeleteTopicAdmin(ctx context.Context, client *pubsub.Client, projectID, top
 	if err != nil {
 		return fmt.Errorf("failed to delete topic: %w", err)
 	}
+	topicExistence.forget(client, topicName)
 
 	return nil
 }
 
 // CreateTopicWithConfig creates a new topic with full configuration support
 func CreateTopicWithConfig(ctx context.Context, client *pubsub.Client, projectID, topicID string, config models.TopicTemplateConfig) error {
-	// Normalize topic ID (extract short name if full path provided)
-	shortTopicID := topicID
-	if strings.HasPrefix(topicID, "projects/") {
-		parts := strings.Split(topicID, "/")
-		if len(parts) >= 4 && parts[0] == "projects" && parts[2] == "topics" {
-			shortTopicID = parts[3]
-		}
+	_, topicName := NormalizeName(projectID, "topic", topicID)
+	if err := validateResourceID(projectID, "topic", topicID); err != nil {
+		return err
 	}
 
-	// Build full resource name
-	topicName := "projects/" + projectID + "/topics/" + shortTopicID
-
 	// Create topic using Topic object directly (v2 API pattern)
 	req := &pubsubpb.Topic{
 		Name: topicName,
@@ -177,6 +271,9 @@ func CreateTopicWithConfig(ctx context.Context, client *pubsub.Client, projectID
 
 	// Set labels if provided
 	if len(config.Labels) > 0 {
+		if err := ValidateLabels(config.Labels); err != nil {
+			return err
+		}
 		req.Labels = config.Labels
 	}
 
@@ -186,13 +283,13 @@ func CreateTopicWithConfig(ctx context.Context, client *pubsub.Client, projectID
 	}
 
 	// Set message storage policy if provided
-	if config.MessageStoragePolicy != nil && len(config.MessageStoragePolicy.AllowedPersistenceRegions) > 0 {
-		req.MessageStoragePolicy = &pubsubpb.MessageStoragePolicy{
-			AllowedPersistenceRegions: config.MessageStoragePolicy.AllowedPersistenceRegions,
-		}
+	policy, err := storagePolicyToProto(config.MessageStoragePolicy)
+	if err != nil {
+		return err
 	}
+	req.MessageStoragePolicy = policy
 
-	_, err := client.TopicAdminClient.CreateTopic(ctx, req)
+	_, err = client.TopicAdminClient.CreateTopic(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to create topic %s: %w. Ensure you have 'pubsub.topics.create' permission", topicName, err)
 	}
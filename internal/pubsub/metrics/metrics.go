@@ -0,0 +1,182 @@
+// Package metrics queries Cloud Monitoring for Pub/Sub subscription metrics
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	monitoring "google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/option"
+)
+
+// Metric types used by the app
+const (
+	MetricNumUndelivered = "pubsub.googleapis.com/subscription/num_undelivered_messages"
+	MetricAckCount       = "pubsub.googleapis.com/subscription/ack_message_count"
+	MetricOldestUnacked  = "pubsub.googleapis.com/subscription/oldest_unacked_message_age"
+)
+
+// backlogLookback is how far back to look for the latest backlog sample
+// Pub/Sub metrics are sampled every 60s and can be delayed by a few minutes
+const backlogLookback = 10 * time.Minute
+
+// ErrNoData is returned when Cloud Monitoring has no samples for the requested metric
+var ErrNoData = errors.New("no metric data available")
+
+// NewService creates a Cloud Monitoring client using the given credentials
+func NewService(ctx context.Context, opts ...option.ClientOption) (*monitoring.Service, error) {
+	svc, err := monitoring.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create monitoring client: %w", err)
+	}
+	return svc, nil
+}
+
+// GetSubscriptionBacklog returns the latest num_undelivered_messages sample for a subscription
+func GetSubscriptionBacklog(ctx context.Context, svc *monitoring.Service, projectID, subscriptionID string) (int64, error) {
+	backlog, err := latestSample(ctx, svc, projectID, MetricNumUndelivered, subscriptionID)
+	if err != nil && !errors.Is(err, ErrNoData) {
+		return 0, fmt.Errorf("failed to query backlog metric: %w", err)
+	}
+	return backlog, err
+}
+
+// GetSubscriptionOldestUnackedAge returns the latest oldest_unacked_message_age sample for a subscription
+func GetSubscriptionOldestUnackedAge(ctx context.Context, svc *monitoring.Service, projectID, subscriptionID string) (time.Duration, error) {
+	seconds, err := latestSample(ctx, svc, projectID, MetricOldestUnacked, subscriptionID)
+	if err != nil && !errors.Is(err, ErrNoData) {
+		return 0, fmt.Errorf("failed to query oldest unacked age metric: %w", err)
+	}
+	return time.Duration(seconds) * time.Second, err
+}
+
+// latestSample returns the newest sample of a gauge metric for a subscription within backlogLookback
+func latestSample(ctx context.Context, svc *monitoring.Service, projectID, metricType, subscriptionID string) (int64, error) {
+	now := time.Now()
+	call := svc.Projects.TimeSeries.List("projects/" + projectID).
+		Filter(subscriptionFilter(metricType, subscriptionID)).
+		IntervalStartTime(now.Add(-backlogLookback).Format(time.RFC3339)).
+		IntervalEndTime(now.Format(time.RFC3339)).
+		Context(ctx)
+
+	resp, err := call.Do()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, ts := range resp.TimeSeries {
+		// Points are returned newest first
+		if len(ts.Points) > 0 && ts.Points[0].Value != nil {
+			return pointInt(ts.Points[0].Value), nil
+		}
+	}
+
+	return 0, ErrNoData
+}
+
+// GetSubscriptionAckRate returns the average acknowledged messages per second over the window
+func GetSubscriptionAckRate(ctx context.Context, svc *monitoring.Service, projectID, subscriptionID string, window time.Duration) (float64, error) {
+	now := time.Now()
+	call := svc.Projects.TimeSeries.List("projects/" + projectID).
+		Filter(subscriptionFilter(MetricAckCount, subscriptionID)).
+		IntervalStartTime(now.Add(-window).Format(time.RFC3339)).
+		IntervalEndTime(now.Format(time.RFC3339)).
+		AggregationAlignmentPeriod(fmt.Sprintf("%ds", int(window.Seconds()))).
+		AggregationPerSeriesAligner("ALIGN_RATE").
+		AggregationCrossSeriesReducer("REDUCE_SUM").
+		Context(ctx)
+
+	resp, err := call.Do()
+	if err != nil {
+		return 0, fmt.Errorf("failed to query ack rate metric: %w", err)
+	}
+
+	for _, ts := range resp.TimeSeries {
+		if len(ts.Points) > 0 && ts.Points[0].Value != nil {
+			return pointFloat(ts.Points[0].Value), nil
+		}
+	}
+
+	return 0, ErrNoData
+}
+
+// GetSubscriptionAckCount returns the number of messages acknowledged on a subscription over the window
+func GetSubscriptionAckCount(ctx context.Context, svc *monitoring.Service, projectID, subscriptionID string, window time.Duration) (int64, error) {
+	now := time.Now()
+	call := svc.Projects.TimeSeries.List("projects/" + projectID).
+		Filter(subscriptionFilter(MetricAckCount, subscriptionID)).
+		IntervalStartTime(now.Add(-window).Format(time.RFC3339)).
+		IntervalEndTime(now.Format(time.RFC3339)).
+		AggregationAlignmentPeriod(fmt.Sprintf("%ds", int(window.Seconds()))).
+		AggregationPerSeriesAligner("ALIGN_SUM").
+		AggregationCrossSeriesReducer("REDUCE_SUM").
+		Context(ctx)
+
+	resp, err := call.Do()
+	if err != nil {
+		return 0, fmt.Errorf("failed to query ack count metric: %w", err)
+	}
+
+	var total int64
+	found := false
+	for _, ts := range resp.TimeSeries {
+		for _, point := range ts.Points {
+			if point.Value != nil {
+				total += pointInt(point.Value)
+				found = true
+			}
+		}
+	}
+	if !found {
+		return 0, ErrNoData
+	}
+
+	return total, nil
+}
+
+// EstimateDrainSeconds returns how many seconds a backlog takes to clear at the given rate (messages/second)
+// drains is false when the rate is zero or negative and the backlog would never clear
+func EstimateDrainSeconds(backlog int64, rate float64) (seconds float64, drains bool) {
+	if backlog <= 0 {
+		return 0, true
+	}
+	if rate <= 0 || math.IsNaN(rate) {
+		return 0, false
+	}
+	return float64(backlog) / rate, true
+}
+
+// subscriptionFilter builds a monitoring filter for a metric on a single subscription
+func subscriptionFilter(metricType, subscriptionID string) string {
+	// Metric labels use the short subscription ID
+	if idx := strings.LastIndex(subscriptionID, "/"); idx >= 0 {
+		subscriptionID = subscriptionID[idx+1:]
+	}
+	return fmt.Sprintf(`metric.type = %q AND resource.type = "pubsub_subscription" AND resource.label.subscription_id = %q`, metricType, subscriptionID)
+}
+
+// pointInt returns a typed value as int64
+func pointInt(v *monitoring.TypedValue) int64 {
+	if v.Int64Value != nil {
+		return *v.Int64Value
+	}
+	if v.DoubleValue != nil {
+		return int64(*v.DoubleValue)
+	}
+	return 0
+}
+
+// pointFloat returns a typed value as float64
+func pointFloat(v *monitoring.TypedValue) float64 {
+	if v.DoubleValue != nil {
+		return *v.DoubleValue
+	}
+	if v.Int64Value != nil {
+		return float64(*v.Int64Value)
+	}
+	return 0
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEstimateDrainSeconds(t *testing.T) {
+	tests := []struct {
+		name        string
+		backlog     int64
+		rate        float64
+		wantSeconds float64
+		wantDrains  bool
+	}{
+		{name: "empty backlog", backlog: 0, rate: 0, wantSeconds: 0, wantDrains: true},
+		{name: "steady consumption", backlog: 1000, rate: 10, wantSeconds: 100, wantDrains: true},
+		{name: "no consumption", backlog: 1000, rate: 0, wantSeconds: 0, wantDrains: false},
+		{name: "negative rate", backlog: 1000, rate: -1, wantSeconds: 0, wantDrains: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seconds, drains := EstimateDrainSeconds(tt.backlog, tt.rate)
+			if seconds != tt.wantSeconds || drains != tt.wantDrains {
+				t.Errorf("EstimateDrainSeconds(%d, %v) = (%v, %v), want (%v, %v)",
+					tt.backlog, tt.rate, seconds, drains, tt.wantSeconds, tt.wantDrains)
+			}
+		})
+	}
+}
+
+func TestSubscriptionFilter_UsesShortID(t *testing.T) {
+	filter := subscriptionFilter(MetricNumUndelivered, "projects/my-project/subscriptions/orders-sub")
+	if !strings.Contains(filter, `resource.label.subscription_id = "orders-sub"`) {
+		t.Errorf("subscriptionFilter() = %q, want short subscription ID", filter)
+	}
+}
@@ -0,0 +1,172 @@
+// Package publisher provides functions for publishing messages to Pub/Sub topics
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+)
+
+// Batch publish limits
+const (
+	defaultBatchConcurrency = 10
+	maxBatchConcurrency     = 100
+	BatchProgressInterval   = 100 // Progress is reported every this many completed messages (and at the end)
+)
+
+// BatchMessageInput is a single message of a batch publish
+type BatchMessageInput struct {
+	Payload    string            `json:"payload"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// BatchFailure describes a message of a batch that could not be published
+type BatchFailure struct {
+	Index int    `json:"index"`          // Position in the input slice (record number for file publishes)
+	Line  int    `json:"line,omitempty"` // 1-based line in the source file; only set for file publishes
+	Error string `json:"error"`
+}
+
+// BatchPublishResult aggregates the outcome of a batch publish
+type BatchPublishResult struct {
+	Total      int            `json:"total"`
+	Succeeded  int            `json:"succeeded"`
+	Failed     int            `json:"failed"`
+	MessageIDs []string       `json:"messageIds"` // Index-aligned with the input; empty for failed messages
+	Failures   []BatchFailure `json:"failures"`
+	DurationMs int64          `json:"durationMs"`
+
+	SourceMessageIDs []string `json:"sourceMessageIds,omitempty"` // Index-aligned IDs of the replayed messages; only set by buffer replays
+}
+
+// normalizeBatchConcurrency applies the default and upper bound to a requested concurrency
+func normalizeBatchConcurrency(concurrency int) int {
+	if concurrency <= 0 {
+		return defaultBatchConcurrency
+	}
+	return min(concurrency, maxBatchConcurrency)
+}
+
+// PublishMessagesBatch publishes many messages to one topic using a bounded pool of `concurrency` workers
+// A failed message does not abort the batch. onProgress (optional) is called with the number of completed
+// messages every BatchProgressInterval messages and once at the end.
+func PublishMessagesBatch(ctx context.Context, client *pubsub.Client, topicID string, messages []BatchMessageInput, concurrency int, onProgress func(done, total int)) (BatchPublishResult, error) {
+	if client == nil {
+		return BatchPublishResult{}, fmt.Errorf("pub/sub client is nil")
+	}
+	if topicID == "" {
+		return BatchPublishResult{}, fmt.Errorf("topic ID cannot be empty")
+	}
+	if len(messages) == 0 {
+		return BatchPublishResult{}, fmt.Errorf("batch contains no messages")
+	}
+
+	result := runBatch(ctx, client, topicID, concurrency, func(send func(batchJob), _ func(BatchFailure)) int {
+		for index, msg := range messages {
+			send(batchJob{index: index, msg: msg})
+		}
+		return len(messages)
+	}, onProgress)
+	return result, nil
+}
+
+// batchJob is one message handed to the batch workers
+type batchJob struct {
+	index int
+	line  int // Source file line, 0 when not publishing from a file
+	msg   BatchMessageInput
+}
+
+// batchProducer sends the jobs of a batch and returns the number of records it produced
+// Records that cannot become messages are reported through fail instead of being sent.
+type batchProducer func(send func(batchJob), fail func(BatchFailure)) int
+
+// runBatch publishes the jobs of produce with a pool of `concurrency` workers sharing one topic publisher
+// onProgress gets the completed count and the number of records produced so far (the final total at the end).
+func runBatch(ctx context.Context, client *pubsub.Client, topicID string, concurrency int, produce batchProducer, onProgress func(done, total int)) BatchPublishResult {
+	start := time.Now()
+	result := BatchPublishResult{
+		MessageIDs: []string{},
+		Failures:   []BatchFailure{},
+	}
+
+	// One publisher for the whole batch lets the client library batch requests
+	topicPublisher := client.Publisher(topicID)
+	defer topicPublisher.Stop()
+
+	var mu sync.Mutex
+	var done, produced atomic.Int64
+	fail := func(failure BatchFailure) {
+		mu.Lock()
+		result.Failures = append(result.Failures, failure)
+		mu.Unlock()
+	}
+	setMessageID := func(index int, messageID string) {
+		mu.Lock()
+		for len(result.MessageIDs) <= index {
+			result.MessageIDs = append(result.MessageIDs, "")
+		}
+		result.MessageIDs[index] = messageID
+		mu.Unlock()
+	}
+	completed := func() {
+		if n := int(done.Add(1)); onProgress != nil && n%BatchProgressInterval == 0 {
+			onProgress(n, int(produced.Load()))
+		}
+	}
+
+	jobs := make(chan batchJob)
+	var wg sync.WaitGroup
+	for range normalizeBatchConcurrency(concurrency) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if err := ValidateMessage(job.msg.Payload, job.msg.Attributes); err != nil {
+					fail(BatchFailure{Index: job.index, Line: job.line, Error: err.Error()})
+				} else {
+					publishResult := topicPublisher.Publish(ctx, &pubsub.Message{Data: []byte(job.msg.Payload), Attributes: job.msg.Attributes})
+					if messageID, err := publishResult.Get(ctx); err != nil {
+						fail(BatchFailure{Index: job.index, Line: job.line, Error: friendlyPublishError(err, topicID).Error()})
+					} else {
+						setMessageID(job.index, messageID)
+					}
+				}
+				completed()
+			}
+		}()
+	}
+
+	// Produced records are counted as they come so progress totals grow while a file is read
+	total := produce(func(job batchJob) {
+		produced.Add(1)
+		jobs <- job
+	}, func(failure BatchFailure) {
+		produced.Add(1)
+		fail(failure)
+		completed()
+	})
+	close(jobs)
+	wg.Wait()
+
+	// Index-align message IDs with every record, including trailing failures
+	for len(result.MessageIDs) < total {
+		result.MessageIDs = append(result.MessageIDs, "")
+	}
+	sort.Slice(result.Failures, func(i, j int) bool { return result.Failures[i].Index < result.Failures[j].Index })
+
+	if onProgress != nil {
+		onProgress(total, total)
+	}
+
+	result.Total = total
+	result.Failed = len(result.Failures)
+	result.Succeeded = total - result.Failed
+	result.DurationMs = time.Since(start).Milliseconds()
+	return result
+}
@@ -0,0 +1,123 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"google.golang.org/grpc"
+
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestPublishMessagesBatch_Validation(t *testing.T) {
+	ctx := context.Background()
+	messages := []BatchMessageInput{{Payload: "a"}}
+
+	if _, err := PublishMessagesBatch(ctx, nil, "topic", messages, 1, nil); err == nil {
+		t.Error("PublishMessagesBatch() with nil client error = nil, want error")
+	}
+}
+
+func TestNormalizeBatchConcurrency(t *testing.T) {
+	tests := []struct {
+		in, want int
+	}{
+		{-1, defaultBatchConcurrency},
+		{0, defaultBatchConcurrency},
+		{1, 1},
+		{25, 25},
+		{maxBatchConcurrency + 1, maxBatchConcurrency},
+	}
+	for _, tt := range tests {
+		if got := normalizeBatchConcurrency(tt.in); got != tt.want {
+			t.Errorf("normalizeBatchConcurrency(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPublishMessagesBatch_PartialFailure(t *testing.T) {
+	client, srv := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+
+	// Every third message has a reserved attribute key and fails validation
+	messages := make([]BatchMessageInput, 30)
+	for i := range messages {
+		messages[i] = BatchMessageInput{Payload: fmt.Sprintf("m%d", i)}
+		if i%3 == 0 {
+			messages[i].Attributes = map[string]string{"googclient_x": "v"}
+		}
+	}
+
+	result, err := PublishMessagesBatch(context.Background(), client, "t", messages, 8, nil)
+	if err != nil {
+		t.Fatalf("PublishMessagesBatch() error = %v", err)
+	}
+	if result.Total != 30 || result.Succeeded != 20 || result.Failed != 10 || len(result.Failures) != 10 {
+		t.Fatalf("PublishMessagesBatch() = %+v, want 30 messages, 20 published", result)
+	}
+	for i, failure := range result.Failures {
+		if failure.Index != i*3 || !strings.Contains(failure.Error, "reserved") {
+			t.Errorf("Failures[%d] = %+v, want index %d with a reserved key error", i, failure, i*3)
+		}
+	}
+	if len(result.MessageIDs) != 30 {
+		t.Fatalf("len(MessageIDs) = %d, want 30", len(result.MessageIDs))
+	}
+	for i, messageID := range result.MessageIDs {
+		if (i%3 == 0) != (messageID == "") {
+			t.Errorf("MessageIDs[%d] = %q, want an ID only for published messages", i, messageID)
+		}
+	}
+	if got := len(srv.Messages()); got != 20 {
+		t.Errorf("server received %d messages, want 20", got)
+	}
+}
+
+func TestPublishMessagesBatch_ConcurrencyBound(t *testing.T) {
+	// Each worker waits for its message's result, so the messages in flight never exceed the concurrency
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	countPublished := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		publish, ok := req.(*pubsubpb.PublishRequest)
+		if !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		mu.Lock()
+		inFlight += len(publish.Messages)
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		defer func() {
+			mu.Lock()
+			inFlight -= len(publish.Messages)
+			mu.Unlock()
+		}()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	client, _ := pubsubtest.NewClient(t, grpc.WithUnaryInterceptor(countPublished))
+	pubsubtest.CreateTopic(t, client, "t")
+
+	const concurrency = 3
+	messages := make([]BatchMessageInput, 30)
+	for i := range messages {
+		messages[i] = BatchMessageInput{Payload: fmt.Sprintf("m%d", i)}
+	}
+	result, err := PublishMessagesBatch(context.Background(), client, "t", messages, concurrency, nil)
+	if err != nil {
+		t.Fatalf("PublishMessagesBatch() error = %v", err)
+	}
+	if result.Succeeded != 30 {
+		t.Fatalf("PublishMessagesBatch() = %+v, want all 30 published", result)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if maxInFlight < 1 || maxInFlight > concurrency {
+		t.Errorf("messages in flight peaked at %d, want 1 to %d", maxInFlight, concurrency)
+	}
+}
@@ -0,0 +1,213 @@
+// Package publisher provides functions for publishing messages to Pub/Sub topics
+package publisher
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cloud.google.com/go/pubsub/v2"
+)
+
+// File formats accepted by PublishFromFile
+const (
+	FileFormatJSONL = "jsonl"
+	FileFormatCSV   = "csv"
+)
+
+// maxFileLineBytes bounds a single JSONL line: the Pub/Sub message limit plus room for JSON escaping and attributes
+const maxFileLineBytes = 16 * 1024 * 1024
+
+// csvPayloadColumn is the CSV header of the payload column; every other column is an attribute
+const csvPayloadColumn = "payload"
+
+// fileMessage is one JSONL record; payload is a string or any JSON value (published in compact form)
+type fileMessage struct {
+	Payload    json.RawMessage   `json:"payload"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// DetectFileFormat returns the file format for a path's extension (.jsonl/.ndjson or .csv), or "" if unknown
+func DetectFileFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		return FileFormatJSONL
+	case ".csv":
+		return FileFormatCSV
+	default:
+		return ""
+	}
+}
+
+// PublishFromFile streams messages from a JSONL or CSV file and publishes them with bounded concurrency
+// JSONL: one {"payload": ..., "attributes": {...}} object per line; blank lines are skipped.
+// CSV: a header row with a "payload" column; other columns are attributes (empty values are omitted).
+// Malformed records are reported as failures with their line number and do not stop the run.
+// An empty format is detected from the file extension.
+func PublishFromFile(ctx context.Context, client *pubsub.Client, topicID, filePath, format string, concurrency int, onProgress func(done, total int)) (BatchPublishResult, error) {
+	if client == nil {
+		return BatchPublishResult{}, fmt.Errorf("pub/sub client is nil")
+	}
+	if topicID == "" {
+		return BatchPublishResult{}, fmt.Errorf("topic ID cannot be empty")
+	}
+
+	produce, closeFile, err := openMessageFile(filePath, format)
+	if err != nil {
+		return BatchPublishResult{}, err
+	}
+	defer closeFile()
+
+	return runBatch(ctx, client, topicID, concurrency, produce, onProgress), nil
+}
+
+// openMessageFile opens a message file and returns a producer reading it record by record
+func openMessageFile(filePath, format string) (batchProducer, func() error, error) {
+	if format == "" {
+		format = DetectFileFormat(filePath)
+	}
+	if format != FileFormatJSONL && format != FileFormatCSV {
+		return nil, nil, fmt.Errorf("unsupported file format %q: must be %s or %s", format, FileFormatJSONL, FileFormatCSV)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open message file: %w", err)
+	}
+
+	if format == FileFormatJSONL {
+		return jsonlProducer(file), file.Close, nil
+	}
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1 // Checked per record so a short row fails alone
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	header = append([]string(nil), header...)
+	payloadColumn := -1
+	for i, name := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if header[i] == "" {
+			file.Close()
+			return nil, nil, fmt.Errorf("CSV header column %d has no name", i+1)
+		}
+		if strings.EqualFold(header[i], csvPayloadColumn) {
+			payloadColumn = i
+		}
+	}
+	if payloadColumn < 0 {
+		file.Close()
+		return nil, nil, fmt.Errorf("CSV header has no %q column", csvPayloadColumn)
+	}
+	return csvProducer(reader, header, payloadColumn), file.Close, nil
+}
+
+// jsonlProducer reads one message per line
+func jsonlProducer(r io.Reader) batchProducer {
+	return func(send func(batchJob), fail func(BatchFailure)) int {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxFileLineBytes)
+
+		index, line := 0, 0
+		for scanner.Scan() {
+			line++
+			text := bytes.TrimSpace(scanner.Bytes())
+			if len(text) == 0 {
+				continue
+			}
+			msg, err := parseJSONLMessage(text)
+			if err != nil {
+				fail(BatchFailure{Index: index, Line: line, Error: err.Error()})
+			} else {
+				send(batchJob{index: index, line: line, msg: msg})
+			}
+			index++
+		}
+		if err := scanner.Err(); err != nil {
+			// The rest of the file cannot be read (e.g. a line over the size limit)
+			fail(BatchFailure{Index: index, Line: line + 1, Error: fmt.Sprintf("failed to read file: %v", err)})
+			index++
+		}
+		return index
+	}
+}
+
+// parseJSONLMessage decodes a JSONL record into a message
+func parseJSONLMessage(text []byte) (BatchMessageInput, error) {
+	var record fileMessage
+	decoder := json.NewDecoder(bytes.NewReader(text))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&record); err != nil {
+		return BatchMessageInput{}, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if len(record.Payload) == 0 || string(record.Payload) == "null" {
+		return BatchMessageInput{}, fmt.Errorf("missing payload")
+	}
+
+	msg := BatchMessageInput{Attributes: record.Attributes}
+	var payload string
+	if err := json.Unmarshal(record.Payload, &payload); err == nil {
+		msg.Payload = payload
+		return msg, nil
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, record.Payload); err != nil {
+		return BatchMessageInput{}, fmt.Errorf("invalid payload: %w", err)
+	}
+	msg.Payload = compact.String()
+	return msg, nil
+}
+
+// csvProducer reads one message per CSV record after the header
+func csvProducer(reader *csv.Reader, header []string, payloadColumn int) batchProducer {
+	return func(send func(batchJob), fail func(BatchFailure)) int {
+		index := 0
+		for {
+			record, err := reader.Read()
+			if err == io.EOF {
+				return index
+			}
+			if err != nil {
+				var parseErr *csv.ParseError
+				if !errors.As(err, &parseErr) {
+					fail(BatchFailure{Index: index, Error: fmt.Sprintf("failed to read file: %v", err)})
+					return index + 1
+				}
+				fail(BatchFailure{Index: index, Line: parseErr.StartLine, Error: parseErr.Err.Error()})
+				index++
+				continue
+			}
+			line, _ := reader.FieldPos(0)
+			if len(record) != len(header) {
+				fail(BatchFailure{Index: index, Line: line, Error: fmt.Sprintf("expected %d columns, got %d", len(header), len(record))})
+				index++
+				continue
+			}
+
+			msg := BatchMessageInput{Payload: record[payloadColumn]}
+			for i, value := range record {
+				if i == payloadColumn || value == "" {
+					continue
+				}
+				if msg.Attributes == nil {
+					msg.Attributes = make(map[string]string)
+				}
+				msg.Attributes[header[i]] = value
+			}
+			send(batchJob{index: index, line: line, msg: msg})
+			index++
+		}
+	}
+}
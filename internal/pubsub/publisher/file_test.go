@@ -0,0 +1,99 @@
+package publisher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestPublishFromFile_JSONL(t *testing.T) {
+	client, srv := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	path := writeTestFile(t, "messages.jsonl", `{"payload":"hello","attributes":{"k":"v"}}
+{"payload":{"id": 1}}
+
+not json
+{"attributes":{"k":"v"}}
+{"payload":"last"}
+`)
+
+	result, err := PublishFromFile(context.Background(), client, "t", path, "", 2, nil)
+	if err != nil {
+		t.Fatalf("PublishFromFile() error = %v", err)
+	}
+	if result.Total != 5 || result.Succeeded != 3 || result.Failed != 2 {
+		t.Fatalf("PublishFromFile() = %+v, want 5 records, 3 published", result)
+	}
+	if result.Failures[0].Line != 4 || result.Failures[1].Line != 5 {
+		t.Errorf("failure lines = %+v, want 4 and 5", result.Failures)
+	}
+	if len(result.MessageIDs) != 5 || result.MessageIDs[4] == "" || result.MessageIDs[2] != "" {
+		t.Errorf("MessageIDs = %v, want index-aligned IDs", result.MessageIDs)
+	}
+
+	payloads := make(map[string]bool)
+	for _, msg := range srv.Messages() {
+		payloads[string(msg.Data)] = true
+	}
+	if !payloads["hello"] || !payloads[`{"id":1}`] || !payloads["last"] {
+		t.Errorf("published payloads = %v", payloads)
+	}
+}
+
+func TestPublishFromFile_CSV(t *testing.T) {
+	client, srv := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	path := writeTestFile(t, "messages.csv", "id,Payload,region\n1,hello,eu\n2,\"multi\nline\",\n3,short\n4,\"bad\"quote,us\n5,ok,us\n")
+
+	result, err := PublishFromFile(context.Background(), client, "t", path, FileFormatCSV, 0, nil)
+	if err != nil {
+		t.Fatalf("PublishFromFile() error = %v", err)
+	}
+	if result.Total != 5 || result.Succeeded != 3 {
+		t.Fatalf("PublishFromFile() = %+v, want 5 records, 3 published", result)
+	}
+	if result.Failures[0].Line != 5 || result.Failures[1].Line != 6 {
+		t.Errorf("failure lines = %+v, want 5 and 6", result.Failures)
+	}
+
+	for _, msg := range srv.Messages() {
+		switch string(msg.Data) {
+		case "hello":
+			if msg.Attributes["id"] != "1" || msg.Attributes["region"] != "eu" {
+				t.Errorf("attributes of hello = %v", msg.Attributes)
+			}
+		case "multi\nline":
+			if _, ok := msg.Attributes["region"]; ok {
+				t.Errorf("empty CSV value became attribute: %v", msg.Attributes)
+			}
+		}
+	}
+}
+
+func TestPublishFromFile_Errors(t *testing.T) {
+	client, _ := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	ctx := context.Background()
+
+	if _, err := PublishFromFile(ctx, client, "t", writeTestFile(t, "m.txt", "x"), "", 0, nil); err == nil {
+		t.Error("PublishFromFile(unknown format) error = nil, want error")
+	}
+	if _, err := PublishFromFile(ctx, client, "t", writeTestFile(t, "m.csv", "id,data\n1,x\n"), "", 0, nil); err == nil {
+		t.Error("PublishFromFile(CSV without payload column) error = nil, want error")
+	}
+	if _, err := PublishFromFile(ctx, client, "t", filepath.Join(t.TempDir(), "missing.jsonl"), "", 0, nil); err == nil {
+		t.Error("PublishFromFile(missing file) error = nil, want error")
+	}
+}
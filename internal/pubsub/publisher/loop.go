@@ -0,0 +1,217 @@
+// Package publisher provides functions for publishing messages to Pub/Sub topics
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/google/uuid"
+)
+
+// Publish loop limits
+const (
+	MinPublishLoopInterval = 50 * time.Millisecond // At most 20 messages per second per loop
+	maxPublishLoops        = 10
+	loopProgressInterval   = time.Second // Progress is reported about this often (and when the loop ends)
+)
+
+// PublishLoopStatus reports the progress of a publish loop
+type PublishLoopStatus struct {
+	ID         string    `json:"id"`
+	TopicID    string    `json:"topicId"`
+	IntervalMs int       `json:"intervalMs"`
+	Count      int       `json:"count"` // Messages to send; 0 means until stopped
+	Sent       int       `json:"sent"`
+	Failed     int       `json:"failed"`
+	Running    bool      `json:"running"`
+	StartedAt  time.Time `json:"startedAt"`
+	LastError  string    `json:"lastError,omitempty"`
+}
+
+// LoopRunner runs publish loops: each sends one message per interval from its own goroutine
+type LoopRunner struct {
+	mu         sync.Mutex
+	loops      map[string]*publishLoop
+	wg         sync.WaitGroup
+	onProgress func(PublishLoopStatus) // Optional; called from the loop goroutines
+}
+
+// publishLoop is a running loop and the function that stops it
+type publishLoop struct {
+	status PublishLoopStatus
+	cancel context.CancelFunc
+}
+
+// NewLoopRunner creates a loop runner; onProgress (optional) receives periodic and final status updates
+func NewLoopRunner(onProgress func(PublishLoopStatus)) *LoopRunner {
+	return &LoopRunner{
+		loops:      make(map[string]*publishLoop),
+		onProgress: onProgress,
+	}
+}
+
+// Start begins publishing payload to topicID every interval until count messages were sent or the loop is stopped
+// Placeholders ({{uuid}}, {{faker:...}}, ...) are resolved for every message. count 0 runs until stopped.
+func (r *LoopRunner) Start(ctx context.Context, client *pubsub.Client, topicID, payload string, attributes map[string]string, interval time.Duration, count int) (string, error) {
+	if client == nil {
+		return "", fmt.Errorf("pub/sub client is nil")
+	}
+	if topicID == "" {
+		return "", fmt.Errorf("topic ID cannot be empty")
+	}
+	if interval < MinPublishLoopInterval {
+		return "", fmt.Errorf("interval %s is too short: minimum is %s", interval, MinPublishLoopInterval)
+	}
+	if count < 0 {
+		return "", fmt.Errorf("count cannot be negative")
+	}
+	// Fail fast on broken placeholders or oversized messages instead of failing every message
+	resolvedPayload, resolvedAttributes, err := ResolveMessageVariables(payload, attributes)
+	if err != nil {
+		return "", err
+	}
+	if err := ValidateMessage(resolvedPayload, resolvedAttributes); err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.loops) >= maxPublishLoops {
+		return "", fmt.Errorf("too many publish loops running (max %d): stop one first", maxPublishLoops)
+	}
+
+	loopCtx, cancel := context.WithCancel(ctx)
+	loop := &publishLoop{
+		status: PublishLoopStatus{
+			ID:         uuid.NewString(),
+			TopicID:    topicID,
+			IntervalMs: int(interval.Milliseconds()),
+			Count:      count,
+			Running:    true,
+			StartedAt:  time.Now(),
+		},
+		cancel: cancel,
+	}
+	r.loops[loop.status.ID] = loop
+
+	r.wg.Add(1)
+	go r.run(loopCtx, client, loop, payload, attributes, interval)
+	return loop.status.ID, nil
+}
+
+// run publishes on a ticker until the count is reached or ctx is cancelled
+func (r *LoopRunner) run(ctx context.Context, client *pubsub.Client, loop *publishLoop, payload string, attributes map[string]string, interval time.Duration) {
+	defer r.wg.Done()
+
+	topicPublisher := client.Publisher(loop.status.TopicID)
+	defer topicPublisher.Stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastProgress := time.Now()
+
+	publishOne := func() {
+		resolvedPayload, resolvedAttributes, err := ResolveMessageVariables(payload, attributes)
+		if err == nil {
+			err = ValidateMessage(resolvedPayload, resolvedAttributes)
+		}
+		if err == nil {
+			msg := &pubsub.Message{Data: []byte(resolvedPayload), Attributes: resolvedAttributes}
+			_, err = topicPublisher.Publish(ctx, msg).Get(ctx)
+		}
+
+		r.mu.Lock()
+		if err != nil {
+			if ctx.Err() != nil {
+				// Stopped while the publish was in flight: not a failure
+				r.mu.Unlock()
+				return
+			}
+			loop.status.Failed++
+			loop.status.LastError = friendlyPublishError(err, loop.status.TopicID).Error()
+		} else {
+			loop.status.Sent++
+		}
+		status := loop.status
+		r.mu.Unlock()
+
+		if r.onProgress != nil && time.Since(lastProgress) >= loopProgressInterval {
+			lastProgress = time.Now()
+			r.onProgress(status)
+		}
+	}
+
+publishing:
+	for {
+		publishOne()
+		if loop.status.Count > 0 && r.attempted(loop) >= loop.status.Count {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break publishing
+		case <-ticker.C:
+		}
+	}
+
+	r.mu.Lock()
+	loop.status.Running = false
+	status := loop.status
+	delete(r.loops, status.ID)
+	r.mu.Unlock()
+	loop.cancel()
+
+	if r.onProgress != nil {
+		r.onProgress(status)
+	}
+}
+
+// attempted returns the number of messages a loop has sent or failed
+func (r *LoopRunner) attempted(loop *publishLoop) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return loop.status.Sent + loop.status.Failed
+}
+
+// Stop stops a running loop; its final status is still reported through onProgress
+func (r *LoopRunner) Stop(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	loop, ok := r.loops[id]
+	if !ok {
+		return fmt.Errorf("publish loop not found (it may have finished already): %s", id)
+	}
+	loop.cancel()
+	return nil
+}
+
+// StopAll stops every running loop and waits for them to finish; returns how many were running
+func (r *LoopRunner) StopAll() int {
+	r.mu.Lock()
+	n := len(r.loops)
+	for _, loop := range r.loops {
+		loop.cancel()
+	}
+	r.mu.Unlock()
+
+	r.wg.Wait()
+	return n
+}
+
+// List returns the status of the running loops, oldest first
+func (r *LoopRunner) List() []PublishLoopStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list := make([]PublishLoopStatus, 0, len(r.loops))
+	for _, loop := range r.loops {
+		list = append(list, loop.status)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].StartedAt.Before(list[j].StartedAt) })
+	return list
+}
@@ -0,0 +1,91 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestLoopRunner_RunsToCount(t *testing.T) {
+	client, srv := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+
+	var mu sync.Mutex
+	var final *PublishLoopStatus
+	done := make(chan struct{})
+	r := NewLoopRunner(func(status PublishLoopStatus) {
+		if !status.Running {
+			mu.Lock()
+			final = &status
+			mu.Unlock()
+			close(done)
+		}
+	})
+
+	id, err := r.Start(context.Background(), client, "t", `{"id":"{{uuid}}"}`, nil, MinPublishLoopInterval, 3)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("loop did not finish")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if final.ID != id || final.Sent != 3 || final.Failed != 0 {
+		t.Errorf("final status = %+v, want 3 sent", final)
+	}
+	messages := srv.Messages()
+	if len(messages) != 3 || string(messages[0].Data) == string(messages[1].Data) {
+		t.Errorf("published %d messages, want 3 with fresh placeholder values", len(messages))
+	}
+	if len(r.List()) != 0 {
+		t.Errorf("List() = %+v, want finished loop removed", r.List())
+	}
+}
+
+func TestLoopRunner_Stop(t *testing.T) {
+	client, _ := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	r := NewLoopRunner(nil)
+
+	id, err := r.Start(context.Background(), client, "t", "x", nil, MinPublishLoopInterval, 0)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if list := r.List(); len(list) != 1 || !list[0].Running {
+		t.Fatalf("List() = %+v, want one running loop", list)
+	}
+	if err := r.Stop(id); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	r.StopAll()
+	if len(r.List()) != 0 {
+		t.Errorf("List() after stop = %+v, want empty", r.List())
+	}
+	if err := r.Stop(id); err == nil {
+		t.Error("Stop() of a stopped loop error = nil, want error")
+	}
+}
+
+func TestLoopRunner_Validation(t *testing.T) {
+	client, _ := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	r := NewLoopRunner(nil)
+	ctx := context.Background()
+
+	if _, err := r.Start(ctx, client, "t", "x", nil, time.Millisecond, 1); err == nil {
+		t.Error("Start(interval below minimum) error = nil, want error")
+	}
+	if _, err := r.Start(ctx, client, "t", "x", nil, time.Second, -1); err == nil {
+		t.Error("Start(negative count) error = nil, want error")
+	}
+	if _, err := r.Start(ctx, client, "t", "{{faker:nope}}", nil, time.Second, 1); err == nil {
+		t.Error("Start(bad placeholder) error = nil, want error")
+	}
+}
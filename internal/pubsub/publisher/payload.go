@@ -0,0 +1,72 @@
+// Package publisher provides functions for publishing messages to Pub/Sub topics
+package publisher
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Payload formatting modes accepted by FormatPayload
+const (
+	FormatPretty = "pretty"
+	FormatMinify = "minify"
+)
+
+// FormatPayload pretty-prints (2-space indent) or minifies a JSON payload
+// Invalid JSON returns an error giving the line and column of the problem.
+func FormatPayload(payload, mode string) (string, error) {
+	if mode != FormatPretty && mode != FormatMinify {
+		return "", fmt.Errorf("unsupported format mode %q: must be %s or %s", mode, FormatPretty, FormatMinify)
+	}
+	if err := ValidateJSONPayload(payload); err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	if mode == FormatPretty {
+		if err := json.Indent(&out, []byte(payload), "", "  "); err != nil {
+			return "", describeJSONError(payload, err)
+		}
+		return out.String(), nil
+	}
+	if err := json.Compact(&out, []byte(payload)); err != nil {
+		return "", describeJSONError(payload, err)
+	}
+	return out.String(), nil
+}
+
+// ValidateJSONPayload checks that payload is a single valid JSON value
+func ValidateJSONPayload(payload string) error {
+	if strings.TrimSpace(payload) == "" {
+		return fmt.Errorf("invalid JSON: payload is empty")
+	}
+	var out bytes.Buffer
+	if err := json.Compact(&out, []byte(payload)); err != nil {
+		return describeJSONError(payload, err)
+	}
+	return nil
+}
+
+// describeJSONError turns the offset of a JSON syntax error into the line and column of the offending character
+func describeJSONError(payload string, err error) error {
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	// Offset counts the bytes read up to and including the offending character
+	pos := int(syntaxErr.Offset) - 1
+	if pos > len(payload) {
+		pos = len(payload)
+	}
+	if pos < 0 {
+		pos = 0
+	}
+	before := payload[:pos]
+	line := strings.Count(before, "\n") + 1
+	column := pos - strings.LastIndex(before, "\n")
+	return fmt.Errorf("invalid JSON at line %d, column %d: %s", line, column, syntaxErr.Error())
+}
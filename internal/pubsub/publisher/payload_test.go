@@ -0,0 +1,57 @@
+package publisher
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatPayload(t *testing.T) {
+	pretty, err := FormatPayload(`{"a":1,"b":[true,null]}`, FormatPretty)
+	if err != nil {
+		t.Fatalf("FormatPayload(pretty) error = %v", err)
+	}
+	want := "{\n  \"a\": 1,\n  \"b\": [\n    true,\n    null\n  ]\n}"
+	if pretty != want {
+		t.Errorf("FormatPayload(pretty) = %q, want %q", pretty, want)
+	}
+
+	minified, err := FormatPayload(pretty, FormatMinify)
+	if err != nil {
+		t.Fatalf("FormatPayload(minify) error = %v", err)
+	}
+	if minified != `{"a":1,"b":[true,null]}` {
+		t.Errorf("FormatPayload(minify) = %q", minified)
+	}
+
+	if _, err := FormatPayload(`{}`, "yaml"); err == nil {
+		t.Error("FormatPayload(unknown mode) error = nil, want error")
+	}
+}
+
+func TestValidateJSONPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		wantErr  bool
+		position string
+	}{
+		{"object", `{"a": 1}`, false, ""},
+		{"scalar", `42`, false, ""},
+		{"empty", "  ", true, ""},
+		{"trailing comma", "{\n  \"a\": 1,\n}", true, "line 3, column 1"},
+		{"bad token", `{"a": tru}`, true, "line 1, column 10"},
+		{"two values", `{} {}`, true, "line 1, column 4"},
+		{"plain text", "hello", true, "line 1, column 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJSONPayload(tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateJSONPayload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.position != "" && !strings.Contains(err.Error(), tt.position) {
+				t.Errorf("ValidateJSONPayload() error = %q, want position %q", err, tt.position)
+			}
+		})
+	}
+}
@@ -4,10 +4,14 @@ package publisher
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/pubsub/v2"
+
+	"pubsub-gui/internal/pubsub/admin"
 )
 
 // contains checks if a string contains a substring (case-insensitive)
@@ -15,8 +19,77 @@ func contains(s, substr string) bool {
 	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
 }
 
+// Message and attribute limits enforced by Pub/Sub
+const (
+	MaxMessageBytes         = 10 * 1000 * 1000 // Data plus attribute keys and values
+	MaxAttributes           = 100
+	maxAttributeKeyBytes    = 256
+	maxAttributeValueBytes  = 1024
+	reservedAttributePrefix = "goog"
+)
+
+// Attribute is a single message attribute (used where a deterministic ordering is needed)
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ValidateAttributes checks attribute keys and values against Pub/Sub constraints
+// Returns a precise error instead of the vague server-side InvalidArgument
+func ValidateAttributes(attributes map[string]string) error {
+	if len(attributes) > MaxAttributes {
+		return fmt.Errorf("message has %d attributes: at most %d are allowed", len(attributes), MaxAttributes)
+	}
+	for _, attr := range SortedAttributes(attributes) {
+		if attr.Key == "" {
+			return fmt.Errorf("attribute key cannot be empty")
+		}
+		if strings.HasPrefix(strings.ToLower(attr.Key), reservedAttributePrefix) {
+			return fmt.Errorf("attribute key %q is reserved: keys must not start with %q", attr.Key, reservedAttributePrefix)
+		}
+		if len(attr.Key) > maxAttributeKeyBytes {
+			return fmt.Errorf("attribute key %q exceeds %d bytes", attr.Key, maxAttributeKeyBytes)
+		}
+		if len(attr.Value) > maxAttributeValueBytes {
+			return fmt.Errorf("value of attribute %q exceeds %d bytes", attr.Key, maxAttributeValueBytes)
+		}
+	}
+	return nil
+}
+
+// ValidateMessage checks a message against Pub/Sub size and attribute limits before it is sent
+// The size counts the payload plus every attribute key and value.
+func ValidateMessage(payload string, attributes map[string]string) error {
+	if err := ValidateAttributes(attributes); err != nil {
+		return err
+	}
+	size := len(payload)
+	for key, value := range attributes {
+		size += len(key) + len(value)
+	}
+	if size > MaxMessageBytes {
+		return fmt.Errorf("message is %d bytes (payload and attributes): the limit is %d bytes", size, MaxMessageBytes)
+	}
+	return nil
+}
+
+// SortedAttributes returns attributes ordered by key
+func SortedAttributes(attributes map[string]string) []Attribute {
+	sorted := make([]Attribute, 0, len(attributes))
+	for key, value := range attributes {
+		sorted = append(sorted, Attribute{Key: key, Value: value})
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+	return sorted
+}
+
 // PublishMessage publishes a message to a Pub/Sub topic and returns the message ID
 func PublishMessage(ctx context.Context, client *pubsub.Client, topicID, payload string, attributes map[string]string) (string, error) {
+	return publishMessage(ctx, client, topicID, payload, attributes, "")
+}
+
+// publishMessage publishes a message, enabling message ordering when an ordering key is given
+func publishMessage(ctx context.Context, client *pubsub.Client, topicID, payload string, attributes map[string]string, orderingKey string) (string, error) {
 	if client == nil {
 		return "", fmt.Errorf("pub/sub client is nil")
 	}
@@ -25,6 +98,10 @@ func PublishMessage(ctx context.Context, client *pubsub.Client, topicID, payload
 		return "", fmt.Errorf("topic ID cannot be empty")
 	}
 
+	if err := ValidateMessage(payload, attributes); err != nil {
+		return "", err
+	}
+
 	// Get publisher for the topic (can use full name or short name)
 	publisher := client.Publisher(topicID)
 	defer publisher.Stop()
@@ -34,6 +111,11 @@ func PublishMessage(ctx context.Context, client *pubsub.Client, topicID, payload
 		Data: []byte(payload),
 	}
 
+	if orderingKey != "" {
+		publisher.EnableMessageOrdering = true
+		msg.OrderingKey = orderingKey
+	}
+
 	// Add attributes if provided
 	if attributes != nil && len(attributes) > 0 {
 		msg.Attributes = attributes
@@ -45,27 +127,32 @@ func PublishMessage(ctx context.Context, client *pubsub.Client, topicID, payload
 	// Wait for publish to complete and get message ID
 	messageID, err := result.Get(ctx)
 	if err != nil {
-		// Provide user-friendly error messages for common issues
-		errStr := err.Error()
-		if contains(errStr, "PermissionDenied") || contains(errStr, "permission denied") {
-			return "", fmt.Errorf("permission denied: you don't have permission to publish to this topic")
-		}
-		if contains(errStr, "NotFound") || contains(errStr, "not found") {
-			return "", fmt.Errorf("topic not found: the topic '%s' does not exist", topicID)
-		}
-		if contains(errStr, "InvalidArgument") || contains(errStr, "invalid argument") {
-			return "", fmt.Errorf("invalid message: check your payload and attributes")
-		}
-		return "", fmt.Errorf("failed to publish message: %w", err)
+		return "", friendlyPublishError(err, topicID)
 	}
 
 	return messageID, nil
 }
 
+// friendlyPublishError maps common publish failures to user-friendly error messages
+func friendlyPublishError(err error, topicID string) error {
+	errStr := err.Error()
+	if contains(errStr, "PermissionDenied") || contains(errStr, "permission denied") {
+		return fmt.Errorf("permission denied: you don't have permission to publish to this topic")
+	}
+	if contains(errStr, "NotFound") || contains(errStr, "not found") {
+		return fmt.Errorf("topic not found: the topic '%s' does not exist", topicID)
+	}
+	if contains(errStr, "InvalidArgument") || contains(errStr, "invalid argument") {
+		return fmt.Errorf("invalid message: check your payload and attributes")
+	}
+	return fmt.Errorf("failed to publish message: %w", err)
+}
+
 // PublishResult represents the result of a publish operation
 type PublishResult struct {
-	MessageID string `json:"messageId"`
-	Timestamp string `json:"timestamp"`
+	MessageID  string      `json:"messageId"`
+	Timestamp  string      `json:"timestamp"`
+	Attributes []Attribute `json:"attributes,omitempty"` // Published attributes, ordered by key
 }
 
 // PublishMessageWithResult publishes a message and returns a result with message ID and timestamp
@@ -76,7 +163,106 @@ func PublishMessageWithResult(ctx context.Context, client *pubsub.Client, topicI
 	}
 
 	return PublishResult{
-		MessageID: messageID,
-		Timestamp: time.Now().Format(time.RFC3339),
+		MessageID:  messageID,
+		Timestamp:  time.Now().Format(time.RFC3339),
+		Attributes: SortedAttributes(attributes),
 	}, nil
 }
+
+// PublishOrderedMessageWithResult publishes a message with an ordering key (empty for none)
+func PublishOrderedMessageWithResult(ctx context.Context, client *pubsub.Client, topicID, payload string, attributes map[string]string, orderingKey string) (PublishResult, error) {
+	messageID, err := publishMessage(ctx, client, topicID, payload, attributes, orderingKey)
+	if err != nil {
+		return PublishResult{}, err
+	}
+
+	return PublishResult{
+		MessageID:  messageID,
+		Timestamp:  time.Now().Format(time.RFC3339),
+		Attributes: SortedAttributes(attributes),
+	}, nil
+}
+
+// maxParallelPublishes bounds the number of topics published to concurrently by PublishToMultiple
+const maxParallelPublishes = 8
+
+// TopicPublishResult is the outcome of publishing to one topic in PublishToMultiple
+type TopicPublishResult struct {
+	TopicID string         `json:"topicId"`
+	Result  *PublishResult `json:"result,omitempty"` // Set on success
+	Error   string         `json:"error,omitempty"`  // Set on failure
+}
+
+// MultiPublishResult combines the per-topic outcomes of PublishToMultiple
+type MultiPublishResult struct {
+	Results   []TopicPublishResult `json:"results"` // Same order as the requested topics
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+}
+
+// PublishToMultiple publishes the same message to several topics in parallel
+// Duplicate topics are published to once, whether given as short IDs or full names (the first spelling
+// is reported). A failure on one topic does not stop the others.
+func PublishToMultiple(ctx context.Context, client *pubsub.Client, projectID string, topicIDs []string, payload string, attributes map[string]string) (MultiPublishResult, error) {
+	if len(topicIDs) == 0 {
+		return MultiPublishResult{}, fmt.Errorf("at least one topic is required")
+	}
+	if err := ValidateMessage(payload, attributes); err != nil {
+		return MultiPublishResult{}, err
+	}
+
+	unique := make([]string, 0, len(topicIDs))
+	seen := make(map[string]bool, len(topicIDs))
+	for _, topicID := range topicIDs {
+		_, fullName := admin.NormalizeName(projectID, "topic", topicID)
+		if !seen[fullName] {
+			seen[fullName] = true
+			unique = append(unique, topicID)
+		}
+	}
+
+	results := make([]TopicPublishResult, len(unique))
+	sem := make(chan struct{}, maxParallelPublishes)
+	var wg sync.WaitGroup
+	for i, topicID := range unique {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, topicID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i].TopicID = topicID
+			result, err := PublishMessageWithResult(ctx, client, topicID, payload, attributes)
+			if err != nil {
+				results[i].Error = err.Error()
+				return
+			}
+			results[i].Result = &result
+		}(i, topicID)
+	}
+	wg.Wait()
+
+	combined := MultiPublishResult{Results: results}
+	for _, r := range results {
+		if r.Error != "" {
+			combined.Failed++
+		} else {
+			combined.Succeeded++
+		}
+	}
+	return combined, nil
+}
+
+// Err summarizes the failed topics as one error, or returns nil when every publish succeeded
+func (r MultiPublishResult) Err() error {
+	if r.Failed == 0 {
+		return nil
+	}
+	failures := make([]string, 0, r.Failed)
+	for _, result := range r.Results {
+		if result.Error != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", result.TopicID, result.Error))
+		}
+	}
+	return fmt.Errorf("failed to publish to %d of %d topics: %s", r.Failed, len(r.Results), strings.Join(failures, "; "))
+}
@@ -0,0 +1,141 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		wantErr    string
+	}{
+		{name: "nil attributes", attributes: nil},
+		{name: "valid attributes", attributes: map[string]string{"source": "gui", "env": "dev"}},
+		{name: "empty key", attributes: map[string]string{"": "value"}, wantErr: "cannot be empty"},
+		{name: "reserved prefix", attributes: map[string]string{"googClient": "x"}, wantErr: "reserved"},
+		{name: "reserved prefix lowercase", attributes: map[string]string{"goog-trace": "x"}, wantErr: "reserved"},
+		{name: "key too long", attributes: map[string]string{strings.Repeat("k", 257): "x"}, wantErr: "exceeds 256 bytes"},
+		{name: "value too long", attributes: map[string]string{"k": strings.Repeat("v", 1025)}, wantErr: "exceeds 1024 bytes"},
+		{name: "too many attributes", attributes: manyAttributes(MaxAttributes + 1), wantErr: "at most 100"},
+		{name: "attribute limit", attributes: manyAttributes(MaxAttributes)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttributes(tt.attributes)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateAttributes() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateAttributes() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateMessage(t *testing.T) {
+	if err := ValidateMessage(strings.Repeat("x", MaxMessageBytes), nil); err != nil {
+		t.Errorf("ValidateMessage(payload at limit) error = %v, want nil", err)
+	}
+
+	err := ValidateMessage(strings.Repeat("x", MaxMessageBytes-3), map[string]string{"ab": "cd"})
+	if err == nil || !strings.Contains(err.Error(), "is 10000001 bytes") {
+		t.Errorf("ValidateMessage(attributes over limit) error = %v, want size error", err)
+	}
+
+	if err := ValidateMessage("{}", map[string]string{"goog-x": "1"}); err == nil {
+		t.Error("ValidateMessage(reserved attribute) error = nil, want error")
+	}
+}
+
+// manyAttributes returns n distinct attributes
+func manyAttributes(n int) map[string]string {
+	attributes := make(map[string]string, n)
+	for i := range n {
+		attributes[fmt.Sprintf("key%d", i)] = "v"
+	}
+	return attributes
+}
+
+func TestSortedAttributes(t *testing.T) {
+	got := SortedAttributes(map[string]string{"b": "2", "c": "3", "a": "1"})
+	want := []Attribute{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}, {Key: "c", Value: "3"}}
+
+	if len(got) != len(want) {
+		t.Fatalf("SortedAttributes() returned %d attributes, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("SortedAttributes()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPublishToMultiple_Validation(t *testing.T) {
+	if _, err := PublishToMultiple(context.Background(), nil, "p", nil, "payload", nil); err == nil {
+		t.Error("PublishToMultiple() with no topics error = nil, want error")
+	}
+	if _, err := PublishToMultiple(context.Background(), nil, "p", []string{"a"}, "payload", map[string]string{"googKey": "v"}); err == nil {
+		t.Error("PublishToMultiple() with reserved attribute error = nil, want error")
+	}
+}
+
+func TestPublishToMultiple_PerTopicResults(t *testing.T) {
+	// A nil client makes every publish fail, which exercises result collection without a server
+	got, err := PublishToMultiple(context.Background(), nil, "p", []string{"a", "b", "projects/p/topics/a", "c", "projects/other/topics/a"}, "payload", nil)
+	if err != nil {
+		t.Fatalf("PublishToMultiple() error = %v", err)
+	}
+
+	// The same topic as a full name is a duplicate; the same ID in another project is not
+	want := []string{"a", "b", "c", "projects/other/topics/a"}
+	if len(got.Results) != len(want) {
+		t.Fatalf("len(Results) = %d, want %d", len(got.Results), len(want))
+	}
+	for i, r := range got.Results {
+		if r.TopicID != want[i] {
+			t.Errorf("Results[%d].TopicID = %q, want %q", i, r.TopicID, want[i])
+		}
+		if r.Error == "" || r.Result != nil {
+			t.Errorf("Results[%d] = %+v, want error and no result", i, r)
+		}
+	}
+	if got.Succeeded != 0 || got.Failed != 4 {
+		t.Errorf("Succeeded/Failed = %d/%d, want 0/4", got.Succeeded, got.Failed)
+	}
+}
+
+func TestMultiPublishResult_Err(t *testing.T) {
+	ok := MultiPublishResult{
+		Results:   []TopicPublishResult{{TopicID: "a", Result: &PublishResult{MessageID: "1"}}},
+		Succeeded: 1,
+	}
+	if err := ok.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+
+	partial := MultiPublishResult{
+		Results: []TopicPublishResult{
+			{TopicID: "a", Result: &PublishResult{MessageID: "1"}},
+			{TopicID: "b", Error: "topic not found"},
+			{TopicID: "c", Error: "permission denied"},
+		},
+		Succeeded: 1,
+		Failed:    2,
+	}
+	err := partial.Err()
+	if err == nil {
+		t.Fatal("Err() = nil, want error")
+	}
+	want := "failed to publish to 2 of 3 topics: b: topic not found; c: permission denied"
+	if err.Error() != want {
+		t.Errorf("Err() = %q, want %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,134 @@
+// Package publisher provides functions for publishing messages to Pub/Sub topics
+package publisher
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxScheduledPublishes bounds the number of pending scheduled publishes
+const maxScheduledPublishes = 1000
+
+// ScheduledPublish is a message waiting to be published at a set time
+type ScheduledPublish struct {
+	ID         string            `json:"id"`
+	TopicID    string            `json:"topicId"`
+	Payload    string            `json:"payload"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	PublishAt  time.Time         `json:"publishAt"`
+	CreatedAt  time.Time         `json:"createdAt"`
+}
+
+// Scheduler holds scheduled publishes in memory and fires each one with a timer
+// Pending publishes are lost when the app exits.
+type Scheduler struct {
+	mu   sync.Mutex
+	jobs map[string]*scheduledJob
+	fire func(ScheduledPublish) // Publishes a due job; runs on the timer's goroutine
+	now  func() time.Time
+}
+
+// scheduledJob is a pending publish and the timer that fires it
+type scheduledJob struct {
+	publish ScheduledPublish
+	timer   *time.Timer
+}
+
+// NewScheduler creates a scheduler that calls fire for every job when it is due
+func NewScheduler(fire func(ScheduledPublish)) *Scheduler {
+	return &Scheduler{
+		jobs: make(map[string]*scheduledJob),
+		fire: fire,
+		now:  time.Now,
+	}
+}
+
+// Schedule queues a message for publishing at publishAt, which must be in the future
+func (s *Scheduler) Schedule(topicID, payload string, attributes map[string]string, publishAt time.Time) (ScheduledPublish, error) {
+	if topicID == "" {
+		return ScheduledPublish{}, fmt.Errorf("topic ID cannot be empty")
+	}
+	if err := ValidateMessage(payload, attributes); err != nil {
+		return ScheduledPublish{}, err
+	}
+	now := s.now()
+	if !publishAt.After(now) {
+		return ScheduledPublish{}, fmt.Errorf("publish time %s is not in the future", publishAt.Format(time.RFC3339))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.jobs) >= maxScheduledPublishes {
+		return ScheduledPublish{}, fmt.Errorf("too many scheduled publishes (max %d): cancel some first", maxScheduledPublishes)
+	}
+
+	publish := ScheduledPublish{
+		ID:         uuid.NewString(),
+		TopicID:    topicID,
+		Payload:    payload,
+		Attributes: attributes,
+		PublishAt:  publishAt,
+		CreatedAt:  now,
+	}
+	job := &scheduledJob{publish: publish}
+	job.timer = time.AfterFunc(publishAt.Sub(now), func() { s.run(publish.ID) })
+	s.jobs[publish.ID] = job
+	return publish, nil
+}
+
+// run removes a due job and fires it, unless it was cancelled in the meantime
+func (s *Scheduler) run(id string) {
+	s.mu.Lock()
+	job, ok := s.jobs[id]
+	delete(s.jobs, id)
+	s.mu.Unlock()
+
+	if ok {
+		s.fire(job.publish)
+	}
+}
+
+// List returns the pending scheduled publishes, soonest first
+func (s *Scheduler) List() []ScheduledPublish {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]ScheduledPublish, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		list = append(list, job.publish)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].PublishAt.Before(list[j].PublishAt) })
+	return list
+}
+
+// Cancel removes a pending scheduled publish
+func (s *Scheduler) Cancel(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return fmt.Errorf("scheduled publish not found (it may have fired already): %s", id)
+	}
+	job.timer.Stop()
+	delete(s.jobs, id)
+	return nil
+}
+
+// CancelAll removes every pending scheduled publish and returns how many there were
+func (s *Scheduler) CancelAll() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := len(s.jobs)
+	for id, job := range s.jobs {
+		job.timer.Stop()
+		delete(s.jobs, id)
+	}
+	return n
+}
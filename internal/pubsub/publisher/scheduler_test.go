@@ -0,0 +1,74 @@
+package publisher
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduler_FiresDueJob(t *testing.T) {
+	fired := make(chan ScheduledPublish, 1)
+	s := NewScheduler(func(p ScheduledPublish) { fired <- p })
+
+	scheduled, err := s.Schedule("orders", "hello", map[string]string{"k": "v"}, time.Now().Add(20*time.Millisecond))
+	if err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	if list := s.List(); len(list) != 1 || list[0].ID != scheduled.ID {
+		t.Fatalf("List() = %+v, want the scheduled job", list)
+	}
+
+	select {
+	case p := <-fired:
+		if p.ID != scheduled.ID || p.TopicID != "orders" || p.Payload != "hello" {
+			t.Errorf("fired %+v, want %+v", p, scheduled)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("scheduled publish did not fire")
+	}
+	if list := s.List(); len(list) != 0 {
+		t.Errorf("List() after firing = %+v, want empty", list)
+	}
+}
+
+func TestScheduler_Cancel(t *testing.T) {
+	fired := make(chan ScheduledPublish, 3)
+	s := NewScheduler(func(p ScheduledPublish) { fired <- p })
+
+	later, _ := s.Schedule("t", "later", nil, time.Now().Add(time.Hour))
+	soon, _ := s.Schedule("t", "soon", nil, time.Now().Add(30*time.Millisecond))
+	if list := s.List(); len(list) != 2 || list[0].ID != soon.ID {
+		t.Errorf("List() = %+v, want soonest first", list)
+	}
+
+	if err := s.Cancel(soon.ID); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if err := s.Cancel(soon.ID); err == nil {
+		t.Error("Cancel() of a cancelled job error = nil, want error")
+	}
+	if n := s.CancelAll(); n != 1 {
+		t.Errorf("CancelAll() = %d, want 1 (%s)", n, later.ID)
+	}
+
+	select {
+	case p := <-fired:
+		t.Errorf("cancelled job fired: %+v", p)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestScheduler_Validation(t *testing.T) {
+	s := NewScheduler(func(ScheduledPublish) {})
+	future := time.Now().Add(time.Hour)
+
+	if _, err := s.Schedule("", "x", nil, future); err == nil {
+		t.Error("Schedule(empty topic) error = nil, want error")
+	}
+	if _, err := s.Schedule("t", "x", nil, time.Now().Add(-time.Second)); err == nil {
+		t.Error("Schedule(past) error = nil, want error")
+	}
+	if _, err := s.Schedule("t", "x", map[string]string{"": "v"}, future); err == nil {
+		t.Error("Schedule(invalid attributes) error = nil, want error")
+	}
+	s.CancelAll()
+}
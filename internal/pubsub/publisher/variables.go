@@ -0,0 +1,279 @@
+// Package publisher provides functions for publishing messages to Pub/Sub topics
+package publisher
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// placeholderPattern matches {{token}} placeholders in payloads and attribute values
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+// fakerPrefix marks generator placeholders such as {{faker:email}}
+const fakerPrefix = "faker:"
+
+// fakerGenerator produces a random value from the arguments following the generator name
+type fakerGenerator func(args []string) (string, error)
+
+// Supported faker generators (arguments are colon-separated, e.g. {{faker:int:1:100}}):
+//
+//	uuid                random UUID v4
+//	name                full name ("Ada Lovelace")
+//	firstName, lastName single name parts
+//	email               email address built from a random name
+//	username            lowercase user handle
+//	int:min:max         integer in [min, max] (default 0:100)
+//	float:min:max       float in [min, max) with 2 decimals (default 0:1)
+//	bool                "true" or "false"
+//	word                single lowercase word
+//	sentence            short sentence
+//	ipv4                IPv4 address
+//	url                 https URL
+//	timestamp           RFC3339 time within the last 30 days
+//	pick:a:b:c          one of the given values
+var fakerGenerators = map[string]fakerGenerator{
+	"uuid":      func([]string) (string, error) { return uuid.NewString(), nil },
+	"name":      func([]string) (string, error) { return pick(firstNames) + " " + pick(lastNames), nil },
+	"firstName": func([]string) (string, error) { return pick(firstNames), nil },
+	"lastName":  func([]string) (string, error) { return pick(lastNames), nil },
+	"email": func([]string) (string, error) {
+		return strings.ToLower(pick(firstNames)+"."+pick(lastNames)) + "@" + pick(emailDomains), nil
+	},
+	"username": func([]string) (string, error) {
+		return strings.ToLower(pick(firstNames)) + strconv.Itoa(rand.IntN(1000)), nil
+	},
+	"int":   fakeInt,
+	"float": fakeFloat,
+	"bool":  func([]string) (string, error) { return strconv.FormatBool(rand.IntN(2) == 1), nil },
+	"word":  func([]string) (string, error) { return pick(words), nil },
+	"sentence": func([]string) (string, error) {
+		n := 4 + rand.IntN(5)
+		parts := make([]string, n)
+		for i := range parts {
+			parts[i] = pick(words)
+		}
+		sentence := strings.Join(parts, " ")
+		return strings.ToUpper(sentence[:1]) + sentence[1:] + ".", nil
+	},
+	"ipv4": func([]string) (string, error) {
+		return fmt.Sprintf("%d.%d.%d.%d", 1+rand.IntN(223), rand.IntN(256), rand.IntN(256), 1+rand.IntN(254)), nil
+	},
+	"url": func([]string) (string, error) {
+		return "https://" + pick(emailDomains) + "/" + pick(words) + "/" + strconv.Itoa(rand.IntN(10000)), nil
+	},
+	"timestamp": func([]string) (string, error) {
+		offset := time.Duration(rand.Int64N(int64(30 * 24 * time.Hour)))
+		return time.Now().Add(-offset).UTC().Format(time.RFC3339), nil
+	},
+	"pick": func(args []string) (string, error) {
+		if len(args) == 0 {
+			return "", fmt.Errorf("faker:pick requires at least one value")
+		}
+		return pick(args), nil
+	},
+}
+
+var (
+	firstNames   = []string{"Ada", "Alan", "Grace", "Linus", "Margaret", "Ken", "Barbara", "Dennis", "Frances", "Edsger", "Radia", "Tim"}
+	lastNames    = []string{"Lovelace", "Turing", "Hopper", "Torvalds", "Hamilton", "Thompson", "Liskov", "Ritchie", "Allen", "Dijkstra", "Perlman", "Berners-Lee"}
+	emailDomains = []string{"example.com", "example.org", "example.net"}
+	words        = []string{"order", "payment", "invoice", "shipment", "customer", "account", "event", "signal", "queue", "stream", "batch", "report"}
+)
+
+// pick returns a random element of values
+func pick(values []string) string {
+	return values[rand.IntN(len(values))]
+}
+
+// fakeInt generates an integer in [min, max]
+func fakeInt(args []string) (string, error) {
+	lo, hi := int64(0), int64(100)
+	if len(args) > 0 {
+		if len(args) != 2 {
+			return "", fmt.Errorf("faker:int expects min and max (e.g. faker:int:1:100)")
+		}
+		var err error
+		if lo, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+			return "", fmt.Errorf("faker:int invalid min %q", args[0])
+		}
+		if hi, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+			return "", fmt.Errorf("faker:int invalid max %q", args[1])
+		}
+	}
+	if lo > hi {
+		return "", fmt.Errorf("faker:int min %d is greater than max %d", lo, hi)
+	}
+	// hi-lo+1 must fit in an int64; a negative span means hi-lo itself overflowed
+	span := hi - lo
+	if span < 0 || span == math.MaxInt64 {
+		return "", fmt.Errorf("faker:int range %d to %d is too large", lo, hi)
+	}
+	return strconv.FormatInt(lo+rand.Int64N(span+1), 10), nil
+}
+
+// fakeFloat generates a float in [min, max) formatted with 2 decimals
+func fakeFloat(args []string) (string, error) {
+	lo, hi := 0.0, 1.0
+	if len(args) > 0 {
+		if len(args) != 2 {
+			return "", fmt.Errorf("faker:float expects min and max (e.g. faker:float:0:9.99)")
+		}
+		var err error
+		if lo, err = strconv.ParseFloat(args[0], 64); err != nil {
+			return "", fmt.Errorf("faker:float invalid min %q", args[0])
+		}
+		if hi, err = strconv.ParseFloat(args[1], 64); err != nil {
+			return "", fmt.Errorf("faker:float invalid max %q", args[1])
+		}
+	}
+	if lo > hi {
+		return "", fmt.Errorf("faker:float min %g is greater than max %g", lo, hi)
+	}
+	return strconv.FormatFloat(lo+rand.Float64()*(hi-lo), 'f', 2, 64), nil
+}
+
+// templateVariable produces a value from the comma-separated arguments after the variable name
+type templateVariable func(args []string) (string, error)
+
+// Supported template variables (arguments follow a colon, e.g. {{random_int:1,100}}):
+//
+//	uuid                 random UUID v4
+//	now                  current time, RFC3339 in UTC
+//	timestamp_ms         current Unix time in milliseconds
+//	random_int:min,max   integer in [min, max] (default 0,100)
+var templateVariables = map[string]templateVariable{
+	"uuid":         func([]string) (string, error) { return uuid.NewString(), nil },
+	"now":          func([]string) (string, error) { return time.Now().UTC().Format(time.RFC3339), nil },
+	"timestamp_ms": func([]string) (string, error) { return strconv.FormatInt(time.Now().UnixMilli(), 10), nil },
+	"random_int": func(args []string) (string, error) {
+		value, err := fakeInt(args)
+		if err != nil {
+			return "", fmt.Errorf("random_int expects min,max (e.g. random_int:1,100)")
+		}
+		return value, nil
+	},
+}
+
+// RenderedMessage is a payload and attributes with all known placeholders resolved
+type RenderedMessage struct {
+	Payload    string            `json:"payload"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Warnings   []string          `json:"warnings,omitempty"` // Placeholders left as-is because they are not known
+}
+
+// ResolveVariables replaces {{faker:...}} generator placeholders and template variables
+// ({{uuid}}, {{now}}, ...) with freshly generated values
+// Each occurrence is generated independently. Unknown faker generators and invalid arguments are an error;
+// other unknown placeholders are left untouched.
+func ResolveVariables(text string) (string, error) {
+	resolved, _, err := resolveVariables(text)
+	return resolved, err
+}
+
+// resolveVariables is ResolveVariables that also returns the unknown placeholders it left in place
+func resolveVariables(text string) (string, []string, error) {
+	var resolveErr error
+	var unknown []string
+	resolved := placeholderPattern.ReplaceAllStringFunc(text, func(match string) string {
+		if resolveErr != nil {
+			return match
+		}
+		token := placeholderPattern.FindStringSubmatch(match)[1]
+		if !strings.HasPrefix(token, fakerPrefix) {
+			name, args, _ := strings.Cut(token, ":")
+			variable, ok := templateVariables[name]
+			if !ok {
+				unknown = append(unknown, match)
+				return match
+			}
+			var argList []string
+			if args != "" {
+				argList = strings.Split(args, ",")
+				for i := range argList {
+					argList[i] = strings.TrimSpace(argList[i])
+				}
+			}
+			value, err := variable(argList)
+			if err != nil {
+				resolveErr = fmt.Errorf("%s: %w", match, err)
+				return match
+			}
+			return value
+		}
+
+		parts := strings.Split(strings.TrimPrefix(token, fakerPrefix), ":")
+		generator, ok := fakerGenerators[parts[0]]
+		if !ok {
+			resolveErr = fmt.Errorf("unknown generator %q in %s", parts[0], match)
+			return match
+		}
+		value, err := generator(parts[1:])
+		if err != nil {
+			resolveErr = err
+			return match
+		}
+		return value
+	})
+	if resolveErr != nil {
+		return "", nil, resolveErr
+	}
+	return resolved, unknown, nil
+}
+
+// ResolveMessageVariables resolves placeholders in a payload and in every attribute value
+func ResolveMessageVariables(payload string, attributes map[string]string) (string, map[string]string, error) {
+	rendered, err := RenderMessage(payload, attributes)
+	if err != nil {
+		return "", nil, err
+	}
+	return rendered.Payload, rendered.Attributes, nil
+}
+
+// RenderMessage resolves placeholders in a payload and in every attribute value
+// Unknown placeholders are kept and reported once each in Warnings.
+func RenderMessage(payload string, attributes map[string]string) (RenderedMessage, error) {
+	resolvedPayload, unknown, err := resolveVariables(payload)
+	if err != nil {
+		return RenderedMessage{}, fmt.Errorf("payload: %w", err)
+	}
+	rendered := RenderedMessage{Payload: resolvedPayload}
+	seen := make(map[string]bool)
+	addWarnings := func(where string, placeholders []string) {
+		for _, placeholder := range placeholders {
+			if !seen[placeholder] {
+				seen[placeholder] = true
+				rendered.Warnings = append(rendered.Warnings, fmt.Sprintf("unknown placeholder %s in %s left as-is", placeholder, where))
+			}
+		}
+	}
+	addWarnings("payload", unknown)
+
+	if attributes != nil {
+		keys := make([]string, 0, len(attributes))
+		for key := range attributes {
+			keys = append(keys, key)
+		}
+		// Sorted so warnings come out in a stable order
+		sort.Strings(keys)
+
+		rendered.Attributes = make(map[string]string, len(attributes))
+		for _, key := range keys {
+			resolved, unknown, err := resolveVariables(attributes[key])
+			if err != nil {
+				return RenderedMessage{}, fmt.Errorf("attribute %s: %w", key, err)
+			}
+			rendered.Attributes[key] = resolved
+			addWarnings("attribute "+key, unknown)
+		}
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,146 @@
+package publisher
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestResolveVariables_Generators(t *testing.T) {
+	tests := []struct {
+		input string
+		match *regexp.Regexp
+	}{
+		{"{{faker:uuid}}", regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}$`)},
+		{"{{faker:email}}", regexp.MustCompile(`^[a-z-]+\.[a-z-]+@example\.(com|org|net)$`)},
+		{"{{faker:name}}", regexp.MustCompile(`^[A-Z][a-z]+ [A-Z][A-Za-z-]+$`)},
+		{"{{ faker:bool }}", regexp.MustCompile(`^(true|false)$`)},
+		{"{{faker:float:1:2}}", regexp.MustCompile(`^1\.\d\d$`)},
+		{"{{faker:pick:red:green}}", regexp.MustCompile(`^(red|green)$`)},
+		{`{"id":"{{faker:int:5:5}}"}`, regexp.MustCompile(`^\{"id":"5"\}$`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ResolveVariables(tt.input)
+			if err != nil {
+				t.Fatalf("ResolveVariables() error = %v", err)
+			}
+			if !tt.match.MatchString(got) {
+				t.Errorf("ResolveVariables(%q) = %q, want match %s", tt.input, got, tt.match)
+			}
+		})
+	}
+}
+
+func TestResolveVariables_IntRange(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		got, err := ResolveVariables("{{faker:int:1:3}}")
+		if err != nil {
+			t.Fatalf("ResolveVariables() error = %v", err)
+		}
+		n, err := strconv.Atoi(got)
+		if err != nil || n < 1 || n > 3 {
+			t.Fatalf("ResolveVariables() = %q, want integer in [1, 3]", got)
+		}
+	}
+
+	// The widest range whose size still fits in an int64
+	if _, err := ResolveVariables("{{faker:int:1:9223372036854775807}}"); err != nil {
+		t.Errorf("ResolveVariables(widest range) error = %v", err)
+	}
+}
+
+func TestResolveVariables_Errors(t *testing.T) {
+	for _, input := range []string{"{{faker:unknown}}", "{{faker:int:5:1}}", "{{faker:int:a:b}}", "{{faker:int:1}}", "{{faker:pick}}",
+		"{{faker:int:-9223372036854775808:9223372036854775807}}", "{{faker:int:0:9223372036854775807}}", "{{faker:int:-1:9223372036854775807}}"} {
+		if _, err := ResolveVariables(input); err == nil {
+			t.Errorf("ResolveVariables(%q) error = nil, want error", input)
+		}
+	}
+}
+
+func TestResolveVariables_LeavesOtherPlaceholders(t *testing.T) {
+	input := "hello {{name}} {not a placeholder}"
+	got, err := ResolveVariables(input)
+	if err != nil {
+		t.Fatalf("ResolveVariables() error = %v", err)
+	}
+	if got != input {
+		t.Errorf("ResolveVariables() = %q, want unchanged %q", got, input)
+	}
+}
+
+func TestResolveMessageVariables(t *testing.T) {
+	payload, attributes, err := ResolveMessageVariables("{{faker:uuid}}", map[string]string{"id": "{{faker:uuid}}", "static": "x"})
+	if err != nil {
+		t.Fatalf("ResolveMessageVariables() error = %v", err)
+	}
+	if strings.Contains(payload, "{{") || strings.Contains(attributes["id"], "{{") {
+		t.Errorf("ResolveMessageVariables() left placeholders: payload=%q attributes=%v", payload, attributes)
+	}
+	if payload == attributes["id"] {
+		t.Error("ResolveMessageVariables() generated the same UUID twice, want independent values")
+	}
+	if attributes["static"] != "x" {
+		t.Errorf("ResolveMessageVariables() static attribute = %q, want %q", attributes["static"], "x")
+	}
+
+	if _, _, err := ResolveMessageVariables("ok", map[string]string{"bad": "{{faker:nope}}"}); err == nil || !strings.Contains(err.Error(), "attribute bad") {
+		t.Errorf("ResolveMessageVariables() error = %v, want attribute error", err)
+	}
+}
+
+func TestResolveVariables_TemplateVariables(t *testing.T) {
+	before := time.Now().UnixMilli()
+	got, err := ResolveVariables("{{uuid}}|{{now}}|{{timestamp_ms}}|{{random_int:5,5}}|{{ random_int: 1 , 3 }}")
+	if err != nil {
+		t.Fatalf("ResolveVariables() error = %v", err)
+	}
+	parts := strings.Split(got, "|")
+	if _, err := uuid.Parse(parts[0]); err != nil {
+		t.Errorf("uuid = %q, not a UUID", parts[0])
+	}
+	if _, err := time.Parse(time.RFC3339, parts[1]); err != nil {
+		t.Errorf("now = %q, not RFC3339", parts[1])
+	}
+	if ms, err := strconv.ParseInt(parts[2], 10, 64); err != nil || ms < before {
+		t.Errorf("timestamp_ms = %q, want current Unix milliseconds", parts[2])
+	}
+	if parts[3] != "5" {
+		t.Errorf("random_int:5,5 = %q, want 5", parts[3])
+	}
+	if n, err := strconv.Atoi(parts[4]); err != nil || n < 1 || n > 3 {
+		t.Errorf("random_int:1,3 = %q, want 1..3", parts[4])
+	}
+
+	if _, err := ResolveVariables("{{random_int:9,1}}"); err == nil {
+		t.Error("ResolveVariables(random_int:9,1) error = nil, want error")
+	}
+}
+
+func TestRenderMessage_Warnings(t *testing.T) {
+	rendered, err := RenderMessage(`{"id":"{{uuid}}","x":"{{unknown}}","y":"{{unknown}}"}`, map[string]string{"b": "{{other}}", "a": "{{uuid}}"})
+	if err != nil {
+		t.Fatalf("RenderMessage() error = %v", err)
+	}
+	if !strings.Contains(rendered.Payload, `"x":"{{unknown}}"`) || strings.Contains(rendered.Payload, "{{uuid}}") {
+		t.Errorf("Payload = %q, want known placeholders resolved and unknown kept", rendered.Payload)
+	}
+	if rendered.Attributes["b"] != "{{other}}" || rendered.Attributes["a"] == "{{uuid}}" {
+		t.Errorf("Attributes = %v", rendered.Attributes)
+	}
+	if len(rendered.Warnings) != 2 || !strings.Contains(rendered.Warnings[0], "{{unknown}}") || !strings.Contains(rendered.Warnings[1], "attribute b") {
+		t.Errorf("Warnings = %v, want one per unknown placeholder", rendered.Warnings)
+	}
+
+	second, _ := RenderMessage(`{{uuid}}`, nil)
+	first, _ := RenderMessage(`{{uuid}}`, nil)
+	if first.Payload == second.Payload {
+		t.Error("RenderMessage() produced the same UUID twice, want fresh values per render")
+	}
+}
@@ -0,0 +1,88 @@
+// Package pubsubtest provides helpers for tests that run against an in-memory Pub/Sub server (pstest)
+package pubsubtest
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/pubsub/v2"
+	vkit "cloud.google.com/go/pubsub/v2/apiv1"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"cloud.google.com/go/pubsub/v2/pstest"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ProjectID is the project of the clients returned by NewClient
+const ProjectID = "p"
+
+// NewClient returns a client for project "p" connected to a new in-memory Pub/Sub server
+// dialOpts are added to the connection (e.g. grpc.WithUnaryInterceptor to inject errors).
+// The server, connection and client are closed when the test ends.
+func NewClient(t testing.TB, dialOpts ...grpc.DialOption) (*pubsub.Client, *pstest.Server) {
+	t.Helper()
+	srv := pstest.NewServer()
+	t.Cleanup(func() { _ = srv.Close() })
+
+	opts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, dialOpts...)
+	conn, err := grpc.NewClient(srv.Addr, opts...)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	client, err := pubsub.NewClient(context.Background(), ProjectID, option.WithGRPCConn(conn))
+	if err != nil {
+		t.Fatalf("pubsub.NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client, srv
+}
+
+// NewSchemaClient returns a schema client connected to srv, closed when the test ends
+func NewSchemaClient(t testing.TB, srv *pstest.Server) *vkit.SchemaClient {
+	t.Helper()
+	sc, err := vkit.NewSchemaClient(context.Background(),
+		option.WithEndpoint(srv.Addr),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	)
+	if err != nil {
+		t.Fatalf("NewSchemaClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = sc.Close() })
+	return sc
+}
+
+// TopicName returns the full resource name of a topic of project "p"
+func TopicName(topicID string) string {
+	return "projects/" + ProjectID + "/topics/" + topicID
+}
+
+// SubscriptionName returns the full resource name of a subscription of project "p"
+func SubscriptionName(subscriptionID string) string {
+	return "projects/" + ProjectID + "/subscriptions/" + subscriptionID
+}
+
+// CreateTopic creates a topic of project "p"
+func CreateTopic(t testing.TB, client *pubsub.Client, topicID string) {
+	t.Helper()
+	if _, err := client.TopicAdminClient.CreateTopic(context.Background(), &pubsubpb.Topic{Name: TopicName(topicID)}); err != nil {
+		t.Fatalf("CreateTopic(%s) error = %v", topicID, err)
+	}
+}
+
+// CreateSubscription creates a subscription of project "p" to topicID
+// sub carries any other settings (dead letter policy, exactly-once delivery, ...); its Name and Topic are set here.
+func CreateSubscription(t testing.TB, client *pubsub.Client, subscriptionID, topicID string, sub *pubsubpb.Subscription) {
+	t.Helper()
+	if sub == nil {
+		sub = &pubsubpb.Subscription{}
+	}
+	sub.Name = SubscriptionName(subscriptionID)
+	sub.Topic = TopicName(topicID)
+	if _, err := client.SubscriptionAdminClient.CreateSubscription(context.Background(), sub); err != nil {
+		t.Fatalf("CreateSubscription(%s) error = %v", subscriptionID, err)
+	}
+}
@@ -0,0 +1,171 @@
+// Package schema provides functions for managing Pub/Sub schemas and validating messages against them
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	vkit "cloud.google.com/go/pubsub/v2/apiv1"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+// SchemaInfo represents schema metadata
+type SchemaInfo struct {
+	Name               string `json:"name"`
+	DisplayName        string `json:"displayName"`
+	Type               string `json:"type"`                         // "AVRO" or "PROTOCOL_BUFFER"
+	Definition         string `json:"definition,omitempty"`         // Only set by GetSchema/CreateSchema
+	RevisionID         string `json:"revisionId,omitempty"`         // Current revision
+	RevisionCreateTime string `json:"revisionCreateTime,omitempty"` // RFC3339
+}
+
+// ParseSchemaType converts "AVRO" or "PROTOCOL_BUFFER" (case-insensitive; "protobuf" is accepted) to the API type
+func ParseSchemaType(schemaType string) (pubsubpb.Schema_Type, error) {
+	switch strings.ToUpper(strings.TrimSpace(schemaType)) {
+	case "AVRO":
+		return pubsubpb.Schema_AVRO, nil
+	case "PROTOCOL_BUFFER", "PROTOBUF":
+		return pubsubpb.Schema_PROTOCOL_BUFFER, nil
+	default:
+		return pubsubpb.Schema_TYPE_UNSPECIFIED, fmt.Errorf("invalid schema type %q: must be AVRO or PROTOCOL_BUFFER", schemaType)
+	}
+}
+
+// CreateSchema creates a schema of the given type ("AVRO" or "PROTOCOL_BUFFER") from its definition
+func CreateSchema(ctx context.Context, sc *vkit.SchemaClient, projectID, schemaID, schemaType, definition string) (SchemaInfo, error) {
+	parsedType, err := ParseSchemaType(schemaType)
+	if err != nil {
+		return SchemaInfo{}, err
+	}
+	if strings.TrimSpace(definition) == "" {
+		return SchemaInfo{}, fmt.Errorf("schema definition cannot be empty")
+	}
+
+	if sc == nil {
+		return SchemaInfo{}, fmt.Errorf("schema client is nil")
+	}
+
+	shortID, _ := admin.NormalizeName(projectID, "schema", schemaID)
+	created, err := sc.CreateSchema(ctx, &pubsubpb.CreateSchemaRequest{
+		Parent:   "projects/" + projectID,
+		SchemaId: shortID,
+		Schema: &pubsubpb.Schema{
+			Type:       parsedType,
+			Definition: definition,
+		},
+	})
+	if err != nil {
+		return SchemaInfo{}, fmt.Errorf("failed to create schema %s: %w", shortID, err)
+	}
+
+	return schemaInfoFromProto(created), nil
+}
+
+// ListSchemas lists all schemas in the project (without definitions)
+func ListSchemas(ctx context.Context, sc *vkit.SchemaClient, projectID string) ([]SchemaInfo, error) {
+	if sc == nil {
+		return nil, fmt.Errorf("schema client is nil")
+	}
+
+	schemas := []SchemaInfo{}
+	it := sc.ListSchemas(ctx, &pubsubpb.ListSchemasRequest{
+		Parent: "projects/" + projectID,
+		View:   pubsubpb.SchemaView_BASIC,
+	})
+	for {
+		s, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list schemas: %w", err)
+		}
+		schemas = append(schemas, schemaInfoFromProto(s))
+	}
+
+	return schemas, nil
+}
+
+// GetSchema retrieves a schema including its definition
+func GetSchema(ctx context.Context, sc *vkit.SchemaClient, projectID, schemaID string) (SchemaInfo, error) {
+	if sc == nil {
+		return SchemaInfo{}, fmt.Errorf("schema client is nil")
+	}
+
+	_, schemaName := admin.NormalizeName(projectID, "schema", schemaID)
+	s, err := sc.GetSchema(ctx, &pubsubpb.GetSchemaRequest{
+		Name: schemaName,
+		View: pubsubpb.SchemaView_FULL,
+	})
+	if err != nil {
+		return SchemaInfo{}, fmt.Errorf("failed to get schema %s: %w", schemaName, err)
+	}
+
+	return schemaInfoFromProto(s), nil
+}
+
+// DeleteSchema deletes a schema
+// Topics using the schema keep their settings but publishing to them fails until a new schema is attached.
+func DeleteSchema(ctx context.Context, sc *vkit.SchemaClient, projectID, schemaID string) error {
+	if sc == nil {
+		return fmt.Errorf("schema client is nil")
+	}
+
+	_, schemaName := admin.NormalizeName(projectID, "schema", schemaID)
+	if err := sc.DeleteSchema(ctx, &pubsubpb.DeleteSchemaRequest{Name: schemaName}); err != nil {
+		return fmt.Errorf("failed to delete schema %s: %w", schemaName, err)
+	}
+
+	return nil
+}
+
+// ValidateMessage checks a payload against a schema using the given encoding ("JSON" or "BINARY")
+// Validation failures are returned with the API's message verbatim so users can fix their payload.
+func ValidateMessage(ctx context.Context, sc *vkit.SchemaClient, projectID, schemaID, payload, encoding string) error {
+	parsedEncoding, err := admin.ParseSchemaEncoding(encoding)
+	if err != nil {
+		return err
+	}
+
+	if sc == nil {
+		return fmt.Errorf("schema client is nil")
+	}
+
+	_, schemaName := admin.NormalizeName(projectID, "schema", schemaID)
+	_, err = sc.ValidateMessage(ctx, &pubsubpb.ValidateMessageRequest{
+		Parent:     "projects/" + projectID,
+		SchemaSpec: &pubsubpb.ValidateMessageRequest_Name{Name: schemaName},
+		Message:    []byte(payload),
+		Encoding:   parsedEncoding,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return fmt.Errorf("%s", st.Message())
+		}
+		return fmt.Errorf("failed to validate message against schema %s: %w", schemaName, err)
+	}
+
+	return nil
+}
+
+// schemaInfoFromProto converts an API schema to SchemaInfo
+func schemaInfoFromProto(s *pubsubpb.Schema) SchemaInfo {
+	info := SchemaInfo{
+		Name:       s.Name,
+		Type:       s.Type.String(),
+		Definition: s.Definition,
+		RevisionID: s.RevisionId,
+	}
+	info.DisplayName, _ = admin.NormalizeName("", "schema", s.Name)
+	if s.RevisionCreateTime != nil {
+		info.RevisionCreateTime = s.RevisionCreateTime.AsTime().Format(time.RFC3339)
+	}
+	return info
+}
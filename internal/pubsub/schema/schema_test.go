@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+const avroDefinition = `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`
+
+func TestSchemaLifecycle(t *testing.T) {
+	ctx := context.Background()
+	_, srv := pubsubtest.NewClient(t)
+	sc := pubsubtest.NewSchemaClient(t, srv)
+
+	created, err := CreateSchema(ctx, sc, "p", "orders", "avro", avroDefinition)
+	if err != nil {
+		t.Fatalf("CreateSchema() error = %v", err)
+	}
+	if created.Name != "projects/p/schemas/orders" || created.DisplayName != "orders" || created.Type != "AVRO" {
+		t.Errorf("CreateSchema() = %+v", created)
+	}
+
+	schemas, err := ListSchemas(ctx, sc, "p")
+	if err != nil {
+		t.Fatalf("ListSchemas() error = %v", err)
+	}
+	if len(schemas) != 1 || schemas[0].DisplayName != "orders" {
+		t.Errorf("ListSchemas() = %+v, want [orders]", schemas)
+	}
+
+	got, err := GetSchema(ctx, sc, "p", "orders")
+	if err != nil {
+		t.Fatalf("GetSchema() error = %v", err)
+	}
+	if got.Definition != avroDefinition {
+		t.Errorf("GetSchema().Definition = %q, want %q", got.Definition, avroDefinition)
+	}
+
+	if err := ValidateMessage(ctx, sc, "p", "orders", `{"id":"1"}`, "JSON"); err != nil {
+		t.Errorf("ValidateMessage() error = %v", err)
+	}
+	if err := ValidateMessage(ctx, sc, "p", "orders", `{"id":"1"}`, "XML"); err == nil {
+		t.Error("ValidateMessage() with invalid encoding error = nil, want error")
+	}
+
+	if err := DeleteSchema(ctx, sc, "p", "orders"); err != nil {
+		t.Fatalf("DeleteSchema() error = %v", err)
+	}
+	if err := ValidateMessage(ctx, sc, "p", "orders", `{"id":"1"}`, "JSON"); err == nil {
+		t.Error("ValidateMessage() against deleted schema error = nil, want error")
+	}
+}
+
+func TestCreateSchema_Validation(t *testing.T) {
+	ctx := context.Background()
+	if _, err := CreateSchema(ctx, nil, "p", "s", "xml", avroDefinition); err == nil {
+		t.Error("CreateSchema() with invalid type error = nil, want error")
+	}
+	if _, err := CreateSchema(ctx, nil, "p", "s", "PROTOCOL_BUFFER", " "); err == nil {
+		t.Error("CreateSchema() with empty definition error = nil, want error")
+	}
+}
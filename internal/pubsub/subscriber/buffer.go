@@ -13,10 +13,13 @@ type PubSubMessage struct {
 	ID              string            `json:"id"`
 	PublishTime     string            `json:"publishTime"` // ISO 8601
 	ReceiveTime     string            `json:"receiveTime"` // ISO 8601 (local)
-	Data            string            `json:"data"`        // Decoded payload
+	Data            string            `json:"data"`        // Raw payload bytes (re-encoded by RenderMessages)
 	Attributes      map[string]string `json:"attributes"`
 	DeliveryAttempt *int              `json:"deliveryAttempt,omitempty"`
 	OrderingKey     string            `json:"orderingKey,omitempty"`
+	DeliveryCount   int               `json:"deliveryCount,omitempty"` // Times the message is in the buffer; only set by the deduplicated view
+	Encoding        string            `json:"encoding,omitempty"`      // How Data is encoded ("text", "base64" or "hex"); only set by RenderMessages
+	ContentType     string            `json:"contentType,omitempty"`   // Payload MIME type; only set by RenderMessages
 }
 
 // MessageBuffer manages a FIFO buffer of messages
@@ -64,11 +67,27 @@ func (mb *MessageBuffer) GetMessages() []PubSubMessage {
 	return result
 }
 
-// Clear removes all messages from the buffer
-func (mb *MessageBuffer) Clear() {
+// GetMessage returns the most recently buffered message with the given ID
+func (mb *MessageBuffer) GetMessage(id string) (PubSubMessage, bool) {
+	mb.mu.RLock()
+	defer mb.mu.RUnlock()
+
+	// Search newest first: redeliveries of the same message are appended later
+	for i := len(mb.messages) - 1; i >= 0; i-- {
+		if mb.messages[i].ID == id {
+			return mb.messages[i], true
+		}
+	}
+	return PubSubMessage{}, false
+}
+
+// Clear removes all messages from the buffer and returns how many were removed
+func (mb *MessageBuffer) Clear() int {
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
+	cleared := len(mb.messages)
 	mb.messages = []PubSubMessage{}
+	return cleared
 }
 
 // Size returns the current number of messages in the buffer
@@ -90,6 +109,56 @@ func (mb *MessageBuffer) SetMaxSize(maxSize int) {
 	}
 }
 
+// SizeBucket counts buffered messages whose payload size falls in [MinBytes, MaxBytes)
+// MaxBytes is 0 for the open-ended last bucket
+type SizeBucket struct {
+	Label    string `json:"label"`
+	MinBytes int    `json:"minBytes"`
+	MaxBytes int    `json:"maxBytes"`
+	Count    int    `json:"count"`
+}
+
+// SizeHistogram is the payload size distribution of the buffered messages
+type SizeHistogram struct {
+	Buckets  []SizeBucket `json:"buckets"`
+	Total    int          `json:"total"`    // Messages counted
+	MaxBytes int          `json:"maxBytes"` // Largest payload observed
+}
+
+// newSizeBuckets returns the empty histogram buckets (Pub/Sub messages are limited to 10MB)
+func newSizeBuckets() []SizeBucket {
+	return []SizeBucket{
+		{Label: "0-1KB", MinBytes: 0, MaxBytes: 1024},
+		{Label: "1-10KB", MinBytes: 1024, MaxBytes: 10 * 1024},
+		{Label: "10-100KB", MinBytes: 10 * 1024, MaxBytes: 100 * 1024},
+		{Label: "100KB-1MB", MinBytes: 100 * 1024, MaxBytes: 1024 * 1024},
+		{Label: ">1MB", MinBytes: 1024 * 1024},
+	}
+}
+
+// PayloadSizeHistogram buckets the payload sizes of all buffered messages
+func (mb *MessageBuffer) PayloadSizeHistogram() SizeHistogram {
+	histogram := SizeHistogram{Buckets: newSizeBuckets()}
+
+	mb.mu.RLock()
+	defer mb.mu.RUnlock()
+
+	for _, msg := range mb.messages {
+		size := len(msg.Data)
+		for i := range histogram.Buckets {
+			bucket := &histogram.Buckets[i]
+			if size >= bucket.MinBytes && (bucket.MaxBytes == 0 || size < bucket.MaxBytes) {
+				bucket.Count++
+				break
+			}
+		}
+		histogram.Total++
+		histogram.MaxBytes = max(histogram.MaxBytes, size)
+	}
+
+	return histogram
+}
+
 // decodeMessage decodes a Pub/Sub message to our PubSubMessage format
 func decodeMessage(msg *pubsub.Message) PubSubMessage {
 	// Decode payload (base64 → string)
@@ -0,0 +1,50 @@
+package subscriber
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPayloadSizeHistogram(t *testing.T) {
+	tests := []struct {
+		name         string
+		sizes        []int
+		wantCounts   []int // Per bucket: 0-1KB, 1-10KB, 10-100KB, 100KB-1MB, >1MB
+		wantMaxBytes int
+	}{
+		{name: "empty buffer", wantCounts: []int{0, 0, 0, 0, 0}},
+		{name: "empty payload", sizes: []int{0}, wantCounts: []int{1, 0, 0, 0, 0}},
+		{name: "below 1KB", sizes: []int{1023}, wantCounts: []int{1, 0, 0, 0, 0}, wantMaxBytes: 1023},
+		{name: "exactly 1KB", sizes: []int{1024}, wantCounts: []int{0, 1, 0, 0, 0}, wantMaxBytes: 1024},
+		{name: "exactly 10KB", sizes: []int{10 * 1024}, wantCounts: []int{0, 0, 1, 0, 0}, wantMaxBytes: 10 * 1024},
+		{name: "exactly 100KB", sizes: []int{100 * 1024}, wantCounts: []int{0, 0, 0, 1, 0}, wantMaxBytes: 100 * 1024},
+		{name: "exactly 1MB", sizes: []int{1024 * 1024}, wantCounts: []int{0, 0, 0, 0, 1}, wantMaxBytes: 1024 * 1024},
+		{
+			name:         "mixed",
+			sizes:        []int{10, 2000, 500, 50 * 1024, 2 * 1024 * 1024},
+			wantCounts:   []int{2, 1, 1, 0, 1},
+			wantMaxBytes: 2 * 1024 * 1024,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := NewMessageBuffer(len(tt.sizes) + 1)
+			for _, size := range tt.sizes {
+				buffer.AddMessage(PubSubMessage{Data: strings.Repeat("x", size)})
+			}
+
+			histogram := buffer.PayloadSizeHistogram()
+			if len(histogram.Buckets) != len(tt.wantCounts) {
+				t.Fatalf("PayloadSizeHistogram() has %d buckets, want %d", len(histogram.Buckets), len(tt.wantCounts))
+			}
+			for i, bucket := range histogram.Buckets {
+				if bucket.Count != tt.wantCounts[i] {
+					t.Errorf("bucket %s count = %d, want %d", bucket.Label, bucket.Count, tt.wantCounts[i])
+				}
+			}
+			if histogram.Total != len(tt.sizes) || histogram.MaxBytes != tt.wantMaxBytes {
+				t.Errorf("PayloadSizeHistogram() total, max = %d, %d, want %d, %d", histogram.Total, histogram.MaxBytes, len(tt.sizes), tt.wantMaxBytes)
+			}
+		})
+	}
+}
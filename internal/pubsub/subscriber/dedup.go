@@ -0,0 +1,26 @@
+// Package subscriber provides streaming pull functionality for Pub/Sub subscriptions
+package subscriber
+
+// Deduplicated returns the buffered messages with redeliveries collapsed, oldest first
+// Messages sharing an ID are reduced to their first occurrence, whose DeliveryCount is the number of
+// copies in the buffer. Works on a snapshot: the buffer itself keeps every delivery.
+func (mb *MessageBuffer) Deduplicated() []PubSubMessage {
+	return DeduplicateMessages(mb.GetMessages())
+}
+
+// DeduplicateMessages collapses messages sharing an ID into their first occurrence with a DeliveryCount
+// Messages without an ID are never merged. The input slice is not modified.
+func DeduplicateMessages(messages []PubSubMessage) []PubSubMessage {
+	results := make([]PubSubMessage, 0, len(messages))
+	positions := make(map[string]int, len(messages))
+	for _, msg := range messages {
+		if i, seen := positions[msg.ID]; seen && msg.ID != "" {
+			results[i].DeliveryCount++
+			continue
+		}
+		msg.DeliveryCount = 1
+		positions[msg.ID] = len(results)
+		results = append(results, msg)
+	}
+	return results
+}
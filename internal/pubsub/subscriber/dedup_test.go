@@ -0,0 +1,43 @@
+package subscriber
+
+import (
+	"testing"
+)
+
+func TestMessageBuffer_Deduplicated(t *testing.T) {
+	buffer := NewMessageBuffer(10)
+	for _, id := range []string{"a", "b", "a", "c", "a", "b"} {
+		buffer.AddMessage(PubSubMessage{ID: id, Data: "payload-" + id, ReceiveTime: id})
+	}
+
+	deduped := buffer.Deduplicated()
+	want := []struct {
+		id    string
+		count int
+	}{{"a", 3}, {"b", 2}, {"c", 1}}
+	if len(deduped) != len(want) {
+		t.Fatalf("Deduplicated() = %d messages, want %d", len(deduped), len(want))
+	}
+	for i, w := range want {
+		if deduped[i].ID != w.id || deduped[i].DeliveryCount != w.count {
+			t.Errorf("Deduplicated()[%d] = %s x%d, want %s x%d", i, deduped[i].ID, deduped[i].DeliveryCount, w.id, w.count)
+		}
+	}
+
+	raw := buffer.GetMessages()
+	if len(raw) != 6 {
+		t.Errorf("buffer has %d messages after Deduplicated(), want all 6", len(raw))
+	}
+	for _, msg := range raw {
+		if msg.DeliveryCount != 0 {
+			t.Errorf("raw message %s has DeliveryCount %d, want 0", msg.ID, msg.DeliveryCount)
+		}
+	}
+}
+
+func TestDeduplicateMessages_KeepsMessagesWithoutID(t *testing.T) {
+	deduped := DeduplicateMessages([]PubSubMessage{{Data: "x"}, {Data: "y"}, {ID: "a"}})
+	if len(deduped) != 3 {
+		t.Errorf("DeduplicateMessages() = %d messages, want 3", len(deduped))
+	}
+}
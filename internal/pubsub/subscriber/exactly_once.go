@@ -0,0 +1,118 @@
+// Package subscriber provides streaming pull functionality for Pub/Sub subscriptions
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"pubsub-gui/internal/logger"
+)
+
+// ackConfirmTimeout bounds how long an exactly-once ack/nack may take to be confirmed
+// The client library retries transient failures itself (for up to 10 minutes); past this bound the
+// ack is reported as failed and the message may be redelivered.
+const ackConfirmTimeout = time.Minute
+
+// SetExactlyOnce switches the streamer to result-based ack/nack for exactly-once subscriptions (call before Start)
+func (ms *MessageStreamer) SetExactlyOnce(enabled bool) {
+	ms.exactlyOnce.Store(enabled)
+}
+
+// IsExactlyOnce reports whether acks and nacks are confirmed with the server
+func (ms *MessageStreamer) IsExactlyOnce() bool {
+	return ms.exactlyOnce.Load()
+}
+
+// settle acks or nacks a received message and counts it
+// On exactly-once subscriptions the message is counted once the server confirmed the ack or nack;
+// a permanent failure emits "message:ack-failed" instead.
+func (ms *MessageStreamer) settle(msg *pubsub.Message, ack bool) {
+	if !ms.exactlyOnce.Load() {
+		if ack {
+			msg.Ack()
+			ms.acked.Add(1)
+		} else {
+			msg.Nack()
+			ms.nacked.Add(1)
+		}
+		return
+	}
+
+	var result *pubsub.AckResult
+	if ack {
+		result = msg.AckWithResult()
+	} else {
+		result = msg.NackWithResult()
+	}
+	go ms.confirmSettle(msg.ID, ack, result)
+}
+
+// confirmSettle waits for an exactly-once ack/nack result and records the outcome
+func (ms *MessageStreamer) confirmSettle(messageID string, ack bool, result *pubsub.AckResult) {
+	err := waitAckResult(ms.ctx, result)
+	if err == nil {
+		if ack {
+			ms.acked.Add(1)
+		} else {
+			ms.nacked.Add(1)
+		}
+		return
+	}
+	if ms.ctx.Err() != nil {
+		return // Monitor stopped while waiting: the outcome no longer matters
+	}
+
+	operation := "nack"
+	if ack {
+		operation = "ack"
+	}
+	ms.ackFailed.Add(1)
+	logger.Warn("Exactly-once ack failed", "subscriptionID", ms.subscriptionID, "messageID", messageID, "operation", operation, "error", err)
+	runtime.EventsEmit(ms.ctx, "message:ack-failed", map[string]interface{}{
+		"subscriptionID": ms.subscriptionID,
+		"messageID":      messageID,
+		"operation":      operation,
+		"reason":         err.Error(),
+	})
+}
+
+// waitAckResult waits up to ackConfirmTimeout for an ack/nack to be confirmed
+func waitAckResult(ctx context.Context, result *pubsub.AckResult) error {
+	waitCtx, cancel := context.WithTimeout(ctx, ackConfirmTimeout)
+	defer cancel()
+
+	status, err := result.Get(waitCtx)
+	if waitCtx.Err() != nil && ctx.Err() == nil {
+		return fmt.Errorf("not confirmed within %s", ackConfirmTimeout)
+	}
+	return ackStatusError(status, err)
+}
+
+// ackStatusError turns an ack result into an error with a readable reason, or nil on success
+func ackStatusError(status pubsub.AcknowledgeStatus, err error) error {
+	switch status {
+	case pubsub.AcknowledgeStatusSuccess:
+		return nil
+	case pubsub.AcknowledgeStatusPermissionDenied:
+		return fmt.Errorf("permission denied: %w", errOrUnknown(err))
+	case pubsub.AcknowledgeStatusFailedPrecondition:
+		return fmt.Errorf("failed precondition: %w", errOrUnknown(err))
+	case pubsub.AcknowledgeStatusInvalidAckID:
+		return fmt.Errorf("ack ID is invalid or expired, the message will be redelivered: %w", errOrUnknown(err))
+	default:
+		return errOrUnknown(err)
+	}
+}
+
+// errOrUnknown returns err, or a generic error when the library reported a failure without one
+func errOrUnknown(err error) error {
+	if err == nil {
+		return errors.New("unknown error")
+	}
+	return err
+}
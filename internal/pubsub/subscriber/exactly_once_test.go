@@ -0,0 +1,76 @@
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestMessageStreamer_ExactlyOnceAckConfirmed(t *testing.T) {
+	ctx := context.Background()
+
+	client, srv := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	pubsubtest.CreateSubscription(t, client, "s", "t", &pubsubpb.Subscription{EnableExactlyOnceDelivery: true})
+	srv.Publish("projects/p/topics/t", []byte("payload"), nil)
+
+	// Drive the streamer's settle path from a plain Receive: a confirmed ack emits no Wails event
+	streamer := NewMessageStreamer(ctx, nil, "s", NewMessageBuffer(10), true)
+	streamer.SetExactlyOnce(true)
+	t.Cleanup(func() { _ = streamer.Stop() })
+
+	receiveCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	err := client.Subscriber("s").Receive(receiveCtx, func(_ context.Context, msg *pubsub.Message) {
+		streamer.settle(msg, true)
+		cancel()
+	})
+	if err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for streamer.GetAckStats().Acked == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if stats := streamer.GetAckStats(); stats.Acked != 1 || stats.AckFailed != 0 {
+		t.Errorf("GetAckStats() = %+v, want 1 acked and no failures", stats)
+	}
+}
+
+func TestAckStatusError(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  pubsub.AcknowledgeStatus
+		err     error
+		wantErr string
+	}{
+		{"success", pubsub.AcknowledgeStatusSuccess, nil, ""},
+		{"permission denied", pubsub.AcknowledgeStatusPermissionDenied, errors.New("no access"), "permission denied: no access"},
+		{"failed precondition", pubsub.AcknowledgeStatusFailedPrecondition, errors.New("detached"), "failed precondition: detached"},
+		{"invalid ack ID", pubsub.AcknowledgeStatusInvalidAckID, errors.New("PERMANENT_FAILURE_INVALID_ACK_ID"), "redelivered"},
+		{"other without error", pubsub.AcknowledgeStatusOther, nil, "unknown error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ackStatusError(tt.status, tt.err)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ackStatusError() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ackStatusError() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Package subscriber provides streaming pull functionality for Pub/Sub subscriptions
+package subscriber
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Export formats supported by ExportMessages
+const (
+	ExportFormatNDJSON = "ndjson"
+	ExportFormatCSV    = "csv"
+)
+
+// csvAttributePrefix prefixes the attribute columns of a CSV export
+const csvAttributePrefix = "attr:"
+
+// exportedMessage is one NDJSON line of an export
+type exportedMessage struct {
+	ID              string            `json:"id"`
+	PublishTime     string            `json:"publishTime"`
+	Data            string            `json:"data"`
+	DataEncoding    string            `json:"dataEncoding,omitempty"` // "base64" when data is encoded
+	Attributes      map[string]string `json:"attributes"`
+	OrderingKey     string            `json:"orderingKey,omitempty"`
+	DeliveryAttempt *int              `json:"deliveryAttempt,omitempty"`
+}
+
+// ExportMessages serializes messages as NDJSON (one message object per line) or CSV
+// With base64Data the payload is base64-encoded, otherwise it is written as received.
+// CSV attribute columns are the sorted union of the attribute keys of all messages.
+// An empty message list yields an empty string.
+func ExportMessages(messages []PubSubMessage, format string, base64Data bool) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format != ExportFormatNDJSON && format != ExportFormatCSV {
+		return "", fmt.Errorf("unsupported export format %q: must be %s or %s", format, ExportFormatNDJSON, ExportFormatCSV)
+	}
+	if len(messages) == 0 {
+		return "", nil
+	}
+
+	if format == ExportFormatCSV {
+		return exportCSV(messages, base64Data)
+	}
+	return exportNDJSON(messages, base64Data)
+}
+
+// exportData returns the payload as exported
+func exportData(msg PubSubMessage, base64Data bool) string {
+	if base64Data {
+		return base64.StdEncoding.EncodeToString([]byte(msg.Data))
+	}
+	return msg.Data
+}
+
+// exportNDJSON writes one JSON object per message
+func exportNDJSON(messages []PubSubMessage, base64Data bool) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	for _, msg := range messages {
+		line := exportedMessage{
+			ID:              msg.ID,
+			PublishTime:     msg.PublishTime,
+			Data:            exportData(msg, base64Data),
+			Attributes:      msg.Attributes,
+			OrderingKey:     msg.OrderingKey,
+			DeliveryAttempt: msg.DeliveryAttempt,
+		}
+		if base64Data {
+			line.DataEncoding = "base64"
+		}
+		if line.Attributes == nil {
+			line.Attributes = map[string]string{}
+		}
+		// Encode appends the newline that terminates each NDJSON record
+		if err := encoder.Encode(line); err != nil {
+			return "", fmt.Errorf("failed to encode message %s: %w", msg.ID, err)
+		}
+	}
+	return buf.String(), nil
+}
+
+// exportCSV writes a header row and one row per message with one column per attribute key
+func exportCSV(messages []PubSubMessage, base64Data bool) (string, error) {
+	keySet := make(map[string]struct{})
+	for _, msg := range messages {
+		for key := range msg.Attributes {
+			keySet[key] = struct{}{}
+		}
+	}
+	attributeKeys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		attributeKeys = append(attributeKeys, key)
+	}
+	sort.Strings(attributeKeys)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	header := []string{"id", "publishTime", "orderingKey", "deliveryAttempt", "data"}
+	for _, key := range attributeKeys {
+		header = append(header, csvAttributePrefix+key)
+	}
+	if err := writer.Write(header); err != nil {
+		return "", fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, msg := range messages {
+		deliveryAttempt := ""
+		if msg.DeliveryAttempt != nil {
+			deliveryAttempt = strconv.Itoa(*msg.DeliveryAttempt)
+		}
+		row := []string{msg.ID, msg.PublishTime, msg.OrderingKey, deliveryAttempt, exportData(msg, base64Data)}
+		for _, key := range attributeKeys {
+			row = append(row, msg.Attributes[key])
+		}
+		if err := writer.Write(row); err != nil {
+			return "", fmt.Errorf("failed to write message %s: %w", msg.ID, err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,59 @@
+package subscriber
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportMessages_NDJSON(t *testing.T) {
+	messages := []PubSubMessage{
+		{ID: "1", PublishTime: "2024-01-15T10:30:00Z", Data: `{"a":1}`, Attributes: map[string]string{"k": "v"}},
+		{ID: "2", PublishTime: "2024-01-15T10:31:00Z", Data: "hi"},
+	}
+
+	got, err := ExportMessages(messages, "ndjson", false)
+	if err != nil {
+		t.Fatalf("ExportMessages() error = %v", err)
+	}
+	want := `{"id":"1","publishTime":"2024-01-15T10:30:00Z","data":"{\"a\":1}","attributes":{"k":"v"}}` + "\n" +
+		`{"id":"2","publishTime":"2024-01-15T10:31:00Z","data":"hi","attributes":{}}` + "\n"
+	if got != want {
+		t.Errorf("ExportMessages() =\n%s\nwant\n%s", got, want)
+	}
+
+	got, err = ExportMessages(messages[1:], "NDJSON", true)
+	if err != nil {
+		t.Fatalf("ExportMessages(base64) error = %v", err)
+	}
+	if !strings.Contains(got, `"data":"aGk=","dataEncoding":"base64"`) {
+		t.Errorf("ExportMessages(base64) = %s, want base64 data", got)
+	}
+}
+
+func TestExportMessages_CSV(t *testing.T) {
+	attempt := 3
+	messages := []PubSubMessage{
+		{ID: "1", PublishTime: "t1", Data: "a,b", Attributes: map[string]string{"region": "eu"}},
+		{ID: "2", PublishTime: "t2", Data: "c", Attributes: map[string]string{"tenant": "x", "region": "us"}, DeliveryAttempt: &attempt},
+	}
+
+	got, err := ExportMessages(messages, "csv", false)
+	if err != nil {
+		t.Fatalf("ExportMessages() error = %v", err)
+	}
+	want := "id,publishTime,orderingKey,deliveryAttempt,data,attr:region,attr:tenant\n" +
+		"1,t1,,,\"a,b\",eu,\n" +
+		"2,t2,,3,c,us,x\n"
+	if got != want {
+		t.Errorf("ExportMessages() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExportMessages_EmptyAndInvalid(t *testing.T) {
+	if got, err := ExportMessages(nil, "csv", false); err != nil || got != "" {
+		t.Errorf("ExportMessages(empty) = %q, %v; want \"\", nil", got, err)
+	}
+	if _, err := ExportMessages(nil, "xml", false); err == nil {
+		t.Error("ExportMessages(xml) error = nil, want error")
+	}
+}
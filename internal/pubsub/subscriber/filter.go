@@ -0,0 +1,423 @@
+// Package subscriber provides streaming pull functionality for Pub/Sub subscriptions
+package subscriber
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxFilterLength is the longest subscription filter Pub/Sub accepts, in bytes
+const MaxFilterLength = 256
+
+// payloadIdentifiers are names users reach for when trying to filter on the message body
+var payloadIdentifiers = map[string]bool{"data": true, "payload": true, "body": true, "message": true}
+
+// FilterSyntaxError reports where a subscription filter could not be parsed
+type FilterSyntaxError struct {
+	Position int // 1-based byte offset in the filter
+	Message  string
+}
+
+// Error implements the error interface
+func (e *FilterSyntaxError) Error() string {
+	return fmt.Sprintf("invalid filter at position %d: %s", e.Position, e.Message)
+}
+
+// Filter is a parsed subscription filter that can be evaluated against message attributes
+type Filter struct {
+	root filterNode // nil for the empty filter, which matches every message
+}
+
+// ParseFilter parses a Pub/Sub subscription filter
+// Supported: attributes:KEY, attributes.KEY = "v", attributes.KEY != "v", hasPrefix(attributes.KEY, "p"),
+// NOT (or -), AND, OR and parentheses. AND and OR cannot be mixed without parentheses, as in Pub/Sub.
+// Keys with characters other than letters, digits, _ and - are quoted with backticks.
+func ParseFilter(expression string) (*Filter, error) {
+	if len(expression) > MaxFilterLength {
+		return nil, fmt.Errorf("filter is %d bytes long: the maximum is %d", len(expression), MaxFilterLength)
+	}
+	tokens, err := lexFilter(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return &Filter{}, nil
+	}
+
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, tok.errorf("unexpected %s", tok.describe())
+	}
+	return &Filter{root: root}, nil
+}
+
+// Matches reports whether a message with these attributes would be delivered through the filter
+func (f *Filter) Matches(attributes map[string]string) bool {
+	if f.root == nil {
+		return true
+	}
+	return f.root.matches(attributes)
+}
+
+// filterNode is a node of a parsed filter expression
+type filterNode interface {
+	matches(attributes map[string]string) bool
+}
+
+type andNode []filterNode
+type orNode []filterNode
+
+type notNode struct{ operand filterNode }
+
+type hasAttributeNode struct{ key string }
+
+type equalsNode struct {
+	key, value string
+	negate     bool // != matches messages without the attribute too
+}
+
+type hasPrefixNode struct{ key, prefix string }
+
+// matches reports whether every operand matches
+func (n andNode) matches(attributes map[string]string) bool {
+	for _, child := range n {
+		if !child.matches(attributes) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether any operand matches
+func (n orNode) matches(attributes map[string]string) bool {
+	for _, child := range n {
+		if child.matches(attributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether the operand does not match
+func (n notNode) matches(attributes map[string]string) bool {
+	return !n.operand.matches(attributes)
+}
+
+// matches reports whether the attribute is set, whatever its value
+func (n hasAttributeNode) matches(attributes map[string]string) bool {
+	_, ok := attributes[n.key]
+	return ok
+}
+
+// matches compares the attribute value; a missing attribute is never equal
+func (n equalsNode) matches(attributes map[string]string) bool {
+	value, ok := attributes[n.key]
+	return (ok && value == n.value) != n.negate
+}
+
+// matches reports whether the attribute value starts with the prefix
+func (n hasPrefixNode) matches(attributes map[string]string) bool {
+	value, ok := attributes[n.key]
+	return ok && strings.HasPrefix(value, n.prefix)
+}
+
+// filterTokenKind identifies a lexical token of a filter
+type filterTokenKind int
+
+const (
+	tokenEOF filterTokenKind = iota
+	tokenIdent
+	tokenQuotedKey // `key with.special/chars`
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenDot
+	tokenColon
+	tokenComma
+	tokenEquals
+	tokenNotEquals
+	tokenMinus
+)
+
+// punctuationTokens maps single-character tokens to their kind
+var punctuationTokens = map[byte]filterTokenKind{
+	'(': tokenLParen, ')': tokenRParen, '.': tokenDot, ':': tokenColon, ',': tokenComma, '=': tokenEquals, '-': tokenMinus,
+}
+
+// filterToken is a token and its 1-based position
+type filterToken struct {
+	kind filterTokenKind
+	text string // Identifier, key or unquoted string value
+	pos  int
+}
+
+// describe names a token for error messages
+func (t filterToken) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of filter"
+	case tokenIdent:
+		return fmt.Sprintf("%q", t.text)
+	case tokenQuotedKey:
+		return fmt.Sprintf("`%s`", t.text)
+	case tokenString:
+		return fmt.Sprintf("string %q", t.text)
+	case tokenNotEquals:
+		return `"!="`
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// errorf returns a syntax error located at the token
+func (t filterToken) errorf(format string, args ...interface{}) error {
+	return &FilterSyntaxError{Position: t.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+// lexFilter splits a filter into tokens
+func lexFilter(expression string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		start := i + 1
+		punctuation, isPunctuation := punctuationTokens[c]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isPunctuation:
+			tokens = append(tokens, filterToken{kind: punctuation, text: string(c), pos: start})
+			i++
+		case c == '!':
+			if i+1 >= len(expression) || expression[i+1] != '=' {
+				return nil, &FilterSyntaxError{Position: start, Message: `"!" must be followed by "=" (use NOT to negate)`}
+			}
+			tokens = append(tokens, filterToken{kind: tokenNotEquals, text: "!=", pos: start})
+			i += 2
+		case c == '"':
+			value, next, err := lexQuoted(expression, i, '"')
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, filterToken{kind: tokenString, text: value, pos: start})
+			i = next
+		case c == '`':
+			key, next, err := lexQuoted(expression, i, '`')
+			if err != nil {
+				return nil, err
+			}
+			if key == "" {
+				return nil, &FilterSyntaxError{Position: start, Message: "attribute key cannot be empty"}
+			}
+			tokens = append(tokens, filterToken{kind: tokenQuotedKey, text: key, pos: start})
+			i = next
+		case isFilterIdentChar(c) && c != '-':
+			j := i
+			for j < len(expression) && isFilterIdentChar(expression[j]) {
+				j++
+			}
+			tokens = append(tokens, filterToken{kind: tokenIdent, text: expression[i:j], pos: start})
+			i = j
+		case c == '\'':
+			return nil, &FilterSyntaxError{Position: start, Message: "strings must use double quotes"}
+		default:
+			return nil, &FilterSyntaxError{Position: start, Message: fmt.Sprintf("unexpected character %q", rune(c))}
+		}
+	}
+	return append(tokens, filterToken{kind: tokenEOF, pos: len(expression) + 1}), nil
+}
+
+// lexQuoted reads a quoted string or key starting at expression[i] and returns it with the offset after it
+// A backslash escapes the next character.
+func lexQuoted(expression string, i int, quote byte) (string, int, error) {
+	var value strings.Builder
+	for j := i + 1; j < len(expression); j++ {
+		switch expression[j] {
+		case '\\':
+			if j+1 >= len(expression) {
+				return "", 0, &FilterSyntaxError{Position: j + 1, Message: "unfinished escape sequence"}
+			}
+			j++
+			value.WriteByte(expression[j])
+		case quote:
+			return value.String(), j + 1, nil
+		default:
+			value.WriteByte(expression[j])
+		}
+	}
+	return "", 0, &FilterSyntaxError{Position: i + 1, Message: fmt.Sprintf("unterminated %c quote", quote)}
+}
+
+// isFilterIdentChar reports whether c may appear in an unquoted identifier or attribute key
+func isFilterIdentChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '-'
+}
+
+// filterParser is a recursive descent parser over filter tokens
+type filterParser struct {
+	tokens []filterToken
+	next   int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.next]
+}
+
+func (p *filterParser) advance() filterToken {
+	tok := p.tokens[p.next]
+	if tok.kind != tokenEOF {
+		p.next++
+	}
+	return tok
+}
+
+// expect consumes a token of the given kind or fails with a message naming what was wanted
+func (p *filterParser) expect(kind filterTokenKind, want string) (filterToken, error) {
+	tok := p.advance()
+	if tok.kind != kind {
+		return tok, tok.errorf("expected %s, got %s", want, tok.describe())
+	}
+	return tok, nil
+}
+
+// parseExpression parses terms joined by AND or by OR (not both)
+func (p *filterParser) parseExpression() (filterNode, error) {
+	first, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	operator := ""
+	operands := []filterNode{first}
+	for {
+		tok := p.peek()
+		if tok.kind != tokenIdent || (tok.text != "AND" && tok.text != "OR") {
+			break
+		}
+		if operator != "" && tok.text != operator {
+			return nil, tok.errorf("AND and OR cannot be mixed without parentheses")
+		}
+		operator = p.advance().text
+		operand, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+
+	switch operator {
+	case "AND":
+		return andNode(operands), nil
+	case "OR":
+		return orNode(operands), nil
+	default:
+		return first, nil
+	}
+}
+
+// parseTerm parses a negation, a parenthesized expression or a predicate
+func (p *filterParser) parseTerm() (filterNode, error) {
+	tok := p.peek()
+	switch {
+	case tok.kind == tokenMinus || (tok.kind == tokenIdent && tok.text == "NOT"):
+		p.advance()
+		operand, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	case tok.kind == tokenLParen:
+		p.advance()
+		inner, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen, `")"`); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	case tok.kind == tokenIdent && tok.text == "attributes":
+		return p.parseAttributePredicate()
+	case tok.kind == tokenIdent && tok.text == "hasPrefix":
+		return p.parseHasPrefix()
+	case tok.kind == tokenIdent && payloadIdentifiers[strings.ToLower(tok.text)]:
+		return nil, tok.errorf("%q is not supported: Pub/Sub filters can only match message attributes, not the payload", tok.text)
+	default:
+		return nil, tok.errorf("expected attributes, hasPrefix, NOT or \"(\", got %s", tok.describe())
+	}
+}
+
+// parseAttributePredicate parses attributes:KEY, attributes.KEY = "v" or attributes.KEY != "v"
+func (p *filterParser) parseAttributePredicate() (filterNode, error) {
+	p.advance() // attributes
+	separator := p.advance()
+	switch separator.kind {
+	case tokenColon:
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		return hasAttributeNode{key: key}, nil
+	case tokenDot:
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		operator := p.advance()
+		if operator.kind != tokenEquals && operator.kind != tokenNotEquals {
+			return nil, operator.errorf(`expected "=" or "!=" after attributes.%s, got %s`, key, operator.describe())
+		}
+		value, err := p.expect(tokenString, "a double-quoted string")
+		if err != nil {
+			return nil, err
+		}
+		return equalsNode{key: key, value: value.text, negate: operator.kind == tokenNotEquals}, nil
+	default:
+		return nil, separator.errorf(`expected "." or ":" after attributes, got %s`, separator.describe())
+	}
+}
+
+// parseHasPrefix parses hasPrefix(attributes.KEY, "prefix")
+func (p *filterParser) parseHasPrefix() (filterNode, error) {
+	p.advance() // hasPrefix
+	if _, err := p.expect(tokenLParen, `"(" after hasPrefix`); err != nil {
+		return nil, err
+	}
+	tok := p.advance()
+	if tok.kind != tokenIdent || tok.text != "attributes" {
+		if tok.kind == tokenIdent && payloadIdentifiers[strings.ToLower(tok.text)] {
+			return nil, tok.errorf("%q is not supported: Pub/Sub filters can only match message attributes, not the payload", tok.text)
+		}
+		return nil, tok.errorf("expected attributes.KEY as the first hasPrefix argument, got %s", tok.describe())
+	}
+	if _, err := p.expect(tokenDot, `"." after attributes`); err != nil {
+		return nil, err
+	}
+	key, err := p.parseKey()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenComma, `","`); err != nil {
+		return nil, err
+	}
+	prefix, err := p.expect(tokenString, "a double-quoted prefix")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRParen, `")"`); err != nil {
+		return nil, err
+	}
+	return hasPrefixNode{key: key, prefix: prefix.text}, nil
+}
+
+// parseKey parses an attribute key: a plain identifier or a backtick-quoted key
+func (p *filterParser) parseKey() (string, error) {
+	tok := p.advance()
+	if tok.kind != tokenIdent && tok.kind != tokenQuotedKey {
+		return "", tok.errorf("expected an attribute key, got %s", tok.describe())
+	}
+	return tok.text, nil
+}
@@ -0,0 +1,91 @@
+package subscriber
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFilter_Matches(t *testing.T) {
+	attributes := map[string]string{"region": "eu-west", "source": "checkout", "iana.org/language_tag": "en"}
+
+	tests := []struct {
+		name   string
+		filter string
+		want   bool
+	}{
+		{"empty filter matches all", "", true},
+		{"blank filter matches all", "   ", true},
+		{"has attribute", "attributes:region", true},
+		{"missing attribute", "attributes:tenant", false},
+		{"equals", `attributes.source = "checkout"`, true},
+		{"equals is case-sensitive", `attributes.source = "Checkout"`, false},
+		{"not equals", `attributes.source != "billing"`, true},
+		{"not equals on missing attribute", `attributes.tenant != "acme"`, true},
+		{"has prefix", `hasPrefix(attributes.region, "eu-")`, true},
+		{"has prefix on missing attribute", `hasPrefix(attributes.tenant, "")`, false},
+		{"backtick key", "attributes.`iana.org/language_tag` = \"en\"", true},
+		{"escaped quote", `attributes.source = "check\"out"`, false},
+		{"NOT", "NOT attributes:tenant", true},
+		{"minus", "-attributes:region", false},
+		{"AND", `attributes:region AND attributes.source = "checkout"`, true},
+		{"AND with a false operand", `attributes:region AND attributes:tenant`, false},
+		{"OR", `attributes:tenant OR hasPrefix(attributes.region, "eu")`, true},
+		{"parentheses", `(attributes:tenant OR attributes:region) AND NOT attributes.source = "billing"`, true},
+		{"nested negation", "NOT (attributes:region AND attributes:source)", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := ParseFilter(tt.filter)
+			if err != nil {
+				t.Fatalf("ParseFilter(%q) error = %v", tt.filter, err)
+			}
+			if got := filter.Matches(attributes); got != tt.want {
+				t.Errorf("ParseFilter(%q).Matches() = %v, want %v", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFilter_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		position int // 0 when the error is not a syntax error
+		contains string
+	}{
+		{"mixed AND and OR", "attributes:a AND attributes:b OR attributes:c", 31, "cannot be mixed"},
+		{"payload reference", `data = "x"`, 1, "only match message attributes"},
+		{"payload in hasPrefix", `hasPrefix(payload, "x")`, 11, "only match message attributes"},
+		{"lowercase operator", "attributes:a and attributes:b", 14, `unexpected "and"`},
+		{"missing value", "attributes.a =", 15, "expected a double-quoted string"},
+		{"single quotes", "attributes.a = 'x'", 16, "double quotes"},
+		{"unterminated string", `attributes.a = "x`, 16, "unterminated"},
+		{"missing operator", `attributes.a "x"`, 14, `expected "=" or "!="`},
+		{"bare bang", `attributes.a ! "x"`, 14, `"!" must be followed by "="`},
+		{"missing key", "attributes:", 12, "expected an attribute key"},
+		{"unknown identifier", "labels:a", 1, "expected attributes"},
+		{"unclosed parenthesis", "(attributes:a", 14, `expected ")"`},
+		{"trailing token", "attributes:a attributes:b", 14, "unexpected"},
+		{"hasPrefix without attributes", `hasPrefix("x", "y")`, 11, "first hasPrefix argument"},
+		{"too long", `attributes.a = "` + strings.Repeat("x", MaxFilterLength) + `"`, 0, "maximum is 256"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFilter(tt.filter)
+			if err == nil {
+				t.Fatalf("ParseFilter(%q) error = nil, want error", tt.filter)
+			}
+			if !strings.Contains(err.Error(), tt.contains) {
+				t.Errorf("ParseFilter(%q) error = %q, want it to contain %q", tt.filter, err, tt.contains)
+			}
+			var syntaxErr *FilterSyntaxError
+			if isSyntax := errors.As(err, &syntaxErr); isSyntax != (tt.position > 0) {
+				t.Fatalf("ParseFilter(%q) error = %T, want syntax error: %v", tt.filter, err, tt.position > 0)
+			}
+			if syntaxErr != nil && syntaxErr.Position != tt.position {
+				t.Errorf("ParseFilter(%q) error position = %d, want %d", tt.filter, syntaxErr.Position, tt.position)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Package subscriber provides streaming pull functionality for Pub/Sub subscriptions
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+)
+
+// RedeliveryTrace records what happened while repeatedly nacking a single message
+type RedeliveryTrace struct {
+	MessageID       string            `json:"messageId"`
+	Data            string            `json:"data"`
+	Attributes      map[string]string `json:"attributes,omitempty"`
+	Nacks           int               `json:"nacks"`           // Number of times the message was nacked
+	Deliveries      int               `json:"deliveries"`      // Number of times the message was received
+	DeliveryAttempt int               `json:"deliveryAttempt"` // Last delivery attempt reported by Pub/Sub (0 if not reported)
+	Redelivered     bool              `json:"redelivered"`     // True if the message came back after the final nack
+	DeadLettered    bool              `json:"deadLettered"`    // True if the last nack used up the dead letter policy's delivery attempts
+}
+
+// NackRepeatedly receives the next message on a subscription and nacks it `times` times,
+// then waits for one more delivery to observe the resulting delivery attempt
+// The final delivery is nacked as well so the message stays available to real consumers.
+// Other messages received meanwhile are nacked immediately. Stops when timeout elapses, or returns the
+// partial trace as soon as a nack reaches maxDeliveryAttempts (0 without a dead letter policy), since the
+// message then goes to the dead letter topic instead of being redelivered.
+func NackRepeatedly(ctx context.Context, sub *pubsub.Subscriber, times, maxDeliveryAttempts int, timeout time.Duration) (*RedeliveryTrace, error) {
+	if times <= 0 {
+		return nil, fmt.Errorf("times must be positive")
+	}
+
+	// One message at a time keeps the target message from being starved by others
+	sub.ReceiveSettings.MaxOutstandingMessages = 1
+	sub.ReceiveSettings.NumGoroutines = 1
+
+	receiveCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	trace := &RedeliveryTrace{}
+
+	err := sub.Receive(receiveCtx, func(_ context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if trace.MessageID == "" {
+			trace.MessageID = msg.ID
+			trace.Data = string(msg.Data)
+			trace.Attributes = maps.Clone(msg.Attributes)
+		}
+		if msg.ID != trace.MessageID {
+			msg.Nack()
+			return
+		}
+
+		trace.Deliveries++
+		if msg.DeliveryAttempt != nil {
+			trace.DeliveryAttempt = int(*msg.DeliveryAttempt)
+		}
+
+		if trace.Nacks < times {
+			trace.Nacks++
+			msg.Nack()
+			if maxDeliveryAttempts > 0 && trace.DeliveryAttempt >= maxDeliveryAttempts {
+				trace.DeadLettered = true
+				cancel()
+			}
+			return
+		}
+
+		// Delivery after the final nack: record it and stop
+		trace.Redelivered = true
+		msg.Nack()
+		cancel()
+	})
+	if err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+		return nil, fmt.Errorf("failed to receive messages: %w", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if trace.MessageID == "" {
+		return nil, fmt.Errorf("no message received within %s", timeout)
+	}
+
+	return trace, nil
+}
+
+// FindMessage waits for a message matching data and attributes on a subscription and acks it
+// Used to detect messages forwarded to a dead letter topic (forwarded copies get a new message ID)
+func FindMessage(ctx context.Context, sub *pubsub.Subscriber, data string, attributes map[string]string, timeout time.Duration) (bool, error) {
+	receiveCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	found := false
+
+	err := sub.Receive(receiveCtx, func(_ context.Context, msg *pubsub.Message) {
+		msg.Ack()
+		if string(msg.Data) != data {
+			return
+		}
+		for key, value := range attributes {
+			if msg.Attributes[key] != value {
+				return
+			}
+		}
+
+		mu.Lock()
+		found = true
+		mu.Unlock()
+		cancel()
+	})
+	if err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+		return false, fmt.Errorf("failed to receive messages: %w", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return found, nil
+}
@@ -0,0 +1,42 @@
+package subscriber
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+func TestNackRepeatedly_DeadLettered(t *testing.T) {
+	ctx := context.Background()
+	client, srv := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	pubsubtest.CreateTopic(t, client, "dlq")
+	pubsubtest.CreateSubscription(t, client, "s", "t", &pubsubpb.Subscription{
+		DeadLetterPolicy: &pubsubpb.DeadLetterPolicy{DeadLetterTopic: pubsubtest.TopicName("dlq"), MaxDeliveryAttempts: 1},
+	})
+	srv.Publish("projects/p/topics/t", []byte("poison"), map[string]string{"kind": "test"})
+
+	// A limit the first nack reaches keeps the test independent of pstest's redelivery timing
+	timeout := 20 * time.Second
+	start := time.Now()
+	trace, err := NackRepeatedly(ctx, client.Subscriber("s"), 10, 1, timeout)
+	if err != nil {
+		t.Fatalf("NackRepeatedly() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= timeout {
+		t.Errorf("NackRepeatedly() took %v, want it to return once the message was dead-lettered", elapsed)
+	}
+	if !trace.DeadLettered || trace.Redelivered {
+		t.Errorf("trace dead lettered/redelivered = %v/%v, want true/false", trace.DeadLettered, trace.Redelivered)
+	}
+	if trace.Nacks != 1 || trace.DeliveryAttempt != 1 {
+		t.Errorf("trace nacks/delivery attempt = %d/%d, want 1/1", trace.Nacks, trace.DeliveryAttempt)
+	}
+	if trace.Data != "poison" || trace.Attributes["kind"] != "test" {
+		t.Errorf("trace message = %q %v, want the published message", trace.Data, trace.Attributes)
+	}
+}
@@ -0,0 +1,86 @@
+// Package subscriber provides streaming pull functionality for Pub/Sub subscriptions
+package subscriber
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// Payload render modes accepted by RenderMessages
+const (
+	RenderModeAuto   = "auto"   // Text when the payload is valid UTF-8, base64 otherwise
+	RenderModeText   = "text"   // Invalid UTF-8 sequences are replaced with U+FFFD
+	RenderModeBase64 = "base64" // Standard encoding with padding
+	RenderModeHex    = "hex"    // Lowercase, no separators
+)
+
+// contentTypeAttribute is the attribute publishers conventionally use to declare the payload type
+const contentTypeAttribute = "content-type"
+
+// ValidateRenderMode checks that mode is one of the payload render modes
+func ValidateRenderMode(mode string) error {
+	switch mode {
+	case RenderModeAuto, RenderModeText, RenderModeBase64, RenderModeHex:
+		return nil
+	default:
+		return fmt.Errorf("invalid render mode %q: must be %s, %s, %s or %s", mode, RenderModeAuto, RenderModeText, RenderModeBase64, RenderModeHex)
+	}
+}
+
+// RenderMessages returns copies of messages with Data encoded for display and Encoding and ContentType set
+// The buffer keeps the raw payload bytes, so rendering in another mode later loses nothing.
+func RenderMessages(messages []PubSubMessage, mode string) ([]PubSubMessage, error) {
+	if err := ValidateRenderMode(mode); err != nil {
+		return nil, err
+	}
+
+	results := make([]PubSubMessage, len(messages))
+	for i, msg := range messages {
+		msg.ContentType = DetectContentType(msg.Data, msg.Attributes)
+		msg.Data, msg.Encoding = renderPayload(msg.Data, mode)
+		results[i] = msg
+	}
+	return results, nil
+}
+
+// renderPayload encodes raw payload bytes in a render mode and returns the encoding actually used
+func renderPayload(data, mode string) (string, string) {
+	if mode == RenderModeAuto {
+		mode = RenderModeText
+		if !utf8.ValidString(data) {
+			mode = RenderModeBase64
+		}
+	}
+
+	switch mode {
+	case RenderModeBase64:
+		return base64.StdEncoding.EncodeToString([]byte(data)), RenderModeBase64
+	case RenderModeHex:
+		return hex.EncodeToString([]byte(data)), RenderModeHex
+	default:
+		return strings.ToValidUTF8(data, "\uFFFD"), RenderModeText
+	}
+}
+
+// DetectContentType returns the payload's MIME type: the content-type attribute (matched case-insensitively)
+// when present, otherwise sniffed from the payload ("application/json" for valid JSON)
+func DetectContentType(data string, attributes map[string]string) string {
+	for key, value := range attributes {
+		if strings.EqualFold(key, contentTypeAttribute) && strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	if data == "" {
+		return "text/plain"
+	}
+	if utf8.ValidString(data) && json.Valid([]byte(data)) {
+		return "application/json"
+	}
+	return http.DetectContentType([]byte(data))
+}
@@ -0,0 +1,66 @@
+package subscriber
+
+import (
+	"testing"
+)
+
+func TestRenderMessages(t *testing.T) {
+	binary := string([]byte{0xff, 0x00, 0x41})
+	tests := []struct {
+		name         string
+		data         string
+		mode         string
+		wantData     string
+		wantEncoding string
+	}{
+		{"auto text", "héllo", RenderModeAuto, "héllo", RenderModeText},
+		{"auto binary", binary, RenderModeAuto, "/wBB", RenderModeBase64},
+		{"text binary", binary, RenderModeText, "\uFFFD\x00A", RenderModeText},
+		{"base64", "hi", RenderModeBase64, "aGk=", RenderModeBase64},
+		{"hex", binary, RenderModeHex, "ff0041", RenderModeHex},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := []PubSubMessage{{ID: "1", Data: tt.data}}
+			got, err := RenderMessages(messages, tt.mode)
+			if err != nil {
+				t.Fatalf("RenderMessages() error = %v", err)
+			}
+			if got[0].Data != tt.wantData || got[0].Encoding != tt.wantEncoding {
+				t.Errorf("RenderMessages() = %q (%s), want %q (%s)", got[0].Data, got[0].Encoding, tt.wantData, tt.wantEncoding)
+			}
+			if messages[0].Data != tt.data {
+				t.Error("RenderMessages() modified the input message")
+			}
+		})
+	}
+
+	if _, err := RenderMessages(nil, "utf16"); err == nil {
+		t.Error("RenderMessages(utf16) error = nil, want error")
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		attributes map[string]string
+		want       string
+	}{
+		{"attribute", `{"a":1}`, map[string]string{"Content-Type": "application/avro"}, "application/avro"},
+		{"json", `{"a":1}`, nil, "application/json"},
+		{"text", "hello", nil, "text/plain; charset=utf-8"},
+		{"empty", "", nil, "text/plain"},
+		{"png", "\x89PNG\r\n\x1a\n\x00", nil, "image/png"},
+		{"binary", string([]byte{0x00, 0x01, 0xff}), nil, "application/octet-stream"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectContentType(tt.data, tt.attributes); got != tt.want {
+				t.Errorf("DetectContentType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Package subscriber provides streaming pull functionality for Pub/Sub subscriptions
+package subscriber
+
+import (
+	"strings"
+)
+
+// attrQueryPrefix selects exact attribute matching in a search query: "attr:key=value"
+const attrQueryPrefix = "attr:"
+
+// Search returns the buffered messages matching query, oldest first
+// A plain query matches payloads (and attribute keys/values when searchAttributes is set) by
+// case-insensitive substring. "attr:key=value" matches messages whose attribute key equals value
+// exactly, and "attr:key" matches messages that have the attribute. An empty query matches everything.
+// The buffer is copied under its read lock and scanned without holding it, so streaming is not blocked.
+func (mb *MessageBuffer) Search(query string, searchAttributes bool) []PubSubMessage {
+	messages := mb.GetMessages()
+
+	match := newMessageMatcher(query, searchAttributes)
+	if match == nil {
+		return messages
+	}
+
+	results := make([]PubSubMessage, 0)
+	for _, msg := range messages {
+		if match(msg) {
+			results = append(results, msg)
+		}
+	}
+	return results
+}
+
+// newMessageMatcher builds the predicate for a search query (nil matches everything)
+func newMessageMatcher(query string, searchAttributes bool) func(PubSubMessage) bool {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil
+	}
+
+	if attr, ok := strings.CutPrefix(query, attrQueryPrefix); ok {
+		key, value, hasValue := strings.Cut(attr, "=")
+		return func(msg PubSubMessage) bool {
+			actual, exists := msg.Attributes[key]
+			return exists && (!hasValue || actual == value)
+		}
+	}
+
+	needle := strings.ToLower(query)
+	return func(msg PubSubMessage) bool {
+		if strings.Contains(strings.ToLower(msg.Data), needle) {
+			return true
+		}
+		if !searchAttributes {
+			return false
+		}
+		for key, value := range msg.Attributes {
+			if strings.Contains(strings.ToLower(key), needle) || strings.Contains(strings.ToLower(value), needle) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,66 @@
+package subscriber
+
+import (
+	"fmt"
+	"testing"
+)
+
+func newSearchBuffer(n int) *MessageBuffer {
+	buffer := NewMessageBuffer(n)
+	for i := 0; i < n; i++ {
+		buffer.AddMessage(PubSubMessage{
+			ID:         fmt.Sprintf("msg-%d", i),
+			Data:       fmt.Sprintf(`{"orderId":"ORD-%d","status":"%s"}`, i, []string{"created", "Shipped", "cancelled"}[i%3]),
+			Attributes: map[string]string{"region": []string{"eu", "us"}[i%2], "source": "checkout"},
+		})
+	}
+	return buffer
+}
+
+func TestMessageBuffer_Search(t *testing.T) {
+	buffer := newSearchBuffer(6)
+
+	tests := []struct {
+		name             string
+		query            string
+		searchAttributes bool
+		want             int
+	}{
+		{"empty query matches all", "", false, 6},
+		{"case-insensitive payload", "shipped", false, 2},
+		{"payload only ignores attributes", "checkout", false, 0},
+		{"attributes searched", "CHECKOUT", true, 6},
+		{"exact attribute", "attr:region=eu", false, 3},
+		{"exact attribute is case-sensitive", "attr:region=EU", false, 0},
+		{"attribute presence", "attr:source", false, 6},
+		{"missing attribute", "attr:tenant", false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buffer.Search(tt.query, tt.searchAttributes); len(got) != tt.want {
+				t.Errorf("Search(%q, %v) returned %d messages, want %d", tt.query, tt.searchAttributes, len(got), tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkMessageBuffer_Search(b *testing.B) {
+	buffer := newSearchBuffer(10000)
+
+	benchmarks := []struct {
+		name             string
+		query            string
+		searchAttributes bool
+	}{
+		{"payload", "ord-9999", false},
+		{"payload and attributes", "checkout", true},
+		{"exact attribute", "attr:region=eu", false},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for b.Loop() {
+				buffer.Search(bm.query, bm.searchAttributes)
+			}
+		})
+	}
+}
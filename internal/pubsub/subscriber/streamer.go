@@ -5,12 +5,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"cloud.google.com/go/pubsub/v2"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 
 	"pubsub-gui/internal/logger"
+	"pubsub-gui/internal/models"
 )
 
 // MessageStreamer handles streaming pull for a subscription
@@ -19,25 +22,91 @@ type MessageStreamer struct {
 	subscriber     *pubsub.Subscriber
 	subscriptionID string
 	buffer         *MessageBuffer
-	autoAck        bool
+	autoAck        atomic.Bool // Acks new messages on receipt; messages already leased can still be settled manually
 	cancel         context.CancelFunc
-	doneChan       chan struct{}
 	errChan        chan error
+
+	runMu         sync.Mutex         // Guards the receive loop state below
+	receiveCancel context.CancelFunc // Cancels the current Receive loop
+	doneChan      chan struct{}      // Closed when the current Receive loop has returned
+	paused        bool
+	startedAt     time.Time
+	received      atomic.Int64
+
+	acked       atomic.Int64
+	nacked      atomic.Int64
+	expired     atomic.Int64
+	redelivered atomic.Int64
+	ackFailed   atomic.Int64
+
+	exactlyOnce atomic.Bool // Acks and nacks are confirmed with the server (exactly-once subscriptions)
+
+	leaseMu   sync.Mutex
+	leased    map[string]*leasedMessage // Unacked messages by ID (guarded by leaseMu)
+	leaseHold time.Duration             // How long unacked messages stay leased; 0 uses the client library default
+}
+
+// AckStats counts how received messages were handled since start or the last buffer clear
+type AckStats struct {
+	Acked       int64 `json:"acked"`       // Messages acknowledged (auto-ack or manual)
+	Nacked      int64 `json:"nacked"`      // Messages explicitly nacked
+	Expired     int64 `json:"expired"`     // Messages released unacked after their lease hold (will be redelivered)
+	Redelivered int64 `json:"redelivered"` // Messages received again while a copy was still buffered
+	AckFailed   int64 `json:"ackFailed"`   // Exactly-once acks/nacks the server did not confirm
+}
+
+// ackStatsInterval is how often changed ack stats are emitted to the frontend
+const ackStatsInterval = time.Second
+
+// defaultLeaseExtension matches the client library's default MaxExtension
+// Unacked messages are forgotten (not released) after this long when no explicit hold is set
+const defaultLeaseExtension = 60 * time.Minute
+
+// leasedMessage is an unacked message whose ack deadline the client library keeps extending
+type leasedMessage struct {
+	msg        *pubsub.Message
+	receivedAt time.Time
+	timer      *time.Timer
+}
+
+// ApplyMonitorOptions sets a subscriber's flow control from monitor options
+// Zero fields leave the subscriber's current settings (the client library defaults) untouched.
+func ApplyMonitorOptions(sub *pubsub.Subscriber, options models.MonitorOptions) {
+	if options.MaxOutstandingMessages > 0 {
+		sub.ReceiveSettings.MaxOutstandingMessages = options.MaxOutstandingMessages
+	}
+	if options.MaxOutstandingBytes > 0 {
+		sub.ReceiveSettings.MaxOutstandingBytes = options.MaxOutstandingBytes
+	}
+	if options.NumGoroutines > 0 {
+		sub.ReceiveSettings.NumGoroutines = options.NumGoroutines
+	}
 }
 
 // NewMessageStreamer creates a new MessageStreamer
 func NewMessageStreamer(ctx context.Context, subscriber *pubsub.Subscriber, subscriptionID string, buffer *MessageBuffer, autoAck bool) *MessageStreamer {
 	streamCtx, cancel := context.WithCancel(ctx)
-	return &MessageStreamer{
+	ms := &MessageStreamer{
 		ctx:            streamCtx,
 		subscriber:     subscriber,
 		subscriptionID: subscriptionID,
 		buffer:         buffer,
-		autoAck:        autoAck,
 		cancel:         cancel,
-		doneChan:       make(chan struct{}),
 		errChan:        make(chan error, 1),
+		leased:         make(map[string]*leasedMessage),
+	}
+	ms.autoAck.Store(autoAck)
+	if subscriber != nil {
+		forgetOnShutdown(subscriber)
 	}
+	return ms
+}
+
+// forgetOnShutdown makes Receive return as soon as it is cancelled instead of waiting for outstanding
+// messages to be settled. Held leases are dropped on Stop and Pause, so those messages would never be
+// settled and Receive would block forever; Pub/Sub redelivers them after their ack deadline.
+func forgetOnShutdown(sub *pubsub.Subscriber) {
+	sub.ReceiveSettings.ShutdownOptions = &pubsub.ShutdownOptions{Timeout: 0}
 }
 
 // Start begins streaming pull for the subscription
@@ -46,34 +115,121 @@ func (ms *MessageStreamer) Start() error {
 		return fmt.Errorf("subscriber is nil")
 	}
 
+	ms.startedAt = time.Now()
+
 	// Start goroutine for Receive callback
-	go ms.receiveMessages()
+	ms.runMu.Lock()
+	ms.startReceive()
+	ms.runMu.Unlock()
+	go ms.emitAckStats()
 
 	return nil
 }
 
-// receiveMessages handles the streaming pull receive loop
-func (ms *MessageStreamer) receiveMessages() {
-	defer close(ms.doneChan)
+// startReceive starts a new Receive loop bound to the streamer context (runMu must be held)
+func (ms *MessageStreamer) startReceive() {
+	receiveCtx, cancel := context.WithCancel(ms.ctx)
+	done := make(chan struct{})
+	ms.receiveCancel = cancel
+	ms.doneChan = done
+	go ms.receiveMessages(receiveCtx, done)
+}
+
+// Pause stops receiving messages while keeping the buffer, stats and settings
+// Outstanding unacked messages are forgotten; Pub/Sub redelivers them after their ack deadline.
+func (ms *MessageStreamer) Pause() error {
+	ms.runMu.Lock()
+	defer ms.runMu.Unlock()
+
+	if ms.paused {
+		return fmt.Errorf("monitor for %s is already paused", ms.subscriptionID)
+	}
+	if ms.ctx.Err() != nil {
+		return fmt.Errorf("monitor for %s is stopped", ms.subscriptionID)
+	}
+
+	ms.receiveCancel()
+	if err := ms.waitReceiveDone(); err != nil {
+		return err
+	}
+	ms.dropLeases()
+	ms.paused = true
+	return nil
+}
+
+// Resume restarts receiving messages after Pause
+func (ms *MessageStreamer) Resume() error {
+	ms.runMu.Lock()
+	defer ms.runMu.Unlock()
+
+	if !ms.paused {
+		return fmt.Errorf("monitor for %s is not paused", ms.subscriptionID)
+	}
+	if ms.ctx.Err() != nil {
+		return fmt.Errorf("monitor for %s is stopped", ms.subscriptionID)
+	}
+
+	ms.startReceive()
+	ms.paused = false
+	return nil
+}
+
+// IsPaused reports whether the streamer is paused
+func (ms *MessageStreamer) IsPaused() bool {
+	ms.runMu.Lock()
+	defer ms.runMu.Unlock()
+	return ms.paused
+}
+
+// waitReceiveDone waits for the current Receive loop to return (runMu must be held)
+func (ms *MessageStreamer) waitReceiveDone() error {
+	select {
+	case <-ms.doneChan:
+		return nil
+	case <-time.After(5 * time.Second):
+		return fmt.Errorf("timeout waiting for streamer to stop")
+	}
+}
+
+// dropLeases stops tracking unacked messages; the client library stops extending them once Receive returns
+func (ms *MessageStreamer) dropLeases() {
+	ms.leaseMu.Lock()
+	defer ms.leaseMu.Unlock()
+	for id, entry := range ms.leased {
+		entry.timer.Stop()
+		delete(ms.leased, id)
+	}
+}
+
+// receiveMessages handles the streaming pull receive loop until receiveCtx is cancelled
+func (ms *MessageStreamer) receiveMessages(receiveCtx context.Context, done chan struct{}) {
+	defer close(done)
 
 	// Use Receive with a callback function
-	err := ms.subscriber.Receive(ms.ctx, func(_ context.Context, msg *pubsub.Message) {
+	err := ms.subscriber.Receive(receiveCtx, func(_ context.Context, msg *pubsub.Message) {
 		// Decode and transform message
 		pubSubMsg := decodeMessage(msg)
 
+		if _, seen := ms.buffer.GetMessage(msg.ID); seen || (msg.DeliveryAttempt != nil && *msg.DeliveryAttempt > 1) {
+			ms.redelivered.Add(1)
+		}
+
 		// Add to buffer
 		ms.buffer.AddMessage(pubSubMsg)
+		ms.received.Add(1)
 
 		// Emit Wails event for new message
 		runtime.EventsEmit(ms.ctx, "message:received", pubSubMsg)
 
 		// Acknowledge if auto-ack enabled
-		if ms.autoAck {
-			msg.Ack()
+		if ms.autoAck.Load() {
+			ms.settle(msg, true)
+			return
 		}
 		// Otherwise, message remains unacked until:
-		// - User manually acks (future feature)
-		// - Ack deadline expires (Pub/Sub will redeliver)
+		// - User manually acks or nacks it (AckMessage/NackMessage)
+		// - The lease hold elapses and the message is released for redelivery
+		ms.holdLease(msg)
 	})
 
 	// Handle errors
@@ -94,9 +250,9 @@ func (ms *MessageStreamer) receiveMessages() {
 		// Log error for debugging
 		logger.Error("Error receiving messages for subscription", "subscriptionID", ms.subscriptionID, "error", err)
 
-		// Only emit error event if context is still active (not cancelled)
+		// Only emit error event if context is still active (not cancelled by Stop or Pause)
 		select {
-		case <-ms.ctx.Done():
+		case <-receiveCtx.Done():
 			// Context cancelled, don't emit error (expected shutdown)
 		default:
 			// Context still active, emit error for unexpected issues
@@ -117,30 +273,184 @@ func (ms *MessageStreamer) receiveMessages() {
 
 // Stop gracefully stops streaming pull
 func (ms *MessageStreamer) Stop() error {
-	// Cancel context to stop Receive loop
+	// Cancel context to stop Receive loop (already returned when paused)
 	ms.cancel()
 
+	// Drop lease timers; the client library stops extending deadlines once Receive returns
+	ms.dropLeases()
+
 	// Wait for goroutine to finish (with timeout)
-	select {
-	case <-ms.doneChan:
-		return nil
-	case <-time.After(5 * time.Second):
-		return fmt.Errorf("timeout waiting for streamer to stop")
+	ms.runMu.Lock()
+	defer ms.runMu.Unlock()
+	if ms.doneChan == nil {
+		return nil // Never started
 	}
+	return ms.waitReceiveDone()
 }
 
 // SetAutoAck updates the auto-acknowledge setting
-// Note: This only affects new messages, not messages already received
+// Note: This only affects new messages; messages already leased stay unacked until settled or released
 func (ms *MessageStreamer) SetAutoAck(enabled bool) {
-	ms.autoAck = enabled
+	ms.autoAck.Store(enabled)
 }
 
 // GetAutoAck returns the current auto-ack setting
 func (ms *MessageStreamer) GetAutoAck() bool {
-	return ms.autoAck
+	return ms.autoAck.Load()
 }
 
 // GetBuffer returns the message buffer
 func (ms *MessageStreamer) GetBuffer() *MessageBuffer {
 	return ms.buffer
 }
+
+// GetReceiveStats returns the number of messages received since Start and when streaming started
+func (ms *MessageStreamer) GetReceiveStats() (int64, time.Time) {
+	return ms.received.Load(), ms.startedAt
+}
+
+// GetAckStats returns the current ack/nack/expire/redelivery counts
+func (ms *MessageStreamer) GetAckStats() AckStats {
+	return AckStats{
+		Acked:       ms.acked.Load(),
+		Nacked:      ms.nacked.Load(),
+		Expired:     ms.expired.Load(),
+		Redelivered: ms.redelivered.Load(),
+		AckFailed:   ms.ackFailed.Load(),
+	}
+}
+
+// ResetAckStats zeroes the ack stats (called when the buffer is cleared)
+func (ms *MessageStreamer) ResetAckStats() {
+	ms.acked.Store(0)
+	ms.nacked.Store(0)
+	ms.expired.Store(0)
+	ms.redelivered.Store(0)
+	ms.ackFailed.Store(0)
+}
+
+// emitAckStats emits "monitor:ack-stats" whenever the stats changed, at most once per ackStatsInterval
+func (ms *MessageStreamer) emitAckStats() {
+	ticker := time.NewTicker(ackStatsInterval)
+	defer ticker.Stop()
+
+	var last AckStats
+	for {
+		select {
+		case <-ms.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		stats := ms.GetAckStats()
+		if stats == last {
+			continue
+		}
+		last = stats
+		runtime.EventsEmit(ms.ctx, "monitor:ack-stats", map[string]interface{}{
+			"subscriptionID": ms.subscriptionID,
+			"stats":          stats,
+		})
+	}
+}
+
+// SetLeaseHold sets how long unacked messages stay leased (deadline extended) before being released
+// for redelivery. A hold of 0 restores the client library default (extension up to 60 minutes).
+// Messages already held are rescheduled relative to when they were received.
+func (ms *MessageStreamer) SetLeaseHold(hold time.Duration) {
+	if hold < 0 {
+		hold = 0
+	}
+
+	ms.leaseMu.Lock()
+	defer ms.leaseMu.Unlock()
+
+	ms.leaseHold = hold
+	for _, entry := range ms.leased {
+		entry.timer.Reset(max(time.Until(entry.receivedAt.Add(ms.effectiveLeaseHold())), 0))
+	}
+}
+
+// GetLeaseHold returns the configured lease hold (0 means the client library default)
+func (ms *MessageStreamer) GetLeaseHold() time.Duration {
+	ms.leaseMu.Lock()
+	defer ms.leaseMu.Unlock()
+	return ms.leaseHold
+}
+
+// effectiveLeaseHold returns how long a message is held under the current setting (leaseMu must be held)
+func (ms *MessageStreamer) effectiveLeaseHold() time.Duration {
+	if ms.leaseHold > 0 {
+		return ms.leaseHold
+	}
+	return defaultLeaseExtension
+}
+
+// holdLease tracks an unacked message and schedules its release
+func (ms *MessageStreamer) holdLease(msg *pubsub.Message) {
+	ms.leaseMu.Lock()
+	defer ms.leaseMu.Unlock()
+
+	// A redelivered message replaces its previous entry
+	if previous, exists := ms.leased[msg.ID]; exists {
+		previous.timer.Stop()
+	}
+
+	id := msg.ID
+	ms.leased[id] = &leasedMessage{
+		msg:        msg,
+		receivedAt: time.Now(),
+		timer:      time.AfterFunc(ms.effectiveLeaseHold(), func() { ms.expireLease(id) }),
+	}
+}
+
+// expireLease releases a message once its hold has elapsed
+// With an explicit hold the message is nacked so Pub/Sub redelivers it immediately;
+// with the default the library has already stopped extending it, so it is only forgotten
+func (ms *MessageStreamer) expireLease(id string) {
+	ms.leaseMu.Lock()
+	entry, exists := ms.leased[id]
+	// Timers may fire late after a reschedule; only release once the current hold has elapsed
+	if !exists || time.Since(entry.receivedAt) < ms.effectiveLeaseHold() {
+		ms.leaseMu.Unlock()
+		return
+	}
+	delete(ms.leased, id)
+	release := ms.leaseHold > 0
+	ms.leaseMu.Unlock()
+
+	ms.expired.Add(1)
+
+	if release {
+		entry.msg.Nack() // Not a user action, so exactly-once results are not tracked
+	}
+}
+
+// AckMessage acknowledges an outstanding (unacked, still leased) message
+func (ms *MessageStreamer) AckMessage(messageID string) error {
+	return ms.settleMessage(messageID, true)
+}
+
+// NackMessage nacks an outstanding message so Pub/Sub redelivers it
+func (ms *MessageStreamer) NackMessage(messageID string) error {
+	return ms.settleMessage(messageID, false)
+}
+
+// settleMessage acks or nacks an outstanding message and stops tracking it
+// Works whatever the auto-ack setting, so messages leased before auto-ack was turned on can still be settled.
+func (ms *MessageStreamer) settleMessage(messageID string, ack bool) error {
+	ms.leaseMu.Lock()
+	entry, exists := ms.leased[messageID]
+	if exists {
+		entry.timer.Stop()
+		delete(ms.leased, messageID)
+	}
+	ms.leaseMu.Unlock()
+
+	if !exists {
+		return fmt.Errorf("%w: %s", models.ErrMessageNotOutstanding, messageID)
+	}
+
+	ms.settle(entry.msg, ack)
+	return nil
+}
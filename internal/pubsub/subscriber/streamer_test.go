@@ -0,0 +1,312 @@
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/pubsubtest"
+)
+
+// newTestStreamer starts a streamer on an empty subscription of an in-memory Pub/Sub server
+// No messages are published, so the streamer never emits Wails events
+func newTestStreamer(t *testing.T) *MessageStreamer {
+	t.Helper()
+	client, _ := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	pubsubtest.CreateSubscription(t, client, "s", "t", nil)
+
+	streamer := NewMessageStreamer(context.Background(), client.Subscriber("s"), "s", NewMessageBuffer(10), false)
+	if err := streamer.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	return streamer
+}
+
+func TestMessageStreamer_PauseResume(t *testing.T) {
+	streamer := newTestStreamer(t)
+	streamer.GetBuffer().AddMessage(PubSubMessage{ID: "kept"})
+
+	if err := streamer.Resume(); err == nil {
+		t.Error("Resume() on a running streamer error = nil, want error")
+	}
+	if err := streamer.Pause(); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+	if !streamer.IsPaused() {
+		t.Error("IsPaused() = false after Pause, want true")
+	}
+	if err := streamer.Pause(); err == nil {
+		t.Error("Pause() twice error = nil, want error")
+	}
+	if err := streamer.Resume(); err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if streamer.IsPaused() {
+		t.Error("IsPaused() = true after Resume, want false")
+	}
+	if n := streamer.GetBuffer().Size(); n != 1 {
+		t.Errorf("buffer size after pause/resume = %d, want 1", n)
+	}
+
+	// Stopping from the paused state must not block
+	if err := streamer.Pause(); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+	if err := streamer.Stop(); err != nil {
+		t.Errorf("Stop() while paused error = %v", err)
+	}
+	if err := streamer.Resume(); err == nil {
+		t.Error("Resume() after Stop error = nil, want error")
+	}
+}
+
+func TestMessageStreamer_PauseResumeDoesNotLeakGoroutines(t *testing.T) {
+	streamer := newTestStreamer(t)
+	defer func() { _ = streamer.Stop() }()
+
+	cycle := func() {
+		if err := streamer.Pause(); err != nil {
+			t.Fatalf("Pause() error = %v", err)
+		}
+		if err := streamer.Resume(); err != nil {
+			t.Fatalf("Resume() error = %v", err)
+		}
+	}
+
+	// Warm up so lazily started client goroutines are already counted
+	cycle()
+	time.Sleep(100 * time.Millisecond)
+	baseline := runtime.NumGoroutine()
+
+	for range 20 {
+		cycle()
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	// Allow for transient gRPC goroutines; a leak would add at least one per cycle
+	if got := runtime.NumGoroutine(); got > baseline+5 {
+		t.Errorf("goroutines after 20 pause/resume cycles = %d, baseline %d", got, baseline)
+	}
+}
+
+func TestApplyMonitorOptions(t *testing.T) {
+	sub := &pubsub.Subscriber{ReceiveSettings: pubsub.DefaultReceiveSettings}
+	ApplyMonitorOptions(sub, models.MonitorOptions{MaxOutstandingMessages: 5, MaxOutstandingBytes: 4096, NumGoroutines: 1})
+
+	if sub.ReceiveSettings.MaxOutstandingMessages != 5 {
+		t.Errorf("MaxOutstandingMessages = %d, want 5", sub.ReceiveSettings.MaxOutstandingMessages)
+	}
+	if sub.ReceiveSettings.MaxOutstandingBytes != 4096 {
+		t.Errorf("MaxOutstandingBytes = %d, want 4096", sub.ReceiveSettings.MaxOutstandingBytes)
+	}
+	if sub.ReceiveSettings.NumGoroutines != 1 {
+		t.Errorf("NumGoroutines = %d, want 1", sub.ReceiveSettings.NumGoroutines)
+	}
+
+	// Zero options keep the current settings
+	defaults := &pubsub.Subscriber{ReceiveSettings: pubsub.DefaultReceiveSettings}
+	ApplyMonitorOptions(defaults, models.MonitorOptions{})
+	if defaults.ReceiveSettings != pubsub.DefaultReceiveSettings {
+		t.Errorf("ReceiveSettings = %+v, want defaults %+v", defaults.ReceiveSettings, pubsub.DefaultReceiveSettings)
+	}
+}
+
+func TestMessageStreamer_SettleMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		autoAck bool // Auto-ack setting when the message is settled
+		ack     bool
+	}{
+		{name: "ack", ack: true},
+		{name: "nack", ack: false},
+		{name: "ack after auto-ack was enabled", autoAck: true, ack: true},
+		{name: "nack after auto-ack was enabled", autoAck: true, ack: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client, srv := pubsubtest.NewClient(t)
+			pubsubtest.CreateTopic(t, client, "t")
+			pubsubtest.CreateSubscription(t, client, "s", "t", nil)
+			srv.Publish("projects/p/topics/t", []byte("payload"), nil)
+
+			// The message is leased while auto-ack is off, then settled from a plain Receive (no Wails events)
+			streamer := NewMessageStreamer(ctx, nil, "s", NewMessageBuffer(10), false)
+			t.Cleanup(func() { _ = streamer.Stop() })
+			settle := streamer.NackMessage
+			if tt.ack {
+				settle = streamer.AckMessage
+			}
+
+			var once sync.Once
+			var settleErr, againErr error
+			receiveCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+			err := client.Subscriber("s").Receive(receiveCtx, func(_ context.Context, msg *pubsub.Message) {
+				once.Do(func() {
+					streamer.holdLease(msg)
+					streamer.SetAutoAck(tt.autoAck)
+					settleErr = settle(msg.ID)
+					againErr = settle(msg.ID)
+					cancel()
+				})
+			})
+			if err != nil {
+				t.Fatalf("Receive() error = %v", err)
+			}
+
+			if settleErr != nil {
+				t.Errorf("settling a leased message error = %v, want nil", settleErr)
+			}
+			if !errors.Is(againErr, models.ErrMessageNotOutstanding) {
+				t.Errorf("settling it again error = %v, want ErrMessageNotOutstanding", againErr)
+			}
+			stats := streamer.GetAckStats()
+			if tt.ack && (stats.Acked != 1 || stats.Nacked != 0) || !tt.ack && (stats.Acked != 0 || stats.Nacked != 1) {
+				t.Errorf("GetAckStats() = %+v, want the message counted as acked=%v", stats, tt.ack)
+			}
+		})
+	}
+
+	streamer := NewMessageStreamer(context.Background(), nil, "s", NewMessageBuffer(10), true)
+	if err := streamer.AckMessage("unknown"); !errors.Is(err, models.ErrMessageNotOutstanding) {
+		t.Errorf("AckMessage(unknown) error = %v, want ErrMessageNotOutstanding", err)
+	}
+}
+
+// receiveHeld publishes one message and holds every delivery of it on streamer from a plain Receive
+// (no Wails events) configured like the streamer's own. onDelivery is called after each delivery is held; Receive stops when it returns true
+// or after timeout. Returns the time of each delivery.
+func receiveHeld(t *testing.T, streamer *MessageStreamer, timeout time.Duration, onDelivery func(n int, msg *pubsub.Message) bool) []time.Time {
+	t.Helper()
+	client, srv := pubsubtest.NewClient(t)
+	pubsubtest.CreateTopic(t, client, "t")
+	pubsubtest.CreateSubscription(t, client, "s", "t", nil)
+	srv.Publish("projects/p/topics/t", []byte("payload"), nil)
+
+	sub := client.Subscriber("s")
+	forgetOnShutdown(sub)
+
+	var mu sync.Mutex
+	var deliveries []time.Time
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := sub.Receive(ctx, func(_ context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+		deliveries = append(deliveries, time.Now())
+		streamer.holdLease(msg)
+		if onDelivery(len(deliveries), msg) {
+			cancel()
+		}
+	})
+	if err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	return deliveries
+}
+
+func TestMessageStreamer_LeaseHoldReleasesAfterHold(t *testing.T) {
+	const hold = 300 * time.Millisecond
+	streamer := NewMessageStreamer(context.Background(), nil, "s", NewMessageBuffer(10), false)
+	t.Cleanup(func() { _ = streamer.Stop() })
+	streamer.SetLeaseHold(hold)
+
+	deliveries := receiveHeld(t, streamer, 10*time.Second, func(n int, msg *pubsub.Message) bool {
+		if n == 2 {
+			_ = streamer.AckMessage(msg.ID)
+		}
+		return n == 2
+	})
+
+	if len(deliveries) != 2 {
+		t.Fatalf("deliveries = %d, want the held message nacked and redelivered once", len(deliveries))
+	}
+	if gap := deliveries[1].Sub(deliveries[0]); gap < hold {
+		t.Errorf("message redelivered after %v, want no redelivery before the %v hold ends", gap, hold)
+	}
+	if stats := streamer.GetAckStats(); stats.Expired != 1 || stats.Acked != 1 {
+		t.Errorf("GetAckStats() = %+v, want 1 expired and 1 acked", stats)
+	}
+}
+
+func TestMessageStreamer_LoweringLeaseHoldReleasesEarly(t *testing.T) {
+	streamer := NewMessageStreamer(context.Background(), nil, "s", NewMessageBuffer(10), false)
+	t.Cleanup(func() { _ = streamer.Stop() })
+	streamer.SetLeaseHold(time.Hour)
+
+	// Without the lower hold the library keeps extending the lease and the message is never redelivered
+	// The lower hold leaves time for the library's receipt modack, which would otherwise override the nack
+	deliveries := receiveHeld(t, streamer, 10*time.Second, func(n int, msg *pubsub.Message) bool {
+		if n == 1 {
+			streamer.SetLeaseHold(300 * time.Millisecond)
+			return false
+		}
+		_ = streamer.AckMessage(msg.ID)
+		return true
+	})
+
+	if len(deliveries) != 2 {
+		t.Fatalf("deliveries = %d, want the held message released after lowering the hold", len(deliveries))
+	}
+	if stats := streamer.GetAckStats(); stats.Expired != 1 {
+		t.Errorf("GetAckStats().Expired = %d, want 1", stats.Expired)
+	}
+}
+
+func TestMessageStreamer_StopClearsLeaseTimers(t *testing.T) {
+	const hold = 100 * time.Millisecond
+	streamer := NewMessageStreamer(context.Background(), nil, "s", NewMessageBuffer(10), false)
+	streamer.SetLeaseHold(hold)
+
+	receiveHeld(t, streamer, 10*time.Second, func(int, *pubsub.Message) bool {
+		if err := streamer.Stop(); err != nil {
+			t.Errorf("Stop() error = %v", err)
+		}
+		return true
+	})
+
+	streamer.leaseMu.Lock()
+	leased := len(streamer.leased)
+	streamer.leaseMu.Unlock()
+	if leased != 0 {
+		t.Errorf("leased messages after Stop = %d, want 0", leased)
+	}
+
+	time.Sleep(3 * hold)
+	if stats := streamer.GetAckStats(); stats.Expired != 0 {
+		t.Errorf("GetAckStats().Expired = %d after Stop, want 0 (timers must not fire)", stats.Expired)
+	}
+}
+
+func TestMessageStreamer_EffectiveLeaseHold(t *testing.T) {
+	tests := []struct {
+		name string
+		hold time.Duration
+		want time.Duration
+	}{
+		{"unset uses the library default", 0, defaultLeaseExtension},
+		{"negative is treated as unset", -time.Second, defaultLeaseExtension},
+		{"explicit hold", 30 * time.Second, 30 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streamer := NewMessageStreamer(context.Background(), nil, "s", NewMessageBuffer(1), false)
+			streamer.SetLeaseHold(tt.hold)
+
+			streamer.leaseMu.Lock()
+			got := streamer.effectiveLeaseHold()
+			streamer.leaseMu.Unlock()
+			if got != tt.want {
+				t.Errorf("effectiveLeaseHold() with hold %v = %v, want %v", tt.hold, got, tt.want)
+			}
+		})
+	}
+}
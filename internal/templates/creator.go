@@ -30,6 +30,38 @@ func NewCreator(ctx context.Context, client *pubsub.Client, projectID string, re
 	}
 }
 
+// CreateFromTemplateMultiEnv creates resources from a template once per environment suffix
+// Each environment is created independently: a failure (and its rollback) never affects
+// resources already created for other environments, and creation continues past failures
+func (c *Creator) CreateFromTemplateMultiEnv(templateID, baseName string, environments []string) []models.TemplateEnvironmentResult {
+	results := make([]models.TemplateEnvironmentResult, 0, len(environments))
+	seen := make(map[string]bool)
+
+	for _, env := range environments {
+		env = strings.ToLower(strings.TrimSpace(env))
+		if env == "" || seen[env] {
+			continue
+		}
+		seen[env] = true
+
+		result, err := c.CreateFromTemplate(&models.TemplateCreateRequest{
+			TemplateID:  templateID,
+			BaseName:    baseName,
+			Environment: env,
+		})
+		if err != nil {
+			result = &models.TemplateCreateResult{Success: false, Error: err.Error()}
+		}
+
+		results = append(results, models.TemplateEnvironmentResult{
+			Environment: env,
+			Result:      *result,
+		})
+	}
+
+	return results
+}
+
 // CreateFromTemplate creates resources from a template
 func (c *Creator) CreateFromTemplate(request *models.TemplateCreateRequest) (*models.TemplateCreateResult, error) {
 	// Validate request
@@ -147,6 +179,7 @@ func (c *Creator) CreateFromTemplate(request *models.TemplateCreateRequest) (*mo
 			subConfig.PushConfig = &models.PushConfig{
 				Endpoint:   subTemplate.PushConfig.Endpoint,
 				Attributes: subTemplate.PushConfig.Attributes,
+				OIDCToken:  subTemplate.PushConfig.OIDCToken,
 			}
 		}
 
@@ -156,7 +189,7 @@ func (c *Creator) CreateFromTemplate(request *models.TemplateCreateRequest) (*mo
 			if request.Overrides.MaxDeliveryAttempts != nil {
 				maxAttempts = *request.Overrides.MaxDeliveryAttempts
 			}
-			deadLetterTopicName := "projects/" + c.projectID + "/topics/" + deadLetterTopicID
+			_, deadLetterTopicName := admin.NormalizeName(c.projectID, "topic", deadLetterTopicID)
 			subConfig.DeadLetterPolicy = &admin.DeadLetterPolicyInfo{
 				DeadLetterTopic:     deadLetterTopicName,
 				MaxDeliveryAttempts: maxAttempts,
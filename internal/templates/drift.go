@@ -0,0 +1,72 @@
+// Package templates provides template system for creating topics and subscriptions with best practices
+package templates
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+// DetectSubscriptionDrift compares a live subscription against the subscription at subIndex in a template
+// Returns the fields whose live values differ from what the template would create (empty if none)
+func DetectSubscriptionDrift(template *models.TopicSubscriptionTemplate, subIndex int, actual admin.SubscriptionInfo) ([]models.DriftField, error) {
+	if subIndex < 0 || subIndex >= len(template.Subscriptions) {
+		return nil, fmt.Errorf("subscription index %d out of range (template has %d subscriptions)", subIndex, len(template.Subscriptions))
+	}
+	expected := template.Subscriptions[subIndex]
+
+	drift := []models.DriftField{}
+	add := func(field, want, got string) {
+		if want != got {
+			drift = append(drift, models.DriftField{Field: field, Expected: want, Actual: got})
+		}
+	}
+
+	add("ackDeadline", strconv.Itoa(expected.AckDeadline)+"s", strconv.Itoa(actual.AckDeadline)+"s")
+	add("enableOrdering", strconv.FormatBool(expected.EnableOrdering), strconv.FormatBool(actual.EnableOrdering))
+	add("enableExactlyOnce", strconv.FormatBool(expected.EnableExactlyOnce), strconv.FormatBool(actual.EnableExactlyOnce))
+	add("filter", expected.Filter, actual.Filter)
+
+	// Retry policy: no policy means immediate redelivery
+	wantMin, wantMax := "", ""
+	if expected.RetryPolicy != nil {
+		wantMin = normalizeDuration(expected.RetryPolicy.MinimumBackoff)
+		wantMax = normalizeDuration(expected.RetryPolicy.MaximumBackoff)
+	}
+	gotMin, gotMax := "", ""
+	if actual.RetryPolicy != nil {
+		gotMin = normalizeDuration(actual.RetryPolicy.MinimumBackoff)
+		gotMax = normalizeDuration(actual.RetryPolicy.MaximumBackoff)
+	}
+	add("retryPolicy.minimumBackoff", wantMin, gotMin)
+	add("retryPolicy.maximumBackoff", wantMax, gotMax)
+
+	// Dead letter policy: only presence and max attempts are comparable, topic names depend on the base name
+	wantDLQ, wantAttempts := "false", ""
+	if template.DeadLetter != nil {
+		wantDLQ, wantAttempts = "true", strconv.Itoa(template.DeadLetter.MaxDeliveryAttempts)
+	}
+	gotDLQ, gotAttempts := "false", ""
+	if actual.DeadLetterPolicy != nil {
+		gotDLQ, gotAttempts = "true", strconv.Itoa(actual.DeadLetterPolicy.MaxDeliveryAttempts)
+	}
+	add("deadLetterPolicy", wantDLQ, gotDLQ)
+	if wantDLQ == "true" && gotDLQ == "true" {
+		add("deadLetterPolicy.maxDeliveryAttempts", wantAttempts, gotAttempts)
+	}
+
+	return drift, nil
+}
+
+// normalizeDuration renders a duration string canonically so "10s" and "0m10s" compare equal
+// Unparseable values are returned unchanged
+func normalizeDuration(value string) string {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return value
+	}
+	return d.String()
+}
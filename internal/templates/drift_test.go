@@ -0,0 +1,111 @@
+package templates
+
+import (
+	"reflect"
+	"testing"
+
+	"pubsub-gui/internal/models"
+	"pubsub-gui/internal/pubsub/admin"
+)
+
+func TestDetectSubscriptionDrift(t *testing.T) {
+	template := &models.TopicSubscriptionTemplate{
+		Subscriptions: []models.SubscriptionTemplateConfig{{
+			Name:           "sub",
+			AckDeadline:    60,
+			EnableOrdering: true,
+			Filter:         `attributes.type = "order"`,
+			RetryPolicy:    &models.RetryPolicy{MinimumBackoff: "10s", MaximumBackoff: "600s"},
+		}},
+		DeadLetter: &models.DeadLetterTemplateConfig{MaxDeliveryAttempts: 5},
+	}
+	matching := admin.SubscriptionInfo{
+		AckDeadline:      60,
+		EnableOrdering:   true,
+		Filter:           `attributes.type = "order"`,
+		RetryPolicy:      &models.RetryPolicy{MinimumBackoff: "10s", MaximumBackoff: "10m0s"},
+		DeadLetterPolicy: &admin.DeadLetterPolicyInfo{DeadLetterTopic: "projects/p/topics/orders-dlq", MaxDeliveryAttempts: 5},
+	}
+
+	tests := []struct {
+		name   string
+		modify func(info *admin.SubscriptionInfo)
+		want   []models.DriftField
+	}{
+		{
+			name:   "no drift",
+			modify: func(info *admin.SubscriptionInfo) {},
+			want:   []models.DriftField{},
+		},
+		{
+			name:   "ack deadline",
+			modify: func(info *admin.SubscriptionInfo) { info.AckDeadline = 30 },
+			want:   []models.DriftField{{Field: "ackDeadline", Expected: "60s", Actual: "30s"}},
+		},
+		{
+			name: "ordering and exactly-once",
+			modify: func(info *admin.SubscriptionInfo) {
+				info.EnableOrdering = false
+				info.EnableExactlyOnce = true
+			},
+			want: []models.DriftField{
+				{Field: "enableOrdering", Expected: "true", Actual: "false"},
+				{Field: "enableExactlyOnce", Expected: "false", Actual: "true"},
+			},
+		},
+		{
+			name:   "filter",
+			modify: func(info *admin.SubscriptionInfo) { info.Filter = "" },
+			want:   []models.DriftField{{Field: "filter", Expected: `attributes.type = "order"`, Actual: ""}},
+		},
+		{
+			name:   "retry backoff",
+			modify: func(info *admin.SubscriptionInfo) { info.RetryPolicy.MinimumBackoff = "20s" },
+			want:   []models.DriftField{{Field: "retryPolicy.minimumBackoff", Expected: "10s", Actual: "20s"}},
+		},
+		{
+			name:   "retry policy removed",
+			modify: func(info *admin.SubscriptionInfo) { info.RetryPolicy = nil },
+			want: []models.DriftField{
+				{Field: "retryPolicy.minimumBackoff", Expected: "10s", Actual: ""},
+				{Field: "retryPolicy.maximumBackoff", Expected: "10m0s", Actual: ""},
+			},
+		},
+		{
+			name:   "dead letter attempts",
+			modify: func(info *admin.SubscriptionInfo) { info.DeadLetterPolicy.MaxDeliveryAttempts = 10 },
+			want:   []models.DriftField{{Field: "deadLetterPolicy.maxDeliveryAttempts", Expected: "5", Actual: "10"}},
+		},
+		{
+			name:   "dead letter removed",
+			modify: func(info *admin.SubscriptionInfo) { info.DeadLetterPolicy = nil },
+			want:   []models.DriftField{{Field: "deadLetterPolicy", Expected: "true", Actual: "false"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := matching
+			retry := *matching.RetryPolicy
+			deadLetter := *matching.DeadLetterPolicy
+			actual.RetryPolicy, actual.DeadLetterPolicy = &retry, &deadLetter
+			tt.modify(&actual)
+
+			got, err := DetectSubscriptionDrift(template, 0, actual)
+			if err != nil {
+				t.Fatalf("DetectSubscriptionDrift() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DetectSubscriptionDrift() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectSubscriptionDrift_IndexOutOfRange(t *testing.T) {
+	template := &models.TopicSubscriptionTemplate{Subscriptions: []models.SubscriptionTemplateConfig{{Name: "sub"}}}
+	for _, index := range []int{-1, 1} {
+		if _, err := DetectSubscriptionDrift(template, index, admin.SubscriptionInfo{}); err == nil {
+			t.Errorf("DetectSubscriptionDrift(index %d) error = nil, want error", index)
+		}
+	}
+}
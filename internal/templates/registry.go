@@ -129,7 +129,7 @@ func (r *Registry) DeleteCustomTemplate(id string) error {
 
 	// Delete custom template
 	if _, exists := r.customTemplates[id]; !exists {
-		return fmt.Errorf("custom template not found: %s", id)
+		return fmt.Errorf("%w: %s", models.ErrTemplateNotFound, id)
 	}
 
 	delete(r.customTemplates, id)
@@ -172,3 +172,25 @@ func (r *Registry) LoadCustomTemplates(templates []*models.TopicSubscriptionTemp
 
 	return nil
 }
+
+// ReplaceCustomTemplates drops all custom templates and loads the given ones (after a config import)
+// On error the registry is left unchanged.
+func (r *Registry) ReplaceCustomTemplates(templates []*models.TopicSubscriptionTemplate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	customTemplates := make(map[string]*models.TopicSubscriptionTemplate, len(templates))
+	for _, template := range templates {
+		if err := template.Validate(); err != nil {
+			return fmt.Errorf("invalid custom template %s: %w", template.ID, err)
+		}
+		template.IsBuiltIn = false
+		if _, exists := r.builtInTemplates[template.ID]; exists {
+			return fmt.Errorf("custom template ID conflicts with built-in template: %s", template.ID)
+		}
+		customTemplates[template.ID] = template
+	}
+
+	r.customTemplates = customTemplates
+	return nil
+}
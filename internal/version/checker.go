@@ -4,6 +4,7 @@ package version
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	hv "github.com/hashicorp/go-version"
 )
@@ -41,33 +42,70 @@ func CheckForUpdates() (*UpdateInfo, error) {
 		return nil, fmt.Errorf("failed to fetch latest release: %w", err)
 	}
 
-	// Normalize versions (remove 'v' prefix if present)
-	currentNormalized := normalizeVersion(currentVersion)
-	latestNormalized := normalizeVersion(release.TagName)
-
-	// Compare versions using go-version library
-	currentVer, err := hv.NewVersion(currentNormalized)
+	isUpdateAvailable, err := compareVersions(currentVersion, release.TagName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse current version '%s': %w", currentNormalized, err)
+		return nil, err
 	}
 
-	latestVer, err := hv.NewVersion(latestNormalized)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse latest version '%s': %w", latestNormalized, err)
-	}
-
-	isUpdateAvailable := latestVer.GreaterThan(currentVer)
-
 	return &UpdateInfo{
 		CurrentVersion:    currentVersion,
 		LatestVersion:     release.TagName,
 		ReleaseNotes:      release.Body,
 		ReleaseURL:        release.HTMLURL,
-		PublishedAt:       release.PublishedAt.Format("2006-01-02T15:04:05Z"),
+		PublishedAt:       release.PublishedAt.UTC().Format(time.RFC3339),
 		IsUpdateAvailable: isUpdateAvailable,
 	}, nil
 }
 
+// GetLatestReleaseInfo fetches the latest published release and compares it with the running version
+// Dev builds never report a newer release.
+func GetLatestReleaseInfo() (ReleaseInfo, error) {
+	release, err := FetchLatestRelease()
+	if err != nil {
+		return ReleaseInfo{}, fmt.Errorf("failed to fetch latest release: %w", err)
+	}
+	return newReleaseInfo(release, GetVersion()), nil
+}
+
+// newReleaseInfo describes a release relative to the current version
+func newReleaseInfo(release *GitHubRelease, currentVersion string) ReleaseInfo {
+	return ReleaseInfo{
+		TagName:        release.TagName,
+		Name:           release.Name,
+		PublishedAt:    release.PublishedAt.UTC().Format(time.RFC3339),
+		HTMLURL:        release.HTMLURL,
+		Body:           release.Body,
+		CurrentVersion: currentVersion,
+		IsNewer:        !isDevBuild(currentVersion) && IsNewer(currentVersion, release.TagName),
+	}
+}
+
+// IsNewer reports whether latest is a higher semantic version than current
+// A "v" prefix is ignored and pre-releases sort before their release (1.2.0-rc.1 < 1.2.0).
+// Unparseable versions are never newer.
+func IsNewer(current, latest string) bool {
+	newer, err := compareVersions(current, latest)
+	return err == nil && newer
+}
+
+// compareVersions reports whether latest is greater than current
+func compareVersions(current, latest string) (bool, error) {
+	currentNormalized := normalizeVersion(current)
+	latestNormalized := normalizeVersion(latest)
+
+	currentVer, err := hv.NewVersion(currentNormalized)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse current version '%s': %w", currentNormalized, err)
+	}
+
+	latestVer, err := hv.NewVersion(latestNormalized)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse latest version '%s': %w", latestNormalized, err)
+	}
+
+	return latestVer.GreaterThan(currentVer), nil
+}
+
 // normalizeVersion removes the 'v' prefix from version strings if present
 func normalizeVersion(v string) string {
 	v = strings.TrimSpace(v)
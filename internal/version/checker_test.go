@@ -2,6 +2,7 @@ package version
 
 import (
 	"testing"
+	"time"
 )
 
 func TestNormalizeVersion(t *testing.T) {
@@ -194,3 +195,54 @@ func TestCheckForUpdates_VersionComparison(t *testing.T) {
 // - Version normalization (remove v prefix) ✓
 // - Dev build skipping ✓
 // - Version comparison logic (via normalizeVersion + go-version library) ✓
+
+func TestIsNewer(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		latest  string
+		want    bool
+	}{
+		{name: "patch release", current: "v1.2.3", latest: "v1.2.4", want: true},
+		{name: "mixed v prefix", current: "1.2.3", latest: "V1.3.0", want: true},
+		{name: "same version", current: "v1.2.3", latest: "1.2.3", want: false},
+		{name: "older release", current: "v2.0.0", latest: "v1.9.9", want: false},
+		{name: "numeric not lexical", current: "v1.9.0", latest: "v1.10.0", want: true},
+		{name: "release after its pre-release", current: "v1.3.0-rc.1", latest: "v1.3.0", want: true},
+		{name: "pre-release before its release", current: "v1.3.0", latest: "v1.3.0-rc.1", want: false},
+		{name: "pre-release ordering", current: "v1.3.0-alpha", latest: "v1.3.0-beta", want: true},
+		{name: "unparseable current", current: "dev", latest: "v1.0.0", want: false},
+		{name: "unparseable latest", current: "v1.0.0", latest: "latest", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNewer(tt.current, tt.latest); got != tt.want {
+				t.Errorf("IsNewer(%q, %q) = %v, want %v", tt.current, tt.latest, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewReleaseInfo(t *testing.T) {
+	release := &GitHubRelease{
+		TagName:     "v1.3.0",
+		Name:        "Release v1.3.0",
+		Body:        "## Changes\n- Faster monitors",
+		HTMLURL:     "https://github.com/B87/pubsub-gui/releases/tag/v1.3.0",
+		PublishedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	info := newReleaseInfo(release, "v1.2.0")
+	if !info.IsNewer || info.Body != release.Body || info.PublishedAt != "2026-03-01T12:00:00Z" || info.CurrentVersion != "v1.2.0" {
+		t.Errorf("newReleaseInfo() = %+v", info)
+	}
+	if info := newReleaseInfo(release, "dev"); info.IsNewer {
+		t.Error("newReleaseInfo() IsNewer = true for a dev build, want false")
+	}
+
+	// Times in another zone are reported in UTC
+	release.PublishedAt = time.Date(2026, 3, 1, 14, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	if info := newReleaseInfo(release, "v1.2.0"); info.PublishedAt != "2026-03-01T12:00:00Z" {
+		t.Errorf("newReleaseInfo() PublishedAt = %q, want 2026-03-01T12:00:00Z", info.PublishedAt)
+	}
+}
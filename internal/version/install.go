@@ -0,0 +1,302 @@
+// Package version provides version checking and update functionality
+package version
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// maxExtractedFileBytes bounds a single file extracted from an update archive
+const maxExtractedFileBytes = 1024 * 1024 * 1024
+
+// Platform of the running app; variables so tests can pick another platform
+var (
+	currentOS   = runtime.GOOS
+	currentArch = runtime.GOARCH
+)
+
+// runningAppImage returns the path of the AppImage the app runs from, or "" when it is not an AppImage
+func runningAppImage() string {
+	return os.Getenv("APPIMAGE")
+}
+
+// InstallUpdate replaces the installed app with an update downloaded by DownloadUpdate
+// The file's checksum is checked again first, and its download directory is removed afterwards, whether or not
+// the install succeeded. The running app keeps its version: the update is used from the next start.
+func InstallUpdate(update DownloadedUpdate) error {
+	defer update.Remove()
+	if err := update.Verify(); err != nil {
+		return err
+	}
+	return installArchive(update.Path)
+}
+
+// installArchive installs an update file for the running platform
+// AppImages and Linux/Windows binaries are replaced in place, macOS replaces the .app bundle.
+func installArchive(archivePath string) error {
+	name := filepath.Base(archivePath)
+	if !strings.HasPrefix(name, assetPrefix(currentOS, currentArch)) {
+		return fmt.Errorf("%s is not an update for %s/%s", name, currentOS, currentArch)
+	}
+	if _, err := os.Stat(archivePath); err != nil {
+		return fmt.Errorf("update file not found: %w", err)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate the running app: %w", err)
+	}
+	if exe, err = filepath.EvalSymlinks(exe); err != nil {
+		return fmt.Errorf("failed to locate the running app: %w", err)
+	}
+
+	switch {
+	case strings.HasSuffix(name, assetExtAppImage) && currentOS == "linux":
+		target := runningAppImage()
+		if target == "" {
+			return fmt.Errorf("the app is not running from an AppImage: start %s directly instead", archivePath)
+		}
+		return installFile(target, func(staged string) error { return copyFile(archivePath, staged) })
+	case strings.HasSuffix(name, assetExtTarGz) && currentOS == "darwin":
+		bundle, err := appBundlePath(exe)
+		if err != nil {
+			return err
+		}
+		return installBundle(bundle, archivePath)
+	case strings.HasSuffix(name, assetExtTarGz) && currentOS == "linux":
+		return installFile(exe, func(staged string) error { return extractTarFile(archivePath, GitHubRepo, staged) })
+	case strings.HasSuffix(name, assetExtZip) && currentOS == "windows":
+		return installFile(exe, func(staged string) error { return extractZipFile(archivePath, GitHubRepo+".exe", staged) })
+	default:
+		return fmt.Errorf("%s cannot be installed on %s: install it manually", name, currentOS)
+	}
+}
+
+// installFile writes a new executable next to target with write, then swaps it in
+func installFile(target string, write func(staged string) error) error {
+	staged := target + ".new"
+	if err := write(staged); err != nil {
+		os.Remove(staged)
+		return err
+	}
+	if err := os.Chmod(staged, 0755); err != nil {
+		os.Remove(staged)
+		return fmt.Errorf("failed to make the update executable: %w", err)
+	}
+	return swapInPlace(target, staged)
+}
+
+// installBundle extracts the .app bundle from a macOS archive next to the installed bundle, then swaps it in
+func installBundle(bundle, archivePath string) error {
+	stageDir, err := os.MkdirTemp(filepath.Dir(bundle), ".pubsub-gui-update-")
+	if err != nil {
+		return fmt.Errorf("failed to prepare the update (is %s writable?): %w", filepath.Dir(bundle), err)
+	}
+	defer os.RemoveAll(stageDir)
+
+	root := GitHubRepo + ".app"
+	if err := extractTarTree(archivePath, root, stageDir); err != nil {
+		return err
+	}
+	return swapInPlace(bundle, filepath.Join(stageDir, root))
+}
+
+// swapInPlace replaces target with replacement, keeping target if the swap fails
+// The previous version is moved aside first: Windows allows renaming a running executable but not
+// deleting it, so a leftover .old file is removed by the next update.
+func swapInPlace(target, replacement string) error {
+	old := target + ".old"
+	os.RemoveAll(old)
+	if err := os.Rename(target, old); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", target, err)
+	}
+	if err := os.Rename(replacement, target); err != nil {
+		if restoreErr := os.Rename(old, target); restoreErr != nil {
+			return fmt.Errorf("failed to replace %s: %w (previous version left at %s)", target, err, old)
+		}
+		return fmt.Errorf("failed to replace %s: %w", target, err)
+	}
+	os.RemoveAll(old)
+	return nil
+}
+
+// appBundlePath returns the .app bundle containing a macOS executable
+func appBundlePath(exe string) (string, error) {
+	for dir := filepath.Dir(exe); ; dir = filepath.Dir(dir) {
+		if strings.HasSuffix(dir, ".app") {
+			return dir, nil
+		}
+		if filepath.Dir(dir) == dir {
+			return "", fmt.Errorf("the app is not running from an .app bundle: install the update manually")
+		}
+	}
+}
+
+// copyFile copies src to a new file at dst
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open update: %w", err)
+	}
+	defer in.Close()
+	return writeFile(dst, in)
+}
+
+// writeFile writes r to a new file at dst, bounded by maxExtractedFileBytes
+func writeFile(dst string, r io.Reader) error {
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return fmt.Errorf("failed to write update: %w", err)
+	}
+	n, err := io.Copy(out, io.LimitReader(r, maxExtractedFileBytes+1))
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write update: %w", err)
+	}
+	if n > maxExtractedFileBytes {
+		return fmt.Errorf("update file is larger than %d bytes", int64(maxExtractedFileBytes))
+	}
+	return nil
+}
+
+// openTarGz opens a .tar.gz archive; the returned function closes it
+func openTarGz(archivePath string) (*tar.Reader, func(), error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open update: %w", err)
+	}
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to read update archive: %w", err)
+	}
+	return tar.NewReader(gz), func() { gz.Close(); file.Close() }, nil
+}
+
+// cleanArchivePath normalizes an archive entry name; ok is false for absolute or escaping names
+func cleanArchivePath(name string) (string, bool) {
+	clean := filepath.ToSlash(filepath.Clean(strings.TrimPrefix(name, "./")))
+	if clean == "." || strings.HasPrefix(clean, "/") || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", false
+	}
+	return clean, true
+}
+
+// extractTarFile extracts the regular file named name from a .tar.gz archive to dst
+func extractTarFile(archivePath, name, dst string) error {
+	reader, closeArchive, err := openTarGz(archivePath)
+	if err != nil {
+		return err
+	}
+	defer closeArchive()
+
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("update archive does not contain %s", name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read update archive: %w", err)
+		}
+		if clean, ok := cleanArchivePath(header.Name); ok && clean == name && header.Typeflag == tar.TypeReg {
+			return writeFile(dst, reader)
+		}
+	}
+}
+
+// extractTarTree extracts the directory root and everything below it from a .tar.gz archive into destDir
+// Other entries are ignored; links must stay inside root.
+func extractTarTree(archivePath, root, destDir string) error {
+	reader, closeArchive, err := openTarGz(archivePath)
+	if err != nil {
+		return err
+	}
+	defer closeArchive()
+
+	found := false
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read update archive: %w", err)
+		}
+		clean, ok := cleanArchivePath(header.Name)
+		if !ok {
+			return fmt.Errorf("update archive has an invalid entry: %s", header.Name)
+		}
+		if clean != root && !strings.HasPrefix(clean, root+"/") {
+			continue
+		}
+		found = true
+		target := filepath.Join(destDir, filepath.FromSlash(clean))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return fmt.Errorf("failed to extract update: %w", err)
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return fmt.Errorf("failed to extract update: %w", err)
+			}
+			if err := writeFile(target, reader); err != nil {
+				return err
+			}
+			if err := os.Chmod(target, header.FileInfo().Mode().Perm()); err != nil {
+				return fmt.Errorf("failed to extract update: %w", err)
+			}
+		case tar.TypeSymlink:
+			linked := filepath.ToSlash(filepath.Join(filepath.Dir(clean), header.Linkname))
+			if filepath.IsAbs(header.Linkname) || (linked != root && !strings.HasPrefix(linked, root+"/")) {
+				return fmt.Errorf("update archive has a link outside the app: %s", header.Name)
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return fmt.Errorf("failed to extract update: %w", err)
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return fmt.Errorf("failed to extract update: %w", err)
+			}
+		default:
+			return fmt.Errorf("update archive has an unsupported entry: %s", header.Name)
+		}
+	}
+	if !found {
+		return fmt.Errorf("update archive does not contain %s", root)
+	}
+	return nil
+}
+
+// extractZipFile extracts the file named name from a .zip archive to dst
+func extractZipFile(archivePath, name, dst string) error {
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to read update archive: %w", err)
+	}
+	defer archive.Close()
+
+	for _, file := range archive.File {
+		clean, ok := cleanArchivePath(file.Name)
+		if !ok || clean != name || file.FileInfo().IsDir() {
+			continue
+		}
+		r, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("failed to read update archive: %w", err)
+		}
+		defer r.Close()
+		return writeFile(dst, r)
+	}
+	return fmt.Errorf("update archive does not contain %s", name)
+}
@@ -0,0 +1,174 @@
+package version
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// tarEntry is a file, directory (content "/") or symlink (link set) written by writeTarGz
+type tarEntry struct {
+	name    string
+	content string
+	link    string
+}
+
+func writeTarGz(t *testing.T, entries ...tarEntry) string {
+	t.Helper()
+	archivePath := filepath.Join(t.TempDir(), "update.tar.gz")
+	file, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0755, Typeflag: tar.TypeReg, Size: int64(len(entry.content))}
+		switch {
+		case entry.link != "":
+			header.Typeflag, header.Linkname, header.Size = tar.TypeSymlink, entry.link, 0
+		case entry.content == "/":
+			header.Typeflag, header.Size = tar.TypeDir, 0
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatalf("WriteHeader() error = %v", err)
+		}
+		if header.Typeflag == tar.TypeReg {
+			tw.Write([]byte(entry.content))
+		}
+	}
+	tw.Close()
+	gz.Close()
+	file.Close()
+	return archivePath
+}
+
+func TestExtractTarTree(t *testing.T) {
+	archivePath := writeTarGz(t,
+		tarEntry{name: "./pubsub-gui.app/", content: "/"},
+		tarEntry{name: "./pubsub-gui.app/Contents/MacOS/pubsub-gui", content: "binary"},
+		tarEntry{name: "./pubsub-gui.app/Contents/Current", link: "MacOS"},
+		tarEntry{name: "./other.txt", content: "ignored"},
+	)
+	dest := t.TempDir()
+
+	if err := extractTarTree(archivePath, "pubsub-gui.app", dest); err != nil {
+		t.Fatalf("extractTarTree() error = %v", err)
+	}
+	got, err := os.ReadFile(filepath.Join(dest, "pubsub-gui.app", "Contents", "Current", "pubsub-gui"))
+	if err != nil || string(got) != "binary" {
+		t.Errorf("extracted binary = %q, %v, want %q", got, err, "binary")
+	}
+	if _, err := os.Stat(filepath.Join(dest, "other.txt")); !os.IsNotExist(err) {
+		t.Errorf("entry outside the bundle was extracted (Stat error = %v)", err)
+	}
+}
+
+func TestExtractTarTree_RejectsEscapes(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry tarEntry
+	}{
+		{name: "parent path", entry: tarEntry{name: "../evil", content: "x"}},
+		{name: "link outside the bundle", entry: tarEntry{name: "pubsub-gui.app/link", link: "../../etc"}},
+		{name: "absolute link", entry: tarEntry{name: "pubsub-gui.app/link", link: "/etc/passwd"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archivePath := writeTarGz(t, tarEntry{name: "pubsub-gui.app/", content: "/"}, tt.entry)
+			if err := extractTarTree(archivePath, "pubsub-gui.app", t.TempDir()); err == nil {
+				t.Error("extractTarTree() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestExtractTarFile(t *testing.T) {
+	archivePath := writeTarGz(t, tarEntry{name: "pubsub-gui", content: "binary"})
+	dst := filepath.Join(t.TempDir(), "pubsub-gui.new")
+
+	if err := extractTarFile(archivePath, "pubsub-gui", dst); err != nil {
+		t.Fatalf("extractTarFile() error = %v", err)
+	}
+	if got, _ := os.ReadFile(dst); string(got) != "binary" {
+		t.Errorf("extracted file = %q, want %q", got, "binary")
+	}
+	if err := extractTarFile(archivePath, "missing", dst); err == nil {
+		t.Error("extractTarFile(missing) error = nil, want error")
+	}
+}
+
+func TestExtractZipFile(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "update.zip")
+	file, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	zw := zip.NewWriter(file)
+	w, _ := zw.Create("pubsub-gui.exe")
+	w.Write([]byte("binary"))
+	zw.Close()
+	file.Close()
+
+	dst := filepath.Join(t.TempDir(), "pubsub-gui.exe.new")
+	if err := extractZipFile(archivePath, "pubsub-gui.exe", dst); err != nil {
+		t.Fatalf("extractZipFile() error = %v", err)
+	}
+	if got, _ := os.ReadFile(dst); string(got) != "binary" {
+		t.Errorf("extracted file = %q, want %q", got, "binary")
+	}
+}
+
+func TestSwapInPlace(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "pubsub-gui")
+	replacement := filepath.Join(dir, "pubsub-gui.new")
+	os.WriteFile(target, []byte("old"), 0755)
+	os.WriteFile(target+".old", []byte("leftover"), 0755)
+	os.WriteFile(replacement, []byte("new"), 0755)
+
+	if err := swapInPlace(target, replacement); err != nil {
+		t.Fatalf("swapInPlace() error = %v", err)
+	}
+	if got, _ := os.ReadFile(target); string(got) != "new" {
+		t.Errorf("target = %q, want %q", got, "new")
+	}
+	for _, leftover := range []string{replacement, target + ".old"} {
+		if _, err := os.Stat(leftover); !os.IsNotExist(err) {
+			t.Errorf("%s still exists (Stat error = %v)", filepath.Base(leftover), err)
+		}
+	}
+}
+
+func TestInstallUpdate_RejectsOtherPlatforms(t *testing.T) {
+	setPlatform(t, "linux", "amd64")
+	archivePath := filepath.Join(t.TempDir(), "pubsub-gui_windows_amd64_v1.2.0.zip")
+	os.WriteFile(archivePath, []byte("zip"), 0600)
+
+	if err := installArchive(archivePath); err == nil {
+		t.Error("installArchive() error = nil, want error for another platform's update")
+	}
+}
+
+func TestInstallUpdate_RejectsModifiedFile(t *testing.T) {
+	setPlatform(t, "linux", "amd64")
+	dir := filepath.Join(t.TempDir(), "pubsub-gui-update-1")
+	os.Mkdir(dir, 0700)
+	archivePath := filepath.Join(dir, "pubsub-gui_linux_amd64_v1.2.0.tar.gz")
+	os.WriteFile(archivePath, []byte("replaced"), 0600)
+	sum := sha256.Sum256([]byte("original"))
+
+	update := DownloadedUpdate{Path: archivePath, SHA256: hex.EncodeToString(sum[:]), Version: "v1.2.0"}
+	if err := InstallUpdate(update); err == nil || !strings.Contains(err.Error(), "changed since it was downloaded") {
+		t.Errorf("InstallUpdate() error = %v, want checksum error", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("download directory still exists after a failed install (Stat error = %v)", err)
+	}
+}
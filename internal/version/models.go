@@ -5,13 +5,21 @@ import "time"
 
 // GitHubRelease represents a GitHub release from the API
 type GitHubRelease struct {
-	TagName     string    `json:"tag_name"`
-	Name        string    `json:"name"`
-	Body        string    `json:"body"`
-	HTMLURL     string    `json:"html_url"`
-	PublishedAt time.Time `json:"published_at"`
-	Draft       bool      `json:"draft"`
-	Prerelease  bool      `json:"prerelease"`
+	TagName     string         `json:"tag_name"`
+	Name        string         `json:"name"`
+	Body        string         `json:"body"`
+	HTMLURL     string         `json:"html_url"`
+	PublishedAt time.Time      `json:"published_at"`
+	Draft       bool           `json:"draft"`
+	Prerelease  bool           `json:"prerelease"`
+	Assets      []ReleaseAsset `json:"assets"`
+}
+
+// ReleaseAsset represents a file attached to a GitHub release
+type ReleaseAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+	Size               int64  `json:"size"`
 }
 
 // UpdateInfo represents information about an available update
@@ -23,3 +31,14 @@ type UpdateInfo struct {
 	PublishedAt       string `json:"publishedAt"`
 	IsUpdateAvailable bool   `json:"isUpdateAvailable"`
 }
+
+// ReleaseInfo describes the latest release for the changelog shown in the upgrade flow
+type ReleaseInfo struct {
+	TagName        string `json:"tagName"`
+	Name           string `json:"name"`
+	PublishedAt    string `json:"publishedAt"`
+	HTMLURL        string `json:"htmlUrl"`
+	Body           string `json:"body"` // Release notes as raw markdown
+	CurrentVersion string `json:"currentVersion"`
+	IsNewer        bool   `json:"isNewer"` // Whether the release is newer than the running version
+}
@@ -0,0 +1,275 @@
+// Package version provides version checking and update functionality
+package version
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"pubsub-gui/internal/models"
+)
+
+// Update download limits
+const (
+	maxChecksumFileBytes   = 1024 * 1024
+	downloadProgressPeriod = 200 * time.Millisecond // Progress is reported at most this often (and when done)
+)
+
+// Release asset file extensions, as built by the release workflow
+const (
+	assetExtAppImage = ".AppImage"
+	assetExtTarGz    = ".tar.gz"
+	assetExtZip      = ".zip"
+)
+
+// downloadClient downloads release assets; the request context bounds the download
+var downloadClient = &http.Client{}
+
+// DownloadProgress reports the progress of an update download
+type DownloadProgress struct {
+	Asset      string `json:"asset"`
+	Downloaded int64  `json:"downloaded"`
+	Total      int64  `json:"total"` // 0 when the size is unknown
+}
+
+// assetExtensions returns the asset extensions usable on a platform, preferred first
+// Linux prefers the AppImage when the app runs from one and the tarball otherwise.
+func assetExtensions(goos string, appImage bool) []string {
+	switch goos {
+	case "linux":
+		if appImage {
+			return []string{assetExtAppImage, assetExtTarGz}
+		}
+		return []string{assetExtTarGz, assetExtAppImage}
+	case "darwin":
+		return []string{assetExtTarGz}
+	case "windows":
+		return []string{assetExtZip}
+	default:
+		return nil
+	}
+}
+
+// assetPrefix returns the name prefix of a platform's release assets (pubsub-gui_<os>_<arch>_<version>.<ext>)
+func assetPrefix(goos, goarch string) string {
+	return fmt.Sprintf("%s_%s_%s_", GitHubRepo, goos, goarch)
+}
+
+// SelectAsset picks the release asset for an OS and architecture
+// appImage reports whether the running app is an AppImage (Linux only).
+func SelectAsset(release *GitHubRelease, goos, goarch string, appImage bool) (*ReleaseAsset, error) {
+	prefix := assetPrefix(goos, goarch)
+	for _, ext := range assetExtensions(goos, appImage) {
+		for i := range release.Assets {
+			asset := &release.Assets[i]
+			if strings.HasPrefix(asset.Name, prefix) && strings.HasSuffix(asset.Name, ext) {
+				return asset, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %s has no download for %s/%s, get it from %s", models.ErrNoUpdateAsset, release.TagName, goos, goarch, release.HTMLURL)
+}
+
+// findChecksumAsset returns the release's checksums file, or nil if none was published
+func findChecksumAsset(release *GitHubRelease) *ReleaseAsset {
+	for i := range release.Assets {
+		if strings.HasSuffix(release.Assets[i].Name, "_checksums.txt") {
+			return &release.Assets[i]
+		}
+	}
+	return nil
+}
+
+// parseChecksum finds the SHA-256 of a file in sha256sum/shasum output ("<hex>  ./name" or "<hex> *name")
+func parseChecksum(data []byte, name string) (string, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		file := path.Base(strings.TrimPrefix(fields[1], "*"))
+		if file == name && len(fields[0]) == sha256.Size*2 {
+			return strings.ToLower(fields[0]), true
+		}
+	}
+	return "", false
+}
+
+// DownloadedUpdate is an update file written by DownloadUpdate
+type DownloadedUpdate struct {
+	Path    string // Downloaded file, alone in its temporary directory
+	SHA256  string // Hex SHA-256 of the file when it was downloaded
+	Version string // Release tag
+}
+
+// Remove deletes the update's temporary download directory
+func (u DownloadedUpdate) Remove() {
+	os.RemoveAll(filepath.Dir(u.Path))
+}
+
+// Verify checks that the downloaded file still has the checksum recorded when it was downloaded
+func (u DownloadedUpdate) Verify() error {
+	file, err := os.Open(u.Path)
+	if err != nil {
+		return fmt.Errorf("update file not found: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return fmt.Errorf("failed to read update: %w", err)
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != u.SHA256 {
+		return fmt.Errorf("update file %s changed since it was downloaded: expected SHA-256 %s, got %s", filepath.Base(u.Path), u.SHA256, actual)
+	}
+	return nil
+}
+
+// DownloadUpdate downloads the release asset for the running platform into a new temporary directory
+// The file is verified against the release's checksums file when one is published. onProgress (optional)
+// is called periodically and once the download is complete.
+func DownloadUpdate(ctx context.Context, release *GitHubRelease, onProgress func(DownloadProgress)) (DownloadedUpdate, error) {
+	asset, err := SelectAsset(release, currentOS, currentArch, runningAppImage() != "")
+	if err != nil {
+		return DownloadedUpdate{}, err
+	}
+
+	expected := ""
+	if checksums := findChecksumAsset(release); checksums != nil {
+		data, err := fetchAsset(ctx, checksums.BrowserDownloadURL, maxChecksumFileBytes)
+		if err != nil {
+			return DownloadedUpdate{}, fmt.Errorf("failed to download checksums: %w", err)
+		}
+		sum, ok := parseChecksum(data, asset.Name)
+		if !ok {
+			return DownloadedUpdate{}, fmt.Errorf("checksums file %s has no entry for %s", checksums.Name, asset.Name)
+		}
+		expected = sum
+	}
+
+	dir, err := os.MkdirTemp("", "pubsub-gui-update-")
+	if err != nil {
+		return DownloadedUpdate{}, fmt.Errorf("failed to create download directory: %w", err)
+	}
+	filePath := filepath.Join(dir, asset.Name)
+	actual, err := downloadAsset(ctx, asset, filePath, onProgress)
+	if err != nil {
+		os.RemoveAll(dir)
+		return DownloadedUpdate{}, err
+	}
+	if expected != "" && actual != expected {
+		os.RemoveAll(dir)
+		return DownloadedUpdate{}, fmt.Errorf("checksum mismatch for %s: expected %s, got %s", asset.Name, expected, actual)
+	}
+	return DownloadedUpdate{Path: filePath, SHA256: actual, Version: release.TagName}, nil
+}
+
+// newAssetRequest creates a download request for a release asset URL
+func newAssetRequest(ctx context.Context, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", fmt.Sprintf("pubsub-gui/%s", GetVersion()))
+	req.Header.Set("Accept", "application/octet-stream")
+	return req, nil
+}
+
+// fetchAsset downloads a small release asset into memory
+func fetchAsset(ctx context.Context, url string, limit int64) ([]byte, error) {
+	req, err := newAssetRequest(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("file is larger than %d bytes", limit)
+	}
+	return data, nil
+}
+
+// downloadAsset streams a release asset to filePath and returns its SHA-256
+func downloadAsset(ctx context.Context, asset *ReleaseAsset, filePath string, onProgress func(DownloadProgress)) (string, error) {
+	req, err := newAssetRequest(ctx, asset.BrowserDownloadURL)
+	if err != nil {
+		return "", err
+	}
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", asset.Name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download %s: unexpected status code: %d", asset.Name, resp.StatusCode)
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	progress := DownloadProgress{Asset: asset.Name, Total: resp.ContentLength}
+	if progress.Total <= 0 {
+		progress.Total = asset.Size
+	}
+	hash := sha256.New()
+	counter := &progressWriter{progress: progress, report: onProgress}
+	if _, err := io.Copy(io.MultiWriter(file, hash, counter), resp.Body); err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", asset.Name, err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", filePath, err)
+	}
+	if counter.progress.Total > 0 && counter.progress.Downloaded != counter.progress.Total {
+		return "", fmt.Errorf("download of %s is incomplete: got %d of %d bytes", asset.Name, counter.progress.Downloaded, counter.progress.Total)
+	}
+	counter.flush()
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// progressWriter counts downloaded bytes and reports them at most every downloadProgressPeriod
+type progressWriter struct {
+	progress     DownloadProgress
+	report       func(DownloadProgress)
+	lastReported time.Time
+}
+
+// Write counts p and reports progress when due
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.progress.Downloaded += int64(len(p))
+	if w.report != nil && time.Since(w.lastReported) >= downloadProgressPeriod {
+		w.lastReported = time.Now()
+		w.report(w.progress)
+	}
+	return len(p), nil
+}
+
+// flush reports the final progress
+func (w *progressWriter) flush() {
+	if w.report != nil {
+		w.report(w.progress)
+	}
+}
@@ -0,0 +1,180 @@
+package version
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pubsub-gui/internal/models"
+)
+
+// setPlatform makes the updater act as if it ran on goos/goarch, outside an AppImage
+func setPlatform(t *testing.T, goos, goarch string) {
+	t.Helper()
+	oldOS, oldArch := currentOS, currentArch
+	currentOS, currentArch = goos, goarch
+	t.Setenv("APPIMAGE", "")
+	t.Cleanup(func() { currentOS, currentArch = oldOS, oldArch })
+}
+
+func testRelease() *GitHubRelease {
+	return &GitHubRelease{
+		TagName: "v1.2.0",
+		HTMLURL: "https://github.com/B87/pubsub-gui/releases/tag/v1.2.0",
+		Assets: []ReleaseAsset{
+			{Name: "pubsub-gui_darwin_amd64_v1.2.0.tar.gz"},
+			{Name: "pubsub-gui_darwin_arm64_v1.2.0.tar.gz"},
+			{Name: "pubsub-gui_linux_amd64_v1.2.0.AppImage"},
+			{Name: "pubsub-gui_linux_amd64_v1.2.0.tar.gz"},
+			{Name: "pubsub-gui_windows_amd64_v1.2.0.zip"},
+			{Name: "pubsub-gui_v1.2.0_checksums.txt"},
+		},
+	}
+}
+
+func TestSelectAsset(t *testing.T) {
+	tests := []struct {
+		name     string
+		goos     string
+		goarch   string
+		appImage bool
+		want     string
+	}{
+		{name: "macOS Apple Silicon", goos: "darwin", goarch: "arm64", want: "pubsub-gui_darwin_arm64_v1.2.0.tar.gz"},
+		{name: "macOS Intel", goos: "darwin", goarch: "amd64", want: "pubsub-gui_darwin_amd64_v1.2.0.tar.gz"},
+		{name: "Linux tarball", goos: "linux", goarch: "amd64", want: "pubsub-gui_linux_amd64_v1.2.0.tar.gz"},
+		{name: "Linux AppImage", goos: "linux", goarch: "amd64", appImage: true, want: "pubsub-gui_linux_amd64_v1.2.0.AppImage"},
+		{name: "Windows", goos: "windows", goarch: "amd64", want: "pubsub-gui_windows_amd64_v1.2.0.zip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asset, err := SelectAsset(testRelease(), tt.goos, tt.goarch, tt.appImage)
+			if err != nil {
+				t.Fatalf("SelectAsset() error = %v", err)
+			}
+			if asset.Name != tt.want {
+				t.Errorf("SelectAsset() = %s, want %s", asset.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectAsset_NoMatch(t *testing.T) {
+	for _, platform := range [][2]string{{"linux", "arm64"}, {"windows", "arm64"}, {"freebsd", "amd64"}} {
+		_, err := SelectAsset(testRelease(), platform[0], platform[1], false)
+		if !errors.Is(err, models.ErrNoUpdateAsset) {
+			t.Errorf("SelectAsset(%s/%s) error = %v, want ErrNoUpdateAsset", platform[0], platform[1], err)
+		}
+	}
+}
+
+func TestParseChecksum(t *testing.T) {
+	sum := "ab" + fmt.Sprintf("%062d", 0)
+	data := []byte("not a checksum line\n" +
+		sum + "  ./pubsub-gui_linux_amd64_v1.2.0.tar.gz\n" +
+		"CD" + fmt.Sprintf("%062d", 1) + " *pubsub-gui_windows_amd64_v1.2.0.zip\n")
+
+	if got, ok := parseChecksum(data, "pubsub-gui_linux_amd64_v1.2.0.tar.gz"); !ok || got != sum {
+		t.Errorf("parseChecksum(linux) = %q, %v, want %q", got, ok, sum)
+	}
+	if got, ok := parseChecksum(data, "pubsub-gui_windows_amd64_v1.2.0.zip"); !ok || got != "cd"+fmt.Sprintf("%062d", 1) {
+		t.Errorf("parseChecksum(windows) = %q, %v, want lowercase checksum", got, ok)
+	}
+	if _, ok := parseChecksum(data, "pubsub-gui_darwin_arm64_v1.2.0.tar.gz"); ok {
+		t.Error("parseChecksum(darwin) found a checksum, want none")
+	}
+}
+
+// serveRelease serves a release whose Linux tarball has the given content and checksums file
+func serveRelease(t *testing.T, content []byte, checksums string) *GitHubRelease {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/asset":
+			w.Write(content)
+		case "/checksums":
+			w.Write([]byte(checksums))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	release := &GitHubRelease{
+		TagName: "v1.2.0",
+		Assets: []ReleaseAsset{
+			{Name: "pubsub-gui_linux_amd64_v1.2.0.tar.gz", BrowserDownloadURL: server.URL + "/asset", Size: int64(len(content))},
+		},
+	}
+	if checksums != "" {
+		release.Assets = append(release.Assets, ReleaseAsset{Name: "pubsub-gui_v1.2.0_checksums.txt", BrowserDownloadURL: server.URL + "/checksums"})
+	}
+	return release
+}
+
+func TestDownloadUpdate(t *testing.T) {
+	setPlatform(t, "linux", "amd64")
+	content := []byte("new release")
+	sum := sha256.Sum256(content)
+	release := serveRelease(t, content, hex.EncodeToString(sum[:])+"  ./pubsub-gui_linux_amd64_v1.2.0.tar.gz\n")
+
+	var last DownloadProgress
+	update, err := DownloadUpdate(context.Background(), release, func(p DownloadProgress) { last = p })
+	if err != nil {
+		t.Fatalf("DownloadUpdate() error = %v", err)
+	}
+	defer update.Remove()
+	if update.SHA256 != hex.EncodeToString(sum[:]) || update.Version != release.TagName {
+		t.Errorf("DownloadUpdate() = %+v, want SHA-256 %x and version %s", update, sum, release.TagName)
+	}
+	if err := update.Verify(); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+
+	got, err := os.ReadFile(update.Path)
+	if err != nil || string(got) != string(content) {
+		t.Errorf("downloaded file = %q, %v, want %q", got, err, content)
+	}
+	if last.Downloaded != int64(len(content)) || last.Total != int64(len(content)) {
+		t.Errorf("final progress = %+v, want %d of %d bytes", last, len(content), len(content))
+	}
+}
+
+func TestDownloadUpdate_ChecksumMismatch(t *testing.T) {
+	setPlatform(t, "linux", "amd64")
+	release := serveRelease(t, []byte("tampered"), fmt.Sprintf("%064d  ./pubsub-gui_linux_amd64_v1.2.0.tar.gz\n", 0))
+
+	if _, err := DownloadUpdate(context.Background(), release, nil); err == nil {
+		t.Fatal("DownloadUpdate() error = nil, want checksum mismatch")
+	}
+}
+
+func TestDownloadUpdate_WithoutChecksums(t *testing.T) {
+	setPlatform(t, "linux", "amd64")
+	release := serveRelease(t, []byte("new release"), "")
+
+	update, err := DownloadUpdate(context.Background(), release, nil)
+	if err != nil {
+		t.Fatalf("DownloadUpdate() error = %v", err)
+	}
+	update.Remove()
+	if _, err := os.Stat(filepath.Dir(update.Path)); !os.IsNotExist(err) {
+		t.Errorf("Remove() left the download directory (Stat error = %v)", err)
+	}
+}
+
+func TestDownloadUpdate_NoAsset(t *testing.T) {
+	setPlatform(t, "windows", "arm64")
+	release := serveRelease(t, []byte("new release"), "")
+
+	if _, err := DownloadUpdate(context.Background(), release, nil); !errors.Is(err, models.ErrNoUpdateAsset) {
+		t.Errorf("DownloadUpdate() error = %v, want ErrNoUpdateAsset", err)
+	}
+}
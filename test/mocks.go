@@ -88,12 +88,13 @@ type MockTemplateHandler struct {
 
 // MockMonitoringHandler is a mock for monitoring handler
 type MockMonitoringHandler struct {
-	StartMonitorFunc        func(subscriptionID string) error
+	StartMonitorFunc        func(subscriptionID string, options models.MonitorOptions) error
 	StopMonitorFunc         func(subscriptionID string) error
-	StartTopicMonitorFunc   func(topicID string, subscriptionID string) error
+	StartTopicMonitorFunc   func(topicID string, subscriptionID string, options models.MonitorOptions) error
 	StopTopicMonitorFunc    func(topicID string) error
 	GetBufferedMessagesFunc func(subscriptionID string) ([]subscriber.PubSubMessage, error)
-	ClearMessageBufferFunc  func(subscriptionID string) error
+	ClearMessageBufferFunc  func(subscriptionID string) (int, error)
+	ClearAllBuffersFunc     func() int
 }
 
 // MockConfigHandler is a mock for config handler